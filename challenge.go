@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"unicode/utf8"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/code"
+	"github.com/bitmap/wordle/internal/lang"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+const challengeUsage = `usage:
+  wordle challenge create <word> [-o file]
+  wordle challenge play <challenge or file>
+  wordle challenge result <result>`
+
+// Set a word for someone else to guess, play a challenge, or see how someone
+// did on a challenge you set.
+func runChallenge(args []string) error {
+	if len(args) < 2 {
+		return errors.New(challengeUsage)
+	}
+
+	switch args[0] {
+	case "create":
+		return createChallenge(args[1], args[2:])
+	case "play":
+		return playChallenge(args[1])
+	case "result":
+		return showChallengeResult(args[1])
+	}
+
+	return errors.New(challengeUsage)
+}
+
+// Print a sealed challenge for a word, or write it to a file.
+func createChallenge(word string, args []string) error {
+	flags := flag.NewFlagSet("challenge create", flag.ExitOnError)
+	out := flags.String("o", "", "write the challenge to a file")
+	flags.Parse(args)
+
+	// Any word that may be guessed can be set, not just the usual answers.
+	word = words.Language().Fold(word)
+	if !words.IsValidWord(word) {
+		return errors.New(word + " is not a valid word")
+	}
+
+	challenge := code.Challenge{Word: word, Language: words.Current().Language}.String()
+
+	if *out != "" {
+		return os.WriteFile(*out, []byte(challenge+"\n"), 0o644)
+	}
+
+	fmt.Println("Send this to your friend:\n\n  wordle challenge play " + challenge)
+	return nil
+}
+
+// Play a challenge given as a string or a file holding one.
+func playChallenge(arg string) error {
+	if data, err := os.ReadFile(arg); err == nil {
+		arg = string(data)
+	}
+
+	challenge, err := code.ParseChallenge(arg)
+	if err != nil {
+		return err
+	}
+	if n := utf8.RuneCountInString(challenge.Word); n < words.MinLength || n > words.MaxLength {
+		return fmt.Errorf("the challenge word must be %d to %d letters long", words.MinLength, words.MaxLength)
+	}
+
+	// The word is guessed among the words of its own language.
+	if lang.Get(challenge.Language) != words.Language() {
+		if err := words.Use(words.Source{Language: challenge.Language}); err != nil {
+			return err
+		}
+	}
+
+	opts := options()
+	opts.MaxGuesses = game.DefaultMaxGuesses
+
+	r := round{Multi: game.NewMulti(game.New(challenge.Word, opts)), kind: "challenge"}
+	play(r)
+	return r.finish()
+}
+
+// Show how someone did on a challenge.
+func showChallengeResult(arg string) error {
+	result, err := code.ParseChallengeResult(arg)
+	if err != nil {
+		return err
+	}
+
+	useLanguage(result.Language)
+	g := replayResult(game.Result{Answer: result.Word, Guesses: result.Guesses})
+	fmt.Println("\nYour challenge: " + words.Language().Upper(result.Word) + "\n")
+	render(os.Stdout, game.NewMulti(g))
+
+	if g.Status() == game.Won {
+		fmt.Printf("Solved in %d/%d.\n", len(g.Guesses()), g.MaxGuesses())
+	} else {
+		fmt.Println("Not solved.")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/daily"
+	"github.com/bitmap/wordle/internal/saved"
+)
+
+// Play today's puzzle, or a past one given by number or date. Every puzzle
+// can only be played once; after that its result is shown instead.
+func playDaily(args []string) error {
+	number := daily.Today()
+	if len(args) > 0 {
+		var err error
+		if number, err = daily.Parse(args[0]); err != nil {
+			return err
+		}
+	}
+
+	puzzle := fmt.Sprintf("#%d", number)
+
+	result, played, err := daily.Played(number)
+	if err != nil {
+		return err
+	}
+
+	if played {
+		fmt.Println("\nYou already played Wordle " + puzzle + " on " + daily.Date(number).Format("Jan 2, 2006") + ".\n")
+		gameOver(game.NewMulti(replayResult(result)))
+		return nil
+	}
+
+	// A puzzle that was left unfinished is picked up where it was left.
+	r := round{kind: "daily", puzzle: puzzle}
+	if s, ok, err := saved.Find(r.kind, r.puzzle); err != nil {
+		return err
+	} else if ok {
+		// Daily puzzles are played with the built-in words already, so
+		// there is nothing to go back to.
+		if r, _, err = resumeRound(s); err != nil {
+			return err
+		}
+	} else {
+		// Daily puzzles are always a single five-letter board with six
+		// guesses.
+		opts := options()
+		opts.MaxGuesses = game.DefaultMaxGuesses
+		r.Multi = game.NewMulti(game.New(daily.Answer(number), opts))
+	}
+
+	play(r)
+	return r.finish()
+}
+
+// Rebuild a finished game from its result.
+func replayResult(result game.Result) *game.Game {
+	g := game.New(result.Answer, result.Options)
+	for _, word := range result.Guesses {
+		g.Submit(word)
+	}
+	return g
+}
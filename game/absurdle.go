@@ -0,0 +1,62 @@
+package game
+
+import "unicode/utf8"
+
+// adversary picks the answer as late as possible, always keeping the largest
+// group of candidates that share a pattern for the latest guess.
+type adversary struct {
+	candidates []string
+}
+
+// narrow scores a guess against every remaining candidate, keeps the largest
+// group and returns its pattern. Ties go to the pattern that reveals the
+// least.
+func (a *adversary) narrow(guess string) int {
+	groups := map[int]int{}
+	for _, word := range a.candidates {
+		groups[pattern(guess, word)]++
+	}
+
+	best := -1
+	for code, size := range groups {
+		if best == -1 || size > groups[best] || size == groups[best] && code < best {
+			best = code
+		}
+	}
+
+	kept := a.candidates[:0]
+	for _, word := range a.candidates {
+		if pattern(guess, word) == best {
+			kept = append(kept, word)
+		}
+	}
+	a.candidates = kept
+
+	return best
+}
+
+// NewAbsurdle starts an adversarial game. No answer is fixed up front: after
+// each guess the game keeps the largest group of candidates that share a
+// pattern and shows that pattern. The player wins once only one candidate is
+// left and they guess it. All candidates must be the same length.
+func NewAbsurdle(candidates []string, opts Options) *Game {
+	g := New("", opts)
+	g.length = utf8.RuneCountInString(candidates[0])
+	g.known = NewConstraints(g.length)
+	g.adversary = &adversary{candidates: append([]string(nil), candidates...)}
+	return g
+}
+
+// Absurdle returns true if the answer is picked adversarially.
+func (g *Game) Absurdle() bool {
+	return g.adversary != nil
+}
+
+// Candidates returns the number of words the answer could still be. Only
+// absurdle games ever have more than one.
+func (g *Game) Candidates() int {
+	if g.adversary == nil {
+		return 1
+	}
+	return len(g.adversary.candidates)
+}
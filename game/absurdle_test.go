@@ -0,0 +1,48 @@
+package game
+
+import "testing"
+
+func TestAbsurdle(t *testing.T) {
+	candidates := []string{"those", "chose", "whose", "house", "crane", "slate"}
+	g := NewAbsurdle(candidates, Options{MaxGuesses: Unlimited})
+
+	// Guessing a candidate early never wins while others share a pattern.
+	for _, word := range []string{"those", "chose"} {
+		f, err := g.Submit(word)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.Solved() {
+			t.Fatalf("%s was accepted as the answer with %d candidates left", word, g.Candidates())
+		}
+		if got := g.Answer(); got != "" {
+			t.Errorf("Answer() = %q before the game is over", got)
+		}
+	}
+
+	if _, err := g.Submit("whose"); err != nil {
+		t.Fatal(err)
+	}
+
+	if g.Status() != Won || g.Answer() != "whose" {
+		t.Errorf("status %v with answer %q, want a win with whose", g.Status(), g.Answer())
+	}
+}
+
+func TestAbsurdleLargestGroup(t *testing.T) {
+	candidates := []string{"those", "chose", "whose", "crane", "slate"}
+	g := NewAbsurdle(candidates, Options{})
+
+	f, err := g.Submit("those")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// chose and whose share -gggg; every other group has one word.
+	if got := f.Colors(); got != "-gggg" {
+		t.Errorf("feedback %s, want -gggg", got)
+	}
+	if got := g.Candidates(); got != 2 {
+		t.Errorf("Candidates() = %d, want 2", got)
+	}
+}
@@ -0,0 +1,166 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Difficulty is how strictly revealed hints must be followed.
+type Difficulty int
+
+const (
+	// Normal games accept any valid word.
+	Normal Difficulty = iota
+	// Hard games keep green letters in place and require yellow letters.
+	Hard
+	// UltraHard games also forbid eliminated letters, yellow letters in
+	// positions already ruled out, and more copies of a letter than the
+	// answer can hold.
+	UltraHard
+)
+
+func (d Difficulty) String() string {
+	switch d {
+	case Hard:
+		return "hard"
+	case UltraHard:
+		return "ultra hard"
+	}
+	return "normal"
+}
+
+// Constraints is everything the feedback so far reveals about the answer.
+type Constraints struct {
+	fixed    []rune          // known letter at each position, or 0
+	excluded []map[rune]bool // letters ruled out at each position
+	min      map[rune]int    // fewest copies of a letter in the answer
+	max      map[rune]int    // most copies of a letter, when known
+}
+
+// NewConstraints returns constraints for words of the given length, with
+// nothing known yet.
+func NewConstraints(length int) *Constraints {
+	c := &Constraints{
+		fixed:    make([]rune, length),
+		excluded: make([]map[rune]bool, length),
+		min:      map[rune]int{},
+		max:      map[rune]int{},
+	}
+	for i := range c.excluded {
+		c.excluded[i] = map[rune]bool{}
+	}
+	return c
+}
+
+// Add narrows the constraints with the feedback of a guess.
+func (c *Constraints) Add(f Feedback) {
+	found := map[rune]int{}
+	capped := map[rune]bool{}
+
+	for i, t := range f {
+		switch t.State {
+		case Correct:
+			c.fixed[i] = t.Letter
+			found[t.Letter]++
+		case Present:
+			c.excluded[i][t.Letter] = true
+			found[t.Letter]++
+		case Absent:
+			// A gray tile means there are no more copies than were found.
+			c.excluded[i][t.Letter] = true
+			capped[t.Letter] = true
+		}
+	}
+
+	for r, n := range found {
+		c.min[r] = max(c.min[r], n)
+	}
+	for r := range capped {
+		c.max[r] = found[r]
+	}
+}
+
+// Check returns an error describing the first hint the word ignores at the
+// given difficulty.
+func (c *Constraints) Check(word string, d Difficulty) error {
+	if d == Normal {
+		return nil
+	}
+
+	letters := []rune(word)
+
+	// Green letters must stay where they are.
+	for i, r := range c.fixed {
+		if r != 0 && letters[i] != r {
+			return fmt.Errorf("%s letter must be %s", ordinal(i+1), upper(r))
+		}
+	}
+
+	// Yellow letters must be used, as many times as they were revealed.
+	for _, r := range sortedLetters(c.min) {
+		if n := c.min[r]; strings.Count(word, string(r)) < n {
+			if n == 1 {
+				return fmt.Errorf("guess must contain %s", upper(r))
+			}
+			return fmt.Errorf("guess must contain %s %d times", upper(r), n)
+		}
+	}
+
+	if d < UltraHard {
+		return nil
+	}
+
+	// Eliminated letters may not come back, and no letter may be used more
+	// often than the answer holds it.
+	for _, r := range sortedLetters(c.max) {
+		n := c.max[r]
+		switch count := strings.Count(word, string(r)); {
+		case count > n && n == 0:
+			return fmt.Errorf("%s is not in the word", upper(r))
+		case count > n && n == 1:
+			return fmt.Errorf("guess may contain %s only once", upper(r))
+		case count > n:
+			return fmt.Errorf("guess may contain %s only %d times", upper(r), n)
+		}
+	}
+
+	// Letters may not go back to a position already ruled out.
+	for i, r := range letters {
+		if c.excluded[i][r] {
+			return fmt.Errorf("%s letter can't be %s", ordinal(i+1), upper(r))
+		}
+	}
+
+	return nil
+}
+
+// Returns the keys of a letter count in alphabetical order, so the same word
+// always gets the same error.
+func sortedLetters(counts map[rune]int) []rune {
+	var letters []rune
+	for r := range counts {
+		letters = append(letters, r)
+	}
+	slices.Sort(letters)
+	return letters
+}
+
+func upper(r rune) string {
+	return strings.ToUpper(string(r))
+}
+
+// Returns 1st, 2nd, 3rd and so on.
+func ordinal(n int) string {
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		return fmt.Sprintf("%dth", n)
+	case n%10 == 1:
+		return fmt.Sprintf("%dst", n)
+	case n%10 == 2:
+		return fmt.Sprintf("%dnd", n)
+	case n%10 == 3:
+		return fmt.Sprintf("%drd", n)
+	}
+	return fmt.Sprintf("%dth", n)
+}
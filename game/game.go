@@ -0,0 +1,320 @@
+// Package game implements the rules of Wordle without any terminal I/O, so
+// that many independent games can run side by side in one process.
+package game
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultMaxGuesses is the number of guesses a player gets in a standard game.
+const DefaultMaxGuesses = 6
+
+// Unlimited guesses let the player keep going until the word is found.
+const Unlimited = -1
+
+// LetterState is what is known about a letter after it has been scored.
+type LetterState int
+
+const (
+	// Unknown letters have not been guessed yet.
+	Unknown LetterState = iota
+	// Absent letters are not in the answer.
+	Absent
+	// Present letters are in the answer, but somewhere else.
+	Present
+	// Correct letters are in the answer at this position.
+	Correct
+)
+
+// Tile is a single scored letter of a guess.
+type Tile struct {
+	Letter rune
+	State  LetterState
+}
+
+// Feedback is the scored result of a single guess.
+type Feedback []Tile
+
+// Word returns the guessed word the feedback was scored for.
+func (f Feedback) Word() string {
+	var b strings.Builder
+	for _, t := range f {
+		b.WriteRune(t.Letter)
+	}
+	return b.String()
+}
+
+// Colors returns the states of the feedback as text, one character per
+// letter: g for correct, y for present and - for absent.
+func (f Feedback) Colors() string {
+	b := make([]byte, len(f))
+	for i, t := range f {
+		switch t.State {
+		case Correct:
+			b[i] = 'g'
+		case Present:
+			b[i] = 'y'
+		default:
+			b[i] = '-'
+		}
+	}
+	return string(b)
+}
+
+// ParseFeedback reads back the feedback of a guess from its colors.
+func ParseFeedback(word, colors string) (Feedback, error) {
+	letters := []rune(word)
+	if len(letters) != len(colors) {
+		return nil, fmt.Errorf("%q does not have a color for every letter of %q", colors, word)
+	}
+
+	feedback := make(Feedback, len(letters))
+	for i, r := range letters {
+		feedback[i] = Tile{Letter: r, State: Absent}
+		switch colors[i] {
+		case 'g':
+			feedback[i].State = Correct
+		case 'y':
+			feedback[i].State = Present
+		case '-':
+		default:
+			return nil, fmt.Errorf("%q is not a color", colors[i])
+		}
+	}
+	return feedback, nil
+}
+
+// Solved returns true if every letter is correct.
+func (f Feedback) Solved() bool {
+	for _, t := range f {
+		if t.State != Correct {
+			return false
+		}
+	}
+	return len(f) > 0
+}
+
+// Status is the outcome of a game.
+type Status int
+
+const (
+	// Playing games still accept guesses.
+	Playing Status = iota
+	// Won games were solved within the allowed number of guesses.
+	Won
+	// Lost games ran out of guesses.
+	Lost
+)
+
+// ErrGameOver is returned when a guess is submitted to a finished game.
+var ErrGameOver = errors.New("the game is over")
+
+// ErrInvalidWord is returned when a guess is not in the word list.
+var ErrInvalidWord = errors.New("invalid word")
+
+// Dictionary tells which words may be guessed.
+type Dictionary interface {
+	IsValid(word string) bool
+}
+
+// Folder turns a word into the letters it is played with, the way a language
+// writes them in lower case.
+type Folder interface {
+	Fold(word string) string
+}
+
+// Options change the rules of a game. The zero value plays a standard game.
+type Options struct {
+	// MaxGuesses is the number of guesses allowed, or Unlimited. Zero means
+	// DefaultMaxGuesses.
+	MaxGuesses int
+
+	// Difficulty is how strictly revealed hints must be used in later
+	// guesses.
+	Difficulty Difficulty
+
+	// Words are the words that may be guessed. Nil allows any word of the
+	// right length.
+	Words Dictionary `json:"-"`
+
+	// Fold turns the answer and guesses into the letters they are played
+	// with. Nil lower-cases them with strings.ToLower, which is only right
+	// for languages without special casing, unlike Turkish.
+	Fold Folder `json:"-"`
+}
+
+// Game is a single round of Wordle.
+type Game struct {
+	answer    string
+	length    int
+	adversary *adversary
+	opts      Options
+	guesses   []Feedback
+	letters   map[rune]LetterState
+	known     *Constraints
+	status    Status
+}
+
+// New starts a game with the given answer.
+func New(answer string, opts Options) *Game {
+	if opts.MaxGuesses == 0 {
+		opts.MaxGuesses = DefaultMaxGuesses
+	}
+
+	return &Game{
+		answer:  fold(answer, opts),
+		length:  utf8.RuneCountInString(answer),
+		opts:    opts,
+		letters: map[rune]LetterState{},
+		known:   NewConstraints(utf8.RuneCountInString(answer)),
+	}
+}
+
+// Returns a word the way the options play it.
+func fold(word string, opts Options) string {
+	if opts.Fold == nil {
+		return strings.ToLower(word)
+	}
+	return opts.Fold.Fold(word)
+}
+
+// Answer returns the word the player is trying to find. Absurdle games have
+// no answer until they are over.
+func (g *Game) Answer() string {
+	return g.answer
+}
+
+// WordLength returns the number of letters in the answer, which may be more
+// than its number of bytes.
+func (g *Game) WordLength() int {
+	return g.length
+}
+
+// MaxGuesses returns the number of guesses the player is allowed, or
+// Unlimited.
+func (g *Game) MaxGuesses() int {
+	return g.opts.MaxGuesses
+}
+
+// Options returns the rules the game is played with.
+func (g *Game) Options() Options {
+	return g.opts
+}
+
+// Guesses returns the feedback of every guess made so far, in order.
+func (g *Game) Guesses() []Feedback {
+	return g.guesses
+}
+
+// Status returns whether the game is still running, won or lost.
+func (g *Game) Status() Status {
+	return g.status
+}
+
+// Letter returns the best known state of a letter across all guesses.
+func (g *Game) Letter(r rune) LetterState {
+	return g.letters[r]
+}
+
+// Check returns the error Submit would give for a guess, without making it.
+func (g *Game) Check(word string) error {
+	if g.status != Playing {
+		return ErrGameOver
+	}
+
+	word = fold(word, g.opts)
+
+	if utf8.RuneCountInString(word) != g.length {
+		return fmt.Errorf("your guess must be %d letters long", g.length)
+	}
+
+	if g.opts.Words != nil && !g.opts.Words.IsValid(word) {
+		return ErrInvalidWord
+	}
+
+	return g.known.Check(word, g.opts.Difficulty)
+}
+
+// Submit scores a guess, records it and advances the game.
+func (g *Game) Submit(word string) (Feedback, error) {
+	if err := g.Check(word); err != nil {
+		return nil, err
+	}
+
+	word = fold(word, g.opts)
+
+	var feedback Feedback
+	if g.adversary != nil {
+		feedback = decode(word, g.adversary.narrow(word))
+	} else {
+		feedback = Score(word, g.answer)
+	}
+
+	g.record(feedback)
+
+	// Absurdle games settle on an answer once they are over.
+	if g.adversary != nil && g.status != Playing {
+		g.answer = g.adversary.candidates[0]
+	}
+
+	return feedback, nil
+}
+
+// Records a scored guess and advances the game.
+func (g *Game) record(feedback Feedback) {
+	g.guesses = append(g.guesses, feedback)
+	g.known.Add(feedback)
+
+	// The keyboard only ever moves to a better state for each letter.
+	for _, t := range feedback {
+		if t.State > g.letters[t.Letter] {
+			g.letters[t.Letter] = t.State
+		}
+	}
+
+	switch {
+	case feedback.Solved():
+		g.status = Won
+	case g.opts.MaxGuesses != Unlimited && len(g.guesses) >= g.opts.MaxGuesses:
+		g.status = Lost
+	}
+}
+
+// Restore rebuilds a game from guesses that were scored before, without
+// checking or scoring them again. Tiles of the last guess may still be
+// Unknown, to show a guess that is only partly revealed.
+func Restore(answer string, guesses []Feedback, opts Options) *Game {
+	g := New(answer, opts)
+	for _, f := range guesses {
+		g.record(f)
+	}
+	return g
+}
+
+// Result summarises a finished game, for keeping score.
+type Result struct {
+	Answer   string
+	Guesses  []string
+	Won      bool
+	Absurdle bool
+	Options  Options
+}
+
+// Result returns the outcome of the game so far.
+func (g *Game) Result() Result {
+	guesses := make([]string, len(g.guesses))
+	for i, f := range g.guesses {
+		guesses[i] = f.Word()
+	}
+
+	return Result{
+		Answer:   g.answer,
+		Guesses:  guesses,
+		Won:      g.status == Won,
+		Absurdle: g.adversary != nil,
+		Options:  g.opts,
+	}
+}
@@ -0,0 +1,194 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestSubmitKeyboard(t *testing.T) {
+	g := New("those", Options{})
+
+	if _, err := g.Submit("geese"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The green E wins over the gray ones on the keyboard.
+	if got := g.Letter('e'); got != Correct {
+		t.Errorf("Letter('e') = %v, want %v", got, Correct)
+	}
+	if got := g.Letter('g'); got != Absent {
+		t.Errorf("Letter('g') = %v, want %v", got, Absent)
+	}
+	if got := g.Letter('t'); got != Unknown {
+		t.Errorf("Letter('t') = %v, want %v", got, Unknown)
+	}
+}
+
+// A dictionary of the words in a map.
+type dictionary map[string]bool
+
+func (d dictionary) IsValid(word string) bool {
+	return d[word]
+}
+
+func TestWords(t *testing.T) {
+	g := New("those", Options{Words: dictionary{"those": true, "crane": true}})
+
+	if err := g.Check("geese"); err != ErrInvalidWord {
+		t.Errorf("Check(%q) = %v, want %v", "geese", err, ErrInvalidWord)
+	}
+	if err := g.Check("CRANE"); err != nil {
+		t.Errorf("Check(%q) = %v", "CRANE", err)
+	}
+
+	// Without a dictionary any word of the right length may be guessed.
+	if err := New("those", Options{}).Check("zzzzz"); err != nil {
+		t.Errorf("Check(%q) without words = %v", "zzzzz", err)
+	}
+}
+
+// Folds words with Turkish casing, where I is the capital of ı.
+type turkish struct{}
+
+func (turkish) Fold(word string) string {
+	return strings.ToLowerSpecial(unicode.TurkishCase, word)
+}
+
+func TestFold(t *testing.T) {
+	g := New("IŞIK", Options{Words: dictionary{"ışık": true}, Fold: turkish{}})
+	if g.Answer() != "ışık" {
+		t.Errorf("Answer() = %q, want %q", g.Answer(), "ışık")
+	}
+
+	if _, err := g.Submit("IŞIK"); err != nil {
+		t.Fatal(err)
+	}
+	if g.Status() != Won {
+		t.Errorf("Status() = %v, want %v", g.Status(), Won)
+	}
+}
+
+func TestMaxGuesses(t *testing.T) {
+	tests := []struct {
+		max, guesses int
+		want         Status
+	}{
+		{0, DefaultMaxGuesses - 1, Playing},
+		{0, DefaultMaxGuesses, Lost},
+		{4, 4, Lost},
+		{10, 9, Playing},
+		{Unlimited, 50, Playing},
+	}
+
+	for _, tt := range tests {
+		g := New("those", Options{MaxGuesses: tt.max})
+
+		for range tt.guesses {
+			if _, err := g.Submit("crane"); err != nil {
+				t.Fatalf("MaxGuesses %d: %v", tt.max, err)
+			}
+		}
+
+		if got := g.Status(); got != tt.want {
+			t.Errorf("MaxGuesses %d after %d guesses: status %v, want %v", tt.max, tt.guesses, got, tt.want)
+		}
+	}
+}
+
+func TestHardMode(t *testing.T) {
+	tests := []struct {
+		answer  string
+		guesses []string
+		want    string
+	}{
+		{"those", []string{"crane", "house"}, ""},
+		{"those", []string{"crane", "house", "chose"}, ""},
+		{"those", []string{"crane", "house", "mouse"}, "guess must contain H"},
+		{"those", []string{"crane", "stink"}, "5th letter must be E"},
+		{"those", []string{"horse", "cause"}, "guess must contain H"},
+		{"those", []string{"horse", "caste"}, "4th letter must be S"},
+		{"those", []string{"shoes", "hoses"}, "2nd letter must be H"},
+		{"abbey", []string{"babes", "baker"}, "3rd letter must be B"},
+		{"abbey", []string{"kebab", "abbot"}, "guess must contain E"},
+	}
+
+	for _, tt := range tests {
+		g := New(tt.answer, Options{Difficulty: Hard})
+
+		var err error
+		for _, word := range tt.guesses {
+			if _, err = g.Submit(word); err != nil {
+				break
+			}
+		}
+
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tt.want {
+			t.Errorf("%s %v: got error %q, want %q", tt.answer, tt.guesses, got, tt.want)
+		}
+	}
+}
+
+func TestUltraHardMode(t *testing.T) {
+	tests := []struct {
+		answer  string
+		guesses []string
+		want    string
+	}{
+		{"those", []string{"crane", "those"}, ""},
+		{"those", []string{"crane", "chose"}, "C is not in the word"},
+		{"those", []string{"shout", "shots"}, "1st letter can't be S"},
+		{"those", []string{"horse", "hoise"}, "1st letter can't be H"},
+		{"those", []string{"geese", "those"}, ""},
+		{"those", []string{"geese", "tease"}, "guess may contain E only once"},
+		{"abbey", []string{"eerie", "abbey"}, ""},
+		{"abbey", []string{"eerie", "bebop"}, "2nd letter can't be E"},
+		{"abbey", []string{"eerie", "beefy"}, "guess may contain E only once"},
+		{"those", []string{"crane", "aside"}, "A is not in the word"},
+	}
+
+	for _, tt := range tests {
+		g := New(tt.answer, Options{Difficulty: UltraHard})
+
+		var err error
+		for _, word := range tt.guesses {
+			if _, err = g.Submit(word); err != nil {
+				break
+			}
+		}
+
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tt.want {
+			t.Errorf("%s %v: got error %q, want %q", tt.answer, tt.guesses, got, tt.want)
+		}
+	}
+}
+
+func TestRestore(t *testing.T) {
+	played := New("those", Options{})
+	for _, word := range []string{"crane", "house"} {
+		if _, err := played.Submit(word); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	g := Restore("those", played.Guesses(), Options{})
+	if len(g.Guesses()) != 2 || g.Letter('h') != Present || g.Letter('c') != Absent {
+		t.Errorf("restored %d guesses, H %v, C %v", len(g.Guesses()), g.Letter('h'), g.Letter('c'))
+	}
+
+	// Tiles that are not revealed yet don't show on the keyboard.
+	partial := Score("those", "those")
+	partial[1].State = Unknown
+	g = Restore("those", []Feedback{partial}, Options{})
+	if g.Status() != Playing || g.Letter('h') != Unknown || g.Letter('t') != Correct {
+		t.Errorf("status %v, H %v, T %v", g.Status(), g.Letter('h'), g.Letter('t'))
+	}
+}
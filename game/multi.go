@@ -0,0 +1,115 @@
+package game
+
+// MultiGuesses returns the usual number of guesses for playing several boards
+// at once: 6 for one board, 7 for Dordle, 9 for Quordle and 13 for Octordle.
+func MultiGuesses(boards int) int {
+	return boards + 5
+}
+
+// Multi plays several boards at once, as in Dordle, Quordle and Octordle.
+// Every guess goes to each board that is not solved yet, and the game is only
+// won once all boards are solved.
+type Multi struct {
+	boards []*Game
+}
+
+// NewMulti plays the given boards together. The boards should share their
+// options, so that they run out of guesses at the same time.
+func NewMulti(boards ...*Game) *Multi {
+	return &Multi{boards: boards}
+}
+
+// Boards returns every board, in order.
+func (m *Multi) Boards() []*Game {
+	return m.boards
+}
+
+// MaxGuesses returns the number of guesses the player is allowed, or
+// Unlimited.
+func (m *Multi) MaxGuesses() int {
+	return m.boards[0].MaxGuesses()
+}
+
+// Played returns the number of guesses made so far.
+func (m *Multi) Played() int {
+	played := 0
+	for _, b := range m.boards {
+		played = max(played, len(b.Guesses()))
+	}
+	return played
+}
+
+// Guesses returns every guess made so far, in order. The board that took the
+// most guesses saw all of them.
+func (m *Multi) Guesses() []string {
+	var longest *Game
+	for _, b := range m.boards {
+		if longest == nil || len(b.Guesses()) > len(longest.Guesses()) {
+			longest = b
+		}
+	}
+
+	guesses := make([]string, len(longest.Guesses()))
+	for i, f := range longest.Guesses() {
+		guesses[i] = f.Word()
+	}
+	return guesses
+}
+
+// Solved returns the number of boards that have been solved.
+func (m *Multi) Solved() int {
+	solved := 0
+	for _, b := range m.boards {
+		if b.Status() == Won {
+			solved++
+		}
+	}
+	return solved
+}
+
+// Status returns Won once every board is solved, and Lost once any board
+// runs out of guesses.
+func (m *Multi) Status() Status {
+	for _, b := range m.boards {
+		if b.Status() == Lost {
+			return Lost
+		}
+	}
+
+	if m.Solved() == len(m.boards) {
+		return Won
+	}
+	return Playing
+}
+
+// Submit applies a guess to every unsolved board. The guess is only made if
+// every one of them accepts it. The feedback of solved boards is nil.
+func (m *Multi) Submit(word string) ([]Feedback, error) {
+	if m.Status() != Playing {
+		return nil, ErrGameOver
+	}
+
+	for _, b := range m.boards {
+		if b.Status() != Playing {
+			continue
+		}
+		if err := b.Check(word); err != nil {
+			return nil, err
+		}
+	}
+
+	feedback := make([]Feedback, len(m.boards))
+	for i, b := range m.boards {
+		if b.Status() != Playing {
+			continue
+		}
+
+		f, err := b.Submit(word)
+		if err != nil {
+			return nil, err
+		}
+		feedback[i] = f
+	}
+
+	return feedback, nil
+}
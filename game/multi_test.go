@@ -0,0 +1,75 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMulti(t *testing.T) {
+	opts := Options{MaxGuesses: MultiGuesses(2)}
+	m := NewMulti(New("those", opts), New("crane", opts))
+
+	f, err := m.Submit("those")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f[0].Solved() || f[1].Solved() {
+		t.Fatalf("feedback %s %s, want only the first board solved", f[0].Colors(), f[1].Colors())
+	}
+	if m.Status() != Playing {
+		t.Fatalf("status %v with one board left, want %v", m.Status(), Playing)
+	}
+
+	// Solved boards take no further guesses.
+	f, err = m.Submit("crane")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f[0] != nil {
+		t.Errorf("solved board was scored again: %s", f[0].Colors())
+	}
+	if got := len(m.Boards()[0].Guesses()); got != 1 {
+		t.Errorf("solved board has %d guesses, want 1", got)
+	}
+	if m.Status() != Won || m.Played() != 2 {
+		t.Errorf("status %v after %d guesses, want %v after 2", m.Status(), m.Played(), Won)
+	}
+	if got := strings.Join(m.Guesses(), " "); got != "those crane" {
+		t.Errorf("guesses are %s, want those crane", got)
+	}
+}
+
+func TestMultiRejectsForAllBoards(t *testing.T) {
+	opts := Options{MaxGuesses: MultiGuesses(2), Difficulty: Hard}
+	m := NewMulti(New("those", opts), New("crane", opts))
+
+	if _, err := m.Submit("chose"); err != nil {
+		t.Fatal(err)
+	}
+
+	// "those" keeps the first board's hints but drops the C that the second
+	// board revealed, so neither board may take it.
+	if _, err := m.Submit("those"); err == nil {
+		t.Fatal("guess was accepted in hard mode")
+	}
+	for i, b := range m.Boards() {
+		if got := len(b.Guesses()); got != 1 {
+			t.Errorf("board %d has %d guesses, want 1", i, got)
+		}
+	}
+}
+
+func TestMultiLost(t *testing.T) {
+	opts := Options{MaxGuesses: 2}
+	m := NewMulti(New("those", opts), New("crane", opts))
+
+	for _, word := range []string{"those", "slate"} {
+		if _, err := m.Submit(word); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if m.Status() != Lost || m.Solved() != 1 {
+		t.Errorf("status %v with %d solved, want %v with 1", m.Status(), m.Solved(), Lost)
+	}
+}
@@ -0,0 +1,98 @@
+package game
+
+import "unicode/utf8"
+
+// Longest word that is scored without allocating. Longer words are scored
+// all the same, only more slowly.
+const maxWordLength = 16
+
+// Score compares a guess against the answer the way the official game does.
+//
+// Letters in the right place are marked first. The remaining letters are then
+// marked present from left to right, but only as many times as they are still
+// unaccounted for in the answer, so guessing "geese" against "those" shows a
+// single green E and leaves the other two gray. Both words should be the same
+// length; letters of a longer guess past the end of the answer can only be
+// present or absent.
+func Score(guess, answer string) Feedback {
+	if utf8.RuneCountInString(guess) <= maxWordLength && utf8.RuneCountInString(answer) <= maxWordLength {
+		return decode(guess, pattern(guess, answer))
+	}
+
+	g, a := []rune(guess), []rune(answer)
+	states := make([]LetterState, len(g))
+	mark(states, g, a, make([]bool, len(a)))
+
+	feedback := make(Feedback, len(g))
+	for i, r := range g {
+		feedback[i] = Tile{Letter: r, State: states[i]}
+	}
+	return feedback
+}
+
+// pattern scores a guess without allocating, packing the feedback into a
+// base-3 number with one digit per letter. Guesses with the same pattern got
+// the same colors. Neither word may be longer than maxWordLength.
+func pattern(guess, answer string) int {
+	var states [maxWordLength]LetterState
+	var used [maxWordLength]bool
+
+	// Words are compared letter by letter, not byte by byte.
+	var g, a [maxWordLength]rune
+	ng := 0
+	for _, r := range guess {
+		g[ng] = r
+		ng++
+	}
+	na := 0
+	for _, r := range answer {
+		a[na] = r
+		na++
+	}
+
+	mark(states[:ng], g[:ng], a[:na], used[:na])
+
+	code := 0
+	for i := ng - 1; i >= 0; i-- {
+		code = code*3 + int(states[i]-Absent)
+	}
+	return code
+}
+
+// mark sets the state of every letter of a guess against the answer. Used
+// keeps track of the letters of the answer that are accounted for.
+func mark(states []LetterState, guess, answer []rune, used []bool) {
+	// First pass: exact matches.
+	for i := range guess {
+		states[i] = Absent
+		if i < len(answer) && guess[i] == answer[i] {
+			states[i] = Correct
+			used[i] = true
+		}
+	}
+
+	// Second pass: letters elsewhere in the answer that are not yet used up.
+	for i := range guess {
+		if states[i] == Correct {
+			continue
+		}
+
+		for j := range answer {
+			if !used[j] && answer[j] == guess[i] {
+				states[i] = Present
+				used[j] = true
+				break
+			}
+		}
+	}
+}
+
+// decode unpacks a pattern into the feedback for a guess.
+func decode(guess string, code int) Feedback {
+	feedback := make(Feedback, 0, len(guess))
+	for _, r := range guess {
+		feedback = append(feedback, Tile{Letter: r, State: Absent + LetterState(code%3)})
+		code /= 3
+	}
+	return feedback
+}
@@ -0,0 +1,41 @@
+package game
+
+import "testing"
+
+func TestScore(t *testing.T) {
+	// Feedback is written the way Feedback.Colors prints it.
+	tests := []struct {
+		guess, answer, want string
+	}{
+		{"those", "those", "ggggg"},
+		{"crane", "those", "----g"},
+		{"geese", "those", "---gg"},
+		{"eerie", "those", "----g"},
+		{"speed", "abide", "--y-y"},
+		{"speed", "erase", "y-yy-"},
+		{"abbey", "kebab", "yygy-"},
+		{"babes", "abbey", "yygg-"},
+		{"kebab", "abbey", "-ygyy"},
+		{"llama", "hello", "yy---"},
+		{"hello", "llama", "--yy-"},
+		{"lolly", "hello", "-ygg-"},
+		{"array", "ratty", "yy--g"},
+		{"mamma", "madam", "ggy-y"},
+		{"sissy", "bless", "y--g-"},
+		{"eeeee", "crane", "----g"},
+		{"tepee", "eerie", "-g-yg"},
+		{"oozes", "sooty", "yg--y"},
+		{"niño", "nino", "gg-g"},
+		{"ñandu", "uñero", "y---y"},
+		{"ığdır", "kılıç", "y--g-"},
+		{"incomprehensibilities", "incomprehensibilities", "ggggggggggggggggggggg"},
+		{"abcdefghijklmnopqr", "bacdefghijklmnopqz", "yyggggggggggggggg-"},
+	}
+
+	for _, tt := range tests {
+		got := Score(tt.guess, tt.answer)
+		if got.Word() != tt.guess || got.Colors() != tt.want {
+			t.Errorf("Score(%q, %q) = %s, want %s", tt.guess, tt.answer, got.Colors(), tt.want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+const emptySpaceRune = '•'
+
+// guess is a single tile of the board, as drawn on screen.
+type guess game.Tile
+
+// Prints guess rune in the color of its state.
+func (g guess) Render() {
+	fmt.Print(g.String())
+}
+
+// Returns guess rune in the color of its state.
+func (g guess) String() string {
+	return currentTheme.Style(g.State).Escape() + words.Language().Upper(string(g.Letter)) + color.Reset
+}
+
+// Returns guess rune between the marks of its state, as a tile of the board.
+func (g guess) Tile() string {
+	return currentTheme.Style(g.State).Mark(g.String())
+}
+
+// Most rows of the grid that are drawn at once. Longer games scroll.
+const maxVisibleRows = 8
+
+// gameGrid is the x * y grid of guesses and empty rows of one board.
+type gameGrid struct {
+	rows   [][]guess
+	played int // rows taken by guesses, blank once the board is solved
+}
+
+// Build the grid for one board of a game. Boards share the number of guesses
+// played, so a board that was solved early is left blank below its answer.
+// Letters typed so far fill the next row of boards still being played.
+func newGameGrid(m *game.Multi, board int, typed string) gameGrid {
+	b := m.Boards()[board]
+	guesses := b.Guesses()
+	grid := gameGrid{played: m.Played()}
+	letters := []rune(typed)
+
+	// Unlimited games only show the row being typed into.
+	height := m.MaxGuesses()
+	if height == game.Unlimited {
+		height = grid.played
+		if m.Status() == game.Playing {
+			height++
+		}
+	}
+
+	for i := range height {
+		row := make([]guess, b.WordLength())
+		for j := range row {
+			switch {
+			case i < len(guesses):
+				row[j] = guess(guesses[i][j])
+			case i < grid.played || b.Status() == game.Won:
+				row[j] = guess{Letter: ' '}
+			case i == grid.played && j < len(letters):
+				row[j] = guess{Letter: letters[j]}
+			default:
+				row[j] = guess{Letter: emptySpaceRune}
+			}
+		}
+		grid.rows = append(grid.rows, row)
+	}
+
+	return grid
+}
+
+// Returns the rows that fit on screen. At least one empty row is kept in view
+// and the rest is filled up with the latest guesses.
+func (g gameGrid) window() (from, to int) {
+	empty := len(g.rows) - g.played
+	shownEmpty := min(empty, max(1, maxVisibleRows-g.played))
+	shownPlayed := min(g.played, maxVisibleRows-shownEmpty)
+	return g.played - shownPlayed, g.played + shownEmpty
+}
+
+// Print the current state of the game.
+func (g gameGrid) render(w io.Writer) {
+	renderGrids(w, []gameGrid{g})
+}
+
+// Print several boards side by side, wrapping onto more lines of boards.
+// All boards must have the same number of rows.
+func renderGrids(w io.Writer, grids []gameGrid) {
+	from, to := grids[0].window()
+	columns := boardColumns(len(grids))
+
+	if from > 0 {
+		fmt.Fprintln(w, color.Gray+"  ⋮ "+fmt.Sprint(from)+" earlier"+color.Reset)
+	}
+
+	for first := 0; first < len(grids); first += columns {
+		if first > 0 {
+			fmt.Fprintln(w)
+		}
+
+		line := grids[first:min(first+columns, len(grids))]
+		for i := from; i < to; i++ {
+			for y := range tileHeight() {
+				for k, grid := range line {
+					if k > 0 {
+						fmt.Fprint(w, "  ")
+					}
+					fmt.Fprint(w, " ")
+					for j := range grid.rows[i] {
+						fmt.Fprint(w, grid.rows[i][j].lines()[y])
+					}
+				}
+				fmt.Fprintln(w)
+			}
+		}
+	}
+
+	if later := len(grids[0].rows) - to; later > 0 {
+		fmt.Fprintln(w, color.Gray+"  ⋮ "+fmt.Sprint(later)+" more"+color.Reset)
+	}
+	fmt.Fprintln(w)
+}
+
+// Returns how many boards are drawn next to each other: two for Dordle, two
+// by two for Quordle and four by two for Octordle.
+func boardColumns(boards int) int {
+	if boards > 4 {
+		return 4
+	}
+	return min(boards, 2)
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/history"
+	"github.com/bitmap/wordle/internal/lang"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// List past games, filtered by the flags given, or show one game in full.
+func showHistory(args []string) error {
+	flags := flag.NewFlagSet("history", flag.ExitOnError)
+	since := flags.String("since", "", "only games played on or after this date (2006-01-02)")
+	until := flags.String("until", "", "only games played on or before this date (2006-01-02)")
+	mode := flags.String("mode", "", "only games played in this mode")
+	won := flags.Bool("won", false, "only games that were won")
+	lost := flags.Bool("lost", false, "only games that were lost")
+	answer := flags.String("answer", "", "only games with this answer")
+	args = parseArgs(flags, args)
+
+	if err := setup(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return errors.New(args[0] + " is not a game ID")
+		}
+		return showEntry(id)
+	}
+
+	filter := history.Filter{Mode: *mode, Answer: *answer}
+
+	var err error
+	if filter.Since, err = parseDay(*since); err != nil {
+		return err
+	}
+	if filter.Until, err = parseDay(*until); err != nil {
+		return err
+	}
+	if !filter.Until.IsZero() {
+		// Include the whole of the last day.
+		filter.Until = filter.Until.AddDate(0, 0, 1)
+	}
+
+	switch {
+	case *won && *lost:
+		return errors.New("pick either -won or -lost")
+	case *won:
+		filter.Outcome = history.Won
+	case *lost:
+		filter.Outcome = history.Lost
+	}
+
+	entries, err := history.Load()
+	if err != nil {
+		return err
+	}
+
+	var shown []history.Entry
+	for _, e := range entries {
+		if filter.Match(e) {
+			shown = append(shown, e)
+		}
+	}
+
+	if len(shown) == 0 {
+		fmt.Println("No games found.")
+		return nil
+	}
+
+	fmt.Printf("%5s  %-16s  %-24s  %-12s  %-6s  %s\n", "ID", "Finished", "Mode", "Answer", "Result", "Time")
+	for _, e := range shown {
+		fmt.Printf("%5d  %-16s  %-24s  %-12s  %-6s  %s\n",
+			e.ID,
+			e.Finished.Local().Format("2006-01-02 15:04"),
+			e.Mode,
+			lang.Get(e.Language).Upper(strings.Join(e.Answers(), "/")),
+			e.Score(),
+			e.Duration().Round(time.Second),
+		)
+	}
+	return nil
+}
+
+// Print one past game with every guess.
+func showEntry(id int) error {
+	e, ok, err := history.Find(id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("there is no game %d", id)
+	}
+	useLanguage(e.Language)
+
+	fmt.Printf("\nGame %d, %s", e.ID, e.Mode)
+	if e.Puzzle != "" {
+		fmt.Print(" " + e.Puzzle)
+	}
+	fmt.Printf("\nPlayed %s, took %s\n\n",
+		e.Finished.Local().Format("Jan 2, 2006 15:04"),
+		e.Duration().Round(time.Second))
+
+	for i, word := range e.Guesses {
+		fmt.Printf("  %2d  ", i+1)
+		for b := range e.Boards {
+			if i >= len(e.Boards[b].Colors) {
+				fmt.Print(strings.Repeat(" ", utf8.RuneCountInString(word)) + "  ")
+				continue
+			}
+			f, err := game.ParseFeedback(word, e.Boards[b].Colors[i])
+			if err != nil {
+				return err
+			}
+			for _, t := range f {
+				guess(t).Render()
+			}
+			fmt.Print("  ")
+		}
+		fmt.Println()
+	}
+
+	fmt.Println()
+	if e.Won {
+		fmt.Println("Won, " + e.Score() + ".")
+	} else {
+		fmt.Println("Lost. The answer was " + currentTheme.Correct.Escape() + lang.Get(e.Language).Upper(strings.Join(e.Answers(), ", ")) + color.Reset + ".")
+	}
+	return nil
+}
+
+// Draw a past game with the alphabet and casing of the language it was played
+// in. Games in a language that is no longer built in are drawn in English.
+func useLanguage(code string) {
+	if err := words.Use(words.Source{Language: code}); err != nil {
+		words.Use(words.Source{})
+	}
+}
+
+// Reads a date given on the command line, in local time.
+func parseDay(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	day, err := time.ParseInLocation(time.DateOnly, s, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a date like 2006-01-02", s)
+	}
+	return day, nil
+}
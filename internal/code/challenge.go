@@ -0,0 +1,83 @@
+package code
+
+import (
+	"errors"
+	"strings"
+)
+
+// Challenge is a word picked by one player for another to guess.
+type Challenge struct {
+	Word     string
+	Language string // code of the language, empty for English
+}
+
+// String returns the sealed challenge.
+func (c Challenge) String() string {
+	return Seal([]byte(withLanguage(c.Word, c.Language)))
+}
+
+// ParseChallenge opens a sealed challenge.
+func ParseChallenge(s string) (Challenge, error) {
+	data, err := Open(s)
+	if err != nil {
+		return Challenge{}, err
+	}
+
+	if strings.Contains(string(data), ":") {
+		return Challenge{}, errors.New("that is a challenge result, not a challenge")
+	}
+
+	word, language := splitLanguage(string(data))
+	return Challenge{Word: word, Language: language}, nil
+}
+
+// ChallengeResult is how a player did on a challenge, to be sent back to the
+// player who set it.
+type ChallengeResult struct {
+	Word     string
+	Language string // code of the language, empty for English
+	Guesses  []string
+}
+
+// String returns the sealed result.
+func (r ChallengeResult) String() string {
+	return Seal([]byte(withLanguage(r.Word, r.Language) + ":" + strings.Join(r.Guesses, ",")))
+}
+
+// ParseChallengeResult opens a sealed challenge result.
+func ParseChallengeResult(s string) (ChallengeResult, error) {
+	data, err := Open(s)
+	if err != nil {
+		return ChallengeResult{}, err
+	}
+
+	word, guesses, ok := strings.Cut(string(data), ":")
+	if !ok {
+		return ChallengeResult{}, errors.New("that is a challenge, not a result")
+	}
+
+	r := ChallengeResult{}
+	r.Word, r.Language = splitLanguage(word)
+	if guesses != "" {
+		r.Guesses = strings.Split(guesses, ",")
+	}
+	return r, nil
+}
+
+// Returns a word led by the code of its language and a slash, like es/cañon.
+// English words have no code, as in challenges made before there were other
+// languages.
+func withLanguage(word, language string) string {
+	if language == "" {
+		return word
+	}
+	return strings.ToLower(language) + "/" + word
+}
+
+// Splits a word from the code of its language.
+func splitLanguage(s string) (word, language string) {
+	if language, word, ok := strings.Cut(s, "/"); ok {
+		return word, language
+	}
+	return s, ""
+}
@@ -0,0 +1,138 @@
+// Package code turns games into short codes that can be shared, so that
+// someone else can play the same puzzle without being told the word.
+package code
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Crockford's base32 alphabet leaves out I, L, O and U, so codes are easy to
+// read out and type.
+const alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// Encode writes a number in base32.
+func Encode(v uint64) string {
+	if v == 0 {
+		return "0"
+	}
+
+	var b []byte
+	for ; v > 0; v /= 32 {
+		b = append(b, alphabet[v%32])
+	}
+
+	// Most significant digit first.
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+// Decode reads a number written in base32. Lowercase letters are accepted,
+// and the letters that are easily mistaken for digits are read as those
+// digits.
+func Decode(s string) (uint64, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if s == "" || len(s) > 13 {
+		return 0, fmt.Errorf("%q is not a valid code", s)
+	}
+
+	var v uint64
+	for _, r := range s {
+		switch r {
+		case 'O':
+			r = '0'
+		case 'I', 'L':
+			r = '1'
+		}
+
+		digit := strings.IndexRune(alphabet, r)
+		if digit < 0 {
+			return 0, fmt.Errorf("%q is not a valid code", s)
+		}
+		if v > (1<<64-1-uint64(digit))/32 {
+			return 0, fmt.Errorf("%q is not a valid code", s)
+		}
+		v = v*32 + uint64(digit)
+	}
+
+	return v, nil
+}
+
+// Game is everything needed to pick the same answers again.
+type Game struct {
+	Seed     uint64
+	Length   int
+	Boards   int
+	Language string // code of the language, empty for English
+}
+
+// Values of Length and Boards that fit in a code.
+const (
+	minLength = 4
+	maxLength = 8
+)
+
+var boardCounts = []int{1, 2, 4, 8}
+
+// RandomSeeds are the seeds that still give five-letter codes.
+const RandomSeeds = 1 << 20
+
+// String returns the code of the game. The seed takes the high bits and the
+// settings the low five, so random seeds give codes like XK3P9. Games in
+// another language end with its code, like XK3P9-ES.
+func (g Game) String() string {
+	boards := 0
+	for i, n := range boardCounts {
+		if n == g.Boards {
+			boards = i
+		}
+	}
+
+	settings := uint64((g.Length-minLength)*len(boardCounts) + boards)
+	c := Encode(g.Seed<<5 | settings)
+	if g.Language != "" {
+		c += "-" + strings.ToUpper(g.Language)
+	}
+	return c
+}
+
+// ErrSeedTooLarge is returned for seeds that don't fit in a code.
+var ErrSeedTooLarge = errors.New("seed must be less than 2^59")
+
+// Check returns an error if the game can't be turned into a code.
+func (g Game) Check() error {
+	if g.Seed >= 1<<59 {
+		return ErrSeedTooLarge
+	}
+	return nil
+}
+
+// Parse reads a game code. The language, if there is one, is not checked.
+func Parse(s string) (Game, error) {
+	number, language, found := strings.Cut(strings.TrimSpace(s), "-")
+	if found && language == "" {
+		return Game{}, fmt.Errorf("%q is not a valid code", s)
+	}
+
+	v, err := Decode(number)
+	if err != nil {
+		return Game{}, err
+	}
+
+	settings := int(v & 31)
+	g := Game{
+		Seed:     v >> 5,
+		Length:   minLength + settings/len(boardCounts),
+		Boards:   boardCounts[settings%len(boardCounts)],
+		Language: strings.ToLower(language),
+	}
+
+	if g.Length > maxLength {
+		return Game{}, fmt.Errorf("%q is not a valid code", s)
+	}
+
+	return g, nil
+}
@@ -0,0 +1,135 @@
+package code
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGameRoundTrip(t *testing.T) {
+	games := []Game{
+		{Seed: 0, Length: 5, Boards: 1},
+		{Seed: RandomSeeds - 1, Length: 5, Boards: 1},
+		{Seed: 12345, Length: 4, Boards: 8},
+		{Seed: 987654321, Length: 8, Boards: 2},
+		{Seed: 1<<59 - 1, Length: 6, Boards: 4},
+		{Seed: 42, Length: 5, Boards: 1, Language: "es"},
+	}
+
+	for _, g := range games {
+		c := g.String()
+
+		got, err := Parse(c)
+		if err != nil {
+			t.Errorf("Parse(%q) for %+v: %v", c, g, err)
+			continue
+		}
+		if got != g {
+			t.Errorf("Parse(%q) = %+v, want %+v", c, got, g)
+		}
+	}
+}
+
+func TestRandomSeedsGiveShortCodes(t *testing.T) {
+	g := Game{Seed: RandomSeeds - 1, Length: 8, Boards: 8}
+	if c := g.String(); len(c) != 5 {
+		t.Errorf("code %q for %+v is %d characters, want 5", c, g, len(c))
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	g, err := Parse("xk3p9-es")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Language != "es" {
+		t.Errorf("Parse(%q).Language = %q, want es", "xk3p9-es", g.Language)
+	}
+
+	if _, err := Parse("XK3P9-"); err == nil {
+		t.Errorf("Parse(%q) gave no error", "XK3P9-")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		in   string
+		want uint64
+		ok   bool
+	}{
+		{"0", 0, true},
+		{"Z", 31, true},
+		{"10", 32, true},
+		{"xk3p9", 31035081, true},
+		{"XK3P9", 31035081, true},
+		{"1O", 32, true},
+		{"iL", 33, true},
+		{"U", 0, false},
+		{"", 0, false},
+		{"ZZZZZZZZZZZZZ", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, err := Decode(tt.in)
+		if (err == nil) != tt.ok {
+			t.Errorf("Decode(%q) error = %v, want ok %v", tt.in, err, tt.ok)
+			continue
+		}
+		if tt.ok && got != tt.want {
+			t.Errorf("Decode(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSeal(t *testing.T) {
+	for _, s := range []string{"", "crane", "those:crane,geese,those"} {
+		sealed := Seal([]byte(s))
+
+		if s != "" && strings.Contains(strings.ToLower(sealed), s) {
+			t.Errorf("Seal(%q) = %q shows the data", s, sealed)
+		}
+
+		got, err := Open(strings.ToLower(sealed))
+		if err != nil || string(got) != s {
+			t.Errorf("Open(Seal(%q)) = %q, %v", s, got, err)
+		}
+
+		if _, err := Open(sealed[:len(sealed)-1]); err == nil && s != "" {
+			t.Errorf("Open accepted %q cut short", sealed)
+		}
+	}
+}
+
+func TestChallengeResult(t *testing.T) {
+	r := ChallengeResult{Word: "those", Guesses: []string{"crane", "geese", "those"}}
+
+	got, err := ParseChallengeResult(r.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Word != r.Word || strings.Join(got.Guesses, ",") != strings.Join(r.Guesses, ",") {
+		t.Errorf("got %+v, want %+v", got, r)
+	}
+
+	if _, err := ParseChallengeResult(Challenge{Word: "those"}.String()); err == nil {
+		t.Error("a challenge was read as a result")
+	}
+	if _, err := ParseChallenge(r.String()); err == nil {
+		t.Error("a result was read as a challenge")
+	}
+}
+
+func TestChallengeLanguage(t *testing.T) {
+	c := Challenge{Word: "cañon", Language: "es"}
+	if got, err := ParseChallenge(c.String()); err != nil || got != c {
+		t.Errorf("ParseChallenge(%q) = %+v, %v, want %+v", c.String(), got, err, c)
+	}
+
+	r := ChallengeResult{Word: "cañon", Language: "es", Guesses: []string{"nieto", "cañon"}}
+	got, err := ParseChallengeResult(r.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Word != r.Word || got.Language != r.Language || len(got.Guesses) != 2 {
+		t.Errorf("got %+v, want %+v", got, r)
+	}
+}
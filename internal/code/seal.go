@@ -0,0 +1,63 @@
+package code
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"hash/crc32"
+	"strings"
+)
+
+var encoding = base32.NewEncoding(alphabet).WithPadding(base32.NoPadding)
+
+// ErrDamaged is returned for sealed strings that were mistyped or cut short.
+var ErrDamaged = errors.New("the code is damaged or incomplete")
+
+// Seal hides data behind a random key, so that it can be passed around
+// without being read at a glance. It is not encryption: anyone with this
+// package can open it again.
+func Seal(data []byte) string {
+	var key [2]byte
+	rand.Read(key[:])
+
+	sealed := append(key[:], xor(data, key)...)
+	sum := crc32.ChecksumIEEE(data)
+	sealed = append(sealed, byte(sum>>8), byte(sum))
+
+	return encoding.EncodeToString(sealed)
+}
+
+// Open returns the data of a sealed string.
+func Open(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	s = strings.NewReplacer("O", "0", "I", "1", "L", "1", "-", "").Replace(s)
+
+	sealed, err := encoding.DecodeString(s)
+	if err != nil || len(sealed) < 4 {
+		return nil, ErrDamaged
+	}
+
+	key := [2]byte{sealed[0], sealed[1]}
+	data := xor(sealed[2:len(sealed)-2], key)
+
+	sum := crc32.ChecksumIEEE(data)
+	if sealed[len(sealed)-2] != byte(sum>>8) || sealed[len(sealed)-1] != byte(sum) {
+		return nil, ErrDamaged
+	}
+
+	return data, nil
+}
+
+// Mixes data with a keystream grown from the key.
+func xor(data []byte, key [2]byte) []byte {
+	out := make([]byte, len(data))
+	state := uint32(key[0])<<8 | uint32(key[1]) | 1<<16
+	for i, b := range data {
+		// xorshift32
+		state ^= state << 13
+		state ^= state >> 17
+		state ^= state << 5
+		out[i] = b ^ byte(state>>24)
+	}
+	return out
+}
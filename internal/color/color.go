@@ -1,42 +1,275 @@
+// Package color writes text in color, as far as the terminal allows. The
+// escape sequences below are empty when colors are turned off.
 package color
 
-import "runtime"
-
-var Reset = "\033[0m"
-var Black = "\033[30m"
-var Red = "\033[31m"
-var Green = "\033[32m"
-var Yellow = "\033[33m"
-var Blue = "\033[34m"
-var Purple = "\033[35m"
-var Cyan = "\033[36m"
-var White = "\033[37m"
-var Gray = "\033[90m"
-var BrightRed = "\033[91m"
-var BrightGreen = "\033[92m"
-var BrightYellow = "\033[93m"
-var BrightBlue = "\033[94m"
-var BrightPurple = "\033[95m"
-var BrightCyan = "\033[96m"
-var BrightWhite = "\033[97m"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var Reset string
+var Black string
+var Red string
+var Green string
+var Yellow string
+var Blue string
+var Purple string
+var Cyan string
+var White string
+var Gray string
+var BrightRed string
+var BrightGreen string
+var BrightYellow string
+var BrightBlue string
+var BrightPurple string
+var BrightCyan string
+var BrightWhite string
 
 func init() {
-	if runtime.GOOS == "windows" {
-		Reset = ""
-		Red = ""
-		Green = ""
-		Yellow = ""
-		Blue = ""
-		Purple = ""
-		Cyan = ""
-		White = ""
-		Gray = ""
-		BrightRed = ""
-		BrightGreen = ""
-		BrightYellow = ""
-		BrightBlue = ""
-		BrightPurple = ""
-		BrightCyan = ""
-		BrightWhite = ""
+	Use(Detect(Auto))
+}
+
+// Use writes colors at the given level from now on.
+func Use(l Level) {
+	level = l
+
+	for name, v := range map[string]*string{
+		"reset":         &Reset,
+		"black":         &Black,
+		"red":           &Red,
+		"green":         &Green,
+		"yellow":        &Yellow,
+		"blue":          &Blue,
+		"purple":        &Purple,
+		"cyan":          &Cyan,
+		"white":         &White,
+		"gray":          &Gray,
+		"bright-red":    &BrightRed,
+		"bright-green":  &BrightGreen,
+		"bright-yellow": &BrightYellow,
+		"bright-blue":   &BrightBlue,
+		"bright-purple": &BrightPurple,
+		"bright-cyan":   &BrightCyan,
+		"bright-white":  &BrightWhite,
+	} {
+		*v = ""
+		if l != None {
+			*v = "\033[" + codes[name] + "m"
+		}
+	}
+}
+
+// Names of the colors and attributes Parse understands, with their SGR codes.
+var codes = map[string]string{
+	"reset":         "0",
+	"bold":          "1",
+	"dim":           "2",
+	"italic":        "3",
+	"underline":     "4",
+	"reverse":       "7",
+	"black":         "30",
+	"red":           "31",
+	"green":         "32",
+	"yellow":        "33",
+	"blue":          "34",
+	"purple":        "35",
+	"cyan":          "36",
+	"white":         "37",
+	"gray":          "90",
+	"bright-red":    "91",
+	"bright-green":  "92",
+	"bright-yellow": "93",
+	"bright-blue":   "94",
+	"bright-purple": "95",
+	"bright-cyan":   "96",
+	"bright-white":  "97",
+}
+
+// Parse returns the escape sequence for a color written as words separated
+// by spaces: names like "green" or "bright-blue", attributes like "bold" or
+// "underline", numbers of the 256-color palette like "208", or #rrggbb. A
+// color starting with "on-", like "on-#6aaa64", colors the background. Colors
+// the terminal doesn't have are replaced by the closest it has.
+func Parse(spec string) (string, error) {
+	var seq strings.Builder
+	for _, word := range strings.Fields(strings.ToLower(spec)) {
+		code, ok := colorCode(strings.CutPrefix(word, "on-"))
+		if !ok {
+			return "", fmt.Errorf("unknown color %s", word)
+		}
+		seq.WriteString("\033[" + code + "m")
+	}
+
+	if level == None {
+		return "", nil
+	}
+	return seq.String(), nil
+}
+
+// Returns the SGR code of a word of a color, for the foreground or the
+// background.
+func colorCode(word string, background bool) (string, bool) {
+	if n, err := strconv.Atoi(word); err == nil && n >= 0 && n <= 255 {
+		return paletteCode(n, background), true
+	}
+	if r, g, b, ok := Hex(word); ok {
+		return rgbCode(rgb{r, g, b}, background), true
 	}
+
+	code, ok := codes[word]
+	if !ok || !background {
+		return code, ok
+	}
+
+	// Background colors come 10 after the foreground ones; attributes have
+	// no background.
+	n, _ := strconv.Atoi(code)
+	if n < 30 {
+		return "", false
+	}
+	return strconv.Itoa(n + 10), true
+}
+
+// Hex reads a color written as #rrggbb.
+func Hex(s string) (r, g, b uint8, ok bool) {
+	if len(s) != 7 || s[0] != '#' {
+		return 0, 0, 0, false
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return uint8(v >> 16), uint8(v >> 8), uint8(v), true
+}
+
+// Returns the SGR code of a color of the 256-color palette.
+func paletteCode(n int, background bool) string {
+	if level >= Palette {
+		return layer(background) + ";5;" + strconv.Itoa(n)
+	}
+	return basicCode(nearestBasic(paletteRGB(n)), background)
+}
+
+// Returns the SGR code of a 24-bit color.
+func rgbCode(c rgb, background bool) string {
+	switch level {
+	case TrueColor:
+		return fmt.Sprintf("%s;2;%d;%d;%d", layer(background), c[0], c[1], c[2])
+	case Palette:
+		return layer(background) + ";5;" + strconv.Itoa(nearestPalette(c))
+	}
+	return basicCode(nearestBasic(c), background)
+}
+
+// Returns the SGR code that sets an extended color of the foreground or the
+// background.
+func layer(background bool) string {
+	if background {
+		return "48"
+	}
+	return "38"
+}
+
+// Returns the SGR code of one of the 16 basic colors.
+func basicCode(n int, background bool) string {
+	code := 30 + n
+	if n >= 8 {
+		code = 90 + n - 8
+	}
+	if background {
+		code += 10
+	}
+	return strconv.Itoa(code)
+}
+
+// A color as red, green and blue.
+type rgb [3]uint8
+
+// The 16 basic colors as xterm shows them.
+var basic = [16]rgb{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// Levels of red, green and blue in the 6x6x6 color cube of the palette.
+var cube = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// Returns a color of the 256-color palette.
+func paletteRGB(n int) rgb {
+	switch {
+	case n < 16:
+		return basic[n]
+	case n < 232:
+		n -= 16
+		return rgb{cube[n/36], cube[n/6%6], cube[n%6]}
+	}
+	gray := uint8(8 + 10*(n-232))
+	return rgb{gray, gray, gray}
+}
+
+// Returns the color of the palette nearest to a 24-bit color, from the color
+// cube or the grays.
+func nearestPalette(c rgb) int {
+	step := func(v uint8) int {
+		best := 0
+		for i, level := range cube {
+			if square(v, level) < square(v, cube[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+	inCube := 16 + 36*step(c[0]) + 6*step(c[1]) + step(c[2])
+
+	avg := (int(c[0]) + int(c[1]) + int(c[2])) / 3
+	gray := 232 + min(max((avg-8+5)/10, 0), 23)
+
+	if distance(c, paletteRGB(gray)) < distance(c, paletteRGB(inCube)) {
+		return gray
+	}
+	return inCube
+}
+
+// Returns the basic color closest to a 24-bit color. The basic colors are far
+// apart, so rather than the nearest one this picks grays by brightness and
+// other colors by which of red, green and blue are strongest in them.
+func nearestBasic(c rgb) int {
+	lo, hi := min(c[0], c[1], c[2]), max(c[0], c[1], c[2])
+	if hi-lo < 32 {
+		switch avg := (int(c[0]) + int(c[1]) + int(c[2])) / 3; {
+		case avg < 64:
+			return 0 // black
+		case avg < 160:
+			return 8 // gray
+		case avg < 224:
+			return 7 // white
+		}
+		return 15 // bright white
+	}
+
+	on := func(v uint8) int {
+		if int(v)*2 > int(lo)+int(hi) {
+			return 1
+		}
+		return 0
+	}
+	n := on(c[2])<<2 | on(c[1])<<1 | on(c[0])
+	if hi >= 192 {
+		n += 8
+	}
+	return n
+}
+
+// Returns the squared distance between two colors.
+func distance(a, b rgb) int {
+	return square(a[0], b[0]) + square(a[1], b[1]) + square(a[2], b[2])
+}
+
+// Returns the square of the difference of two levels.
+func square(a, b uint8) int {
+	d := int(a) - int(b)
+	return d * d
 }
@@ -0,0 +1,71 @@
+package color
+
+import "testing"
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		mode Mode
+		env  map[string]string
+		tty  bool
+		want Level
+	}{
+		{Auto, map[string]string{"TERM": "xterm"}, true, Basic},
+		{Auto, map[string]string{"TERM": "xterm-256color"}, true, Palette},
+		{Auto, map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor"}, true, TrueColor},
+		{Auto, map[string]string{"TERM": "xterm-256color"}, false, None},
+		{Auto, map[string]string{"TERM": "dumb"}, true, None},
+		{Auto, map[string]string{"TERM": "xterm", "NO_COLOR": "1"}, true, None},
+		{Auto, map[string]string{"TERM": "xterm-256color", "CLICOLOR_FORCE": "1"}, false, Palette},
+		{Auto, map[string]string{"TERM": "xterm", "CLICOLOR_FORCE": "0"}, false, None},
+		{Always, map[string]string{"TERM": "dumb"}, false, Basic},
+		{Always, map[string]string{"COLORTERM": "24bit", "NO_COLOR": "1"}, false, TrueColor},
+		{Never, map[string]string{"TERM": "xterm-256color", "CLICOLOR_FORCE": "1"}, true, None},
+	}
+
+	for _, tt := range tests {
+		getenv := func(key string) string { return tt.env[key] }
+		if got := detect(tt.mode, getenv, tt.tty); got != tt.want {
+			t.Errorf("detect(%v, %v, %v) = %v, want %v", tt.mode, tt.env, tt.tty, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	defer Use(Current())
+
+	tests := []struct {
+		level Level
+		spec  string
+		want  string
+	}{
+		{TrueColor, "bold #f5793a", "\033[1m\033[38;2;245;121;58m"},
+		{Palette, "#f5793a", "\033[38;5;209m"},
+		{Palette, "208", "\033[38;5;208m"},
+		{Basic, "208", "\033[93m"},
+		{Basic, "75", "\033[94m"},
+		{Basic, "#787c7e", "\033[90m"},
+		{Basic, "#6aaa64", "\033[32m"},
+		{TrueColor, "bright-white on-#6aaa64", "\033[97m\033[48;2;106;170;100m"},
+		{Palette, "on-#6aaa64", "\033[48;5;71m"},
+		{Basic, "on-#6aaa64", "\033[42m"},
+		{Basic, "on-gray", "\033[100m"},
+		{None, "green", ""},
+	}
+
+	for _, tt := range tests {
+		Use(tt.level)
+		got, err := Parse(tt.spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) at level %v = %q, want %q", tt.spec, tt.level, got, tt.want)
+		}
+	}
+
+	for _, spec := range []string{"blurple", "on-bold", "on-"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("parsed %q", spec)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package color
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bitmap/wordle/internal/term"
+)
+
+// Level is how many colors the terminal can show.
+type Level int
+
+const (
+	// None turns off colors and other escape sequences.
+	None Level = iota
+	// Basic is the 16 colors every color terminal has.
+	Basic
+	// Palette is the 256-color palette of xterm.
+	Palette
+	// TrueColor is any 24-bit color.
+	TrueColor
+)
+
+// Mode is when colors are used, as picked with --color.
+type Mode int
+
+const (
+	// Auto uses colors when writing to a terminal that has them.
+	Auto Mode = iota
+	// Always uses colors, even when writing to a file or a pipe.
+	Always
+	// Never uses colors.
+	Never
+)
+
+// Modes by name, as given on the command line.
+var Modes = map[string]Mode{
+	"auto":   Auto,
+	"always": Always,
+	"never":  Never,
+}
+
+// ParseMode returns the mode with the given name.
+func ParseMode(name string) (Mode, error) {
+	if m, ok := Modes[strings.ToLower(name)]; ok {
+		return m, nil
+	}
+	return Auto, fmt.Errorf("unknown color mode %s, use auto, always or never", name)
+}
+
+// Level colors are written with.
+var level Level
+
+// Current returns the level colors are written with.
+func Current() Level {
+	return level
+}
+
+// Detect returns the level of the terminal on standard output, in the given
+// mode.
+func Detect(mode Mode) Level {
+	return detect(mode, os.Getenv, term.IsTerminal(os.Stdout))
+}
+
+// Picks the level from the environment, following the NO_COLOR and
+// CLICOLOR_FORCE conventions. COLORTERM and TERM tell how many colors there
+// are.
+func detect(mode Mode, getenv func(string) string, tty bool) Level {
+	forced := getenv("CLICOLOR_FORCE") != "" && getenv("CLICOLOR_FORCE") != "0"
+
+	switch {
+	case mode == Never:
+		return None
+	case mode == Always || forced:
+		// Colors were asked for, so even a terminal that is not known to
+		// have them gets the basic ones.
+	case getenv("NO_COLOR") != "", !tty, term.Dumb(getenv):
+		return None
+	}
+
+	switch colorterm := strings.ToLower(getenv("COLORTERM")); {
+	case colorterm == "truecolor" || colorterm == "24bit":
+		return TrueColor
+	case strings.Contains(getenv("TERM"), "256color"):
+		return Palette
+	}
+	return Basic
+}
@@ -0,0 +1,109 @@
+// Package daily picks the daily puzzle, so that everyone gets the same word
+// on the same day, and remembers which puzzles have been played.
+package daily
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/store"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// Epoch is the day of puzzle #0.
+var Epoch = time.Date(2021, time.June, 19, 0, 0, 0, 0, time.UTC)
+
+// File the results of daily puzzles are kept in.
+const playedFile = "daily.json"
+
+// Returns the number of the puzzle for the given day, in local time.
+func Number(t time.Time) int {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(Epoch).Hours() / 24)
+}
+
+// Returns the day of a puzzle.
+func Date(number int) time.Time {
+	return Epoch.AddDate(0, 0, number)
+}
+
+// Returns the number of today's puzzle.
+func Today() int {
+	return Number(time.Now())
+}
+
+// Parse reads a puzzle number, with or without a leading #, or a date in
+// the form 2006-01-02. Puzzles from the future are not available yet.
+func Parse(s string) (int, error) {
+	if len(s) > 0 && s[0] == '#' {
+		s = s[1:]
+	}
+
+	number, err := strconv.Atoi(s)
+	if err != nil {
+		date, dateErr := time.ParseInLocation(time.DateOnly, s, time.Local)
+		if dateErr != nil {
+			return 0, fmt.Errorf("%q is not a puzzle number or a date like 2006-01-02", s)
+		}
+		number = Number(date)
+	}
+
+	switch {
+	case number < 0:
+		return 0, errors.New("there are no puzzles before " + Epoch.Format(time.DateOnly))
+	case number > Today():
+		return 0, fmt.Errorf("puzzle #%d is not out yet", number)
+	}
+
+	return number, nil
+}
+
+// Returns the answer of a puzzle.
+//
+// Puzzle numbers are mapped onto the answer list with n -> (a*n + b) mod size,
+// where a shares no factor with the size. That visits every answer once
+// before any repeats, does not follow the alphabetical order of the list, and
+// gives the same word for the same puzzle on every machine.
+func Answer(number int) string {
+	answers := words.Answers(words.DefaultLength)
+	size := len(answers)
+
+	a := 7919
+	for gcd(a, size) != 1 {
+		a++
+	}
+
+	return answers[(a*number+1237)%size]
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// Played returns the result of a puzzle, if it was played before.
+func Played(number int) (game.Result, bool, error) {
+	played := map[int]game.Result{}
+	if err := store.Load(playedFile, &played); err != nil {
+		return game.Result{}, false, err
+	}
+
+	result, ok := played[number]
+	return result, ok, nil
+}
+
+// Record remembers the result of a puzzle, so it can't be played again.
+func Record(number int, result game.Result) error {
+	played := map[int]game.Result{}
+	if err := store.Load(playedFile, &played); err != nil {
+		return err
+	}
+
+	played[number] = result
+	return store.Save(playedFile, played)
+}
@@ -0,0 +1,64 @@
+package daily
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want int
+	}{
+		{time.Date(2021, time.June, 19, 0, 0, 0, 0, time.Local), 0},
+		{time.Date(2021, time.June, 19, 23, 59, 0, 0, time.Local), 0},
+		{time.Date(2022, time.January, 1, 8, 0, 0, 0, time.Local), 196},
+		{time.Date(2024, time.March, 15, 12, 0, 0, 0, time.Local), 1000},
+	}
+
+	for _, tt := range tests {
+		if got := Number(tt.date); got != tt.want {
+			t.Errorf("Number(%v) = %d, want %d", tt.date, got, tt.want)
+		}
+		if got := Date(tt.want).Format(time.DateOnly); got != tt.date.Format(time.DateOnly) {
+			t.Errorf("Date(%d) = %s, want %s", tt.want, got, tt.date.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestAnswerCyclesThroughList(t *testing.T) {
+	size := len(words.Answers(words.DefaultLength))
+
+	seen := map[string]bool{}
+	for n := range size {
+		seen[Answer(n)] = true
+	}
+
+	if len(seen) != size {
+		t.Errorf("%d puzzles used %d different answers", size, len(seen))
+	}
+	if Answer(0) != Answer(size) {
+		t.Errorf("puzzle %d does not start the list over", size)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	if _, played, err := Played(5); err != nil || played {
+		t.Fatalf("Played(5) = %v, %v before it was played", played, err)
+	}
+
+	result := game.Result{Answer: Answer(5), Guesses: []string{"crane"}}
+	if err := Record(5, result); err != nil {
+		t.Fatal(err)
+	}
+
+	got, played, err := Played(5)
+	if err != nil || !played || got.Answer != result.Answer {
+		t.Errorf("Played(5) = %+v, %v, %v after recording it", got, played, err)
+	}
+}
@@ -0,0 +1,175 @@
+// Package history keeps a log of every finished game, guess by guess.
+package history
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/lang"
+	"github.com/bitmap/wordle/internal/store"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// File the log is kept in.
+const historyFile = "history.json"
+
+// Board is one board of a finished game.
+type Board struct {
+	Answer string
+
+	// Colors holds the feedback of every guess this board took, as written by
+	// game.Feedback.Colors. Boards that were solved early took fewer guesses.
+	Colors []string
+}
+
+// Entry is a finished game.
+type Entry struct {
+	ID         int
+	Mode       string
+	Puzzle     string `json:",omitempty"` // puzzle number or game code
+	Language   string `json:",omitempty"` // code of the language, English if empty
+	Boards     []Board
+	Guesses    []string
+	MaxGuesses int // or game.Unlimited
+	Difficulty game.Difficulty
+	Won        bool
+	Started    time.Time
+	Finished   time.Time
+}
+
+// NewEntry logs a finished game. The ID is given when the entry is added.
+func NewEntry(m *game.Multi, mode, puzzle string, started, finished time.Time) Entry {
+	e := Entry{
+		Mode:       mode,
+		Puzzle:     puzzle,
+		Language:   words.Current().Language,
+		MaxGuesses: m.MaxGuesses(),
+		Difficulty: m.Boards()[0].Options().Difficulty,
+		Won:        m.Status() == game.Won,
+		Started:    started,
+		Finished:   finished,
+	}
+
+	for _, b := range m.Boards() {
+		board := Board{Answer: b.Answer()}
+		for _, f := range b.Guesses() {
+			board.Colors = append(board.Colors, f.Colors())
+		}
+		e.Boards = append(e.Boards, board)
+	}
+	e.Guesses = m.Guesses()
+
+	return e
+}
+
+// Score returns the number of guesses a won game took out of the number
+// allowed, like 4/6. Lost games score X.
+func (e Entry) Score() string {
+	score := "X"
+	if e.Won {
+		score = fmt.Sprint(len(e.Guesses))
+	}
+	if e.MaxGuesses > 0 {
+		score += "/" + fmt.Sprint(e.MaxGuesses)
+	}
+	return score
+}
+
+// Duration returns how long the game took.
+func (e Entry) Duration() time.Duration {
+	return e.Finished.Sub(e.Started)
+}
+
+// Answers returns the answer of every board.
+func (e Entry) Answers() []string {
+	answers := make([]string, len(e.Boards))
+	for i, b := range e.Boards {
+		answers[i] = b.Answer
+	}
+	return answers
+}
+
+// Feedback returns the scored guesses of a board.
+func (e Entry) Feedback(board int) ([]game.Feedback, error) {
+	var feedback []game.Feedback
+	for i, colors := range e.Boards[board].Colors {
+		f, err := game.ParseFeedback(e.Guesses[i], colors)
+		if err != nil {
+			return nil, err
+		}
+		feedback = append(feedback, f)
+	}
+	return feedback, nil
+}
+
+// Load returns every logged game, oldest first.
+func Load() ([]Entry, error) {
+	var entries []Entry
+	err := store.Load(historyFile, &entries)
+	return entries, err
+}
+
+// Add logs a game and returns it with its ID.
+func Add(e Entry) (Entry, error) {
+	entries, err := Load()
+	if err != nil {
+		return e, err
+	}
+
+	e.ID = 1
+	if len(entries) > 0 {
+		e.ID = entries[len(entries)-1].ID + 1
+	}
+
+	return e, store.Save(historyFile, append(entries, e))
+}
+
+// Find returns the game with the given ID.
+func Find(id int) (Entry, bool, error) {
+	entries, err := Load()
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	i := slices.IndexFunc(entries, func(e Entry) bool { return e.ID == id })
+	if i < 0 {
+		return Entry{}, false, nil
+	}
+	return entries[i], true, nil
+}
+
+// Outcomes a filter can ask for.
+const (
+	Any = iota
+	Won
+	Lost
+)
+
+// Filter picks games from the log. The zero value matches every game.
+type Filter struct {
+	Since   time.Time // games finished on or after this time
+	Until   time.Time // games finished before this time
+	Mode    string
+	Outcome int
+	Answer  string // any board had this answer
+}
+
+// Match returns true if the game passes the filter.
+func (f Filter) Match(e Entry) bool {
+	switch {
+	case !f.Since.IsZero() && e.Finished.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && !e.Finished.Before(f.Until):
+		return false
+	case f.Mode != "" && !strings.EqualFold(e.Mode, f.Mode):
+		return false
+	case f.Outcome == Won && !e.Won, f.Outcome == Lost && e.Won:
+		return false
+	case f.Answer != "" && !slices.Contains(e.Answers(), lang.Get(e.Language).Fold(f.Answer)):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitmap/wordle/game"
+)
+
+func TestNewEntry(t *testing.T) {
+	opts := game.Options{MaxGuesses: game.MultiGuesses(2)}
+	m := game.NewMulti(game.New("those", opts), game.New("crane", opts))
+	for _, word := range []string{"those", "slate", "crane"} {
+		if _, err := m.Submit(word); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	start := time.Date(2024, time.March, 15, 9, 0, 0, 0, time.UTC)
+	e := NewEntry(m, "dordle", "", start, start.Add(90*time.Second))
+
+	if !e.Won || e.Duration() != 90*time.Second || len(e.Guesses) != 3 {
+		t.Errorf("won %v in %v with guesses %v", e.Won, e.Duration(), e.Guesses)
+	}
+	if got := len(e.Boards[0].Colors); got != 1 {
+		t.Errorf("first board took %d guesses, want 1", got)
+	}
+
+	feedback, err := e.Feedback(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := feedback[1]; got.Word() != "slate" || got.Colors() != "--g-g" {
+		t.Errorf("second guess on the second board was %s %s", got.Word(), got.Colors())
+	}
+}
+
+func TestAddAndFilter(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	day := time.Date(2024, time.March, 15, 9, 0, 0, 0, time.UTC)
+	for i, e := range []Entry{
+		{Mode: "classic", Boards: []Board{{Answer: "those"}}, Won: true, Finished: day},
+		{Mode: "hard mode", Boards: []Board{{Answer: "crane"}}, Finished: day.AddDate(0, 0, 1)},
+		{Mode: "classic", Boards: []Board{{Answer: "crane"}}, Won: true, Finished: day.AddDate(0, 0, 2)},
+	} {
+		added, err := Add(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if added.ID != i+1 {
+			t.Errorf("entry %d got ID %d", i+1, added.ID)
+		}
+	}
+
+	entries, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		filter Filter
+		want   int
+	}{
+		{Filter{}, 3},
+		{Filter{Mode: "Classic"}, 2},
+		{Filter{Outcome: Lost}, 1},
+		{Filter{Answer: "CRANE", Outcome: Won}, 1},
+		{Filter{Since: day.AddDate(0, 0, 1)}, 2},
+		{Filter{Until: day.AddDate(0, 0, 1)}, 1},
+	}
+
+	for _, tt := range tests {
+		got := 0
+		for _, e := range entries {
+			if tt.filter.Match(e) {
+				got++
+			}
+		}
+		if got != tt.want {
+			t.Errorf("%+v matched %d games, want %d", tt.filter, got, tt.want)
+		}
+	}
+
+	if e, ok, err := Find(2); err != nil || !ok || e.Mode != "hard mode" {
+		t.Errorf("Find(2) = %+v, %v, %v", e, ok, err)
+	}
+}
@@ -0,0 +1,171 @@
+// Package lang describes the languages Wordle can be played in: the letters
+// of each, how typed and listed words are folded to those letters, and how
+// they are written in upper case.
+package lang
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Language is a language Wordle can be played in.
+type Language struct {
+	Code string // ISO 639-1 code, like "es"
+	Name string // English name, like "Spanish"
+
+	// Alphabet holds the letters words are played with, in order.
+	Alphabet string
+
+	// Special casing of the language, like the dotted and dotless I of
+	// Turkish. Nil for the usual casing.
+	casing unicode.SpecialCase
+
+	// Letters that are typed or listed but not played, like accented vowels,
+	// and what they are played as instead.
+	folded map[rune]string
+}
+
+// English is the language of the built-in word lists.
+var English = &Language{
+	Code:     "en",
+	Name:     "English",
+	Alphabet: "abcdefghijklmnopqrstuvwxyz",
+}
+
+// Languages by code.
+var languages = map[string]*Language{
+	"en": English,
+	"es": {
+		Code:     "es",
+		Name:     "Spanish",
+		Alphabet: "abcdefghijklmnñopqrstuvwxyz",
+		folded:   letters("áa ée íi óo úu üu"),
+	},
+	"de": {
+		Code:     "de",
+		Name:     "German",
+		Alphabet: "abcdefghijklmnopqrstuvwxyzäöü",
+		folded:   map[rune]string{'ß': "ss"},
+	},
+	"pt": {
+		Code:     "pt",
+		Name:     "Portuguese",
+		Alphabet: "abcdefghijklmnopqrstuvwxyz",
+		folded:   letters("áa àa âa ãa çc ée êe íi óo ôo õo úu üu"),
+	},
+	"tr": {
+		Code:     "tr",
+		Name:     "Turkish",
+		Alphabet: "abcçdefgğhıijklmnoöprsştuüvyz",
+		casing:   unicode.TurkishCase,
+		folded:   letters("âa îi ûu"),
+	},
+}
+
+// Returns a fold table from a letter followed by what it is played as, for
+// each word of pairs.
+func letters(pairs string) map[rune]string {
+	folded := map[rune]string{}
+	for _, pair := range strings.Fields(pairs) {
+		r, size := utf8.DecodeRuneInString(pair)
+		folded[r] = pair[size:]
+	}
+	return folded
+}
+
+// Find returns the language with the given code. The empty code is English.
+func Find(code string) (*Language, error) {
+	if code == "" {
+		return English, nil
+	}
+	if l, ok := languages[strings.ToLower(code)]; ok {
+		return l, nil
+	}
+	return nil, fmt.Errorf("unknown language %s, use one of %s", code, strings.Join(Codes(), ", "))
+}
+
+// Get returns the language with the given code, or English if there is no
+// such language.
+func Get(code string) *Language {
+	if l, err := Find(code); err == nil {
+		return l
+	}
+	return English
+}
+
+// Codes returns the codes of every language, sorted.
+func Codes() []string {
+	var codes []string
+	for code := range languages {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// Lower returns s in lower case.
+func (l *Language) Lower(s string) string {
+	return strings.ToLowerSpecial(l.casing, s)
+}
+
+// Upper returns s in upper case.
+func (l *Language) Upper(s string) string {
+	return strings.ToUpperSpecial(l.casing, s)
+}
+
+// Fold returns a word the way it is played: in lower case, with combining
+// accents joined to their letters, and letters that are not played folded to
+// the ones that are, like á to a in Spanish. Letters outside the alphabet are
+// kept, for Check to find.
+func (l *Language) Fold(word string) string {
+	var runes []rune
+	for _, r := range l.Lower(word) {
+		if unicode.Is(unicode.Mn, r) {
+			// Accents that can't be joined to their letter are dropped.
+			if n := len(runes); n > 0 {
+				if c, ok := composed[r][runes[n-1]]; ok {
+					runes[n-1] = c
+				}
+			}
+			continue
+		}
+		runes = append(runes, r)
+	}
+
+	var b strings.Builder
+	for _, r := range runes {
+		if s, ok := l.folded[r]; ok {
+			b.WriteString(s)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Check returns an error if a folded word has letters outside the alphabet.
+func (l *Language) Check(word string) error {
+	for _, r := range word {
+		if !strings.ContainsRune(l.Alphabet, r) {
+			return fmt.Errorf("%s has letters that are not in the %s alphabet", word, l.Name)
+		}
+	}
+	return nil
+}
+
+// Letters made of a plain letter and a combining accent, by accent.
+var composed = map[rune]map[rune]rune{
+	'\u0300': {'a': 'à', 'e': 'è', 'i': 'ì', 'o': 'ò', 'u': 'ù'},                               // grave
+	'\u0301': {'a': 'á', 'e': 'é', 'i': 'í', 'o': 'ó', 'u': 'ú', 'y': 'ý'},                     // acute
+	'\u0302': {'a': 'â', 'e': 'ê', 'i': 'î', 'o': 'ô', 'u': 'û'},                               // circumflex
+	'\u0303': {'a': 'ã', 'n': 'ñ', 'o': 'õ'},                                                   // tilde
+	'\u0306': {'g': 'ğ'},                                                                       // breve
+	'\u0307': {'i': 'i'},                                                                       // dot above, as in the lower case of İ
+	'\u0308': {'a': 'ä', 'e': 'ë', 'i': 'ï', 'o': 'ö', 'u': 'ü', 'y': 'ÿ'},                     // diaeresis
+	'\u030a': {'a': 'å', 'u': 'ů'},                                                             // ring
+	'\u030c': {'c': 'č', 'd': 'ď', 'e': 'ě', 'n': 'ň', 'r': 'ř', 's': 'š', 't': 'ť', 'z': 'ž'}, // caron
+	'\u0327': {'c': 'ç', 's': 'ş'},                                                             // cedilla
+}
@@ -0,0 +1,47 @@
+package lang
+
+import "testing"
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		code, word, want string
+	}{
+		{"en", "CRANE", "crane"},
+		{"es", "Ácido", "acido"},
+		{"es", "NIÑO", "niño"},
+		{"es", "niño", "niño"}, // ñ written as n and a combining tilde
+		{"de", "Straße", "strasse"},
+		{"de", "ÄRGER", "ärger"},
+		{"pt", "Ação", "acao"},
+		{"tr", "IŞIK", "ışık"},
+		{"tr", "İSTANBUL", "istanbul"},
+	}
+
+	for _, tt := range tests {
+		if got := Get(tt.code).Fold(tt.word); got != tt.want {
+			t.Errorf("%s: Fold(%q) = %q, want %q", tt.code, tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestUpper(t *testing.T) {
+	tr := Get("tr")
+	if got := tr.Upper("ışık insan"); got != "IŞIK İNSAN" {
+		t.Errorf("Turkish Upper = %q", got)
+	}
+	if got := English.Upper("insan"); got != "INSAN" {
+		t.Errorf("English Upper = %q", got)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	if err := Get("es").Check("niño"); err != nil {
+		t.Error(err)
+	}
+	if err := English.Check("niño"); err == nil {
+		t.Error("ñ is not an English letter")
+	}
+	if err := Get("tr").Check("quiz"); err == nil {
+		t.Error("q is not a Turkish letter")
+	}
+}
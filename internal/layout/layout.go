@@ -0,0 +1,109 @@
+// Package layout describes the keyboard layouts the on-screen keyboard can be
+// drawn in, so that it matches the keys under the player's fingers.
+package layout
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Layout is the letter keys of a keyboard, row by row from the top.
+type Layout struct {
+	Name string
+	Rows []string
+}
+
+// QWERTY is the layout used when no other is picked.
+var QWERTY = &Layout{Name: "qwerty", Rows: []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}}
+
+// Layouts by name.
+var layouts = map[string]*Layout{
+	"qwerty":  QWERTY,
+	"azerty":  {Name: "azerty", Rows: []string{"azertyuiop", "qsdfghjklm", "wxcvbn"}},
+	"qwertz":  {Name: "qwertz", Rows: []string{"qwertzuiop", "asdfghjkl", "yxcvbnm"}},
+	"dvorak":  {Name: "dvorak", Rows: []string{"pyfgcrl", "aoeuidhtns", "qjkxbmwvz"}},
+	"colemak": {Name: "colemak", Rows: []string{"qwfpgjluy", "arstdhneio", "zxcvbkm"}},
+}
+
+// Rows that letters missing from a layout are added to, roughly where
+// national keyboards have them. Other letters go on the bottom row.
+var extraRow = map[rune]int{
+	'ü': 0, 'ğ': 0, 'ı': 0,
+	'ñ': 1, 'ä': 1, 'ö': 1, 'ş': 1,
+}
+
+// Find returns the layout with the given name.
+func Find(name string) (*Layout, error) {
+	if l, ok := layouts[strings.ToLower(name)]; ok {
+		return l, nil
+	}
+	return nil, fmt.Errorf("unknown keyboard layout %s, use one of %s", name, strings.Join(Names(), ", "))
+}
+
+// Names returns the names of every layout, sorted.
+func Names() []string {
+	var names []string
+	for name := range layouts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Languages whose keyboards are not QWERTY, by language and then by region.
+// The empty region is every other region.
+var national = map[string]map[string]string{
+	"fr": {"": "azerty", "CA": "qwerty", "CH": "qwertz"},
+	"de": {"": "qwertz"},
+	"cs": {"": "qwertz"},
+	"sk": {"": "qwertz"},
+	"hu": {"": "qwertz"},
+	"sl": {"": "qwertz"},
+	"hr": {"": "qwertz"},
+}
+
+// ForLocale returns the layout usual for a locale like "fr_FR.UTF-8", or
+// QWERTY.
+func ForLocale(locale string) *Layout {
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "@")
+	language, region, _ := strings.Cut(locale, "_")
+
+	regions, ok := national[strings.ToLower(language)]
+	if !ok {
+		return QWERTY
+	}
+	name, ok := regions[strings.ToUpper(region)]
+	if !ok {
+		name = regions[""]
+	}
+	return layouts[name]
+}
+
+// Keys returns the rows of keys for an alphabet: the letters of the layout
+// that are in the alphabet, and the letters of the alphabet that are not on
+// the layout added to the end of a row.
+func (l *Layout) Keys(alphabet string) [][]rune {
+	keys := make([][]rune, len(l.Rows))
+	for i, row := range l.Rows {
+		for _, r := range row {
+			if strings.ContainsRune(alphabet, r) {
+				keys[i] = append(keys[i], r)
+			}
+		}
+	}
+
+	all := strings.Join(l.Rows, "")
+	for _, r := range alphabet {
+		if strings.ContainsRune(all, r) {
+			continue
+		}
+		row, ok := extraRow[r]
+		if !ok {
+			row = len(keys) - 1
+		}
+		keys[row] = append(keys[row], r)
+	}
+	return keys
+}
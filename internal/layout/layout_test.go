@@ -0,0 +1,45 @@
+package layout
+
+import "testing"
+
+func TestForLocale(t *testing.T) {
+	tests := map[string]string{
+		"":            "qwerty",
+		"C":           "qwerty",
+		"en_US.UTF-8": "qwerty",
+		"fr_FR.UTF-8": "azerty",
+		"fr_BE":       "azerty",
+		"fr_CA.UTF-8": "qwerty",
+		"fr_CH":       "qwertz",
+		"de_DE@euro":  "qwertz",
+		"cs_CZ.UTF-8": "qwertz",
+	}
+
+	for locale, want := range tests {
+		if got := ForLocale(locale).Name; got != want {
+			t.Errorf("ForLocale(%q) = %s, want %s", locale, got, want)
+		}
+	}
+}
+
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		layout   *Layout
+		alphabet string
+		want     []string
+	}{
+		{QWERTY, "abcdefghijklmnopqrstuvwxyz", []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}},
+		{QWERTY, "abcdefghijklmnñopqrstuvwxyz", []string{"qwertyuiop", "asdfghjklñ", "zxcvbnm"}},
+		{layouts["qwertz"], "abcdefghijklmnopqrstuvwxyzäöü", []string{"qwertzuiopü", "asdfghjkläö", "yxcvbnm"}},
+		{QWERTY, "abcçdefgğhıijklmnoöprsştuüvyz", []string{"ertyuiopğıü", "asdfghjklöş", "zcvbnmç"}},
+	}
+
+	for _, tt := range tests {
+		keys := tt.layout.Keys(tt.alphabet)
+		for i, row := range keys {
+			if string(row) != tt.want[i] {
+				t.Errorf("%s row %d for %s is %s, want %s", tt.layout.Name, i, tt.alphabet, string(row), tt.want[i])
+			}
+		}
+	}
+}
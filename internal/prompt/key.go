@@ -0,0 +1,76 @@
+package prompt
+
+import (
+	"io"
+	"unicode"
+)
+
+// KeyKind is what a key pressed in raw mode does.
+type KeyKind int
+
+const (
+	// Letter keys type their rune.
+	Letter KeyKind = iota
+	// Enter submits what was typed.
+	Enter
+	// Backspace removes the last letter typed.
+	Backspace
+	// Interrupt is Ctrl-C, which is not sent as a signal in raw mode.
+	Interrupt
+	// Other keys, like arrows and function keys, do nothing.
+	Other
+)
+
+// Key is a key pressed in raw mode.
+type Key struct {
+	Kind KeyKind
+	Rune rune
+}
+
+// ReadKey reads one key pressed while the terminal is in raw mode. Ctrl-D
+// gives io.EOF, like the end of input at a prompt.
+func ReadKey() (Key, error) {
+	r, _, err := reader.ReadRune()
+	if err != nil {
+		return Key{}, err
+	}
+
+	switch r {
+	case '\r', '\n':
+		return Key{Kind: Enter}, nil
+	case '\b', 0x7f:
+		return Key{Kind: Backspace}, nil
+	case 0x03:
+		return Key{Kind: Interrupt}, nil
+	case 0x04:
+		return Key{}, io.EOF
+	case 0x1b:
+		skipEscape()
+		return Key{Kind: Other}, nil
+	}
+
+	if unicode.IsLetter(r) || unicode.Is(unicode.Mn, r) {
+		return Key{Kind: Letter, Rune: r}, nil
+	}
+	return Key{Kind: Other}, nil
+}
+
+// Skips the rest of an escape sequence, like the one an arrow key sends, if
+// it came in with the escape.
+func skipEscape() {
+	if reader.Buffered() == 0 {
+		return
+	}
+	b, err := reader.ReadByte()
+	if err != nil || (b != '[' && b != 'O') {
+		return
+	}
+
+	// Parameters and intermediates come before a final byte from @ to ~.
+	for reader.Buffered() > 0 {
+		b, err := reader.ReadByte()
+		if err != nil || (b >= 0x40 && b <= 0x7e) {
+			return
+		}
+	}
+}
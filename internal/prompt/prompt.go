@@ -2,62 +2,77 @@ package prompt
 
 import (
 	"bufio"
-	"errors"
 	"fmt"
 	"os"
 	"strings"
-
-	"github.com/bitmap/wordle/internal/words"
 )
 
-// Returns trimmed & lowercase response to user input
+// Shared by every prompt, so that input buffered by one prompt is not lost
+// to the next.
+var reader = bufio.NewReader(os.Stdin)
+
+// Returns trimmed response to user input. Lower case is left to the caller,
+// as it depends on the language.
 func promptString(str string) (string, error) {
 	var prompt string
 	var err error
 
-	reader := bufio.NewReader(os.Stdin)
 	for {
 		fmt.Fprint(os.Stderr, str)
 		prompt, err = reader.ReadString('\n')
-		if prompt != "" {
+		if prompt != "" || err != nil {
 			break
 		}
 	}
-	prompt = strings.TrimSpace(strings.ToLower(prompt))
+	prompt = strings.TrimSpace(prompt)
 
 	return prompt, err
 }
 
-// Prompt the user to guess a word.
+// Prompt the user to guess a word. Folding the guess to the letters of the
+// game and checking it are left to the caller. An error means there is no
+// more input, as when the user hits Ctrl-D.
 func Guess() (string, error) {
 	prompt, err := promptString("\n  Guess?> ")
-	if err != nil {
-		panic(err)
-	}
 
-	// Display an error if the user doesn't input enough chars
-	if len(prompt) != 5 {
-		return "", errors.New("your guess must be 5 letters long")
+	// A last guess without a newline is still a guess.
+	if prompt != "" {
+		return prompt, nil
 	}
-
-	// Check to see if word is allowed
-	if !words.IsValidWord(prompt) {
-		return "", errors.New("invalid word")
-	}
-
-	return prompt, nil
+	return prompt, err
 }
 
 // Prompt the user to play again.
 func Retry() bool {
 	prompt, err := promptString("\nPlay again? [y/N]")
 	if err != nil {
-		panic(err)
+		return false
 	}
 
-	if prompt == "y" {
+	if strings.EqualFold(prompt, "y") {
 		return true
 	}
 
 	return false
 }
+
+// Prompt the user to pick up a game that was left unfinished.
+func Resume(title string) bool {
+	prompt, err := promptString("\nYou have an unfinished game: " + title + "\nPick it up again? [Y/n]")
+	if err != nil {
+		return false
+	}
+
+	return prompt == "" || strings.EqualFold(prompt, "y")
+}
+
+// Prompt the user to step through a replay. Returns "b" to go back, "q" to
+// quit, or "" for the next step.
+func Step() string {
+	prompt, err := promptString("\n  [Enter] next, [b]ack, [q]uit> ")
+	if err != nil {
+		return "q"
+	}
+
+	return strings.ToLower(prompt)
+}
@@ -0,0 +1,151 @@
+// Package saved keeps games that are still being played, so that they can be
+// picked up again after the terminal is closed or the game is interrupted.
+package saved
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/code"
+	"github.com/bitmap/wordle/internal/store"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// File the games are kept in.
+const savedFile = "saved.json"
+
+// Game is a game that is still being played.
+type Game struct {
+	Kind   string `json:",omitempty"` // daily, challenge, or empty for a random game
+	Puzzle string `json:",omitempty"` // puzzle number or game code
+
+	// Answers holds the answer of every board, sealed so that it can't be
+	// read from the file at a glance. Absurdle boards have no answer yet.
+	Answers  []string
+	Absurdle bool
+	Length   int
+
+	// Words are the word lists the game is played with. They have to be in
+	// use again before the game is rebuilt.
+	Words words.Source
+
+	Options game.Options
+	Guesses []string
+	Started time.Time
+	Saved   time.Time
+}
+
+// New saves the state of a game being played.
+func New(m *game.Multi, kind, puzzle string, started time.Time) Game {
+	g := Game{
+		Kind:    kind,
+		Puzzle:  puzzle,
+		Length:  m.Boards()[0].WordLength(),
+		Words:   words.Current(),
+		Options: m.Boards()[0].Options(),
+		Guesses: m.Guesses(),
+		Started: started,
+		Saved:   time.Now(),
+	}
+
+	for _, b := range m.Boards() {
+		if b.Absurdle() {
+			g.Absurdle = true
+			g.Answers = append(g.Answers, "")
+			continue
+		}
+		g.Answers = append(g.Answers, code.Seal([]byte(b.Answer())))
+	}
+
+	return g
+}
+
+// Multi rebuilds the game by making every saved guess again, with the word
+// lists in use.
+func (g Game) Multi() (*game.Multi, error) {
+	if len(g.Answers) == 0 {
+		return nil, errors.New("the saved game has no boards")
+	}
+
+	opts := g.Options
+	opts.Words, opts.Fold = words.Dict(), words.Language()
+
+	boards := make([]*game.Game, len(g.Answers))
+	for i, sealed := range g.Answers {
+		if g.Absurdle {
+			boards[i] = game.NewAbsurdle(words.Answers(g.Length), opts)
+			continue
+		}
+
+		answer, err := code.Open(sealed)
+		if err != nil {
+			return nil, err
+		}
+		boards[i] = game.New(string(answer), opts)
+	}
+
+	m := game.NewMulti(boards...)
+	for _, word := range g.Guesses {
+		if _, err := m.Submit(word); err != nil {
+			return nil, errors.New("the saved game is damaged: " + err.Error())
+		}
+	}
+	return m, nil
+}
+
+// Returns true if both games are kept in the same place. Every daily puzzle
+// is kept on its own, so that none is lost; of the other kinds of game only
+// the last one is kept.
+func (g Game) sameSlot(other Game) bool {
+	if g.Kind != other.Kind {
+		return false
+	}
+	return g.Kind != "daily" || g.Puzzle == other.Puzzle
+}
+
+// Load returns every saved game, the most recently saved last.
+func Load() ([]Game, error) {
+	var games []Game
+	err := store.Load(savedFile, &games)
+	return games, err
+}
+
+// Find returns the saved game of the given kind and puzzle.
+func Find(kind, puzzle string) (Game, bool, error) {
+	games, err := Load()
+	if err != nil {
+		return Game{}, false, err
+	}
+
+	key := Game{Kind: kind, Puzzle: puzzle}
+	i := slices.IndexFunc(games, key.sameSlot)
+	if i < 0 || games[i].Puzzle != puzzle {
+		return Game{}, false, nil
+	}
+	return games[i], true, nil
+}
+
+// Save keeps a game, replacing the one saved before it in the same place.
+func Save(g Game) error {
+	games, err := Load()
+	if err != nil {
+		return err
+	}
+
+	games = slices.DeleteFunc(games, g.sameSlot)
+	return store.Save(savedFile, append(games, g))
+}
+
+// Remove forgets the saved game of the given kind and puzzle, once it is
+// over.
+func Remove(kind, puzzle string) error {
+	games, err := Load()
+	if err != nil {
+		return err
+	}
+
+	key := Game{Kind: kind, Puzzle: puzzle}
+	return store.Save(savedFile, slices.DeleteFunc(games, key.sameSlot))
+}
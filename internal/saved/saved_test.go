@@ -0,0 +1,88 @@
+package saved
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+func TestMulti(t *testing.T) {
+	opts := game.Options{MaxGuesses: game.MultiGuesses(2), Difficulty: game.Hard, Words: words.Dict(), Fold: words.Language()}
+	m := game.NewMulti(game.New("crane", opts), game.New("those", opts))
+	for _, word := range []string{"crane", "slate"} {
+		if _, err := m.Submit(word); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := New(m, "", "K3P9", time.Now())
+	if s.Answers[1] == "those" {
+		t.Error("the answer was saved as it is")
+	}
+
+	got, err := s.Multi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Played() != 2 || got.Boards()[1].Answer() != "those" || got.Boards()[0].Status() != game.Won {
+		t.Errorf("rebuilt %d guesses on %s, first board %v", got.Played(), got.Boards()[1].Answer(), got.Boards()[0].Status())
+	}
+	if got.Boards()[0].Options() != opts {
+		t.Errorf("rebuilt with %+v, want %+v", got.Boards()[0].Options(), opts)
+	}
+}
+
+func TestMultiAbsurdle(t *testing.T) {
+	a := game.NewAbsurdle(words.Answers(5), game.Options{MaxGuesses: game.Unlimited})
+	m := game.NewMulti(a)
+	if _, err := m.Submit("crane"); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := New(m, "", "", time.Now()).Multi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, want := got.Boards()[0].Candidates(), a.Candidates(); n != want {
+		t.Errorf("rebuilt with %d candidates, want %d", n, want)
+	}
+}
+
+func TestSlots(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	for _, g := range []Game{
+		{Kind: "daily", Puzzle: "#1"},
+		{Kind: "daily", Puzzle: "#2"},
+		{Puzzle: "AAAAA"},
+		{Puzzle: "BBBBB"},
+	} {
+		if err := Save(g); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	games, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 3 || games[2].Puzzle != "BBBBB" {
+		t.Fatalf("saved %+v", games)
+	}
+
+	if _, ok, _ := Find("", "AAAAA"); ok {
+		t.Error("found a random game that was replaced")
+	}
+	if _, ok, _ := Find("daily", "#1"); !ok {
+		t.Error("daily puzzle #1 was lost")
+	}
+
+	if err := Remove("daily", "#2"); err != nil {
+		t.Fatal(err)
+	}
+	if games, _ := Load(); len(games) != 2 {
+		t.Errorf("%d games left, want 2", len(games))
+	}
+}
@@ -0,0 +1,110 @@
+// Package screen draws the game in place on the alternate screen of the
+// terminal, redrawing only the lines that changed since the last frame.
+// Terminals that can't move the cursor get every frame printed after the last
+// one instead.
+package screen
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/bitmap/wordle/internal/term"
+)
+
+// Screen is where frames are drawn.
+type Screen struct {
+	mu     sync.Mutex
+	out    io.Writer
+	full   bool     // the terminal can move the cursor
+	active bool     // the alternate screen is in use
+	hidden bool     // the cursor is hidden
+	shown  []string // lines of the last frame drawn
+}
+
+// New returns the screen of a terminal. Files, pipes and dumb terminals are
+// drawn to line by line.
+func New(f *os.File) *Screen {
+	return newScreen(f, canMove(f))
+}
+
+func newScreen(out io.Writer, full bool) *Screen {
+	return &Screen{out: out, full: full}
+}
+
+// Returns true if f is a terminal that understands cursor movement.
+func canMove(f *os.File) bool {
+	return term.IsTerminal(f) && !term.Dumb(os.Getenv)
+}
+
+// Full returns true if frames are drawn in place rather than line by line.
+func (s *Screen) Full() bool {
+	return s.full
+}
+
+// HideCursor hides the cursor until the screen is closed, for input that is
+// shown in the frame rather than where it is typed.
+func (s *Screen) HideCursor() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.full && !s.hidden {
+		io.WriteString(s.out, "\033[?25l")
+		s.hidden = true
+	}
+}
+
+// Draw shows a frame. The cursor is left on the line below it, with the rest
+// of the screen cleared, for a prompt to follow.
+func (s *Screen) Draw(frame string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.full {
+		fmt.Fprint(s.out, frame)
+		return
+	}
+
+	var b strings.Builder
+	if !s.active {
+		b.WriteString("\033[?1049h\033[H\033[2J")
+		s.active = true
+		s.shown = nil
+	}
+
+	lines := strings.Split(strings.TrimSuffix(frame, "\n"), "\n")
+	for i, line := range lines {
+		if i < len(s.shown) && s.shown[i] == line {
+			continue
+		}
+		fmt.Fprintf(&b, "\033[%d;1H%s\033[K", i+1, line)
+	}
+
+	// Whatever is below the frame, like an older and longer frame or the
+	// last guess typed at the prompt, is cleared.
+	fmt.Fprintf(&b, "\033[%d;1H\033[J", len(lines)+1)
+	s.shown = lines
+
+	io.WriteString(s.out, b.String())
+}
+
+// Close leaves the alternate screen, bringing back what the terminal showed
+// before the first frame, and shows the cursor again. It is safe to call more
+// than once, and from another goroutine while frames are drawn.
+func (s *Screen) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.hidden {
+		io.WriteString(s.out, "\033[?25h")
+		s.hidden = false
+	}
+	if !s.active {
+		return
+	}
+	io.WriteString(s.out, "\033[0m\033[?1049l")
+	s.active = false
+	s.shown = nil
+}
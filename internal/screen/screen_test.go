@@ -0,0 +1,45 @@
+package screen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDraw(t *testing.T) {
+	var out strings.Builder
+	s := newScreen(&out, true)
+
+	s.Draw("title\nCRANE\n•••••\n")
+	want := "\033[?1049h\033[H\033[2J" +
+		"\033[1;1Htitle\033[K\033[2;1HCRANE\033[K\033[3;1H•••••\033[K" +
+		"\033[4;1H\033[J"
+	if got := out.String(); got != want {
+		t.Errorf("first frame is %q, want %q", got, want)
+	}
+
+	// Only the line that changed is drawn again.
+	out.Reset()
+	s.Draw("title\nCRANE\nSLATE\n")
+	if got, want := out.String(), "\033[3;1HSLATE\033[K\033[4;1H\033[J"; got != want {
+		t.Errorf("second frame is %q, want %q", got, want)
+	}
+
+	out.Reset()
+	s.Close()
+	s.Close()
+	if got, want := out.String(), "\033[0m\033[?1049l"; got != want {
+		t.Errorf("closed with %q, want %q", got, want)
+	}
+}
+
+func TestDrawLines(t *testing.T) {
+	var out strings.Builder
+	s := newScreen(&out, false)
+
+	s.Draw("title\nCRANE\n")
+	s.Draw("title\nSLATE\n")
+	s.Close()
+	if got, want := out.String(), "title\nCRANE\ntitle\nSLATE\n"; got != want {
+		t.Errorf("drew %q, want %q", got, want)
+	}
+}
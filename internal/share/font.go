@@ -0,0 +1,77 @@
+package share
+
+// A 5 by 7 pixel font for the letters on image tiles, since the standard
+// library has no fonts. Glyphs are drawn in upper case.
+const (
+	glyphWidth  = 5
+	glyphHeight = 7
+)
+
+var glyphs = map[rune][glyphHeight]string{
+	'A': {".###.", "#...#", "#...#", "#####", "#...#", "#...#", "#...#"},
+	'B': {"####.", "#...#", "#...#", "####.", "#...#", "#...#", "####."},
+	'C': {".###.", "#...#", "#....", "#....", "#....", "#...#", ".###."},
+	'D': {"####.", "#...#", "#...#", "#...#", "#...#", "#...#", "####."},
+	'E': {"#####", "#....", "#....", "####.", "#....", "#....", "#####"},
+	'F': {"#####", "#....", "#....", "####.", "#....", "#....", "#...."},
+	'G': {".###.", "#...#", "#....", "#.###", "#...#", "#...#", ".####"},
+	'H': {"#...#", "#...#", "#...#", "#####", "#...#", "#...#", "#...#"},
+	'I': {".###.", "..#..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'J': {"..###", "...#.", "...#.", "...#.", "...#.", "#..#.", ".##.."},
+	'K': {"#...#", "#..#.", "#.#..", "##...", "#.#..", "#..#.", "#...#"},
+	'L': {"#....", "#....", "#....", "#....", "#....", "#....", "#####"},
+	'M': {"#...#", "##.##", "#.#.#", "#.#.#", "#...#", "#...#", "#...#"},
+	'N': {"#...#", "#...#", "##..#", "#.#.#", "#..##", "#...#", "#...#"},
+	'O': {".###.", "#...#", "#...#", "#...#", "#...#", "#...#", ".###."},
+	'P': {"####.", "#...#", "#...#", "####.", "#....", "#....", "#...."},
+	'Q': {".###.", "#...#", "#...#", "#...#", "#.#.#", "#..#.", ".##.#"},
+	'R': {"####.", "#...#", "#...#", "####.", "#.#..", "#..#.", "#...#"},
+	'S': {".####", "#....", "#....", ".###.", "....#", "....#", "####."},
+	'T': {"#####", "..#..", "..#..", "..#..", "..#..", "..#..", "..#.."},
+	'U': {"#...#", "#...#", "#...#", "#...#", "#...#", "#...#", ".###."},
+	'V': {"#...#", "#...#", "#...#", "#...#", "#...#", ".#.#.", "..#.."},
+	'W': {"#...#", "#...#", "#...#", "#.#.#", "#.#.#", "#.#.#", ".#.#."},
+	'X': {"#...#", "#...#", ".#.#.", "..#..", ".#.#.", "#...#", "#...#"},
+	'Y': {"#...#", "#...#", ".#.#.", "..#..", "..#..", "..#..", "..#.."},
+	'Z': {"#####", "....#", "...#.", "..#..", ".#...", "#....", "#####"},
+}
+
+// An accent drawn as a row of pixels above a glyph, or below it.
+type accent struct {
+	row   string
+	below bool
+}
+
+var (
+	acute      = accent{row: "...#."}
+	grave      = accent{row: ".#..."}
+	circumflex = accent{row: "..#.."}
+	tilde      = accent{row: ".##.#"}
+	diaeresis  = accent{row: ".#.#."}
+	ring       = accent{row: "..#.."}
+	caron      = accent{row: ".###."}
+	breve      = accent{row: "#...#"}
+	dot        = accent{row: "..#.."}
+	cedilla    = accent{row: "..#..", below: true}
+)
+
+// Accented letters, drawn as their plain glyph and an accent.
+var accented = map[rune]struct {
+	base rune
+	accent
+}{
+	'À': {'A', grave}, 'Á': {'A', acute}, 'Â': {'A', circumflex}, 'Ã': {'A', tilde}, 'Ä': {'A', diaeresis}, 'Å': {'A', ring},
+	'Ç': {'C', cedilla}, 'Č': {'C', caron},
+	'Ď': {'D', caron},
+	'È': {'E', grave}, 'É': {'E', acute}, 'Ê': {'E', circumflex}, 'Ë': {'E', diaeresis}, 'Ě': {'E', caron},
+	'Ğ': {'G', breve},
+	'Ì': {'I', grave}, 'Í': {'I', acute}, 'Î': {'I', circumflex}, 'Ï': {'I', diaeresis}, 'İ': {'I', dot},
+	'Ñ': {'N', tilde}, 'Ň': {'N', caron},
+	'Ò': {'O', grave}, 'Ó': {'O', acute}, 'Ô': {'O', circumflex}, 'Õ': {'O', tilde}, 'Ö': {'O', diaeresis},
+	'Ř': {'R', caron},
+	'Ş': {'S', cedilla}, 'Š': {'S', caron},
+	'Ť': {'T', caron},
+	'Ù': {'U', grave}, 'Ú': {'U', acute}, 'Û': {'U', circumflex}, 'Ü': {'U', diaeresis}, 'Ů': {'U', ring},
+	'Ý': {'Y', acute}, 'Ÿ': {'Y', diaeresis},
+	'Ž': {'Z', caron},
+}
@@ -0,0 +1,194 @@
+package share
+
+import (
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bitmap/wordle/game"
+	ansi "github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/history"
+	"github.com/bitmap/wordle/internal/lang"
+	"github.com/bitmap/wordle/internal/theme"
+)
+
+// Sizes of the image, in pixels.
+const (
+	tileSize   = 60
+	tileGap    = 6
+	boardGap   = 30
+	margin     = 20
+	glyphScale = 5
+)
+
+// Colors of the web game.
+var (
+	background  = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	letterColor = color.RGBA{0xff, 0xff, 0xff, 0xff}
+)
+
+// Returns the fill of a tile from the theme. Empty tiles are drawn as a
+// border in the fill of unknown letters.
+func tileColor(state game.LetterState, t *theme.Theme) color.RGBA {
+	r, g, b, _ := ansi.Hex(t.Style(state).Fill)
+	return color.RGBA{r, g, b, 0xff}
+}
+
+// A tile placed on the image. Empty tiles pad boards that were solved early.
+type placed struct {
+	x, y  int
+	tile  game.Tile
+	empty bool
+}
+
+// Places the tiles of every board, two boards to a line as in Summary, and
+// returns the size of the image.
+func layout(e history.Entry) (tiles []placed, width, height int, err error) {
+	step := tileSize + tileGap
+
+	y := margin
+	for first := 0; first < len(e.Boards); first += 2 {
+		boards := e.Boards[first:min(first+2, len(e.Boards))]
+
+		rows := 0
+		for _, board := range boards {
+			rows = max(rows, len(board.Colors))
+		}
+
+		x := margin
+		for _, board := range boards {
+			length := utf8.RuneCountInString(board.Answer)
+			for i := range rows {
+				var f game.Feedback
+				if i < len(board.Colors) {
+					if f, err = game.ParseFeedback(e.Guesses[i], board.Colors[i]); err != nil {
+						return nil, 0, 0, err
+					}
+				}
+
+				for k := range length {
+					p := placed{x: x + k*step, y: y + i*step, empty: f == nil}
+					if f != nil {
+						p.tile = f[k]
+					}
+					tiles = append(tiles, p)
+				}
+			}
+
+			x += length*step - tileGap + boardGap
+			width = max(width, x-boardGap+margin)
+		}
+
+		y += rows*step - tileGap + boardGap
+	}
+
+	return tiles, width, y - boardGap + margin, nil
+}
+
+// PNG draws the boards of a finished game as colored tiles. The letters are
+// only drawn if opts.Letters is set.
+func PNG(w io.Writer, e history.Entry, opts Options) error {
+	tiles, width, height, err := layout(e)
+	if err != nil {
+		return err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	for _, t := range tiles {
+		r := image.Rect(t.x, t.y, t.x+tileSize, t.y+tileSize)
+		if t.empty {
+			draw.Draw(img, r, image.NewUniform(tileColor(game.Unknown, opts.theme())), image.Point{}, draw.Src)
+			draw.Draw(img, r.Inset(2), image.NewUniform(background), image.Point{}, draw.Src)
+			continue
+		}
+
+		draw.Draw(img, r, image.NewUniform(tileColor(t.tile.State, opts.theme())), image.Point{}, draw.Src)
+		if opts.Letters {
+			drawLetter(img, r, upper(e, t.tile.Letter))
+		}
+	}
+
+	return png.Encode(w, img)
+}
+
+// Returns a letter of a game in upper case, the way its language writes it.
+func upper(e history.Entry, letter rune) string {
+	return lang.Get(e.Language).Upper(string(letter))
+}
+
+// Draws an upper case letter in the middle of a tile. Letters without a glyph
+// are left out.
+func drawLetter(img *image.RGBA, r image.Rectangle, letter string) {
+	left := r.Min.X + (tileSize-glyphWidth*glyphScale)/2
+	top := r.Min.Y + (tileSize-glyphHeight*glyphScale)/2
+
+	c, _ := utf8.DecodeRuneInString(letter)
+	if a, ok := accented[c]; ok {
+		c = a.base
+		y := top - 2*glyphScale
+		if a.below {
+			y = top + (glyphHeight+1)*glyphScale
+		}
+		drawRow(img, left, y, a.row)
+	}
+
+	for y, row := range glyphs[c] {
+		drawRow(img, left, top+y*glyphScale, row)
+	}
+}
+
+// Draws one row of a glyph, with # for the pixels that are set.
+func drawRow(img *image.RGBA, left, top int, row string) {
+	for x, c := range row {
+		if c != '#' {
+			continue
+		}
+		px := image.Rect(left+x*glyphScale, top, left+(x+1)*glyphScale, top+glyphScale)
+		draw.Draw(img, px, image.NewUniform(letterColor), image.Point{}, draw.Src)
+	}
+}
+
+// SVG draws the same image as PNG as an SVG document.
+func SVG(w io.Writer, e history.Entry, opts Options) error {
+	tiles, width, height, err := layout(e)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", width, height, width, height)
+	fmt.Fprintf(&b, "<rect width=\"100%%\" height=\"100%%\" fill=\"%s\"/>\n", hex(background))
+
+	for _, t := range tiles {
+		if t.empty {
+			fmt.Fprintf(&b, "<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"none\" stroke=\"%s\" stroke-width=\"2\"/>\n",
+				t.x+1, t.y+1, tileSize-2, tileSize-2, hex(tileColor(game.Unknown, opts.theme())))
+			continue
+		}
+
+		fmt.Fprintf(&b, "<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n",
+			t.x, t.y, tileSize, tileSize, hex(tileColor(t.tile.State, opts.theme())))
+		if opts.Letters {
+			fmt.Fprintf(&b, "<text x=\"%d\" y=\"%d\" fill=\"%s\" font-family=\"Helvetica, Arial, sans-serif\" font-size=\"32\" font-weight=\"bold\" text-anchor=\"middle\" dominant-baseline=\"central\">%s</text>\n",
+				t.x+tileSize/2, t.y+tileSize/2, hex(letterColor), html.EscapeString(upper(e, t.tile.Letter)))
+		}
+	}
+
+	b.WriteString("</svg>\n")
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// Returns a color as #rrggbb.
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
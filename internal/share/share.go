@@ -0,0 +1,197 @@
+// Package share writes spoiler-free summaries of finished games, like the
+// emoji grid of the web game, as text or images for pasting into chat.
+package share
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/history"
+	"github.com/bitmap/wordle/internal/lang"
+	"github.com/bitmap/wordle/internal/theme"
+)
+
+// Format is where the summary is going to be pasted.
+type Format int
+
+const (
+	// Text is plain text.
+	Text Format = iota
+	// Markdown makes the title bold.
+	Markdown
+	// Slack uses Slack's own markup. Slack has no spoiler tags, so the
+	// guesses are left out.
+	Slack
+	// Discord adds every guess behind a spoiler tag.
+	Discord
+)
+
+// Formats by name, as given on the command line.
+var Formats = map[string]Format{
+	"text":     Text,
+	"markdown": Markdown,
+	"slack":    Slack,
+	"discord":  Discord,
+}
+
+// Options pick how the summary or image looks.
+type Options struct {
+	Format Format
+
+	// Theme picks the squares and tile colors. Nil is the classic theme.
+	Theme *theme.Theme
+
+	// Letters draws the guesses on the tiles of images, which gives the
+	// answer away.
+	Letters bool
+}
+
+// Names of the multi-board games, by number of boards.
+var names = map[int]string{
+	1: "Wordle",
+	2: "Dordle",
+	4: "Quordle",
+	8: "Octordle",
+}
+
+// Title returns the first line of the summary, like "Wordle #1234 4/6*". The
+// star marks hard mode, as in the web game.
+func Title(e history.Entry) string {
+	return title(e) + hardMark(e)
+}
+
+// Returns the title without the mark of hard mode.
+func title(e history.Entry) string {
+	name, ok := names[len(e.Boards)]
+	if !ok {
+		name = "Wordle"
+	}
+
+	title := name
+	if e.Puzzle != "" {
+		title += " " + e.Puzzle
+	}
+	return title + " " + e.Score()
+}
+
+// Returns the star that marks games played in hard mode, or nothing.
+func hardMark(e history.Entry) string {
+	if e.Difficulty != game.Normal {
+		return "*"
+	}
+	return ""
+}
+
+// Returns the theme of the options.
+func (opts Options) theme() *theme.Theme {
+	if opts.Theme == nil {
+		return theme.Classic
+	}
+	return opts.Theme
+}
+
+// Square returns the emoji for a tile state.
+func Square(state game.LetterState, t *theme.Theme) string {
+	return t.Style(state).Square
+}
+
+// Summary returns the title and the emoji grid of a finished game. Boards of
+// a multi-board game are drawn two to a line.
+func Summary(e history.Entry, opts Options) (string, error) {
+	var b strings.Builder
+
+	switch opts.Format {
+	// The star of hard mode is markup too, so it is escaped in Markdown.
+	// Slack has no escapes, so there it goes after the bold title.
+	case Markdown:
+		mark := hardMark(e)
+		if mark != "" {
+			mark = `\*`
+		}
+		b.WriteString("**" + title(e) + mark + "**\n\n")
+	case Slack:
+		mark := hardMark(e)
+		if mark != "" {
+			mark = " " + mark
+		}
+		b.WriteString("*" + title(e) + "*" + mark + "\n\n")
+	default:
+		b.WriteString(Title(e) + "\n\n")
+	}
+
+	// Markdown joins lines unless they end in a hard break.
+	lineEnd := "\n"
+	if opts.Format == Markdown {
+		lineEnd = "  \n"
+	}
+
+	for first := 0; first < len(e.Boards); first += 2 {
+		if first > 0 {
+			b.WriteString(lineEnd)
+		}
+
+		boards := e.Boards[first:min(first+2, len(e.Boards))]
+
+		rows := 0
+		for _, board := range boards {
+			rows = max(rows, len(board.Colors))
+		}
+
+		for i := range rows {
+			for k, board := range boards {
+				if k > 0 {
+					b.WriteString(" ")
+				}
+				row, err := squares(e, board, i, opts)
+				if err != nil {
+					return "", err
+				}
+				b.WriteString(row)
+			}
+
+			if opts.Format == Discord && len(e.Boards) == 1 {
+				b.WriteString(" ||" + lang.Get(e.Language).Upper(e.Guesses[i]) + "||")
+			}
+			b.WriteString(lineEnd)
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), lineEnd) + "\n", nil
+}
+
+// Returns one row of a board as squares. Boards that were solved early are
+// padded with the squares of unknown letters.
+func squares(e history.Entry, board history.Board, row int, opts Options) (string, error) {
+	length := utf8.RuneCountInString(board.Answer)
+	if row >= len(board.Colors) {
+		return strings.Repeat(opts.theme().Unknown.Square, length), nil
+	}
+
+	f, err := game.ParseFeedback(e.Guesses[row], board.Colors[row])
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, t := range f {
+		b.WriteString(Square(t.State, opts.theme()))
+	}
+	return b.String(), nil
+}
+
+// Copy puts text on the clipboard of the terminal with the OSC 52 escape
+// sequence. Terminals that don't support it ignore it. Inside tmux the
+// sequence is passed through to the outer terminal.
+func Copy(text string) error {
+	seq := "\033]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+	if os.Getenv("TMUX") != "" {
+		seq = "\033Ptmux;" + strings.ReplaceAll(seq, "\033", "\033\033") + "\033\\"
+	}
+
+	_, err := fmt.Fprint(os.Stdout, seq)
+	return err
+}
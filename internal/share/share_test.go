@@ -0,0 +1,116 @@
+package share
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/history"
+	"github.com/bitmap/wordle/internal/theme"
+)
+
+func entry(t *testing.T, opts game.Options, answers []string, guesses ...string) history.Entry {
+	t.Helper()
+
+	boards := make([]*game.Game, len(answers))
+	for i, answer := range answers {
+		boards[i] = game.New(answer, opts)
+	}
+
+	m := game.NewMulti(boards...)
+	for _, word := range guesses {
+		if _, err := m.Submit(word); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return history.NewEntry(m, "", "", time.Time{}, time.Time{})
+}
+
+func TestSummary(t *testing.T) {
+	e := entry(t, game.Options{Difficulty: game.Hard}, []string{"those"}, "crane", "house", "those")
+	e.Puzzle = "#1234"
+
+	contrast, err := theme.Load("contrast")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		opts Options
+		want string
+	}{
+		{Options{}, "Wordle #1234 3/6*\n\n⬛⬛⬛⬛🟩\n🟨🟨⬛🟩🟩\n🟩🟩🟩🟩🟩\n"},
+		{Options{Theme: contrast}, "Wordle #1234 3/6*\n\n⬛⬛⬛⬛🟧\n🟦🟦⬛🟧🟧\n🟧🟧🟧🟧🟧\n"},
+		{Options{Format: Markdown}, "**Wordle #1234 3/6\\***\n\n⬛⬛⬛⬛🟩  \n🟨🟨⬛🟩🟩  \n🟩🟩🟩🟩🟩\n"},
+		{Options{Format: Slack}, "*Wordle #1234 3/6* *\n\n⬛⬛⬛⬛🟩\n🟨🟨⬛🟩🟩\n🟩🟩🟩🟩🟩\n"},
+		{Options{Format: Discord}, "Wordle #1234 3/6*\n\n⬛⬛⬛⬛🟩 ||CRANE||\n🟨🟨⬛🟩🟩 ||HOUSE||\n🟩🟩🟩🟩🟩 ||THOSE||\n"},
+	}
+
+	for _, tt := range tests {
+		got, err := Summary(e, tt.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Summary(%+v) =\n%s\nwant\n%s", tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestSummaryMultiBoard(t *testing.T) {
+	e := entry(t, game.Options{MaxGuesses: game.MultiGuesses(2)}, []string{"those", "crane"}, "those", "crane")
+
+	want := "Dordle 2/7\n\n🟩🟩🟩🟩🟩 ⬛⬛⬛⬛🟩\n⬜⬜⬜⬜⬜ 🟩🟩🟩🟩🟩\n"
+	if got, _ := Summary(e, Options{}); got != want {
+		t.Errorf("Summary =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSummaryLost(t *testing.T) {
+	e := entry(t, game.Options{MaxGuesses: 1}, []string{"those"}, "crane")
+
+	if got := Title(e); got != "Wordle X/1" {
+		t.Errorf("Title = %q, want %q", got, "Wordle X/1")
+	}
+}
+
+func TestImage(t *testing.T) {
+	e := entry(t, game.Options{}, []string{"those"}, "crane", "those")
+
+	var b bytes.Buffer
+	if err := PNG(&b, e, Options{Letters: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	width := 2*margin + 5*tileSize + 4*tileGap
+	height := 2*margin + 2*tileSize + tileGap
+	if size := img.Bounds().Size(); size.X != width || size.Y != height {
+		t.Errorf("image is %v, want %dx%d", size, width, height)
+	}
+
+	// The corner of the last tile of the first guess is green.
+	x, y := margin+4*(tileSize+tileGap), margin
+	if got, want := img.At(x, y), tileColor(game.Correct, theme.Classic); got != want {
+		t.Errorf("tile color = %v, want %v", got, want)
+	}
+
+	b.Reset()
+	if err := SVG(&b, e, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(b.String(), "<rect"); got != 11 {
+		t.Errorf("SVG has %d rects, want 11", got)
+	}
+	if strings.Contains(b.String(), "<text") {
+		t.Error("SVG has letters without Options.Letters")
+	}
+}
@@ -0,0 +1,74 @@
+// Package stats keeps score across games: how many were played and won, the
+// winning streaks, and how many guesses the wins took.
+package stats
+
+import (
+	"github.com/bitmap/wordle/internal/store"
+)
+
+// File the stats are kept in.
+const statsFile = "stats.json"
+
+// Stats are the totals of every game played in one mode.
+type Stats struct {
+	Played        int
+	Won           int
+	CurrentStreak int
+	MaxStreak     int
+
+	// Distribution counts the wins by number of guesses: Distribution[0] is
+	// the number of games won on the first guess.
+	Distribution []int
+}
+
+// Add counts a finished game.
+func (s *Stats) Add(won bool, guesses int) {
+	s.Played++
+
+	if !won {
+		s.CurrentStreak = 0
+		return
+	}
+
+	s.Won++
+	s.CurrentStreak++
+	s.MaxStreak = max(s.MaxStreak, s.CurrentStreak)
+
+	for len(s.Distribution) < guesses {
+		s.Distribution = append(s.Distribution, 0)
+	}
+	s.Distribution[guesses-1]++
+}
+
+// WinPercent returns the share of games won, rounded down.
+func (s *Stats) WinPercent() int {
+	if s.Played == 0 {
+		return 0
+	}
+	return s.Won * 100 / s.Played
+}
+
+// Load returns the stats of every mode that has been played, by mode.
+func Load() (map[string]*Stats, error) {
+	all := map[string]*Stats{}
+	err := store.Load(statsFile, &all)
+	return all, err
+}
+
+// Record counts a finished game in the stats of its mode. Modes are kept
+// apart, so that hard mode wins don't mix with normal ones.
+func Record(mode string, won bool, guesses int) error {
+	all, err := Load()
+	if err != nil {
+		return err
+	}
+
+	s, ok := all[mode]
+	if !ok {
+		s = &Stats{}
+		all[mode] = s
+	}
+	s.Add(won, guesses)
+
+	return store.Save(statsFile, all)
+}
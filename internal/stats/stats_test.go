@@ -0,0 +1,50 @@
+package stats
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	var s Stats
+	for _, g := range []struct {
+		won     bool
+		guesses int
+	}{
+		{true, 4}, {true, 3}, {false, 6}, {true, 4}, {true, 2}, {true, 6},
+	} {
+		s.Add(g.won, g.guesses)
+	}
+
+	if s.Played != 6 || s.Won != 5 || s.WinPercent() != 83 {
+		t.Errorf("played %d, won %d (%d%%), want 6, 5 (83%%)", s.Played, s.Won, s.WinPercent())
+	}
+	if s.CurrentStreak != 3 || s.MaxStreak != 3 {
+		t.Errorf("streak %d, max %d, want 3 and 3", s.CurrentStreak, s.MaxStreak)
+	}
+	if want := []int{0, 1, 1, 2, 0, 1}; !slices.Equal(s.Distribution, want) {
+		t.Errorf("distribution %v, want %v", s.Distribution, want)
+	}
+}
+
+func TestRecordKeepsModesApart(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	if err := Record("classic", true, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := Record("hard", false, 6); err != nil {
+		t.Fatal(err)
+	}
+	if err := Record("classic", true, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all["classic"].Won != 2 || all["hard"].Played != 1 || all["hard"].Won != 0 {
+		t.Errorf("classic %+v, hard %+v", all["classic"], all["hard"])
+	}
+}
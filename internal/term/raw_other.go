@@ -0,0 +1,17 @@
+//go:build !(linux || darwin || dragonfly || freebsd || netbsd || openbsd)
+
+package term
+
+import "os"
+
+type termios struct{}
+
+// Raw gives ErrUnsupported on this system.
+func Raw(f *os.File) (*State, error) {
+	return nil, ErrUnsupported
+}
+
+// Restore does nothing on this system.
+func (s *State) Restore() error {
+	return nil
+}
@@ -0,0 +1,46 @@
+//go:build linux || darwin || dragonfly || freebsd || netbsd || openbsd
+
+package term
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+type termios = syscall.Termios
+
+// Raw puts the terminal f into raw mode: keys are read one at a time without
+// being echoed, and Ctrl-C and Ctrl-Z are read as keys rather than sent as
+// signals. Output is still translated, so newlines move to the start of the
+// line. Files and pipes give an error.
+func Raw(f *os.File) (*State, error) {
+	s := &State{fd: f.Fd()}
+	if err := ioctl(s.fd, getTermios, &s.saved); err != nil {
+		return nil, err
+	}
+
+	raw := s.saved
+	raw.Iflag &^= syscall.BRKINT | syscall.ICRNL | syscall.INPCK | syscall.ISTRIP | syscall.IXON
+	raw.Cflag |= syscall.CS8
+	raw.Lflag &^= syscall.ECHO | syscall.ICANON | syscall.IEXTEN | syscall.ISIG
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	if err := ioctl(s.fd, setTermios, &raw); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Restore puts the terminal back in the mode it was in before Raw.
+func (s *State) Restore() error {
+	return ioctl(s.fd, setTermios, &s.saved)
+}
+
+func ioctl(fd uintptr, request uintptr, t *termios) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, request, uintptr(unsafe.Pointer(t)))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Package term finds out what the terminal can do, and switches it to raw
+// mode so that keys can be read as they are pressed instead of a line at a
+// time.
+package term
+
+import (
+	"errors"
+	"os"
+	"runtime"
+)
+
+// IsTerminal returns true if f is a terminal rather than a file or a pipe.
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Dumb returns true if the terminal, as described by the environment, shows
+// escape sequences as they are instead of acting on them.
+func Dumb(getenv func(string) string) bool {
+	term := getenv("TERM")
+	if term == "dumb" {
+		return true
+	}
+
+	// So does the old Windows console, which sets no TERM. Windows Terminal
+	// can be told apart by WT_SESSION.
+	return term == "" && runtime.GOOS == "windows" && getenv("WT_SESSION") == ""
+}
+
+// ErrUnsupported is returned on systems where raw mode is not implemented.
+// Input is read a line at a time there.
+var ErrUnsupported = errors.New("raw mode is not supported on this system")
+
+// State is the mode a terminal was in before it was made raw.
+type State struct {
+	fd    uintptr
+	saved termios
+}
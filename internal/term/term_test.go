@@ -0,0 +1,33 @@
+package term
+
+import (
+	"os"
+	"testing"
+)
+
+func TestRawPipe(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	if _, err := Raw(r); err == nil {
+		t.Error("made a pipe raw")
+	}
+}
+
+func TestDumb(t *testing.T) {
+	for term, want := range map[string]bool{"dumb": true, "xterm-256color": false} {
+		getenv := func(key string) string {
+			if key == "TERM" {
+				return term
+			}
+			return ""
+		}
+		if got := Dumb(getenv); got != want {
+			t.Errorf("Dumb with TERM=%s is %v, want %v", term, got, want)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Package theme picks how the states of letters are shown: the colors and
+// marks of tiles on screen, the squares of shared results and the fills of
+// result images.
+package theme
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/store"
+)
+
+// File user themes are kept in, in the config directory.
+const themesFile = "themes.json"
+
+// Style is how one state of a letter is shown.
+type Style struct {
+	// Color of the letter on screen, as read by color.Parse, like
+	// "bold green" or "#6aaa64".
+	Color string
+
+	// Left and Right are drawn around the letter on the board, so that the
+	// state can be told apart without color. A space if empty.
+	Left, Right string
+
+	// Square is the emoji of shared results.
+	Square string
+
+	// Fill is the color of the tile in result images, as #rrggbb.
+	Fill string
+}
+
+// Theme is a style for every state of a letter. Unknown is for letters that
+// have not been guessed yet, and for the padding of boards solved early.
+type Theme struct {
+	Name    string `json:"-"`
+	Base    string `json:",omitempty"` // theme that styles left empty come from
+	Correct Style
+	Present Style
+	Absent  Style
+	Unknown Style
+}
+
+// Classic is the green and yellow of the web game.
+var Classic = &Theme{
+	Name:    "classic",
+	Correct: Style{Color: "green", Square: "🟩", Fill: "#6aaa64"},
+	Present: Style{Color: "yellow", Square: "🟨", Fill: "#c9b458"},
+	Absent:  Style{Color: "gray", Square: "⬛", Fill: "#787c7e"},
+	Unknown: Style{Color: "white", Square: "⬜", Fill: "#d3d6da"},
+}
+
+// Themes that come with the game, by name.
+var builtin = map[string]*Theme{
+	"classic": Classic,
+
+	// Orange and blue, like the high contrast mode of the web game, for
+	// players who can't tell green from yellow.
+	"contrast": {
+		Name:    "contrast",
+		Correct: Style{Color: "208", Square: "🟧", Fill: "#f5793a"},
+		Present: Style{Color: "75", Square: "🟦", Fill: "#85c0f9"},
+		Absent:  Classic.Absent,
+		Unknown: Classic.Unknown,
+	},
+
+	// No color at all: brackets and underlines tell the states apart.
+	"mono": {
+		Name:    "mono",
+		Correct: Style{Color: "bold underline", Left: "[", Right: "]", Square: "⬛", Fill: "#1a1a1b"},
+		Present: Style{Color: "underline", Left: "(", Right: ")", Square: "🔳", Fill: "#6e6e6e"},
+		Absent:  Style{Color: "dim", Square: "⬜", Fill: "#b4b4b4"},
+		Unknown: Style{Square: "➖", Fill: "#d3d6da"},
+	},
+}
+
+// Style returns the style of a state.
+func (t *Theme) Style(state game.LetterState) Style {
+	switch state {
+	case game.Correct:
+		return t.Correct
+	case game.Present:
+		return t.Present
+	case game.Absent:
+		return t.Absent
+	}
+	return t.Unknown
+}
+
+// Escape returns the escape sequence that colors the letter on screen.
+func (s Style) Escape() string {
+	seq, _ := color.Parse(s.Color)
+	return seq
+}
+
+// Filled returns the escape sequence that draws a letter on a tile in the
+// fill of the style, in white or black, whichever stands out more.
+func (s Style) Filled() string {
+	r, g, b, _ := color.Hex(s.Fill)
+	letter := "bold bright-white"
+	if 299*int(r)+587*int(g)+114*int(b) > 186_000 {
+		letter = "bold black"
+	}
+
+	seq, _ := color.Parse(letter + " on-" + s.Fill)
+	return seq
+}
+
+// Border returns the escape sequence that draws the border of a tile in the
+// fill of the style.
+func (s Style) Border() string {
+	seq, _ := color.Parse(s.Fill)
+	return seq
+}
+
+// Mark returns the letter between the marks of the style.
+func (s Style) Mark(letter string) string {
+	left, right := s.Left, s.Right
+	if left == "" {
+		left = " "
+	}
+	if right == "" {
+		right = " "
+	}
+	return left + letter + right
+}
+
+// Load returns the theme with the given name: one of the built-in themes, or
+// one from themes.json in the config directory. A user theme may name a Base
+// theme; styles and fields it leaves empty come from there, or from Classic.
+func Load(name string) (*Theme, error) {
+	if t, ok := builtin[name]; ok {
+		return t, nil
+	}
+
+	themes, err := loadUser()
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := themes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %s, use one of %s", name, names(themes))
+	}
+
+	base := Classic
+	if t.Base != "" {
+		if base, ok = builtin[t.Base]; !ok {
+			return nil, fmt.Errorf("theme %s: unknown base theme %s", name, t.Base)
+		}
+	}
+
+	t.Name = name
+	for _, s := range []struct{ user, base *Style }{
+		{&t.Correct, &base.Correct},
+		{&t.Present, &base.Present},
+		{&t.Absent, &base.Absent},
+		{&t.Unknown, &base.Unknown},
+	} {
+		fill(s.user, *s.base)
+		if err := check(*s.user); err != nil {
+			return nil, fmt.Errorf("theme %s: %w", name, err)
+		}
+	}
+	return t, nil
+}
+
+// Reads the user themes, if there are any.
+func loadUser() (map[string]*Theme, error) {
+	dir, err := store.ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, themesFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var themes map[string]*Theme
+	if err := json.Unmarshal(data, &themes); err != nil {
+		return nil, fmt.Errorf("%s: %w", themesFile, err)
+	}
+	return themes, nil
+}
+
+// Fills the empty fields of a style from another.
+func fill(s *Style, base Style) {
+	if s.Color == "" {
+		s.Color = base.Color
+	}
+	if s.Left == "" && s.Right == "" {
+		s.Left, s.Right = base.Left, base.Right
+	}
+	if s.Square == "" {
+		s.Square = base.Square
+	}
+	if s.Fill == "" {
+		s.Fill = base.Fill
+	}
+}
+
+// Returns an error if a style has a color that can't be read.
+func check(s Style) error {
+	if _, err := color.Parse(s.Color); err != nil {
+		return err
+	}
+	if _, _, _, ok := color.Hex(s.Fill); !ok {
+		return fmt.Errorf("fill %q is not a #rrggbb color", s.Fill)
+	}
+	return nil
+}
+
+// Returns the names of the built-in and user themes, sorted.
+func names(user map[string]*Theme) string {
+	var names []string
+	for name := range builtin {
+		names = append(names, name)
+	}
+	for name := range user {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,61 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeThemes(t *testing.T, data string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	if err := os.MkdirAll(filepath.Join(dir, "wordle"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "wordle", themesFile), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	writeThemes(t, `{
+		"sea": {"Base": "contrast", "Correct": {"Color": "bold #2a9d8f", "Square": "🟦"}},
+		"plain": {"Absent": {"Left": "-"}}
+	}`)
+
+	sea, err := Load("sea")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sea.Correct.Square != "🟦" || sea.Correct.Fill != "#f5793a" || sea.Present.Square != "🟦" {
+		t.Errorf("sea theme is %+v", sea)
+	}
+
+	plain, err := Load("plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := plain.Absent.Mark("A"); got != "-A " {
+		t.Errorf("absent mark is %q", got)
+	}
+	if plain.Correct != Classic.Correct {
+		t.Errorf("correct style is %+v", plain.Correct)
+	}
+
+	if _, err := Load("mono"); err != nil {
+		t.Error(err)
+	}
+	if _, err := Load("neon"); err == nil {
+		t.Error("loaded a theme that does not exist")
+	}
+}
+
+func TestLoadBadColor(t *testing.T) {
+	writeThemes(t, `{"bad": {"Present": {"Color": "blurple"}}}`)
+
+	if _, err := Load("bad"); err == nil {
+		t.Error("loaded a theme with an unknown color")
+	}
+}
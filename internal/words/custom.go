@@ -0,0 +1,161 @@
+package words
+
+import (
+	"bufio"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bitmap/wordle/internal/lang"
+)
+
+// ReadFile reads a word list in a language, one word per line. Blank lines
+// and lines starting with # are skipped, and words are folded the way the
+// language plays them. Every word must be made of letters of the alphabet, be
+// MinLength to MaxLength letters long and be listed only once.
+func ReadFile(name string, l *lang.Language) ([]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var list []string
+	seen := map[string]int{}
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		word := l.Fold(strings.TrimSpace(scanner.Text()))
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+
+		if err := checkWord(word, l); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, line, err)
+		}
+		if first, ok := seen[word]; ok {
+			return nil, fmt.Errorf("%s:%d: %s is already listed on line %d", name, line, word, first)
+		}
+
+		seen[word] = line
+		list = append(list, word)
+	}
+
+	return list, scanner.Err()
+}
+
+// Returns an error if a word can't be played in a language.
+func checkWord(word string, l *lang.Language) error {
+	if err := l.Check(word); err != nil {
+		return err
+	}
+
+	if n := utf8.RuneCountInString(word); n < MinLength || n > MaxLength {
+		return fmt.Errorf("%s must be %d to %d letters long", word, MinLength, MaxLength)
+	}
+	return nil
+}
+
+// Source says where the word lists come from. The zero value is the built-in
+// English lists.
+type Source struct {
+	Language string `json:",omitempty"` // code of the language, English if empty
+	Name     string `json:",omitempty"` // shown with the mode of a game
+	Answers  string `json:",omitempty"` // file of answers, see ReadFile
+	Allowed  string `json:",omitempty"` // file of allowed words
+	Extend   bool   `json:",omitempty"` // add to the built-in lists instead of replacing them
+}
+
+// Where the lists in use came from.
+var current Source
+
+// Current returns where the word lists in use came from.
+func Current() Source {
+	return current
+}
+
+// Language returns the language of the word lists in use.
+func Language() *lang.Language {
+	return lang.Get(current.Language)
+}
+
+// Use reads the word lists of a source and plays with them from now on.
+func Use(s Source) error {
+	l, err := lang.Find(s.Language)
+	if err != nil {
+		return err
+	}
+
+	base, ok := builtin[l.Code]
+	if !ok && s.Answers == "" {
+		return fmt.Errorf("there are no built-in %s words, give a list of answers", l.Name)
+	}
+
+	var answers, allowed []string
+	if s.Answers != "" {
+		if answers, err = ReadFile(s.Answers, l); err != nil {
+			return err
+		}
+	}
+	if s.Allowed != "" {
+		if allowed, err = ReadFile(s.Allowed, l); err != nil {
+			return err
+		}
+	}
+
+	next, err := combine(base, answers, allowed, s.Extend)
+	if err != nil {
+		return err
+	}
+
+	lists, dict, current = next, &Dictionary{next}, s
+	return nil
+}
+
+// Returns the given answers and allowed words instead of the built-in lists,
+// or on top of them if extend is set. Either may be nil to keep the built-in
+// list. Without allowed words the answers are allowed too; with them, every
+// answer must be one of them.
+func combine(builtin map[int]list, answers, allowed []string, extend bool) (map[int]list, error) {
+	next := map[int]list{}
+	for length := MinLength; length <= MaxLength; length++ {
+		l := builtin[length]
+		kept := list{allowed: map[string]bool{}}
+		if answers == nil || extend {
+			kept.answers = slices.Clone(l.answers)
+		}
+		if allowed == nil || extend {
+			maps.Copy(kept.allowed, l.allowed)
+		}
+		next[length] = kept
+	}
+
+	for _, word := range allowed {
+		next[utf8.RuneCountInString(word)].allowed[word] = true
+	}
+
+	for _, word := range answers {
+		n := utf8.RuneCountInString(word)
+		l := next[n]
+		if !slices.Contains(l.answers, word) {
+			l.answers = append(l.answers, word)
+		}
+		if allowed == nil {
+			l.allowed[word] = true
+		}
+		next[n] = l
+	}
+
+	for length := MinLength; length <= MaxLength; length++ {
+		for _, word := range next[length].answers {
+			if !next[length].allowed[word] {
+				return nil, fmt.Errorf("the answer %s is not an allowed word", word)
+			}
+		}
+	}
+
+	return next, nil
+}
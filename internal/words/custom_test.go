@@ -0,0 +1,133 @@
+package words
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bitmap/wordle/internal/lang"
+)
+
+// Writes a word list to a temporary file and returns its name.
+func writeList(t *testing.T, words ...string) string {
+	t.Helper()
+	name := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(name, []byte(strings.Join(words, "\n")+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func TestReadFile(t *testing.T) {
+	tests := []struct {
+		words []string
+		err   string
+	}{
+		{[]string{"# comment", "", " Slice ", "defer"}, ""},
+		{[]string{"go"}, "words.txt:1: go must be 4 to 8 letters long"},
+		{[]string{"slice", "c++ish"}, "words.txt:2: c++ish has letters that are not in the English alphabet"},
+		{[]string{"slice", "defer", "SLICE"}, "words.txt:3: slice is already listed on line 1"},
+	}
+
+	for _, tt := range tests {
+		list, err := ReadFile(writeList(t, tt.words...), lang.English)
+		if tt.err == "" {
+			if err != nil || strings.Join(list, " ") != "slice defer" {
+				t.Errorf("ReadFile(%q) = %q, %v", tt.words, list, err)
+			}
+			continue
+		}
+		if err == nil || !strings.HasSuffix(err.Error(), tt.err) {
+			t.Errorf("ReadFile(%q) error = %v, want %s", tt.words, err, tt.err)
+		}
+	}
+}
+
+func TestReadFileLanguage(t *testing.T) {
+	tests := []struct {
+		code  string
+		words []string
+		want  string
+	}{
+		{"es", []string{"Ácido", "NIÑO", "pingüino"}, "acido niño pinguino"},
+		{"de", []string{"Straße", "MÄDCHEN"}, "strasse mädchen"},
+		{"tr", []string{"IŞIK", "İNSAN", "ağaç"}, "ışık insan ağaç"},
+		{"pt", []string{"Coração", "AÇÚCAR"}, "coracao acucar"},
+	}
+
+	for _, tt := range tests {
+		list, err := ReadFile(writeList(t, tt.words...), lang.Get(tt.code))
+		if err != nil {
+			t.Errorf("%s: %v", tt.code, err)
+			continue
+		}
+		if got := strings.Join(list, " "); got != tt.want {
+			t.Errorf("%s: read %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestUse(t *testing.T) {
+	t.Cleanup(func() { Use(Source{}) })
+
+	answers := writeList(t, "gofmt", "gopls", "chan")
+	if err := Use(Source{Name: "go", Answers: answers}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := Answers(5); strings.Join(got, " ") != "gofmt gopls" {
+		t.Errorf("5-letter answers = %q", got)
+	}
+	if !IsValidWord("chan") || !IsValidWord("crane") {
+		t.Error("answers and built-in words should be allowed")
+	}
+	if err := Check(6); err == nil {
+		t.Error("there should be no 6-letter answers")
+	}
+	if Current().Name != "go" {
+		t.Errorf("Current() = %+v", Current())
+	}
+
+	if err := Use(Source{Answers: answers, Extend: true}); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(Answers(5)); n != len(answerList5)+2 {
+		t.Errorf("extended to %d answers, want %d", n, len(answerList5)+2)
+	}
+
+	allowed := writeList(t, "gofmt", "chan")
+	if err := Use(Source{Answers: answers, Allowed: allowed}); err == nil || err.Error() != "the answer gopls is not an allowed word" {
+		t.Errorf("Use with a missing answer: %v", err)
+	}
+
+	if err := Use(Source{}); err != nil || len(Answers(5)) != len(answerList5) || IsValidWord("chan") {
+		t.Errorf("the built-in lists were not put back: %v", err)
+	}
+}
+
+func TestUseLanguage(t *testing.T) {
+	t.Cleanup(func() { Use(Source{}) })
+
+	if err := Use(Source{Language: "es"}); err != nil {
+		t.Fatal(err)
+	}
+	if !IsValidWord("niñez") || IsValidWord("crane") || Language().Name != "Spanish" {
+		t.Error("Spanish words are not in use")
+	}
+
+	if err := Use(Source{Language: "de"}); err != nil {
+		t.Fatal(err)
+	}
+	if !IsValidWord("strasse") || !IsValidWord("häuser") || IsValidWord("niñez") {
+		t.Error("German words are not in use")
+	}
+
+	answers := writeList(t, "Straße", "Küche")
+	if err := Use(Source{Language: "de", Answers: answers}); err != nil {
+		t.Fatal(err)
+	}
+	if got := Answers(5); strings.Join(got, " ") != "küche" || !IsValidWord("strasse") {
+		t.Errorf("German answers = %q", got)
+	}
+}
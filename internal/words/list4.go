@@ -0,0 +1,2701 @@
+package words
+
+// All possible four-letter answers
+var answerList4 = [...]string{
+	"able",
+	"acid",
+	"also",
+	"arch",
+	"area",
+	"army",
+	"atom",
+	"aunt",
+	"auto",
+	"away",
+	"axis",
+	"baby",
+	"ball",
+	"base",
+	"bean",
+	"beef",
+	"belt",
+	"best",
+	"bike",
+	"bind",
+	"bird",
+	"blue",
+	"blur",
+	"boat",
+	"body",
+	"boil",
+	"bomb",
+	"bone",
+	"book",
+	"boss",
+	"bulb",
+	"bulk",
+	"busy",
+	"buzz",
+	"cage",
+	"cake",
+	"call",
+	"calm",
+	"camp",
+	"card",
+	"cart",
+	"case",
+	"cash",
+	"cave",
+	"chat",
+	"chef",
+	"city",
+	"clap",
+	"claw",
+	"clay",
+	"clip",
+	"clog",
+	"club",
+	"code",
+	"coil",
+	"coin",
+	"come",
+	"cook",
+	"cool",
+	"copy",
+	"core",
+	"corn",
+	"cost",
+	"cram",
+	"crew",
+	"crop",
+	"cube",
+	"cute",
+	"damp",
+	"dash",
+	"dawn",
+	"deal",
+	"deer",
+	"defy",
+	"deny",
+	"desk",
+	"dial",
+	"dice",
+	"diet",
+	"dirt",
+	"dish",
+	"doll",
+	"door",
+	"dose",
+	"dove",
+	"draw",
+	"drip",
+	"drop",
+	"drum",
+	"duck",
+	"dumb",
+	"dune",
+	"dust",
+	"duty",
+	"earn",
+	"east",
+	"easy",
+	"echo",
+	"edge",
+	"edit",
+	"else",
+	"evil",
+	"exit",
+	"face",
+	"fade",
+	"fall",
+	"fame",
+	"farm",
+	"feed",
+	"feel",
+	"file",
+	"film",
+	"find",
+	"fine",
+	"fire",
+	"firm",
+	"fish",
+	"flag",
+	"flat",
+	"flee",
+	"flip",
+	"foam",
+	"foil",
+	"fold",
+	"food",
+	"foot",
+	"fork",
+	"frog",
+	"fuel",
+	"fury",
+	"gain",
+	"game",
+	"gasp",
+	"gate",
+	"gaze",
+	"gift",
+	"girl",
+	"give",
+	"glad",
+	"glow",
+	"glue",
+	"goat",
+	"gold",
+	"good",
+	"gown",
+	"grab",
+	"grid",
+	"grit",
+	"grow",
+	"hair",
+	"half",
+	"hand",
+	"hard",
+	"have",
+	"hawk",
+	"head",
+	"help",
+	"hero",
+	"high",
+	"hill",
+	"hint",
+	"hire",
+	"hold",
+	"hole",
+	"home",
+	"hood",
+	"hope",
+	"horn",
+	"host",
+	"hour",
+	"huge",
+	"hunt",
+	"hurt",
+	"icon",
+	"idea",
+	"idle",
+	"inch",
+	"into",
+	"iron",
+	"item",
+	"jazz",
+	"join",
+	"joke",
+	"jump",
+	"junk",
+	"just",
+	"keen",
+	"keep",
+	"kick",
+	"kind",
+	"kiss",
+	"kite",
+	"kiwi",
+	"knee",
+	"know",
+	"lady",
+	"lake",
+	"lamp",
+	"lava",
+	"lawn",
+	"lazy",
+	"leaf",
+	"left",
+	"lend",
+	"lens",
+	"liar",
+	"life",
+	"lift",
+	"like",
+	"limb",
+	"link",
+	"lion",
+	"list",
+	"live",
+	"load",
+	"loan",
+	"lock",
+	"long",
+	"loop",
+	"loud",
+	"love",
+	"maid",
+	"mail",
+	"main",
+	"make",
+	"mask",
+	"mass",
+	"math",
+	"maze",
+	"mean",
+	"meat",
+	"melt",
+	"menu",
+	"mesh",
+	"milk",
+	"mind",
+	"miss",
+	"moon",
+	"more",
+	"move",
+	"much",
+	"mule",
+	"must",
+	"myth",
+	"name",
+	"near",
+	"neck",
+	"need",
+	"nest",
+	"news",
+	"next",
+	"nice",
+	"nose",
+	"note",
+	"obey",
+	"odor",
+	"okay",
+	"omit",
+	"once",
+	"only",
+	"open",
+	"oval",
+	"oven",
+	"over",
+	"pact",
+	"page",
+	"pair",
+	"palm",
+	"park",
+	"pass",
+	"path",
+	"pave",
+	"pear",
+	"pill",
+	"pink",
+	"pipe",
+	"play",
+	"plug",
+	"poem",
+	"poet",
+	"pole",
+	"pond",
+	"pony",
+	"pool",
+	"post",
+	"pull",
+	"pulp",
+	"push",
+	"quit",
+	"quiz",
+	"race",
+	"rack",
+	"rail",
+	"rain",
+	"ramp",
+	"rare",
+	"rate",
+	"real",
+	"rely",
+	"rent",
+	"rice",
+	"rich",
+	"ride",
+	"ring",
+	"riot",
+	"risk",
+	"road",
+	"roof",
+	"room",
+	"rose",
+	"rude",
+	"rule",
+	"safe",
+	"sail",
+	"salt",
+	"same",
+	"sand",
+	"save",
+	"scan",
+	"seat",
+	"seed",
+	"seek",
+	"sell",
+	"shed",
+	"ship",
+	"shoe",
+	"shop",
+	"sick",
+	"side",
+	"sign",
+	"silk",
+	"sing",
+	"size",
+	"skin",
+	"slab",
+	"slam",
+	"slim",
+	"slot",
+	"slow",
+	"snap",
+	"snow",
+	"soap",
+	"sock",
+	"soda",
+	"soft",
+	"song",
+	"soon",
+	"sort",
+	"soul",
+	"soup",
+	"spin",
+	"spot",
+	"stay",
+	"stem",
+	"step",
+	"such",
+	"suit",
+	"sure",
+	"swap",
+	"swim",
+	"tail",
+	"talk",
+	"tank",
+	"tape",
+	"task",
+	"taxi",
+	"team",
+	"tell",
+	"tent",
+	"term",
+	"test",
+	"text",
+	"that",
+	"then",
+	"they",
+	"this",
+	"tide",
+	"tilt",
+	"time",
+	"tiny",
+	"tone",
+	"tool",
+	"toss",
+	"town",
+	"trap",
+	"tray",
+	"tree",
+	"trim",
+	"trip",
+	"true",
+	"tube",
+	"tuna",
+	"turn",
+	"twin",
+	"type",
+	"ugly",
+	"undo",
+	"unit",
+	"upon",
+	"urge",
+	"used",
+	"vast",
+	"verb",
+	"very",
+	"view",
+	"visa",
+	"void",
+	"vote",
+	"wage",
+	"wait",
+	"walk",
+	"wall",
+	"want",
+	"warm",
+	"wash",
+	"wasp",
+	"wave",
+	"wear",
+	"west",
+	"what",
+	"when",
+	"whip",
+	"wide",
+	"wife",
+	"wild",
+	"will",
+	"wine",
+	"wing",
+	"wink",
+	"wire",
+	"wise",
+	"wish",
+	"wolf",
+	"wood",
+	"wool",
+	"word",
+	"work",
+	"wrap",
+	"yard",
+	"year",
+	"zero",
+	"zone",
+}
+
+// Allowed four-letter words (includes answers from above)
+var allowList4 = map[string]bool{
+	"aaaa": true,
+	"abbe": true,
+	"abed": true,
+	"abel": true,
+	"abet": true,
+	"able": true,
+	"abut": true,
+	"ache": true,
+	"acid": true,
+	"acme": true,
+	"acre": true,
+	"acts": true,
+	"adam": true,
+	"aden": true,
+	"afar": true,
+	"afro": true,
+	"aged": true,
+	"agee": true,
+	"ague": true,
+	"ahem": true,
+	"ahoy": true,
+	"aida": true,
+	"aide": true,
+	"aids": true,
+	"aile": true,
+	"ainu": true,
+	"airy": true,
+	"ajar": true,
+	"ajax": true,
+	"akin": true,
+	"alai": true,
+	"alan": true,
+	"alba": true,
+	"alec": true,
+	"alex": true,
+	"alga": true,
+	"alia": true,
+	"ally": true,
+	"alma": true,
+	"aloe": true,
+	"alps": true,
+	"also": true,
+	"alto": true,
+	"alum": true,
+	"alva": true,
+	"amen": true,
+	"ames": true,
+	"amid": true,
+	"ammo": true,
+	"amok": true,
+	"amos": true,
+	"amra": true,
+	"andy": true,
+	"anew": true,
+	"anna": true,
+	"anne": true,
+	"ansi": true,
+	"ante": true,
+	"anti": true,
+	"anus": true,
+	"apex": true,
+	"apse": true,
+	"aqua": true,
+	"arab": true,
+	"arch": true,
+	"area": true,
+	"ares": true,
+	"argo": true,
+	"arid": true,
+	"army": true,
+	"arpa": true,
+	"arty": true,
+	"arum": true,
+	"aryl": true,
+	"ashy": true,
+	"asia": true,
+	"astm": true,
+	"atom": true,
+	"atop": true,
+	"aunt": true,
+	"aura": true,
+	"auto": true,
+	"aver": true,
+	"avid": true,
+	"avis": true,
+	"aviv": true,
+	"avon": true,
+	"avow": true,
+	"away": true,
+	"awry": true,
+	"axes": true,
+	"axis": true,
+	"axle": true,
+	"axon": true,
+	"babe": true,
+	"baby": true,
+	"bach": true,
+	"back": true,
+	"bade": true,
+	"bail": true,
+	"bait": true,
+	"bake": true,
+	"baku": true,
+	"bald": true,
+	"bale": true,
+	"bali": true,
+	"balk": true,
+	"ball": true,
+	"balm": true,
+	"band": true,
+	"bane": true,
+	"bang": true,
+	"bank": true,
+	"barb": true,
+	"bard": true,
+	"bare": true,
+	"bark": true,
+	"barn": true,
+	"barr": true,
+	"base": true,
+	"bash": true,
+	"bask": true,
+	"bass": true,
+	"bate": true,
+	"bath": true,
+	"bats": true,
+	"batt": true,
+	"baud": true,
+	"bawd": true,
+	"bawl": true,
+	"bbbb": true,
+	"bead": true,
+	"beak": true,
+	"beam": true,
+	"bean": true,
+	"bear": true,
+	"beat": true,
+	"beau": true,
+	"beck": true,
+	"beef": true,
+	"been": true,
+	"beep": true,
+	"beer": true,
+	"beet": true,
+	"bela": true,
+	"bell": true,
+	"belt": true,
+	"bema": true,
+	"bend": true,
+	"bent": true,
+	"benz": true,
+	"berg": true,
+	"bern": true,
+	"bert": true,
+	"bess": true,
+	"best": true,
+	"beta": true,
+	"beth": true,
+	"bevy": true,
+	"bhoy": true,
+	"bias": true,
+	"bibb": true,
+	"bide": true,
+	"bien": true,
+	"bike": true,
+	"bile": true,
+	"bilk": true,
+	"bill": true,
+	"bind": true,
+	"bing": true,
+	"bini": true,
+	"bird": true,
+	"bite": true,
+	"bitt": true,
+	"blab": true,
+	"blah": true,
+	"blat": true,
+	"bled": true,
+	"blew": true,
+	"blip": true,
+	"blob": true,
+	"bloc": true,
+	"blog": true,
+	"blot": true,
+	"blow": true,
+	"blue": true,
+	"blum": true,
+	"blur": true,
+	"blvd": true,
+	"boar": true,
+	"boat": true,
+	"boca": true,
+	"bock": true,
+	"bode": true,
+	"body": true,
+	"bogy": true,
+	"bohr": true,
+	"boil": true,
+	"bois": true,
+	"bold": true,
+	"bole": true,
+	"bolo": true,
+	"bolt": true,
+	"bomb": true,
+	"bona": true,
+	"bond": true,
+	"bone": true,
+	"bong": true,
+	"bonn": true,
+	"bony": true,
+	"book": true,
+	"boom": true,
+	"boon": true,
+	"boor": true,
+	"boot": true,
+	"bore": true,
+	"borg": true,
+	"born": true,
+	"bose": true,
+	"boss": true,
+	"both": true,
+	"bout": true,
+	"bowl": true,
+	"boxy": true,
+	"boyd": true,
+	"brad": true,
+	"brae": true,
+	"brag": true,
+	"bran": true,
+	"bray": true,
+	"bred": true,
+	"brew": true,
+	"brig": true,
+	"brim": true,
+	"brow": true,
+	"bryn": true,
+	"bstj": true,
+	"buck": true,
+	"budd": true,
+	"buff": true,
+	"bulb": true,
+	"bulk": true,
+	"bull": true,
+	"bump": true,
+	"bunk": true,
+	"bunt": true,
+	"buoy": true,
+	"burg": true,
+	"burl": true,
+	"burn": true,
+	"burp": true,
+	"burr": true,
+	"burt": true,
+	"bury": true,
+	"bush": true,
+	"buss": true,
+	"bust": true,
+	"busy": true,
+	"butt": true,
+	"buzz": true,
+	"byrd": true,
+	"byte": true,
+	"cacm": true,
+	"cady": true,
+	"cafe": true,
+	"cage": true,
+	"cain": true,
+	"cake": true,
+	"calf": true,
+	"call": true,
+	"calm": true,
+	"came": true,
+	"camp": true,
+	"cane": true,
+	"cant": true,
+	"cape": true,
+	"capo": true,
+	"card": true,
+	"care": true,
+	"carl": true,
+	"carp": true,
+	"carr": true,
+	"cart": true,
+	"case": true,
+	"cash": true,
+	"cask": true,
+	"cast": true,
+	"cave": true,
+	"cccc": true,
+	"cede": true,
+	"ceil": true,
+	"cell": true,
+	"cent": true,
+	"cern": true,
+	"chad": true,
+	"chai": true,
+	"chao": true,
+	"chap": true,
+	"char": true,
+	"chat": true,
+	"chaw": true,
+	"chef": true,
+	"chen": true,
+	"chew": true,
+	"chic": true,
+	"chin": true,
+	"chip": true,
+	"chit": true,
+	"chop": true,
+	"chou": true,
+	"chow": true,
+	"chub": true,
+	"chug": true,
+	"chum": true,
+	"cite": true,
+	"city": true,
+	"clad": true,
+	"clam": true,
+	"clan": true,
+	"clap": true,
+	"claw": true,
+	"clay": true,
+	"clio": true,
+	"clip": true,
+	"clod": true,
+	"clog": true,
+	"clot": true,
+	"cloy": true,
+	"club": true,
+	"clue": true,
+	"cluj": true,
+	"coal": true,
+	"coat": true,
+	"coax": true,
+	"cobb": true,
+	"coca": true,
+	"cock": true,
+	"coco": true,
+	"coda": true,
+	"code": true,
+	"cody": true,
+	"coed": true,
+	"cohn": true,
+	"coil": true,
+	"coin": true,
+	"coke": true,
+	"cola": true,
+	"cold": true,
+	"cole": true,
+	"colt": true,
+	"coma": true,
+	"comb": true,
+	"come": true,
+	"cone": true,
+	"conn": true,
+	"cony": true,
+	"cook": true,
+	"cool": true,
+	"coon": true,
+	"coop": true,
+	"coot": true,
+	"cope": true,
+	"copy": true,
+	"cord": true,
+	"core": true,
+	"cork": true,
+	"corn": true,
+	"corp": true,
+	"cosh": true,
+	"cost": true,
+	"cosy": true,
+	"coup": true,
+	"cove": true,
+	"cowl": true,
+	"cozy": true,
+	"crab": true,
+	"crag": true,
+	"cram": true,
+	"crap": true,
+	"craw": true,
+	"crew": true,
+	"crib": true,
+	"crop": true,
+	"crow": true,
+	"crud": true,
+	"crux": true,
+	"cruz": true,
+	"cuba": true,
+	"cube": true,
+	"cuff": true,
+	"cull": true,
+	"cult": true,
+	"cuny": true,
+	"curb": true,
+	"curd": true,
+	"cure": true,
+	"curl": true,
+	"curt": true,
+	"cusp": true,
+	"cute": true,
+	"cyst": true,
+	"czar": true,
+	"dada": true,
+	"dade": true,
+	"dahl": true,
+	"dais": true,
+	"dale": true,
+	"daly": true,
+	"dame": true,
+	"damn": true,
+	"damp": true,
+	"dana": true,
+	"dane": true,
+	"dang": true,
+	"dank": true,
+	"dare": true,
+	"dark": true,
+	"darn": true,
+	"dart": true,
+	"dash": true,
+	"data": true,
+	"date": true,
+	"daub": true,
+	"dave": true,
+	"davy": true,
+	"dawn": true,
+	"daze": true,
+	"dddd": true,
+	"dead": true,
+	"deaf": true,
+	"deal": true,
+	"dean": true,
+	"dear": true,
+	"debt": true,
+	"deck": true,
+	"deed": true,
+	"deem": true,
+	"deep": true,
+	"deer": true,
+	"deft": true,
+	"defy": true,
+	"deja": true,
+	"dell": true,
+	"dent": true,
+	"deny": true,
+	"desk": true,
+	"deus": true,
+	"dewy": true,
+	"dial": true,
+	"dibs": true,
+	"dice": true,
+	"dick": true,
+	"dido": true,
+	"died": true,
+	"diem": true,
+	"diet": true,
+	"dill": true,
+	"dime": true,
+	"dine": true,
+	"ding": true,
+	"dint": true,
+	"dire": true,
+	"dirt": true,
+	"disc": true,
+	"dish": true,
+	"disk": true,
+	"diva": true,
+	"dive": true,
+	"dock": true,
+	"dodd": true,
+	"dodo": true,
+	"doff": true,
+	"doge": true,
+	"dole": true,
+	"doll": true,
+	"dolt": true,
+	"dome": true,
+	"done": true,
+	"doom": true,
+	"door": true,
+	"dope": true,
+	"dora": true,
+	"dork": true,
+	"dory": true,
+	"dose": true,
+	"dote": true,
+	"doug": true,
+	"dour": true,
+	"dove": true,
+	"down": true,
+	"doze": true,
+	"drab": true,
+	"drag": true,
+	"dram": true,
+	"draw": true,
+	"dreg": true,
+	"drew": true,
+	"drib": true,
+	"drip": true,
+	"drop": true,
+	"drub": true,
+	"drug": true,
+	"drum": true,
+	"dual": true,
+	"duck": true,
+	"duct": true,
+	"dude": true,
+	"duel": true,
+	"duet": true,
+	"duff": true,
+	"duke": true,
+	"dull": true,
+	"duly": true,
+	"duma": true,
+	"dumb": true,
+	"dump": true,
+	"dune": true,
+	"dung": true,
+	"dunk": true,
+	"dunn": true,
+	"dupe": true,
+	"dusk": true,
+	"dust": true,
+	"duty": true,
+	"dyad": true,
+	"dyer": true,
+	"dyke": true,
+	"dyne": true,
+	"each": true,
+	"earl": true,
+	"earn": true,
+	"ease": true,
+	"east": true,
+	"easy": true,
+	"eats": true,
+	"eave": true,
+	"ebay": true,
+	"eben": true,
+	"echo": true,
+	"eddy": true,
+	"eden": true,
+	"edge": true,
+	"edgy": true,
+	"edit": true,
+	"edna": true,
+	"eeee": true,
+	"eeoc": true,
+	"egan": true,
+	"eire": true,
+	"elan": true,
+	"elba": true,
+	"ella": true,
+	"else": true,
+	"emil": true,
+	"emit": true,
+	"emma": true,
+	"enid": true,
+	"enol": true,
+	"enos": true,
+	"envy": true,
+	"epic": true,
+	"erda": true,
+	"eric": true,
+	"erie": true,
+	"erik": true,
+	"eros": true,
+	"etch": true,
+	"even": true,
+	"evil": true,
+	"exam": true,
+	"exes": true,
+	"exit": true,
+	"eyed": true,
+	"ezra": true,
+	"face": true,
+	"fact": true,
+	"fade": true,
+	"fail": true,
+	"fain": true,
+	"fair": true,
+	"fake": true,
+	"fall": true,
+	"fame": true,
+	"fang": true,
+	"fare": true,
+	"farm": true,
+	"faro": true,
+	"fast": true,
+	"fate": true,
+	"faun": true,
+	"fawn": true,
+	"faze": true,
+	"fear": true,
+	"feat": true,
+	"feed": true,
+	"feel": true,
+	"feet": true,
+	"fell": true,
+	"felt": true,
+	"fend": true,
+	"fern": true,
+	"fest": true,
+	"fete": true,
+	"feud": true,
+	"ffff": true,
+	"fiat": true,
+	"fide": true,
+	"fief": true,
+	"fife": true,
+	"fifo": true,
+	"file": true,
+	"fill": true,
+	"film": true,
+	"find": true,
+	"fine": true,
+	"fink": true,
+	"finn": true,
+	"fire": true,
+	"firm": true,
+	"fish": true,
+	"fisk": true,
+	"fist": true,
+	"five": true,
+	"flag": true,
+	"flak": true,
+	"flam": true,
+	"flap": true,
+	"flat": true,
+	"flaw": true,
+	"flax": true,
+	"flea": true,
+	"fled": true,
+	"flee": true,
+	"flew": true,
+	"flex": true,
+	"flip": true,
+	"flit": true,
+	"floc": true,
+	"floe": true,
+	"flog": true,
+	"flop": true,
+	"flow": true,
+	"flub": true,
+	"flue": true,
+	"flux": true,
+	"foal": true,
+	"foam": true,
+	"foci": true,
+	"fogy": true,
+	"foil": true,
+	"fold": true,
+	"folk": true,
+	"fond": true,
+	"font": true,
+	"food": true,
+	"fool": true,
+	"foot": true,
+	"ford": true,
+	"fore": true,
+	"fork": true,
+	"form": true,
+	"fort": true,
+	"foss": true,
+	"foul": true,
+	"four": true,
+	"fowl": true,
+	"foxy": true,
+	"fran": true,
+	"frau": true,
+	"fray": true,
+	"fred": true,
+	"free": true,
+	"fret": true,
+	"frey": true,
+	"frog": true,
+	"from": true,
+	"frye": true,
+	"fuel": true,
+	"fuji": true,
+	"full": true,
+	"fume": true,
+	"fund": true,
+	"funk": true,
+	"furl": true,
+	"fury": true,
+	"fuse": true,
+	"fuss": true,
+	"fuzz": true,
+	"gaff": true,
+	"gage": true,
+	"gail": true,
+	"gain": true,
+	"gait": true,
+	"gala": true,
+	"gale": true,
+	"gall": true,
+	"galt": true,
+	"game": true,
+	"gang": true,
+	"gape": true,
+	"garb": true,
+	"gary": true,
+	"gash": true,
+	"gasp": true,
+	"gate": true,
+	"gaul": true,
+	"gaur": true,
+	"gave": true,
+	"gawk": true,
+	"gaze": true,
+	"gear": true,
+	"geek": true,
+	"geld": true,
+	"gene": true,
+	"gent": true,
+	"germ": true,
+	"gggg": true,
+	"gibe": true,
+	"gift": true,
+	"gila": true,
+	"gild": true,
+	"gill": true,
+	"gilt": true,
+	"gina": true,
+	"ginn": true,
+	"gino": true,
+	"gird": true,
+	"girl": true,
+	"gist": true,
+	"give": true,
+	"glad": true,
+	"glee": true,
+	"glen": true,
+	"glib": true,
+	"glob": true,
+	"glom": true,
+	"glow": true,
+	"glue": true,
+	"glum": true,
+	"glut": true,
+	"gnat": true,
+	"gnaw": true,
+	"goad": true,
+	"goal": true,
+	"goat": true,
+	"goer": true,
+	"goes": true,
+	"goff": true,
+	"gogh": true,
+	"gogo": true,
+	"gold": true,
+	"golf": true,
+	"gone": true,
+	"gong": true,
+	"good": true,
+	"goof": true,
+	"goon": true,
+	"gore": true,
+	"gory": true,
+	"gosh": true,
+	"gout": true,
+	"gown": true,
+	"grab": true,
+	"grad": true,
+	"gray": true,
+	"greg": true,
+	"grew": true,
+	"grey": true,
+	"grid": true,
+	"grim": true,
+	"grin": true,
+	"grip": true,
+	"grit": true,
+	"grow": true,
+	"grub": true,
+	"guam": true,
+	"gulf": true,
+	"gull": true,
+	"gulp": true,
+	"gunk": true,
+	"guru": true,
+	"gush": true,
+	"gust": true,
+	"guts": true,
+	"gwen": true,
+	"gwyn": true,
+	"gyro": true,
+	"haag": true,
+	"haas": true,
+	"hack": true,
+	"hahn": true,
+	"hail": true,
+	"hair": true,
+	"hale": true,
+	"half": true,
+	"hall": true,
+	"halo": true,
+	"halt": true,
+	"hand": true,
+	"hang": true,
+	"hank": true,
+	"hans": true,
+	"hard": true,
+	"hare": true,
+	"hark": true,
+	"harm": true,
+	"harp": true,
+	"hart": true,
+	"hash": true,
+	"hasp": true,
+	"hast": true,
+	"hate": true,
+	"hath": true,
+	"haul": true,
+	"have": true,
+	"hawk": true,
+	"hays": true,
+	"haze": true,
+	"hazy": true,
+	"head": true,
+	"heal": true,
+	"heap": true,
+	"hear": true,
+	"heat": true,
+	"hebe": true,
+	"heck": true,
+	"heed": true,
+	"heel": true,
+	"heft": true,
+	"heir": true,
+	"held": true,
+	"hell": true,
+	"helm": true,
+	"help": true,
+	"hemp": true,
+	"hera": true,
+	"herb": true,
+	"herd": true,
+	"here": true,
+	"hero": true,
+	"herr": true,
+	"hess": true,
+	"hewn": true,
+	"hhhh": true,
+	"hick": true,
+	"hide": true,
+	"high": true,
+	"hike": true,
+	"hill": true,
+	"hilt": true,
+	"hind": true,
+	"hint": true,
+	"hire": true,
+	"hiss": true,
+	"hive": true,
+	"hoar": true,
+	"hobo": true,
+	"hock": true,
+	"hoff": true,
+	"hold": true,
+	"hole": true,
+	"holm": true,
+	"holt": true,
+	"holy": true,
+	"home": true,
+	"homo": true,
+	"hone": true,
+	"hong": true,
+	"honk": true,
+	"hood": true,
+	"hoof": true,
+	"hook": true,
+	"hoop": true,
+	"hoot": true,
+	"hope": true,
+	"horn": true,
+	"hose": true,
+	"host": true,
+	"hour": true,
+	"hove": true,
+	"howe": true,
+	"howl": true,
+	"hoyt": true,
+	"huck": true,
+	"hued": true,
+	"huff": true,
+	"huge": true,
+	"hugh": true,
+	"hugo": true,
+	"hula": true,
+	"hulk": true,
+	"hull": true,
+	"hump": true,
+	"hung": true,
+	"hunk": true,
+	"hunt": true,
+	"hurd": true,
+	"hurl": true,
+	"hurt": true,
+	"hush": true,
+	"hyde": true,
+	"hymn": true,
+	"ibex": true,
+	"ibid": true,
+	"ibis": true,
+	"icky": true,
+	"icon": true,
+	"idea": true,
+	"idle": true,
+	"idly": true,
+	"idol": true,
+	"ieee": true,
+	"iffy": true,
+	"ifni": true,
+	"igor": true,
+	"iiii": true,
+	"inca": true,
+	"inch": true,
+	"indy": true,
+	"into": true,
+	"iota": true,
+	"iowa": true,
+	"ipad": true,
+	"ipod": true,
+	"ipso": true,
+	"iran": true,
+	"iraq": true,
+	"iris": true,
+	"irma": true,
+	"iron": true,
+	"isis": true,
+	"isle": true,
+	"itch": true,
+	"item": true,
+	"ivan": true,
+	"jack": true,
+	"jacm": true,
+	"jade": true,
+	"jail": true,
+	"jake": true,
+	"jane": true,
+	"java": true,
+	"jaws": true,
+	"jazz": true,
+	"jean": true,
+	"jeep": true,
+	"jeff": true,
+	"jerk": true,
+	"jess": true,
+	"jest": true,
+	"jibe": true,
+	"jill": true,
+	"jilt": true,
+	"jinx": true,
+	"jive": true,
+	"jjjj": true,
+	"joan": true,
+	"jock": true,
+	"joel": true,
+	"joey": true,
+	"john": true,
+	"join": true,
+	"joke": true,
+	"jolt": true,
+	"jose": true,
+	"joss": true,
+	"jove": true,
+	"jowl": true,
+	"juan": true,
+	"judd": true,
+	"jude": true,
+	"judo": true,
+	"judy": true,
+	"juju": true,
+	"juke": true,
+	"july": true,
+	"jump": true,
+	"june": true,
+	"junk": true,
+	"juno": true,
+	"jura": true,
+	"jure": true,
+	"jury": true,
+	"just": true,
+	"jute": true,
+	"kahn": true,
+	"kale": true,
+	"kane": true,
+	"kant": true,
+	"karl": true,
+	"karp": true,
+	"kate": true,
+	"katz": true,
+	"kava": true,
+	"kayo": true,
+	"keel": true,
+	"keen": true,
+	"keep": true,
+	"kelp": true,
+	"kemp": true,
+	"keno": true,
+	"kent": true,
+	"kept": true,
+	"kern": true,
+	"kerr": true,
+	"keys": true,
+	"khan": true,
+	"kick": true,
+	"kiev": true,
+	"kill": true,
+	"kiln": true,
+	"kilt": true,
+	"kind": true,
+	"king": true,
+	"kink": true,
+	"kirk": true,
+	"kiss": true,
+	"kite": true,
+	"kiva": true,
+	"kivu": true,
+	"kiwi": true,
+	"kkkk": true,
+	"klan": true,
+	"klux": true,
+	"knee": true,
+	"knew": true,
+	"knit": true,
+	"knob": true,
+	"knot": true,
+	"know": true,
+	"knox": true,
+	"koch": true,
+	"kola": true,
+	"kong": true,
+	"kudo": true,
+	"kuhn": true,
+	"kung": true,
+	"kurd": true,
+	"kurt": true,
+	"kyle": true,
+	"lace": true,
+	"lack": true,
+	"lacy": true,
+	"lady": true,
+	"laid": true,
+	"lain": true,
+	"lair": true,
+	"lake": true,
+	"lamb": true,
+	"lame": true,
+	"lamp": true,
+	"lana": true,
+	"land": true,
+	"lane": true,
+	"lang": true,
+	"laos": true,
+	"lard": true,
+	"lark": true,
+	"lars": true,
+	"lase": true,
+	"lash": true,
+	"lass": true,
+	"last": true,
+	"late": true,
+	"lath": true,
+	"laud": true,
+	"laue": true,
+	"lava": true,
+	"lawn": true,
+	"laze": true,
+	"lazy": true,
+	"lead": true,
+	"leaf": true,
+	"leak": true,
+	"lean": true,
+	"leap": true,
+	"lear": true,
+	"leek": true,
+	"leer": true,
+	"left": true,
+	"lego": true,
+	"lena": true,
+	"lend": true,
+	"lens": true,
+	"lent": true,
+	"leon": true,
+	"less": true,
+	"lest": true,
+	"levi": true,
+	"levy": true,
+	"lewd": true,
+	"liar": true,
+	"lice": true,
+	"lick": true,
+	"lied": true,
+	"lien": true,
+	"lieu": true,
+	"life": true,
+	"lifo": true,
+	"lift": true,
+	"like": true,
+	"lila": true,
+	"lilt": true,
+	"lily": true,
+	"lima": true,
+	"limb": true,
+	"lime": true,
+	"limp": true,
+	"lind": true,
+	"line": true,
+	"link": true,
+	"lint": true,
+	"lion": true,
+	"lisa": true,
+	"lise": true,
+	"lisp": true,
+	"list": true,
+	"live": true,
+	"llll": true,
+	"load": true,
+	"loaf": true,
+	"loam": true,
+	"loan": true,
+	"lobe": true,
+	"lobo": true,
+	"loci": true,
+	"lock": true,
+	"loeb": true,
+	"loft": true,
+	"loge": true,
+	"loin": true,
+	"lois": true,
+	"loki": true,
+	"lola": true,
+	"loll": true,
+	"lomb": true,
+	"lome": true,
+	"lone": true,
+	"long": true,
+	"look": true,
+	"loom": true,
+	"loon": true,
+	"loop": true,
+	"loot": true,
+	"lope": true,
+	"lord": true,
+	"lore": true,
+	"lose": true,
+	"loss": true,
+	"lost": true,
+	"loud": true,
+	"love": true,
+	"lowe": true,
+	"luau": true,
+	"luck": true,
+	"lucy": true,
+	"luge": true,
+	"luis": true,
+	"luke": true,
+	"lull": true,
+	"lulu": true,
+	"lump": true,
+	"lund": true,
+	"lung": true,
+	"lura": true,
+	"lure": true,
+	"lurk": true,
+	"lush": true,
+	"lust": true,
+	"lute": true,
+	"lutz": true,
+	"luxe": true,
+	"lyle": true,
+	"lynn": true,
+	"lynx": true,
+	"lyon": true,
+	"lyra": true,
+	"mace": true,
+	"mach": true,
+	"mack": true,
+	"made": true,
+	"magi": true,
+	"maid": true,
+	"mail": true,
+	"maim": true,
+	"main": true,
+	"make": true,
+	"mako": true,
+	"male": true,
+	"mali": true,
+	"mall": true,
+	"malt": true,
+	"mama": true,
+	"mana": true,
+	"mane": true,
+	"mann": true,
+	"mans": true,
+	"many": true,
+	"marc": true,
+	"mare": true,
+	"mark": true,
+	"mars": true,
+	"mart": true,
+	"marx": true,
+	"mary": true,
+	"mash": true,
+	"mask": true,
+	"mass": true,
+	"mast": true,
+	"mate": true,
+	"math": true,
+	"maul": true,
+	"mawr": true,
+	"maya": true,
+	"mayo": true,
+	"maze": true,
+	"mead": true,
+	"meal": true,
+	"mean": true,
+	"meat": true,
+	"meek": true,
+	"meet": true,
+	"mega": true,
+	"meir": true,
+	"meld": true,
+	"melt": true,
+	"memo": true,
+	"mend": true,
+	"menu": true,
+	"mere": true,
+	"mesa": true,
+	"mesh": true,
+	"mess": true,
+	"mete": true,
+	"mica": true,
+	"mice": true,
+	"mien": true,
+	"miff": true,
+	"mike": true,
+	"mila": true,
+	"mild": true,
+	"mile": true,
+	"milk": true,
+	"mill": true,
+	"milt": true,
+	"mimi": true,
+	"mind": true,
+	"mine": true,
+	"mini": true,
+	"mink": true,
+	"mint": true,
+	"mira": true,
+	"mire": true,
+	"miss": true,
+	"mist": true,
+	"mite": true,
+	"mitt": true,
+	"mmmm": true,
+	"moan": true,
+	"moat": true,
+	"mock": true,
+	"mode": true,
+	"moen": true,
+	"mohr": true,
+	"mold": true,
+	"mole": true,
+	"moll": true,
+	"molt": true,
+	"mona": true,
+	"monk": true,
+	"mont": true,
+	"mood": true,
+	"moon": true,
+	"moor": true,
+	"moot": true,
+	"more": true,
+	"morn": true,
+	"mort": true,
+	"moss": true,
+	"most": true,
+	"moth": true,
+	"move": true,
+	"much": true,
+	"muck": true,
+	"mudd": true,
+	"muff": true,
+	"muir": true,
+	"mule": true,
+	"mull": true,
+	"mung": true,
+	"muon": true,
+	"murk": true,
+	"muse": true,
+	"mush": true,
+	"musk": true,
+	"must": true,
+	"mute": true,
+	"mutt": true,
+	"muzo": true,
+	"myel": true,
+	"myra": true,
+	"myth": true,
+	"nagy": true,
+	"nail": true,
+	"nair": true,
+	"name": true,
+	"nape": true,
+	"nary": true,
+	"nasa": true,
+	"nash": true,
+	"nate": true,
+	"nato": true,
+	"nave": true,
+	"navy": true,
+	"nazi": true,
+	"ncaa": true,
+	"neal": true,
+	"near": true,
+	"neat": true,
+	"neck": true,
+	"need": true,
+	"neff": true,
+	"neil": true,
+	"nell": true,
+	"neon": true,
+	"nerd": true,
+	"nero": true,
+	"ness": true,
+	"nest": true,
+	"neva": true,
+	"neve": true,
+	"news": true,
+	"newt": true,
+	"next": true,
+	"nibs": true,
+	"nice": true,
+	"nick": true,
+	"nigh": true,
+	"nile": true,
+	"nimh": true,
+	"nina": true,
+	"nine": true,
+	"nnnn": true,
+	"noaa": true,
+	"noah": true,
+	"node": true,
+	"noel": true,
+	"noll": true,
+	"nolo": true,
+	"none": true,
+	"nook": true,
+	"noon": true,
+	"nora": true,
+	"norm": true,
+	"nose": true,
+	"note": true,
+	"noun": true,
+	"nova": true,
+	"novo": true,
+	"ntis": true,
+	"nude": true,
+	"null": true,
+	"numb": true,
+	"oath": true,
+	"obey": true,
+	"oboe": true,
+	"odin": true,
+	"odor": true,
+	"ogle": true,
+	"ogre": true,
+	"ohio": true,
+	"oily": true,
+	"oink": true,
+	"oint": true,
+	"okay": true,
+	"olaf": true,
+	"olav": true,
+	"oldy": true,
+	"olga": true,
+	"olin": true,
+	"oman": true,
+	"omen": true,
+	"omit": true,
+	"once": true,
+	"only": true,
+	"onto": true,
+	"onus": true,
+	"onyx": true,
+	"oooo": true,
+	"oops": true,
+	"ooze": true,
+	"oozy": true,
+	"opal": true,
+	"opec": true,
+	"opel": true,
+	"open": true,
+	"opus": true,
+	"oral": true,
+	"orca": true,
+	"orgy": true,
+	"orin": true,
+	"oryx": true,
+	"oslo": true,
+	"otis": true,
+	"otto": true,
+	"ouch": true,
+	"oust": true,
+	"ouzo": true,
+	"oval": true,
+	"oven": true,
+	"over": true,
+	"ovid": true,
+	"owly": true,
+	"oxen": true,
+	"pace": true,
+	"pack": true,
+	"pact": true,
+	"page": true,
+	"paid": true,
+	"pail": true,
+	"pain": true,
+	"pair": true,
+	"pale": true,
+	"pall": true,
+	"palm": true,
+	"palo": true,
+	"pane": true,
+	"pang": true,
+	"pant": true,
+	"papa": true,
+	"pare": true,
+	"park": true,
+	"parr": true,
+	"part": true,
+	"paso": true,
+	"pass": true,
+	"past": true,
+	"pate": true,
+	"path": true,
+	"paul": true,
+	"pave": true,
+	"pawn": true,
+	"peak": true,
+	"peal": true,
+	"pear": true,
+	"peat": true,
+	"peck": true,
+	"peed": true,
+	"peek": true,
+	"peel": true,
+	"peep": true,
+	"peer": true,
+	"pelt": true,
+	"pend": true,
+	"penh": true,
+	"penn": true,
+	"pent": true,
+	"perk": true,
+	"perm": true,
+	"pert": true,
+	"peru": true,
+	"peso": true,
+	"pest": true,
+	"pete": true,
+	"phil": true,
+	"phon": true,
+	"pica": true,
+	"pick": true,
+	"pier": true,
+	"pika": true,
+	"pike": true,
+	"pile": true,
+	"pill": true,
+	"pimp": true,
+	"pine": true,
+	"ping": true,
+	"pink": true,
+	"pint": true,
+	"pion": true,
+	"pipe": true,
+	"pith": true,
+	"pitt": true,
+	"pity": true,
+	"pius": true,
+	"pixy": true,
+	"plan": true,
+	"plat": true,
+	"play": true,
+	"plea": true,
+	"plod": true,
+	"plop": true,
+	"plot": true,
+	"plow": true,
+	"ploy": true,
+	"plug": true,
+	"plum": true,
+	"plus": true,
+	"poem": true,
+	"poet": true,
+	"pogo": true,
+	"poke": true,
+	"pole": true,
+	"polk": true,
+	"poll": true,
+	"polo": true,
+	"pomp": true,
+	"pond": true,
+	"pong": true,
+	"pont": true,
+	"pony": true,
+	"pooh": true,
+	"pool": true,
+	"poop": true,
+	"poor": true,
+	"pope": true,
+	"pore": true,
+	"pork": true,
+	"port": true,
+	"pose": true,
+	"posh": true,
+	"post": true,
+	"posy": true,
+	"pour": true,
+	"pout": true,
+	"pppp": true,
+	"pram": true,
+	"pray": true,
+	"prep": true,
+	"prey": true,
+	"prig": true,
+	"prim": true,
+	"prod": true,
+	"prof": true,
+	"prom": true,
+	"prop": true,
+	"prow": true,
+	"puck": true,
+	"puff": true,
+	"pugh": true,
+	"puke": true,
+	"pull": true,
+	"pulp": true,
+	"puma": true,
+	"pump": true,
+	"punk": true,
+	"punt": true,
+	"puny": true,
+	"pure": true,
+	"purl": true,
+	"purr": true,
+	"push": true,
+	"putt": true,
+	"pyle": true,
+	"pyre": true,
+	"qqqq": true,
+	"quad": true,
+	"quay": true,
+	"quid": true,
+	"quip": true,
+	"quit": true,
+	"quiz": true,
+	"quod": true,
+	"race": true,
+	"rack": true,
+	"racy": true,
+	"raft": true,
+	"rage": true,
+	"raid": true,
+	"rail": true,
+	"rain": true,
+	"rake": true,
+	"ramo": true,
+	"ramp": true,
+	"rand": true,
+	"rang": true,
+	"rank": true,
+	"rant": true,
+	"rape": true,
+	"rapt": true,
+	"rare": true,
+	"rasa": true,
+	"rash": true,
+	"rasp": true,
+	"rata": true,
+	"rate": true,
+	"raul": true,
+	"rave": true,
+	"raze": true,
+	"read": true,
+	"real": true,
+	"ream": true,
+	"reap": true,
+	"rear": true,
+	"reck": true,
+	"reed": true,
+	"reef": true,
+	"reek": true,
+	"reel": true,
+	"reid": true,
+	"rein": true,
+	"rely": true,
+	"rena": true,
+	"rend": true,
+	"rene": true,
+	"rent": true,
+	"reps": true,
+	"rest": true,
+	"reub": true,
+	"rhea": true,
+	"rica": true,
+	"rice": true,
+	"rich": true,
+	"rick": true,
+	"rico": true,
+	"ride": true,
+	"rift": true,
+	"riga": true,
+	"rill": true,
+	"rime": true,
+	"rimy": true,
+	"rind": true,
+	"ring": true,
+	"rink": true,
+	"riot": true,
+	"ripe": true,
+	"rise": true,
+	"risk": true,
+	"rite": true,
+	"ritz": true,
+	"road": true,
+	"roam": true,
+	"roar": true,
+	"robe": true,
+	"rock": true,
+	"rode": true,
+	"roil": true,
+	"role": true,
+	"roll": true,
+	"rome": true,
+	"romp": true,
+	"rood": true,
+	"roof": true,
+	"rook": true,
+	"room": true,
+	"root": true,
+	"rope": true,
+	"rosa": true,
+	"rose": true,
+	"ross": true,
+	"rosy": true,
+	"rotc": true,
+	"roth": true,
+	"rout": true,
+	"rove": true,
+	"rowe": true,
+	"rrrr": true,
+	"rsvp": true,
+	"rube": true,
+	"ruby": true,
+	"rude": true,
+	"rudy": true,
+	"ruin": true,
+	"rule": true,
+	"rump": true,
+	"rune": true,
+	"rung": true,
+	"runt": true,
+	"ruse": true,
+	"rush": true,
+	"rusk": true,
+	"russ": true,
+	"rust": true,
+	"ruth": true,
+	"ryan": true,
+	"sack": true,
+	"safe": true,
+	"saga": true,
+	"sage": true,
+	"sago": true,
+	"said": true,
+	"sail": true,
+	"sake": true,
+	"sale": true,
+	"salk": true,
+	"salt": true,
+	"same": true,
+	"sana": true,
+	"sand": true,
+	"sane": true,
+	"sang": true,
+	"sank": true,
+	"sans": true,
+	"sara": true,
+	"sari": true,
+	"sash": true,
+	"saud": true,
+	"saul": true,
+	"save": true,
+	"scab": true,
+	"scam": true,
+	"scan": true,
+	"scar": true,
+	"scat": true,
+	"scot": true,
+	"scud": true,
+	"scum": true,
+	"seal": true,
+	"seam": true,
+	"sean": true,
+	"sear": true,
+	"seat": true,
+	"sect": true,
+	"seed": true,
+	"seek": true,
+	"seem": true,
+	"seen": true,
+	"seep": true,
+	"self": true,
+	"sell": true,
+	"semi": true,
+	"send": true,
+	"sent": true,
+	"sept": true,
+	"sera": true,
+	"serf": true,
+	"seth": true,
+	"sewn": true,
+	"sexy": true,
+	"shad": true,
+	"shag": true,
+	"shah": true,
+	"sham": true,
+	"shaw": true,
+	"shay": true,
+	"shea": true,
+	"shed": true,
+	"shim": true,
+	"shin": true,
+	"ship": true,
+	"shiv": true,
+	"shod": true,
+	"shoe": true,
+	"shoo": true,
+	"shop": true,
+	"shot": true,
+	"show": true,
+	"shun": true,
+	"shut": true,
+	"sial": true,
+	"siam": true,
+	"sian": true,
+	"sick": true,
+	"side": true,
+	"sift": true,
+	"sigh": true,
+	"sign": true,
+	"silk": true,
+	"sill": true,
+	"silo": true,
+	"silt": true,
+	"sima": true,
+	"sims": true,
+	"sine": true,
+	"sing": true,
+	"sinh": true,
+	"sink": true,
+	"sire": true,
+	"site": true,
+	"situ": true,
+	"siva": true,
+	"size": true,
+	"skat": true,
+	"skew": true,
+	"skid": true,
+	"skim": true,
+	"skin": true,
+	"skip": true,
+	"skit": true,
+	"skye": true,
+	"slab": true,
+	"slag": true,
+	"slam": true,
+	"slap": true,
+	"slat": true,
+	"slav": true,
+	"slaw": true,
+	"slay": true,
+	"sled": true,
+	"slew": true,
+	"slid": true,
+	"slim": true,
+	"slip": true,
+	"slit": true,
+	"slob": true,
+	"sloe": true,
+	"slog": true,
+	"slop": true,
+	"slot": true,
+	"slow": true,
+	"slug": true,
+	"slum": true,
+	"slur": true,
+	"smog": true,
+	"smug": true,
+	"smut": true,
+	"snag": true,
+	"snap": true,
+	"snip": true,
+	"snob": true,
+	"snow": true,
+	"snub": true,
+	"snug": true,
+	"soak": true,
+	"soap": true,
+	"soar": true,
+	"sock": true,
+	"soda": true,
+	"sofa": true,
+	"soft": true,
+	"soil": true,
+	"sold": true,
+	"sole": true,
+	"solo": true,
+	"soma": true,
+	"some": true,
+	"song": true,
+	"sony": true,
+	"soon": true,
+	"soot": true,
+	"sora": true,
+	"sorb": true,
+	"sore": true,
+	"sort": true,
+	"soul": true,
+	"soup": true,
+	"sour": true,
+	"sown": true,
+	"soya": true,
+	"span": true,
+	"spar": true,
+	"spat": true,
+	"spay": true,
+	"spec": true,
+	"sped": true,
+	"spew": true,
+	"spin": true,
+	"spit": true,
+	"spot": true,
+	"spry": true,
+	"spud": true,
+	"spun": true,
+	"spur": true,
+	"ssss": true,
+	"stab": true,
+	"stag": true,
+	"stan": true,
+	"star": true,
+	"stay": true,
+	"stem": true,
+	"step": true,
+	"stew": true,
+	"stir": true,
+	"stop": true,
+	"stow": true,
+	"stub": true,
+	"stud": true,
+	"stun": true,
+	"styx": true,
+	"such": true,
+	"suck": true,
+	"suds": true,
+	"suey": true,
+	"suez": true,
+	"suit": true,
+	"sulk": true,
+	"sung": true,
+	"sunk": true,
+	"suny": true,
+	"sure": true,
+	"surf": true,
+	"swab": true,
+	"swag": true,
+	"swam": true,
+	"swan": true,
+	"swap": true,
+	"swat": true,
+	"sway": true,
+	"swig": true,
+	"swim": true,
+	"swum": true,
+	"sync": true,
+	"tabu": true,
+	"tack": true,
+	"taco": true,
+	"tact": true,
+	"taft": true,
+	"tahr": true,
+	"tail": true,
+	"take": true,
+	"talc": true,
+	"tale": true,
+	"talk": true,
+	"tall": true,
+	"tame": true,
+	"tamp": true,
+	"tang": true,
+	"tanh": true,
+	"tank": true,
+	"taos": true,
+	"tapa": true,
+	"tape": true,
+	"taps": true,
+	"tara": true,
+	"tart": true,
+	"task": true,
+	"tass": true,
+	"tate": true,
+	"taut": true,
+	"taxi": true,
+	"teal": true,
+	"team": true,
+	"tear": true,
+	"teat": true,
+	"tech": true,
+	"teem": true,
+	"teen": true,
+	"teet": true,
+	"tell": true,
+	"tend": true,
+	"tent": true,
+	"term": true,
+	"tern": true,
+	"tess": true,
+	"test": true,
+	"tete": true,
+	"text": true,
+	"thai": true,
+	"than": true,
+	"that": true,
+	"thaw": true,
+	"thea": true,
+	"thee": true,
+	"them": true,
+	"then": true,
+	"they": true,
+	"thin": true,
+	"this": true,
+	"thor": true,
+	"thou": true,
+	"thud": true,
+	"thug": true,
+	"thus": true,
+	"tick": true,
+	"tide": true,
+	"tidy": true,
+	"tied": true,
+	"tier": true,
+	"tift": true,
+	"tile": true,
+	"till": true,
+	"tilt": true,
+	"time": true,
+	"tina": true,
+	"tine": true,
+	"tint": true,
+	"tiny": true,
+	"tire": true,
+	"toad": true,
+	"toby": true,
+	"todd": true,
+	"tofu": true,
+	"togo": true,
+	"togs": true,
+	"toil": true,
+	"told": true,
+	"toll": true,
+	"tomb": true,
+	"tome": true,
+	"tone": true,
+	"tong": true,
+	"toni": true,
+	"tonk": true,
+	"tony": true,
+	"took": true,
+	"tool": true,
+	"toot": true,
+	"tops": true,
+	"tore": true,
+	"tori": true,
+	"torn": true,
+	"torr": true,
+	"tort": true,
+	"tory": true,
+	"toss": true,
+	"tote": true,
+	"tour": true,
+	"tout": true,
+	"town": true,
+	"trag": true,
+	"tram": true,
+	"trap": true,
+	"tray": true,
+	"tree": true,
+	"trek": true,
+	"trig": true,
+	"trim": true,
+	"trio": true,
+	"trip": true,
+	"trod": true,
+	"trot": true,
+	"troy": true,
+	"true": true,
+	"tsar": true,
+	"tttt": true,
+	"tuba": true,
+	"tube": true,
+	"tuck": true,
+	"tuff": true,
+	"tuft": true,
+	"tuna": true,
+	"tune": true,
+	"tung": true,
+	"turf": true,
+	"turk": true,
+	"turn": true,
+	"tusk": true,
+	"tutu": true,
+	"twig": true,
+	"twin": true,
+	"twit": true,
+	"tyke": true,
+	"type": true,
+	"typo": true,
+	"ucla": true,
+	"ugly": true,
+	"ulan": true,
+	"undo": true,
+	"unit": true,
+	"unix": true,
+	"upon": true,
+	"urea": true,
+	"urge": true,
+	"uris": true,
+	"ursa": true,
+	"usaf": true,
+	"usda": true,
+	"used": true,
+	"user": true,
+	"usgs": true,
+	"usia": true,
+	"usps": true,
+	"ussr": true,
+	"utah": true,
+	"uuuu": true,
+	"vade": true,
+	"vail": true,
+	"vain": true,
+	"vale": true,
+	"vamp": true,
+	"vane": true,
+	"vary": true,
+	"vase": true,
+	"vast": true,
+	"veal": true,
+	"veda": true,
+	"veer": true,
+	"vega": true,
+	"veil": true,
+	"vein": true,
+	"vend": true,
+	"vent": true,
+	"vera": true,
+	"verb": true,
+	"very": true,
+	"vest": true,
+	"veto": true,
+	"vial": true,
+	"vice": true,
+	"vida": true,
+	"viet": true,
+	"view": true,
+	"viii": true,
+	"vile": true,
+	"vine": true,
+	"visa": true,
+	"vise": true,
+	"vita": true,
+	"vito": true,
+	"viva": true,
+	"vivo": true,
+	"void": true,
+	"volt": true,
+	"voss": true,
+	"vote": true,
+	"vvvv": true,
+	"wack": true,
+	"waco": true,
+	"wade": true,
+	"wadi": true,
+	"wage": true,
+	"wahl": true,
+	"wail": true,
+	"wait": true,
+	"wake": true,
+	"wale": true,
+	"walk": true,
+	"wall": true,
+	"walt": true,
+	"wand": true,
+	"wane": true,
+	"wang": true,
+	"want": true,
+	"ward": true,
+	"ware": true,
+	"warm": true,
+	"warn": true,
+	"warp": true,
+	"wart": true,
+	"wary": true,
+	"wash": true,
+	"wasp": true,
+	"wast": true,
+	"watt": true,
+	"wave": true,
+	"wavy": true,
+	"waxy": true,
+	"weak": true,
+	"weal": true,
+	"wean": true,
+	"wear": true,
+	"webb": true,
+	"weco": true,
+	"weed": true,
+	"week": true,
+	"weep": true,
+	"wehr": true,
+	"weir": true,
+	"weld": true,
+	"well": true,
+	"welt": true,
+	"went": true,
+	"wept": true,
+	"were": true,
+	"wert": true,
+	"west": true,
+	"wham": true,
+	"what": true,
+	"whee": true,
+	"when": true,
+	"whet": true,
+	"whig": true,
+	"whim": true,
+	"whip": true,
+	"whir": true,
+	"whit": true,
+	"whiz": true,
+	"whoa": true,
+	"whom": true,
+	"whop": true,
+	"whup": true,
+	"wick": true,
+	"wide": true,
+	"wier": true,
+	"wife": true,
+	"wifi": true,
+	"wild": true,
+	"wile": true,
+	"will": true,
+	"wilt": true,
+	"wily": true,
+	"wimp": true,
+	"wind": true,
+	"wine": true,
+	"wing": true,
+	"wink": true,
+	"wino": true,
+	"winy": true,
+	"wipe": true,
+	"wire": true,
+	"wiry": true,
+	"wise": true,
+	"wish": true,
+	"wisp": true,
+	"with": true,
+	"witt": true,
+	"wive": true,
+	"woke": true,
+	"wold": true,
+	"wolf": true,
+	"womb": true,
+	"wong": true,
+	"wont": true,
+	"wood": true,
+	"woof": true,
+	"wool": true,
+	"word": true,
+	"wore": true,
+	"work": true,
+	"worm": true,
+	"worn": true,
+	"wove": true,
+	"wrap": true,
+	"wren": true,
+	"writ": true,
+	"wwww": true,
+	"wynn": true,
+	"xbox": true,
+	"xxxx": true,
+	"yale": true,
+	"yang": true,
+	"yank": true,
+	"yard": true,
+	"yarn": true,
+	"yawl": true,
+	"yawn": true,
+	"yeah": true,
+	"year": true,
+	"yell": true,
+	"yelp": true,
+	"yeti": true,
+	"ymca": true,
+	"yoga": true,
+	"yogi": true,
+	"yoke": true,
+	"yolk": true,
+	"yond": true,
+	"yore": true,
+	"york": true,
+	"yost": true,
+	"your": true,
+	"yoyo": true,
+	"yuck": true,
+	"yuki": true,
+	"yule": true,
+	"yves": true,
+	"ywca": true,
+	"yyyy": true,
+	"zeal": true,
+	"zero": true,
+	"zest": true,
+	"zeta": true,
+	"zeus": true,
+	"zinc": true,
+	"zing": true,
+	"zion": true,
+	"zips": true,
+	"zone": true,
+	"zoom": true,
+	"zorn": true,
+	"zzzz": true,
+}
@@ -0,0 +1,2713 @@
+package words
+
+// All possible six-letter answers
+var answerList6 = [...]string{
+	"absent",
+	"absorb",
+	"absurd",
+	"access",
+	"accuse",
+	"across",
+	"action",
+	"actual",
+	"addict",
+	"adjust",
+	"advice",
+	"affair",
+	"afford",
+	"afraid",
+	"almost",
+	"always",
+	"amount",
+	"amused",
+	"anchor",
+	"animal",
+	"annual",
+	"answer",
+	"appear",
+	"arctic",
+	"around",
+	"arrest",
+	"arrive",
+	"artist",
+	"aspect",
+	"assist",
+	"assume",
+	"asthma",
+	"attack",
+	"attend",
+	"august",
+	"author",
+	"autumn",
+	"bamboo",
+	"banana",
+	"banner",
+	"barely",
+	"barrel",
+	"basket",
+	"battle",
+	"beauty",
+	"become",
+	"before",
+	"behave",
+	"behind",
+	"betray",
+	"better",
+	"beyond",
+	"bitter",
+	"blouse",
+	"border",
+	"boring",
+	"borrow",
+	"bottom",
+	"bounce",
+	"breeze",
+	"bridge",
+	"bright",
+	"broken",
+	"bronze",
+	"bubble",
+	"budget",
+	"bullet",
+	"bundle",
+	"bunker",
+	"burden",
+	"burger",
+	"butter",
+	"cactus",
+	"camera",
+	"cancel",
+	"cannon",
+	"canvas",
+	"canyon",
+	"carbon",
+	"carpet",
+	"casino",
+	"castle",
+	"casual",
+	"cattle",
+	"caught",
+	"celery",
+	"cement",
+	"census",
+	"cereal",
+	"change",
+	"charge",
+	"cheese",
+	"cherry",
+	"choice",
+	"choose",
+	"circle",
+	"clever",
+	"client",
+	"clinic",
+	"clutch",
+	"coffee",
+	"column",
+	"common",
+	"copper",
+	"cotton",
+	"couple",
+	"course",
+	"cousin",
+	"coyote",
+	"cradle",
+	"crater",
+	"credit",
+	"critic",
+	"crouch",
+	"cruise",
+	"crunch",
+	"custom",
+	"damage",
+	"danger",
+	"daring",
+	"debate",
+	"debris",
+	"decade",
+	"decide",
+	"define",
+	"degree",
+	"demand",
+	"demise",
+	"denial",
+	"depart",
+	"depend",
+	"deputy",
+	"derive",
+	"desert",
+	"design",
+	"detail",
+	"detect",
+	"device",
+	"devote",
+	"diesel",
+	"differ",
+	"dinner",
+	"direct",
+	"divert",
+	"divide",
+	"doctor",
+	"domain",
+	"donate",
+	"donkey",
+	"double",
+	"dragon",
+	"during",
+	"easily",
+	"effort",
+	"either",
+	"embark",
+	"embody",
+	"emerge",
+	"employ",
+	"enable",
+	"energy",
+	"engage",
+	"engine",
+	"enlist",
+	"enough",
+	"enrich",
+	"enroll",
+	"ensure",
+	"entire",
+	"escape",
+	"estate",
+	"ethics",
+	"evolve",
+	"excess",
+	"excite",
+	"excuse",
+	"exotic",
+	"expand",
+	"expect",
+	"expire",
+	"expose",
+	"extend",
+	"fabric",
+	"family",
+	"famous",
+	"father",
+	"female",
+	"figure",
+	"filter",
+	"finger",
+	"finish",
+	"fiscal",
+	"flavor",
+	"flight",
+	"flower",
+	"follow",
+	"forest",
+	"forget",
+	"fossil",
+	"foster",
+	"friend",
+	"fringe",
+	"frozen",
+	"future",
+	"gadget",
+	"galaxy",
+	"garage",
+	"garden",
+	"garlic",
+	"gather",
+	"genius",
+	"gentle",
+	"giggle",
+	"ginger",
+	"glance",
+	"gospel",
+	"gossip",
+	"govern",
+	"guitar",
+	"hammer",
+	"harbor",
+	"hazard",
+	"health",
+	"height",
+	"helmet",
+	"hidden",
+	"hockey",
+	"hollow",
+	"horror",
+	"humble",
+	"hungry",
+	"hurdle",
+	"hybrid",
+	"ignore",
+	"immune",
+	"impact",
+	"impose",
+	"income",
+	"indoor",
+	"infant",
+	"inform",
+	"inhale",
+	"inject",
+	"injury",
+	"inmate",
+	"insane",
+	"insect",
+	"inside",
+	"intact",
+	"invest",
+	"invite",
+	"island",
+	"jacket",
+	"jaguar",
+	"jungle",
+	"junior",
+	"kidney",
+	"kitten",
+	"ladder",
+	"laptop",
+	"leader",
+	"legend",
+	"length",
+	"lesson",
+	"letter",
+	"liquid",
+	"little",
+	"lizard",
+	"lonely",
+	"lounge",
+	"lumber",
+	"luxury",
+	"lyrics",
+	"magnet",
+	"mammal",
+	"manage",
+	"manual",
+	"marble",
+	"margin",
+	"marine",
+	"market",
+	"master",
+	"matrix",
+	"matter",
+	"meadow",
+	"melody",
+	"member",
+	"memory",
+	"method",
+	"middle",
+	"minute",
+	"mirror",
+	"misery",
+	"mobile",
+	"modify",
+	"moment",
+	"monkey",
+	"mother",
+	"motion",
+	"muffin",
+	"muscle",
+	"museum",
+	"mutual",
+	"myself",
+	"napkin",
+	"narrow",
+	"nation",
+	"nature",
+	"nephew",
+	"noodle",
+	"normal",
+	"notice",
+	"number",
+	"object",
+	"oblige",
+	"obtain",
+	"office",
+	"online",
+	"oppose",
+	"option",
+	"orange",
+	"orient",
+	"orphan",
+	"output",
+	"oxygen",
+	"oyster",
+	"paddle",
+	"palace",
+	"parade",
+	"parent",
+	"parrot",
+	"patrol",
+	"peanut",
+	"pencil",
+	"people",
+	"pepper",
+	"permit",
+	"person",
+	"phrase",
+	"picnic",
+	"pigeon",
+	"pistol",
+	"planet",
+	"please",
+	"pledge",
+	"plunge",
+	"police",
+	"potato",
+	"powder",
+	"praise",
+	"prefer",
+	"pretty",
+	"prison",
+	"profit",
+	"public",
+	"purity",
+	"puzzle",
+	"rabbit",
+	"random",
+	"rather",
+	"reason",
+	"recall",
+	"recipe",
+	"record",
+	"reduce",
+	"reform",
+	"refuse",
+	"region",
+	"regret",
+	"reject",
+	"relief",
+	"remain",
+	"remind",
+	"remove",
+	"render",
+	"reopen",
+	"repair",
+	"repeat",
+	"report",
+	"rescue",
+	"resist",
+	"result",
+	"retire",
+	"return",
+	"reveal",
+	"review",
+	"reward",
+	"rhythm",
+	"ribbon",
+	"ripple",
+	"ritual",
+	"robust",
+	"rocket",
+	"rookie",
+	"rotate",
+	"rubber",
+	"runway",
+	"saddle",
+	"salmon",
+	"salute",
+	"sample",
+	"scheme",
+	"school",
+	"screen",
+	"script",
+	"search",
+	"season",
+	"second",
+	"secret",
+	"select",
+	"senior",
+	"series",
+	"settle",
+	"shadow",
+	"shield",
+	"shiver",
+	"shrimp",
+	"silent",
+	"silver",
+	"simple",
+	"sister",
+	"sketch",
+	"slight",
+	"slogan",
+	"smooth",
+	"soccer",
+	"social",
+	"source",
+	"sphere",
+	"spider",
+	"spirit",
+	"spread",
+	"spring",
+	"square",
+	"stable",
+	"stairs",
+	"stereo",
+	"street",
+	"strike",
+	"strong",
+	"submit",
+	"subway",
+	"sudden",
+	"suffer",
+	"summer",
+	"sunset",
+	"supply",
+	"survey",
+	"switch",
+	"symbol",
+	"system",
+	"tackle",
+	"talent",
+	"target",
+	"tattoo",
+	"tenant",
+	"tennis",
+	"theory",
+	"thrive",
+	"ticket",
+	"timber",
+	"tissue",
+	"toilet",
+	"tomato",
+	"tongue",
+	"topple",
+	"toward",
+	"tragic",
+	"travel",
+	"trophy",
+	"tumble",
+	"tunnel",
+	"turkey",
+	"turtle",
+	"twelve",
+	"twenty",
+	"unable",
+	"unfair",
+	"unfold",
+	"unique",
+	"unlock",
+	"unveil",
+	"update",
+	"uphold",
+	"useful",
+	"vacant",
+	"vacuum",
+	"valley",
+	"vanish",
+	"velvet",
+	"vendor",
+	"verify",
+	"vessel",
+	"viable",
+	"violin",
+	"visual",
+	"volume",
+	"voyage",
+	"walnut",
+	"wealth",
+	"weapon",
+	"weasel",
+	"window",
+	"winner",
+	"winter",
+	"wisdom",
+	"wonder",
+	"yellow",
+}
+
+// Allowed six-letter words (includes answers from above)
+var allowList6 = map[string]bool{
+	"abacus": true,
+	"abbott": true,
+	"abject": true,
+	"ablaze": true,
+	"abrade": true,
+	"abroad": true,
+	"absent": true,
+	"absorb": true,
+	"absurd": true,
+	"acadia": true,
+	"accent": true,
+	"access": true,
+	"accrue": true,
+	"accuse": true,
+	"acetic": true,
+	"aching": true,
+	"acidic": true,
+	"across": true,
+	"acting": true,
+	"action": true,
+	"active": true,
+	"actual": true,
+	"acuity": true,
+	"adagio": true,
+	"addict": true,
+	"adjust": true,
+	"adonis": true,
+	"advent": true,
+	"advert": true,
+	"advice": true,
+	"advise": true,
+	"aeneid": true,
+	"affair": true,
+	"affine": true,
+	"affirm": true,
+	"afford": true,
+	"aflame": true,
+	"afloat": true,
+	"afraid": true,
+	"africa": true,
+	"agency": true,
+	"agenda": true,
+	"aghast": true,
+	"agreed": true,
+	"airman": true,
+	"airway": true,
+	"alaska": true,
+	"alexei": true,
+	"aliens": true,
+	"alight": true,
+	"allied": true,
+	"allure": true,
+	"almost": true,
+	"alpaca": true,
+	"altair": true,
+	"alumni": true,
+	"always": true,
+	"amazed": true,
+	"ambush": true,
+	"amends": true,
+	"amoeba": true,
+	"amount": true,
+	"ampere": true,
+	"amulet": true,
+	"amused": true,
+	"amuser": true,
+	"anchor": true,
+	"andrew": true,
+	"anemia": true,
+	"anemic": true,
+	"angelo": true,
+	"angled": true,
+	"angler": true,
+	"angles": true,
+	"angola": true,
+	"animal": true,
+	"anklet": true,
+	"annual": true,
+	"annuli": true,
+	"answer": true,
+	"anthem": true,
+	"antics": true,
+	"antler": true,
+	"anyhow": true,
+	"anyone": true,
+	"anyway": true,
+	"apache": true,
+	"apathy": true,
+	"aplomb": true,
+	"appeal": true,
+	"appear": true,
+	"append": true,
+	"arcana": true,
+	"archer": true,
+	"arctic": true,
+	"ardent": true,
+	"argive": true,
+	"arlene": true,
+	"armful": true,
+	"arming": true,
+	"armory": true,
+	"arnold": true,
+	"around": true,
+	"arrear": true,
+	"arrest": true,
+	"arrive": true,
+	"artery": true,
+	"arthur": true,
+	"artist": true,
+	"ascend": true,
+	"ascent": true,
+	"ashley": true,
+	"asleep": true,
+	"aspect": true,
+	"aspire": true,
+	"assist": true,
+	"assort": true,
+	"assume": true,
+	"assure": true,
+	"asthma": true,
+	"astral": true,
+	"astute": true,
+	"athens": true,
+	"atomic": true,
+	"atrium": true,
+	"attach": true,
+	"attack": true,
+	"attain": true,
+	"attend": true,
+	"attest": true,
+	"attire": true,
+	"aubrey": true,
+	"august": true,
+	"austin": true,
+	"author": true,
+	"autism": true,
+	"autumn": true,
+	"avatar": true,
+	"avenge": true,
+	"avenue": true,
+	"aviate": true,
+	"awaken": true,
+	"awhile": true,
+	"awning": true,
+	"azalea": true,
+	"babble": true,
+	"babied": true,
+	"baboon": true,
+	"backed": true,
+	"backer": true,
+	"backup": true,
+	"badass": true,
+	"badger": true,
+	"baffle": true,
+	"bagful": true,
+	"bagged": true,
+	"baggie": true,
+	"bahama": true,
+	"bakery": true,
+	"baking": true,
+	"balkan": true,
+	"balled": true,
+	"ballot": true,
+	"bamboo": true,
+	"banana": true,
+	"bandit": true,
+	"banish": true,
+	"banked": true,
+	"banker": true,
+	"banner": true,
+	"banter": true,
+	"barbed": true,
+	"barber": true,
+	"barely": true,
+	"barfly": true,
+	"barley": true,
+	"barman": true,
+	"barnes": true,
+	"barony": true,
+	"barrel": true,
+	"barter": true,
+	"barton": true,
+	"basics": true,
+	"basket": true,
+	"batboy": true,
+	"battle": true,
+	"bauble": true,
+	"baxter": true,
+	"bazaar": true,
+	"beagle": true,
+	"beauty": true,
+	"becalm": true,
+	"becker": true,
+	"become": true,
+	"bedbug": true,
+	"beetle": true,
+	"befall": true,
+	"before": true,
+	"beggar": true,
+	"behave": true,
+	"behind": true,
+	"beirut": true,
+	"belfry": true,
+	"bemoan": true,
+	"bender": true,
+	"bengal": true,
+	"bereft": true,
+	"berlin": true,
+	"bernet": true,
+	"bessel": true,
+	"bestir": true,
+	"bethel": true,
+	"betray": true,
+	"better": true,
+	"beware": true,
+	"beyond": true,
+	"biceps": true,
+	"billow": true,
+	"binary": true,
+	"bingle": true,
+	"birdie": true,
+	"bisque": true,
+	"bitten": true,
+	"bitter": true,
+	"blazer": true,
+	"bleach": true,
+	"blinks": true,
+	"blithe": true,
+	"blonde": true,
+	"blotch": true,
+	"blouse": true,
+	"bluish": true,
+	"blurry": true,
+	"blythe": true,
+	"bobbed": true,
+	"bobbin": true,
+	"bobble": true,
+	"bobcat": true,
+	"bogged": true,
+	"boggle": true,
+	"boiler": true,
+	"bombay": true,
+	"bonded": true,
+	"bonnet": true,
+	"bonsai": true,
+	"boogie": true,
+	"booted": true,
+	"bootie": true,
+	"border": true,
+	"boring": true,
+	"borneo": true,
+	"borrow": true,
+	"boston": true,
+	"botany": true,
+	"bottle": true,
+	"bottom": true,
+	"bounce": true,
+	"bouncy": true,
+	"bovine": true,
+	"boxcar": true,
+	"boxing": true,
+	"brainy": true,
+	"brandt": true,
+	"brassy": true,
+	"breach": true,
+	"breath": true,
+	"breeze": true,
+	"breezy": true,
+	"bremen": true,
+	"bridge": true,
+	"briggs": true,
+	"bright": true,
+	"broken": true,
+	"broker": true,
+	"bronco": true,
+	"bronze": true,
+	"brooke": true,
+	"browse": true,
+	"brunch": true,
+	"bryant": true,
+	"bubble": true,
+	"bubbly": true,
+	"bucked": true,
+	"bucket": true,
+	"buckle": true,
+	"budget": true,
+	"buenos": true,
+	"buffed": true,
+	"buffer": true,
+	"bulgur": true,
+	"bullet": true,
+	"bundle": true,
+	"bungee": true,
+	"bunion": true,
+	"bunker": true,
+	"bunyan": true,
+	"burden": true,
+	"bureau": true,
+	"burger": true,
+	"buried": true,
+	"burton": true,
+	"busboy": true,
+	"bushel": true,
+	"busily": true,
+	"butane": true,
+	"butter": true,
+	"byline": true,
+	"byword": true,
+	"cabana": true,
+	"cabbie": true,
+	"cackle": true,
+	"cactus": true,
+	"caddie": true,
+	"cadent": true,
+	"cahill": true,
+	"caiman": true,
+	"calder": true,
+	"callus": true,
+	"camber": true,
+	"camera": true,
+	"camper": true,
+	"campus": true,
+	"canary": true,
+	"cancel": true,
+	"cancer": true,
+	"candle": true,
+	"canine": true,
+	"canned": true,
+	"cannon": true,
+	"cannot": true,
+	"canola": true,
+	"canopy": true,
+	"canton": true,
+	"canvas": true,
+	"canyon": true,
+	"capped": true,
+	"carbon": true,
+	"carded": true,
+	"caress": true,
+	"caring": true,
+	"carpet": true,
+	"carrie": true,
+	"carrot": true,
+	"carson": true,
+	"cartel": true,
+	"carton": true,
+	"caruso": true,
+	"cashew": true,
+	"casing": true,
+	"casino": true,
+	"casket": true,
+	"castle": true,
+	"casual": true,
+	"catchy": true,
+	"catkin": true,
+	"catnap": true,
+	"catnip": true,
+	"catsup": true,
+	"cattle": true,
+	"cauchy": true,
+	"caucus": true,
+	"caught": true,
+	"causal": true,
+	"cavern": true,
+	"caviar": true,
+	"cavity": true,
+	"cavort": true,
+	"cayuga": true,
+	"celery": true,
+	"celtic": true,
+	"cement": true,
+	"census": true,
+	"cereal": true,
+	"cetera": true,
+	"chance": true,
+	"change": true,
+	"chapel": true,
+	"charge": true,
+	"chaste": true,
+	"chatty": true,
+	"cheeky": true,
+	"cheese": true,
+	"cheesy": true,
+	"cherry": true,
+	"cherub": true,
+	"chewer": true,
+	"chilly": true,
+	"chirpy": true,
+	"chisel": true,
+	"choice": true,
+	"choose": true,
+	"choosy": true,
+	"chopin": true,
+	"choral": true,
+	"chosen": true,
+	"chrome": true,
+	"chubby": true,
+	"chummy": true,
+	"cicada": true,
+	"cinema": true,
+	"cipher": true,
+	"circle": true,
+	"circus": true,
+	"citric": true,
+	"citrus": true,
+	"clammy": true,
+	"clamor": true,
+	"clarke": true,
+	"clause": true,
+	"clench": true,
+	"clever": true,
+	"cliche": true,
+	"client": true,
+	"clinic": true,
+	"clique": true,
+	"closet": true,
+	"clover": true,
+	"clumsy": true,
+	"clunky": true,
+	"clutch": true,
+	"cobalt": true,
+	"cobble": true,
+	"cobweb": true,
+	"cockle": true,
+	"coddle": true,
+	"coerce": true,
+	"coffee": true,
+	"cogent": true,
+	"collar": true,
+	"collie": true,
+	"colony": true,
+	"column": true,
+	"combat": true,
+	"cometh": true,
+	"coming": true,
+	"common": true,
+	"compel": true,
+	"comply": true,
+	"concur": true,
+	"condor": true,
+	"conner": true,
+	"conway": true,
+	"cooley": true,
+	"copied": true,
+	"copier": true,
+	"coping": true,
+	"copper": true,
+	"corbel": true,
+	"cornea": true,
+	"corned": true,
+	"corner": true,
+	"corral": true,
+	"corset": true,
+	"cortex": true,
+	"corvus": true,
+	"cosmic": true,
+	"cosmos": true,
+	"cotton": true,
+	"cougar": true,
+	"county": true,
+	"couple": true,
+	"course": true,
+	"cousin": true,
+	"cowman": true,
+	"coyote": true,
+	"cozily": true,
+	"cradle": true,
+	"crafty": true,
+	"crater": true,
+	"crayon": true,
+	"crazed": true,
+	"crease": true,
+	"create": true,
+	"credit": true,
+	"creole": true,
+	"cringe": true,
+	"crispy": true,
+	"critic": true,
+	"crocus": true,
+	"crouch": true,
+	"cruise": true,
+	"crummy": true,
+	"crunch": true,
+	"crying": true,
+	"cuddle": true,
+	"cuddly": true,
+	"cupful": true,
+	"cupped": true,
+	"curdle": true,
+	"curfew": true,
+	"curing": true,
+	"curled": true,
+	"curler": true,
+	"cursor": true,
+	"curtly": true,
+	"curtsy": true,
+	"cussed": true,
+	"custom": true,
+	"cutlet": true,
+	"cyclic": true,
+	"cymbal": true,
+	"dactyl": true,
+	"dagger": true,
+	"dahlia": true,
+	"dainty": true,
+	"damage": true,
+	"damsel": true,
+	"dander": true,
+	"danger": true,
+	"dangle": true,
+	"daring": true,
+	"darken": true,
+	"dassie": true,
+	"dating": true,
+	"dawson": true,
+	"daybed": true,
+	"deacon": true,
+	"deadly": true,
+	"dealer": true,
+	"debate": true,
+	"debris": true,
+	"debtor": true,
+	"debunk": true,
+	"decade": true,
+	"deceit": true,
+	"decent": true,
+	"decide": true,
+	"decker": true,
+	"decode": true,
+	"decree": true,
+	"deduce": true,
+	"deduct": true,
+	"deepen": true,
+	"deeply": true,
+	"deface": true,
+	"defame": true,
+	"defeat": true,
+	"defile": true,
+	"define": true,
+	"deform": true,
+	"deftly": true,
+	"defuse": true,
+	"degree": true,
+	"delete": true,
+	"deluge": true,
+	"deluxe": true,
+	"demand": true,
+	"demark": true,
+	"demise": true,
+	"demote": true,
+	"denial": true,
+	"denote": true,
+	"dental": true,
+	"denton": true,
+	"depart": true,
+	"depend": true,
+	"depict": true,
+	"deploy": true,
+	"deport": true,
+	"depose": true,
+	"depute": true,
+	"deputy": true,
+	"derail": true,
+	"derive": true,
+	"desert": true,
+	"design": true,
+	"desist": true,
+	"detach": true,
+	"detail": true,
+	"detect": true,
+	"detest": true,
+	"device": true,
+	"devoid": true,
+	"devote": true,
+	"diaper": true,
+	"dibble": true,
+	"dicing": true,
+	"diesel": true,
+	"differ": true,
+	"dilate": true,
+	"dilute": true,
+	"dimmed": true,
+	"dimmer": true,
+	"dimple": true,
+	"dinghy": true,
+	"dining": true,
+	"dinner": true,
+	"dipped": true,
+	"dipper": true,
+	"direct": true,
+	"disarm": true,
+	"dismay": true,
+	"disney": true,
+	"disown": true,
+	"divert": true,
+	"divide": true,
+	"divine": true,
+	"diving": true,
+	"doable": true,
+	"dobson": true,
+	"docile": true,
+	"docket": true,
+	"doctor": true,
+	"dollar": true,
+	"dollop": true,
+	"domain": true,
+	"donate": true,
+	"doneck": true,
+	"donkey": true,
+	"doodle": true,
+	"dorsal": true,
+	"dosage": true,
+	"dotted": true,
+	"double": true,
+	"douche": true,
+	"dragon": true,
+	"dreamt": true,
+	"dreamy": true,
+	"dreary": true,
+	"drench": true,
+	"dressy": true,
+	"drippy": true,
+	"driven": true,
+	"driver": true,
+	"drudge": true,
+	"dubbed": true,
+	"dublin": true,
+	"duffel": true,
+	"dugout": true,
+	"duller": true,
+	"dunham": true,
+	"dunlop": true,
+	"duplex": true,
+	"duress": true,
+	"during": true,
+	"dwight": true,
+	"earful": true,
+	"earthy": true,
+	"earwig": true,
+	"easily": true,
+	"easing": true,
+	"easter": true,
+	"eatery": true,
+	"eating": true,
+	"eclair": true,
+	"edging": true,
+	"editor": true,
+	"efface": true,
+	"effort": true,
+	"egging": true,
+	"eggnog": true,
+	"egress": true,
+	"either": true,
+	"elated": true,
+	"eldest": true,
+	"eleven": true,
+	"elixir": true,
+	"embalm": true,
+	"embark": true,
+	"emblem": true,
+	"embody": true,
+	"emboss": true,
+	"emerge": true,
+	"employ": true,
+	"enable": true,
+	"enamel": true,
+	"encode": true,
+	"encore": true,
+	"ending": true,
+	"energy": true,
+	"engage": true,
+	"engine": true,
+	"engulf": true,
+	"enlist": true,
+	"enmity": true,
+	"enough": true,
+	"enrage": true,
+	"enrich": true,
+	"enrico": true,
+	"enroll": true,
+	"ensure": true,
+	"entail": true,
+	"entire": true,
+	"entity": true,
+	"entomb": true,
+	"entrap": true,
+	"entree": true,
+	"enzyme": true,
+	"equate": true,
+	"equity": true,
+	"erased": true,
+	"eraser": true,
+	"ernest": true,
+	"errand": true,
+	"errant": true,
+	"escape": true,
+	"eskimo": true,
+	"estate": true,
+	"ethics": true,
+	"eulogy": true,
+	"eureka": true,
+	"evenly": true,
+	"evolve": true,
+	"excess": true,
+	"excite": true,
+	"excuse": true,
+	"exhale": true,
+	"exhume": true,
+	"exodus": true,
+	"exotic": true,
+	"expand": true,
+	"expect": true,
+	"expend": true,
+	"expert": true,
+	"expire": true,
+	"expose": true,
+	"extant": true,
+	"extend": true,
+	"extent": true,
+	"extras": true,
+	"fabric": true,
+	"facial": true,
+	"facile": true,
+	"facing": true,
+	"factor": true,
+	"fading": true,
+	"fairly": true,
+	"falcon": true,
+	"family": true,
+	"famine": true,
+	"famous": true,
+	"fanout": true,
+	"farley": true,
+	"fasten": true,
+	"faster": true,
+	"father": true,
+	"faucet": true,
+	"fedora": true,
+	"feeble": true,
+	"feisty": true,
+	"felice": true,
+	"feline": true,
+	"female": true,
+	"fender": true,
+	"ferret": true,
+	"ferric": true,
+	"ferris": true,
+	"fervor": true,
+	"fester": true,
+	"fibrin": true,
+	"fiddle": true,
+	"figure": true,
+	"filing": true,
+	"filled": true,
+	"filler": true,
+	"fillet": true,
+	"filter": true,
+	"finale": true,
+	"finger": true,
+	"finish": true,
+	"finite": true,
+	"firmly": true,
+	"fiscal": true,
+	"flashy": true,
+	"flatly": true,
+	"flatus": true,
+	"flavor": true,
+	"fleshy": true,
+	"flight": true,
+	"flinch": true,
+	"floppy": true,
+	"floral": true,
+	"flower": true,
+	"fluent": true,
+	"flying": true,
+	"fodder": true,
+	"follow": true,
+	"fondly": true,
+	"fondue": true,
+	"footer": true,
+	"forest": true,
+	"forget": true,
+	"forgot": true,
+	"fossil": true,
+	"foster": true,
+	"franca": true,
+	"frayed": true,
+	"freely": true,
+	"french": true,
+	"frenzy": true,
+	"friday": true,
+	"fridge": true,
+	"friend": true,
+	"frilly": true,
+	"fringe": true,
+	"frisky": true,
+	"frolic": true,
+	"frosty": true,
+	"frozen": true,
+	"frying": true,
+	"fungal": true,
+	"future": true,
+	"gadget": true,
+	"galaxy": true,
+	"galley": true,
+	"gallon": true,
+	"gallop": true,
+	"galore": true,
+	"gaming": true,
+	"gander": true,
+	"gangly": true,
+	"gannet": true,
+	"garage": true,
+	"garden": true,
+	"gargle": true,
+	"garish": true,
+	"garlic": true,
+	"garner": true,
+	"garnet": true,
+	"garter": true,
+	"gather": true,
+	"gating": true,
+	"gauche": true,
+	"gazing": true,
+	"geiger": true,
+	"gender": true,
+	"genius": true,
+	"gentle": true,
+	"gently": true,
+	"gentry": true,
+	"gerbil": true,
+	"geyser": true,
+	"ghetto": true,
+	"gibbon": true,
+	"giblet": true,
+	"giggle": true,
+	"giggly": true,
+	"gigolo": true,
+	"gilled": true,
+	"gimbal": true,
+	"ginger": true,
+	"girdle": true,
+	"giving": true,
+	"gladdy": true,
+	"gladly": true,
+	"glamor": true,
+	"glance": true,
+	"glider": true,
+	"glitch": true,
+	"glitzy": true,
+	"gloomy": true,
+	"gluing": true,
+	"gluten": true,
+	"gnarly": true,
+	"goblin": true,
+	"goggle": true,
+	"golden": true,
+	"google": true,
+	"gopher": true,
+	"gordon": true,
+	"gorged": true,
+	"gospel": true,
+	"gossip": true,
+	"gothic": true,
+	"gotten": true,
+	"govern": true,
+	"graded": true,
+	"grader": true,
+	"granny": true,
+	"grater": true,
+	"gravel": true,
+	"graves": true,
+	"greedy": true,
+	"grieve": true,
+	"grinch": true,
+	"groggy": true,
+	"groove": true,
+	"groovy": true,
+	"groton": true,
+	"ground": true,
+	"grouse": true,
+	"grower": true,
+	"grudge": true,
+	"grunge": true,
+	"guided": true,
+	"guinea": true,
+	"guitar": true,
+	"gurgle": true,
+	"gutter": true,
+	"guyana": true,
+	"hacked": true,
+	"hacker": true,
+	"hadron": true,
+	"halvah": true,
+	"halved": true,
+	"halves": true,
+	"hamlet": true,
+	"hamlin": true,
+	"hammer": true,
+	"hamper": true,
+	"handed": true,
+	"hangup": true,
+	"hankie": true,
+	"hansel": true,
+	"harbor": true,
+	"hardly": true,
+	"harley": true,
+	"harvey": true,
+	"hassle": true,
+	"hatbox": true,
+	"hatred": true,
+	"hazard": true,
+	"hazily": true,
+	"hazing": true,
+	"headed": true,
+	"header": true,
+	"health": true,
+	"hebrew": true,
+	"heckle": true,
+	"height": true,
+	"helium": true,
+	"helmet": true,
+	"helmut": true,
+	"helped": true,
+	"helper": true,
+	"herald": true,
+	"herbal": true,
+	"hermit": true,
+	"heroic": true,
+	"hettie": true,
+	"hewitt": true,
+	"hidden": true,
+	"highly": true,
+	"hirsch": true,
+	"hockey": true,
+	"hodges": true,
+	"holdup": true,
+	"hollow": true,
+	"honest": true,
+	"hookup": true,
+	"hornet": true,
+	"horror": true,
+	"hotbox": true,
+	"hubbub": true,
+	"hubcap": true,
+	"huddle": true,
+	"hugely": true,
+	"hughes": true,
+	"humane": true,
+	"humble": true,
+	"humbly": true,
+	"hummus": true,
+	"humped": true,
+	"humvee": true,
+	"hunger": true,
+	"hungry": true,
+	"hunter": true,
+	"hurdle": true,
+	"hurled": true,
+	"hurler": true,
+	"hurrah": true,
+	"hurray": true,
+	"husked": true,
+	"hybrid": true,
+	"hymnal": true,
+	"hyphen": true,
+	"iambic": true,
+	"iconic": true,
+	"idiocy": true,
+	"ignore": true,
+	"iguana": true,
+	"illume": true,
+	"immune": true,
+	"impact": true,
+	"impala": true,
+	"impale": true,
+	"impart": true,
+	"impish": true,
+	"import": true,
+	"impose": true,
+	"impure": true,
+	"impute": true,
+	"incest": true,
+	"income": true,
+	"indies": true,
+	"indoor": true,
+	"infant": true,
+	"infect": true,
+	"infima": true,
+	"inform": true,
+	"inhale": true,
+	"inhere": true,
+	"inject": true,
+	"injury": true,
+	"inmate": true,
+	"insane": true,
+	"insect": true,
+	"inside": true,
+	"insult": true,
+	"intact": true,
+	"intend": true,
+	"intent": true,
+	"invest": true,
+	"invite": true,
+	"invoke": true,
+	"iodine": true,
+	"iodize": true,
+	"iphone": true,
+	"isabel": true,
+	"island": true,
+	"israel": true,
+	"itunes": true,
+	"jackal": true,
+	"jacket": true,
+	"jacobi": true,
+	"jaguar": true,
+	"jailer": true,
+	"jargon": true,
+	"jejune": true,
+	"jennet": true,
+	"jersey": true,
+	"jester": true,
+	"jetski": true,
+	"jewett": true,
+	"jewish": true,
+	"jigsaw": true,
+	"jingle": true,
+	"jockey": true,
+	"jogger": true,
+	"joshua": true,
+	"jostle": true,
+	"jovial": true,
+	"joyous": true,
+	"juggle": true,
+	"jumble": true,
+	"jungle": true,
+	"junior": true,
+	"junkie": true,
+	"jurist": true,
+	"justly": true,
+	"kalmia": true,
+	"karate": true,
+	"keenly": true,
+	"keller": true,
+	"kennel": true,
+	"kepler": true,
+	"kettle": true,
+	"kidney": true,
+	"kigali": true,
+	"kimono": true,
+	"kindle": true,
+	"kindly": true,
+	"kisser": true,
+	"kitten": true,
+	"knauer": true,
+	"kodiak": true,
+	"kosher": true,
+	"krause": true,
+	"labile": true,
+	"ladder": true,
+	"ladies": true,
+	"lagged": true,
+	"lagoon": true,
+	"landed": true,
+	"lapdog": true,
+	"lapped": true,
+	"laptop": true,
+	"larkin": true,
+	"lately": true,
+	"latest": true,
+	"lather": true,
+	"latter": true,
+	"launch": true,
+	"laurel": true,
+	"lavish": true,
+	"lawson": true,
+	"lazily": true,
+	"leader": true,
+	"leeway": true,
+	"legacy": true,
+	"legend": true,
+	"legged": true,
+	"legion": true,
+	"legume": true,
+	"leland": true,
+	"length": true,
+	"lessee": true,
+	"lesser": true,
+	"lesson": true,
+	"letter": true,
+	"levers": true,
+	"leyden": true,
+	"liable": true,
+	"libido": true,
+	"lifter": true,
+	"likely": true,
+	"liking": true,
+	"linden": true,
+	"lining": true,
+	"linked": true,
+	"liquid": true,
+	"listen": true,
+	"litmus": true,
+	"litter": true,
+	"little": true,
+	"litton": true,
+	"lively": true,
+	"living": true,
+	"lizard": true,
+	"lizzie": true,
+	"locust": true,
+	"loiter": true,
+	"lonely": true,
+	"loudly": true,
+	"louise": true,
+	"lounge": true,
+	"louver": true,
+	"lovely": true,
+	"loving": true,
+	"lugged": true,
+	"lumbar": true,
+	"lumber": true,
+	"lunacy": true,
+	"lushly": true,
+	"luster": true,
+	"luxury": true,
+	"lyrics": true,
+	"mackey": true,
+	"madman": true,
+	"madsen": true,
+	"maggot": true,
+	"magnet": true,
+	"magpie": true,
+	"maimed": true,
+	"mainly": true,
+	"making": true,
+	"malady": true,
+	"mammal": true,
+	"manage": true,
+	"manama": true,
+	"manger": true,
+	"mangle": true,
+	"manila": true,
+	"manned": true,
+	"mantis": true,
+	"mantle": true,
+	"mantra": true,
+	"manual": true,
+	"marble": true,
+	"margin": true,
+	"marina": true,
+	"marine": true,
+	"market": true,
+	"marlin": true,
+	"marmot": true,
+	"maroon": true,
+	"marrow": true,
+	"marshy": true,
+	"marten": true,
+	"martin": true,
+	"mascot": true,
+	"mashed": true,
+	"masque": true,
+	"masses": true,
+	"master": true,
+	"mating": true,
+	"matrix": true,
+	"matron": true,
+	"matted": true,
+	"matter": true,
+	"mature": true,
+	"maxima": true,
+	"mayday": true,
+	"mayfly": true,
+	"mayhem": true,
+	"mcleod": true,
+	"meadow": true,
+	"medley": true,
+	"mellow": true,
+	"melody": true,
+	"member": true,
+	"memoir": true,
+	"memory": true,
+	"merely": true,
+	"mescal": true,
+	"method": true,
+	"meyers": true,
+	"mickey": true,
+	"middle": true,
+	"mighty": true,
+	"mildew": true,
+	"mildly": true,
+	"minnow": true,
+	"minute": true,
+	"mirage": true,
+	"mirror": true,
+	"misery": true,
+	"moaner": true,
+	"mobile": true,
+	"mocker": true,
+	"mockup": true,
+	"modern": true,
+	"modest": true,
+	"modify": true,
+	"modish": true,
+	"module": true,
+	"mollie": true,
+	"molten": true,
+	"moment": true,
+	"monday": true,
+	"monkey": true,
+	"mooing": true,
+	"mooned": true,
+	"morale": true,
+	"morsel": true,
+	"mosaic": true,
+	"mostly": true,
+	"mother": true,
+	"motion": true,
+	"motive": true,
+	"moving": true,
+	"mowing": true,
+	"muffin": true,
+	"mulled": true,
+	"mullet": true,
+	"mumble": true,
+	"munson": true,
+	"muppet": true,
+	"muriel": true,
+	"muscle": true,
+	"museum": true,
+	"musket": true,
+	"muskox": true,
+	"muslim": true,
+	"muster": true,
+	"mutate": true,
+	"mutiny": true,
+	"mutual": true,
+	"muzzle": true,
+	"myopia": true,
+	"myriad": true,
+	"myself": true,
+	"nagoya": true,
+	"namely": true,
+	"naming": true,
+	"napkin": true,
+	"napped": true,
+	"narrow": true,
+	"nation": true,
+	"native": true,
+	"nature": true,
+	"nearby": true,
+	"nearly": true,
+	"neatly": true,
+	"nebula": true,
+	"nectar": true,
+	"needed": true,
+	"negate": true,
+	"nelsen": true,
+	"nephew": true,
+	"neuron": true,
+	"neuter": true,
+	"nibble": true,
+	"nicely": true,
+	"nicety": true,
+	"nimble": true,
+	"nimbly": true,
+	"nimbus": true,
+	"nitric": true,
+	"noodle": true,
+	"normal": true,
+	"norway": true,
+	"notice": true,
+	"nuance": true,
+	"nuclei": true,
+	"nugget": true,
+	"number": true,
+	"numbly": true,
+	"nutmeg": true,
+	"nuzzle": true,
+	"oakley": true,
+	"object": true,
+	"oblige": true,
+	"oblong": true,
+	"obtain": true,
+	"obtuse": true,
+	"occupy": true,
+	"ocelot": true,
+	"octane": true,
+	"octave": true,
+	"ocular": true,
+	"offend": true,
+	"office": true,
+	"online": true,
+	"onward": true,
+	"openly": true,
+	"oppose": true,
+	"option": true,
+	"orange": true,
+	"orchid": true,
+	"ordain": true,
+	"orient": true,
+	"oriole": true,
+	"ornery": true,
+	"orphan": true,
+	"osprey": true,
+	"outage": true,
+	"outbid": true,
+	"outfit": true,
+	"outing": true,
+	"outlet": true,
+	"output": true,
+	"outwit": true,
+	"overly": true,
+	"owlish": true,
+	"oxford": true,
+	"oxnard": true,
+	"oxygen": true,
+	"oyster": true,
+	"pacify": true,
+	"packet": true,
+	"padded": true,
+	"paddle": true,
+	"paging": true,
+	"palace": true,
+	"paltry": true,
+	"panama": true,
+	"pantry": true,
+	"papaya": true,
+	"parade": true,
+	"parcel": true,
+	"pardon": true,
+	"parent": true,
+	"pareto": true,
+	"parish": true,
+	"parlor": true,
+	"parole": true,
+	"parrot": true,
+	"parted": true,
+	"partly": true,
+	"pascal": true,
+	"pasted": true,
+	"pastel": true,
+	"pastor": true,
+	"patchy": true,
+	"patrol": true,
+	"patton": true,
+	"pauper": true,
+	"paving": true,
+	"pawing": true,
+	"payday": true,
+	"paying": true,
+	"peanut": true,
+	"pebble": true,
+	"pebbly": true,
+	"pectin": true,
+	"pellet": true,
+	"pelvis": true,
+	"pencil": true,
+	"penpal": true,
+	"people": true,
+	"pepper": true,
+	"perish": true,
+	"permit": true,
+	"persia": true,
+	"person": true,
+	"peruse": true,
+	"pester": true,
+	"petite": true,
+	"petted": true,
+	"pewter": true,
+	"phenol": true,
+	"phobia": true,
+	"phoney": true,
+	"phrase": true,
+	"physic": true,
+	"picked": true,
+	"pickup": true,
+	"picnic": true,
+	"pierce": true,
+	"pigeon": true,
+	"piglet": true,
+	"pinion": true,
+	"pistol": true,
+	"pitney": true,
+	"plague": true,
+	"planet": true,
+	"plasma": true,
+	"plated": true,
+	"player": true,
+	"please": true,
+	"pledge": true,
+	"plenty": true,
+	"plunge": true,
+	"plural": true,
+	"plushy": true,
+	"poetic": true,
+	"poetry": true,
+	"pointy": true,
+	"poison": true,
+	"poking": true,
+	"police": true,
+	"policy": true,
+	"polish": true,
+	"polite": true,
+	"pomona": true,
+	"poncho": true,
+	"poodle": true,
+	"poorly": true,
+	"poplar": true,
+	"popper": true,
+	"porous": true,
+	"portal": true,
+	"portia": true,
+	"portly": true,
+	"posing": true,
+	"possum": true,
+	"postal": true,
+	"posted": true,
+	"poster": true,
+	"potato": true,
+	"pounce": true,
+	"powder": true,
+	"powwow": true,
+	"praise": true,
+	"prance": true,
+	"prayer": true,
+	"precut": true,
+	"prefer": true,
+	"prefix": true,
+	"prelaw": true,
+	"prepay": true,
+	"preppy": true,
+	"preset": true,
+	"pretty": true,
+	"prewar": true,
+	"primal": true,
+	"primer": true,
+	"prince": true,
+	"prison": true,
+	"prissy": true,
+	"profit": true,
+	"prompt": true,
+	"pronto": true,
+	"proofs": true,
+	"proper": true,
+	"propyl": true,
+	"proton": true,
+	"proved": true,
+	"proven": true,
+	"prozac": true,
+	"pseudo": true,
+	"public": true,
+	"pucker": true,
+	"puddly": true,
+	"pueblo": true,
+	"puerto": true,
+	"pulley": true,
+	"pumice": true,
+	"pummel": true,
+	"pumped": true,
+	"punish": true,
+	"puppet": true,
+	"purely": true,
+	"purify": true,
+	"purist": true,
+	"purity": true,
+	"purple": true,
+	"pusher": true,
+	"pushup": true,
+	"puzzle": true,
+	"pyrite": true,
+	"python": true,
+	"quagga": true,
+	"quarry": true,
+	"queasy": true,
+	"quench": true,
+	"quiver": true,
+	"rabbit": true,
+	"racing": true,
+	"racism": true,
+	"racoon": true,
+	"radial": true,
+	"radish": true,
+	"radium": true,
+	"rafael": true,
+	"raffle": true,
+	"ragged": true,
+	"raging": true,
+	"raider": true,
+	"raisin": true,
+	"raking": true,
+	"ramble": true,
+	"ramrod": true,
+	"ramsey": true,
+	"random": true,
+	"ranged": true,
+	"ranger": true,
+	"ranked": true,
+	"raptor": true,
+	"rarely": true,
+	"rarity": true,
+	"rascal": true,
+	"rather": true,
+	"rattle": true,
+	"ravage": true,
+	"ravine": true,
+	"raving": true,
+	"reagan": true,
+	"really": true,
+	"reason": true,
+	"rebate": true,
+	"reboot": true,
+	"reborn": true,
+	"rebuff": true,
+	"recall": true,
+	"recant": true,
+	"recast": true,
+	"recede": true,
+	"recent": true,
+	"recess": true,
+	"recipe": true,
+	"recite": true,
+	"recoil": true,
+	"recopy": true,
+	"record": true,
+	"recoup": true,
+	"rectal": true,
+	"redeem": true,
+	"reduce": true,
+	"refill": true,
+	"reflex": true,
+	"reflux": true,
+	"refold": true,
+	"reform": true,
+	"refund": true,
+	"refuse": true,
+	"refute": true,
+	"regain": true,
+	"reggae": true,
+	"regime": true,
+	"regina": true,
+	"region": true,
+	"regret": true,
+	"reheat": true,
+	"rehire": true,
+	"reject": true,
+	"rejoin": true,
+	"relief": true,
+	"relish": true,
+	"relive": true,
+	"reload": true,
+	"relock": true,
+	"remain": true,
+	"remake": true,
+	"remark": true,
+	"remedy": true,
+	"remind": true,
+	"remold": true,
+	"remote": true,
+	"remove": true,
+	"rename": true,
+	"render": true,
+	"renown": true,
+	"rental": true,
+	"rented": true,
+	"renter": true,
+	"reopen": true,
+	"repair": true,
+	"repave": true,
+	"repeal": true,
+	"repeat": true,
+	"repent": true,
+	"replay": true,
+	"report": true,
+	"repose": true,
+	"repost": true,
+	"resale": true,
+	"rescue": true,
+	"reseal": true,
+	"resend": true,
+	"resent": true,
+	"resist": true,
+	"resize": true,
+	"resort": true,
+	"rested": true,
+	"result": true,
+	"resume": true,
+	"retail": true,
+	"retake": true,
+	"retire": true,
+	"retold": true,
+	"retool": true,
+	"return": true,
+	"retype": true,
+	"reveal": true,
+	"reverb": true,
+	"revert": true,
+	"review": true,
+	"revise": true,
+	"revoke": true,
+	"revolt": true,
+	"revved": true,
+	"reward": true,
+	"rewash": true,
+	"rewind": true,
+	"rewire": true,
+	"reword": true,
+	"rework": true,
+	"rewrap": true,
+	"rhythm": true,
+	"ribbon": true,
+	"riches": true,
+	"richly": true,
+	"ridden": true,
+	"riding": true,
+	"rimmed": true,
+	"ripley": true,
+	"ripple": true,
+	"rising": true,
+	"ritual": true,
+	"riyadh": true,
+	"roamer": true,
+	"robust": true,
+	"rocker": true,
+	"rocket": true,
+	"rodent": true,
+	"rookie": true,
+	"roping": true,
+	"roster": true,
+	"rotate": true,
+	"rotten": true,
+	"roughy": true,
+	"roving": true,
+	"ruanda": true,
+	"rubbed": true,
+	"rubber": true,
+	"rubble": true,
+	"rubric": true,
+	"ruckus": true,
+	"rudder": true,
+	"ruined": true,
+	"ruling": true,
+	"rumble": true,
+	"rumpus": true,
+	"runner": true,
+	"runway": true,
+	"sabine": true,
+	"sacred": true,
+	"sadden": true,
+	"saddle": true,
+	"safari": true,
+	"safely": true,
+	"salami": true,
+	"salary": true,
+	"saline": true,
+	"salmon": true,
+	"saloon": true,
+	"salute": true,
+	"sample": true,
+	"samuel": true,
+	"sandal": true,
+	"sanded": true,
+	"savage": true,
+	"saving": true,
+	"savior": true,
+	"sawfly": true,
+	"sawyer": true,
+	"scabby": true,
+	"scarce": true,
+	"scared": true,
+	"scenic": true,
+	"scheme": true,
+	"school": true,
+	"scorch": true,
+	"scored": true,
+	"scorer": true,
+	"scoria": true,
+	"scotch": true,
+	"scouts": true,
+	"scrape": true,
+	"screen": true,
+	"scribe": true,
+	"script": true,
+	"scroll": true,
+	"scurry": true,
+	"scurvy": true,
+	"scythe": true,
+	"search": true,
+	"season": true,
+	"secant": true,
+	"second": true,
+	"secret": true,
+	"sector": true,
+	"secure": true,
+	"sedate": true,
+	"seduce": true,
+	"seethe": true,
+	"seldom": true,
+	"select": true,
+	"senate": true,
+	"seneca": true,
+	"senior": true,
+	"sentry": true,
+	"septic": true,
+	"septum": true,
+	"sequel": true,
+	"sequin": true,
+	"series": true,
+	"sermon": true,
+	"serval": true,
+	"sesame": true,
+	"settle": true,
+	"severe": true,
+	"shabby": true,
+	"shaded": true,
+	"shadow": true,
+	"shafer": true,
+	"shaken": true,
+	"shanty": true,
+	"sheath": true,
+	"shelve": true,
+	"sherry": true,
+	"shield": true,
+	"shifty": true,
+	"shimmy": true,
+	"shiner": true,
+	"shinto": true,
+	"shiver": true,
+	"shorts": true,
+	"shorty": true,
+	"shovel": true,
+	"shower": true,
+	"shrank": true,
+	"shriek": true,
+	"shrike": true,
+	"shrill": true,
+	"shrimp": true,
+	"shrine": true,
+	"shrink": true,
+	"shrubs": true,
+	"shrunk": true,
+	"sibley": true,
+	"siding": true,
+	"sierra": true,
+	"siesta": true,
+	"signal": true,
+	"signor": true,
+	"silent": true,
+	"silica": true,
+	"silver": true,
+	"simile": true,
+	"simons": true,
+	"simple": true,
+	"simply": true,
+	"singer": true,
+	"single": true,
+	"sinner": true,
+	"sister": true,
+	"sitcom": true,
+	"sitter": true,
+	"sixgun": true,
+	"sizing": true,
+	"sizzle": true,
+	"skater": true,
+	"sketch": true,
+	"skewed": true,
+	"skewer": true,
+	"skiing": true,
+	"skimpy": true,
+	"skinny": true,
+	"slacks": true,
+	"slater": true,
+	"sleeve": true,
+	"sliced": true,
+	"slicer": true,
+	"slider": true,
+	"slight": true,
+	"slinky": true,
+	"sliver": true,
+	"slogan": true,
+	"sloped": true,
+	"sloppy": true,
+	"slowly": true,
+	"sludge": true,
+	"sluice": true,
+	"smithy": true,
+	"smoked": true,
+	"smooth": true,
+	"smudge": true,
+	"smudgy": true,
+	"smugly": true,
+	"snatch": true,
+	"snazzy": true,
+	"sneeze": true,
+	"snitch": true,
+	"snooze": true,
+	"snugly": true,
+	"soccer": true,
+	"social": true,
+	"soften": true,
+	"solely": true,
+	"solemn": true,
+	"sonora": true,
+	"sought": true,
+	"source": true,
+	"sparge": true,
+	"specks": true,
+	"speech": true,
+	"sperry": true,
+	"sphere": true,
+	"sphinx": true,
+	"spider": true,
+	"spiffy": true,
+	"spinal": true,
+	"spiral": true,
+	"spirit": true,
+	"spleen": true,
+	"splice": true,
+	"spline": true,
+	"spoils": true,
+	"spoken": true,
+	"sponge": true,
+	"spongy": true,
+	"spooky": true,
+	"sports": true,
+	"sporty": true,
+	"spotty": true,
+	"spouse": true,
+	"sprain": true,
+	"sprang": true,
+	"sprawl": true,
+	"spread": true,
+	"spring": true,
+	"sprint": true,
+	"sprite": true,
+	"sprout": true,
+	"spruce": true,
+	"sprung": true,
+	"squall": true,
+	"square": true,
+	"squash": true,
+	"squeak": true,
+	"squibb": true,
+	"squint": true,
+	"squire": true,
+	"squirt": true,
+	"stable": true,
+	"stairs": true,
+	"stance": true,
+	"staple": true,
+	"starch": true,
+	"starry": true,
+	"static": true,
+	"statue": true,
+	"status": true,
+	"steady": true,
+	"steele": true,
+	"stella": true,
+	"stench": true,
+	"stereo": true,
+	"stifle": true,
+	"stingy": true,
+	"stinky": true,
+	"stitch": true,
+	"stooge": true,
+	"storey": true,
+	"strafe": true,
+	"streak": true,
+	"stream": true,
+	"street": true,
+	"stress": true,
+	"strewn": true,
+	"strict": true,
+	"stride": true,
+	"strife": true,
+	"strike": true,
+	"strive": true,
+	"strobe": true,
+	"strode": true,
+	"stroll": true,
+	"strong": true,
+	"struck": true,
+	"strung": true,
+	"stuart": true,
+	"stucco": true,
+	"studio": true,
+	"stuffy": true,
+	"stupor": true,
+	"sturdy": true,
+	"stylus": true,
+	"sublet": true,
+	"submit": true,
+	"subpar": true,
+	"subtle": true,
+	"subtly": true,
+	"suburb": true,
+	"subway": true,
+	"sudden": true,
+	"sudoku": true,
+	"suffer": true,
+	"suffix": true,
+	"suited": true,
+	"suitor": true,
+	"sulfur": true,
+	"sullen": true,
+	"sultry": true,
+	"summer": true,
+	"summon": true,
+	"sunset": true,
+	"superb": true,
+	"supper": true,
+	"supply": true,
+	"surely": true,
+	"surfer": true,
+	"survey": true,
+	"sutton": true,
+	"swampy": true,
+	"sweaty": true,
+	"swerve": true,
+	"switch": true,
+	"swivel": true,
+	"swoosh": true,
+	"sylvan": true,
+	"symbol": true,
+	"system": true,
+	"tables": true,
+	"tablet": true,
+	"tabula": true,
+	"tackle": true,
+	"tacoma": true,
+	"taking": true,
+	"talcum": true,
+	"talent": true,
+	"tallow": true,
+	"tamale": true,
+	"tamper": true,
+	"tanned": true,
+	"target": true,
+	"tariff": true,
+	"tarmac": true,
+	"tarpon": true,
+	"tartar": true,
+	"tartly": true,
+	"tassel": true,
+	"tattle": true,
+	"tattoo": true,
+	"tavern": true,
+	"teensy": true,
+	"tenant": true,
+	"tender": true,
+	"tenney": true,
+	"tennis": true,
+	"tensor": true,
+	"theory": true,
+	"thesis": true,
+	"thinly": true,
+	"thirty": true,
+	"thorny": true,
+	"thrash": true,
+	"thread": true,
+	"thrift": true,
+	"thrill": true,
+	"thrive": true,
+	"throat": true,
+	"throes": true,
+	"throng": true,
+	"thrush": true,
+	"ticket": true,
+	"tidbit": true,
+	"tiling": true,
+	"timber": true,
+	"timing": true,
+	"tingle": true,
+	"tingly": true,
+	"tinker": true,
+	"tinsel": true,
+	"tipoff": true,
+	"tipped": true,
+	"tipper": true,
+	"tiptop": true,
+	"tirade": true,
+	"tiring": true,
+	"tissue": true,
+	"toilet": true,
+	"tomato": true,
+	"tomcat": true,
+	"tongue": true,
+	"tonsil": true,
+	"topple": true,
+	"toucan": true,
+	"toward": true,
+	"tragic": true,
+	"trance": true,
+	"travel": true,
+	"treble": true,
+	"tremor": true,
+	"trench": true,
+	"triage": true,
+	"tricky": true,
+	"trifle": true,
+	"tripod": true,
+	"triton": true,
+	"trophy": true,
+	"trough": true,
+	"trowel": true,
+	"trucks": true,
+	"trudge": true,
+	"trunks": true,
+	"trusty": true,
+	"tryout": true,
+	"tulane": true,
+	"tumble": true,
+	"tunnel": true,
+	"turban": true,
+	"turkey": true,
+	"turret": true,
+	"turtle": true,
+	"tussle": true,
+	"tuxedo": true,
+	"twelve": true,
+	"twenty": true,
+	"twisty": true,
+	"twitch": true,
+	"tyburn": true,
+	"tycoon": true,
+	"umpire": true,
+	"unable": true,
+	"unbend": true,
+	"unbent": true,
+	"unclad": true,
+	"unclip": true,
+	"unclog": true,
+	"uncork": true,
+	"undead": true,
+	"undone": true,
+	"unduly": true,
+	"unease": true,
+	"uneasy": true,
+	"uneven": true,
+	"unfair": true,
+	"unfold": true,
+	"unglue": true,
+	"unholy": true,
+	"unhook": true,
+	"unique": true,
+	"unison": true,
+	"united": true,
+	"unkind": true,
+	"unless": true,
+	"unlock": true,
+	"unmade": true,
+	"unpack": true,
+	"unpaid": true,
+	"unplug": true,
+	"unread": true,
+	"unreal": true,
+	"unrest": true,
+	"unripe": true,
+	"unroll": true,
+	"unruly": true,
+	"unsafe": true,
+	"unsaid": true,
+	"unseen": true,
+	"unsent": true,
+	"unsnap": true,
+	"unsold": true,
+	"unsure": true,
+	"untidy": true,
+	"untied": true,
+	"untold": true,
+	"untrue": true,
+	"unused": true,
+	"unveil": true,
+	"unwary": true,
+	"unwell": true,
+	"unwind": true,
+	"unworn": true,
+	"upbeat": true,
+	"update": true,
+	"upheld": true,
+	"uphill": true,
+	"uphold": true,
+	"upkeep": true,
+	"upload": true,
+	"uproar": true,
+	"uproot": true,
+	"upside": true,
+	"uptake": true,
+	"uptown": true,
+	"upward": true,
+	"upwind": true,
+	"urbane": true,
+	"urchin": true,
+	"urgent": true,
+	"urging": true,
+	"usable": true,
+	"useful": true,
+	"utmost": true,
+	"utopia": true,
+	"vacant": true,
+	"vacate": true,
+	"vacuum": true,
+	"valeur": true,
+	"valium": true,
+	"valley": true,
+	"valued": true,
+	"vanish": true,
+	"vanity": true,
+	"varied": true,
+	"vastly": true,
+	"veggie": true,
+	"velcro": true,
+	"vellum": true,
+	"velvet": true,
+	"vendor": true,
+	"venial": true,
+	"verify": true,
+	"verity": true,
+	"versus": true,
+	"vervet": true,
+	"vessel": true,
+	"viable": true,
+	"viewer": true,
+	"violet": true,
+	"violin": true,
+	"virgil": true,
+	"vision": true,
+	"visual": true,
+	"vivian": true,
+	"volley": true,
+	"volume": true,
+	"voting": true,
+	"voyage": true,
+	"vulcan": true,
+	"waffle": true,
+	"waggle": true,
+	"wakeup": true,
+	"waking": true,
+	"walkie": true,
+	"walnut": true,
+	"walrus": true,
+	"walton": true,
+	"wanted": true,
+	"warmth": true,
+	"warren": true,
+	"wasabi": true,
+	"washed": true,
+	"washer": true,
+	"waving": true,
+	"wealth": true,
+	"weapon": true,
+	"weasel": true,
+	"weekly": true,
+	"weevil": true,
+	"whacky": true,
+	"whinny": true,
+	"wholly": true,
+	"whoops": true,
+	"whoosh": true,
+	"widely": true,
+	"widget": true,
+	"wilder": true,
+	"wildly": true,
+	"wilkes": true,
+	"willed": true,
+	"willow": true,
+	"window": true,
+	"winner": true,
+	"winnie": true,
+	"winter": true,
+	"wiring": true,
+	"wisdom": true,
+	"wizard": true,
+	"wobble": true,
+	"wobbly": true,
+	"wombat": true,
+	"wonder": true,
+	"wooing": true,
+	"worthy": true,
+	"wreath": true,
+	"wrench": true,
+	"writhe": true,
+	"yamaha": true,
+	"yearly": true,
+	"yeasty": true,
+	"yellow": true,
+	"yippee": true,
+	"yogurt": true,
+	"yonder": true,
+	"yuppie": true,
+	"zealot": true,
+	"zodiac": true,
+	"zombie": true,
+	"zoning": true,
+	"zurich": true,
+}
@@ -0,0 +1,2365 @@
+package words
+
+// All possible seven-letter answers
+var answerList7 = [...]string{
+	"abandon",
+	"ability",
+	"account",
+	"achieve",
+	"acquire",
+	"actress",
+	"address",
+	"advance",
+	"aerobic",
+	"airport",
+	"alcohol",
+	"already",
+	"amateur",
+	"amazing",
+	"analyst",
+	"ancient",
+	"another",
+	"antenna",
+	"antique",
+	"anxiety",
+	"apology",
+	"approve",
+	"arrange",
+	"artwork",
+	"assault",
+	"athlete",
+	"attract",
+	"auction",
+	"average",
+	"avocado",
+	"awesome",
+	"awkward",
+	"balance",
+	"balcony",
+	"bargain",
+	"because",
+	"believe",
+	"benefit",
+	"between",
+	"bicycle",
+	"biology",
+	"blanket",
+	"blossom",
+	"bracket",
+	"brother",
+	"buffalo",
+	"cabbage",
+	"capable",
+	"capital",
+	"captain",
+	"catalog",
+	"caution",
+	"ceiling",
+	"century",
+	"certain",
+	"chapter",
+	"chicken",
+	"chimney",
+	"chronic",
+	"chuckle",
+	"citizen",
+	"clarify",
+	"cluster",
+	"coconut",
+	"collect",
+	"combine",
+	"comfort",
+	"company",
+	"concert",
+	"conduct",
+	"confirm",
+	"connect",
+	"control",
+	"correct",
+	"country",
+	"cricket",
+	"crucial",
+	"crumble",
+	"crystal",
+	"culture",
+	"curious",
+	"current",
+	"curtain",
+	"cushion",
+	"decline",
+	"defense",
+	"deliver",
+	"dentist",
+	"deposit",
+	"despair",
+	"destroy",
+	"develop",
+	"diagram",
+	"diamond",
+	"digital",
+	"dignity",
+	"dilemma",
+	"disease",
+	"dismiss",
+	"display",
+	"divorce",
+	"dolphin",
+	"drastic",
+	"dynamic",
+	"ecology",
+	"economy",
+	"educate",
+	"elegant",
+	"element",
+	"embrace",
+	"emotion",
+	"empower",
+	"endless",
+	"endorse",
+	"enforce",
+	"enhance",
+	"episode",
+	"erosion",
+	"essence",
+	"eternal",
+	"example",
+	"exclude",
+	"execute",
+	"exhaust",
+	"exhibit",
+	"explain",
+	"express",
+	"eyebrow",
+	"faculty",
+	"fantasy",
+	"fashion",
+	"fatigue",
+	"feature",
+	"federal",
+	"fiction",
+	"fitness",
+	"fortune",
+	"forward",
+	"fragile",
+	"furnace",
+	"gallery",
+	"garbage",
+	"garment",
+	"general",
+	"genuine",
+	"gesture",
+	"giraffe",
+	"glimpse",
+	"goddess",
+	"gorilla",
+	"gravity",
+	"grocery",
+	"hamster",
+	"harvest",
+	"history",
+	"holiday",
+	"hundred",
+	"husband",
+	"illegal",
+	"illness",
+	"imitate",
+	"immense",
+	"improve",
+	"impulse",
+	"include",
+	"inflict",
+	"inherit",
+	"initial",
+	"inquiry",
+	"inspire",
+	"install",
+	"involve",
+	"isolate",
+	"jealous",
+	"journey",
+	"ketchup",
+	"kingdom",
+	"kitchen",
+	"laundry",
+	"lawsuit",
+	"lecture",
+	"leisure",
+	"leopard",
+	"liberty",
+	"library",
+	"license",
+	"lobster",
+	"lottery",
+	"luggage",
+	"machine",
+	"mandate",
+	"mansion",
+	"maximum",
+	"measure",
+	"mention",
+	"message",
+	"million",
+	"minimum",
+	"miracle",
+	"mistake",
+	"mixture",
+	"monitor",
+	"monster",
+	"morning",
+	"mystery",
+	"neglect",
+	"neither",
+	"network",
+	"neutral",
+	"nominee",
+	"notable",
+	"nothing",
+	"nuclear",
+	"obscure",
+	"observe",
+	"obvious",
+	"october",
+	"olympic",
+	"opinion",
+	"orchard",
+	"ostrich",
+	"outdoor",
+	"outside",
+	"panther",
+	"patient",
+	"pattern",
+	"payment",
+	"peasant",
+	"pelican",
+	"penalty",
+	"perfect",
+	"picture",
+	"pioneer",
+	"plastic",
+	"popular",
+	"portion",
+	"pottery",
+	"poverty",
+	"predict",
+	"prepare",
+	"present",
+	"prevent",
+	"primary",
+	"private",
+	"problem",
+	"process",
+	"produce",
+	"program",
+	"project",
+	"promote",
+	"prosper",
+	"protect",
+	"provide",
+	"pudding",
+	"pumpkin",
+	"purpose",
+	"pyramid",
+	"quality",
+	"quantum",
+	"quarter",
+	"raccoon",
+	"rebuild",
+	"receive",
+	"recycle",
+	"reflect",
+	"regular",
+	"release",
+	"replace",
+	"require",
+	"retreat",
+	"reunion",
+	"romance",
+	"sadness",
+	"satisfy",
+	"satoshi",
+	"sausage",
+	"scatter",
+	"science",
+	"section",
+	"segment",
+	"seminar",
+	"service",
+	"session",
+	"shallow",
+	"sheriff",
+	"shuffle",
+	"sibling",
+	"similar",
+	"situate",
+	"slender",
+	"soldier",
+	"someone",
+	"spatial",
+	"special",
+	"sponsor",
+	"squeeze",
+	"stadium",
+	"stomach",
+	"student",
+	"stumble",
+	"subject",
+	"success",
+	"suggest",
+	"supreme",
+	"surface",
+	"suspect",
+	"sustain",
+	"swallow",
+	"symptom",
+	"thought",
+	"thunder",
+	"tobacco",
+	"toddler",
+	"tonight",
+	"tornado",
+	"tourist",
+	"traffic",
+	"trigger",
+	"trouble",
+	"trumpet",
+	"tuition",
+	"typical",
+	"unaware",
+	"uncover",
+	"unhappy",
+	"uniform",
+	"unknown",
+	"unusual",
+	"upgrade",
+	"useless",
+	"utility",
+	"various",
+	"vehicle",
+	"venture",
+	"version",
+	"veteran",
+	"vibrant",
+	"vicious",
+	"victory",
+	"village",
+	"vintage",
+	"virtual",
+	"volcano",
+	"warfare",
+	"warrior",
+	"weather",
+	"wedding",
+	"weekend",
+	"welcome",
+	"whisper",
+	"witness",
+	"wrestle",
+}
+
+// Allowed seven-letter words (includes answers from above)
+var allowList7 = map[string]bool{
+	"abandon": true,
+	"abdomen": true,
+	"abiding": true,
+	"ability": true,
+	"abreast": true,
+	"abridge": true,
+	"absence": true,
+	"absolve": true,
+	"abstain": true,
+	"academy": true,
+	"acclaim": true,
+	"account": true,
+	"acetone": true,
+	"achieve": true,
+	"acquire": true,
+	"acrobat": true,
+	"acronym": true,
+	"actress": true,
+	"acutely": true,
+	"adapted": true,
+	"address": true,
+	"advance": true,
+	"aerobic": true,
+	"aerosol": true,
+	"affront": true,
+	"ageless": true,
+	"agility": true,
+	"agitate": true,
+	"agonize": true,
+	"aground": true,
+	"aidless": true,
+	"airport": true,
+	"alchemy": true,
+	"alcohol": true,
+	"alfalfa": true,
+	"algebra": true,
+	"allowed": true,
+	"almanac": true,
+	"already": true,
+	"alright": true,
+	"amateur": true,
+	"amazing": true,
+	"amenity": true,
+	"amiable": true,
+	"ammonia": true,
+	"amnesty": true,
+	"amplify": true,
+	"amusing": true,
+	"anagram": true,
+	"analyst": true,
+	"anatomy": true,
+	"anchovy": true,
+	"ancient": true,
+	"android": true,
+	"anemone": true,
+	"angelic": true,
+	"angling": true,
+	"angrily": true,
+	"angular": true,
+	"animate": true,
+	"annuity": true,
+	"another": true,
+	"antacid": true,
+	"antenna": true,
+	"anthill": true,
+	"antique": true,
+	"antonym": true,
+	"anxiety": true,
+	"anybody": true,
+	"anymore": true,
+	"anytime": true,
+	"apology": true,
+	"apostle": true,
+	"appease": true,
+	"applaud": true,
+	"applied": true,
+	"approve": true,
+	"apricot": true,
+	"armband": true,
+	"armhole": true,
+	"armless": true,
+	"armoire": true,
+	"armored": true,
+	"armrest": true,
+	"arousal": true,
+	"arrange": true,
+	"arrival": true,
+	"artwork": true,
+	"aseptic": true,
+	"ashamed": true,
+	"asinine": true,
+	"asocial": true,
+	"aspirin": true,
+	"assault": true,
+	"assured": true,
+	"astound": true,
+	"astride": true,
+	"athlete": true,
+	"atrophy": true,
+	"attempt": true,
+	"attract": true,
+	"auction": true,
+	"audible": true,
+	"audibly": true,
+	"average": true,
+	"aviator": true,
+	"avocado": true,
+	"awaited": true,
+	"awesome": true,
+	"awfully": true,
+	"awkward": true,
+	"axially": true,
+	"backing": true,
+	"backlit": true,
+	"backlog": true,
+	"badland": true,
+	"badness": true,
+	"baggage": true,
+	"bagging": true,
+	"bagpipe": true,
+	"balance": true,
+	"balcony": true,
+	"banking": true,
+	"banshee": true,
+	"barbell": true,
+	"barcode": true,
+	"bargain": true,
+	"barista": true,
+	"barmaid": true,
+	"barrack": true,
+	"barrier": true,
+	"battery": true,
+	"batting": true,
+	"bazooka": true,
+	"because": true,
+	"believe": true,
+	"beloved": true,
+	"benefit": true,
+	"between": true,
+	"bicycle": true,
+	"biology": true,
+	"blabber": true,
+	"bladder": true,
+	"blaming": true,
+	"blanket": true,
+	"blazing": true,
+	"blemish": true,
+	"blender": true,
+	"blessed": true,
+	"blindly": true,
+	"blinked": true,
+	"blinker": true,
+	"bloated": true,
+	"blooper": true,
+	"blossom": true,
+	"blubber": true,
+	"bluejay": true,
+	"blurred": true,
+	"boaster": true,
+	"bobbing": true,
+	"bobsled": true,
+	"bobtail": true,
+	"bolster": true,
+	"bonanza": true,
+	"bonding": true,
+	"bonfire": true,
+	"booting": true,
+	"bootleg": true,
+	"borough": true,
+	"bouquet": true,
+	"boxlike": true,
+	"bracket": true,
+	"breeder": true,
+	"brewery": true,
+	"brewing": true,
+	"bridged": true,
+	"briefly": true,
+	"brigade": true,
+	"brisket": true,
+	"briskly": true,
+	"bristle": true,
+	"brittle": true,
+	"broaden": true,
+	"broadly": true,
+	"broiler": true,
+	"brother": true,
+	"brought": true,
+	"brushes": true,
+	"budding": true,
+	"buffalo": true,
+	"buffing": true,
+	"buffoon": true,
+	"bulldog": true,
+	"bullion": true,
+	"bullish": true,
+	"bullpen": true,
+	"bunkbed": true,
+	"busload": true,
+	"buzzard": true,
+	"cabbage": true,
+	"caboose": true,
+	"cadmium": true,
+	"cahoots": true,
+	"calcium": true,
+	"caliber": true,
+	"caloric": true,
+	"calorie": true,
+	"calzone": true,
+	"camping": true,
+	"candied": true,
+	"canning": true,
+	"canteen": true,
+	"capable": true,
+	"capably": true,
+	"capital": true,
+	"capitol": true,
+	"capsize": true,
+	"capsule": true,
+	"captain": true,
+	"caption": true,
+	"captive": true,
+	"capture": true,
+	"caramel": true,
+	"caravan": true,
+	"cardiac": true,
+	"careful": true,
+	"caribou": true,
+	"carless": true,
+	"carload": true,
+	"carnage": true,
+	"carpool": true,
+	"carport": true,
+	"carried": true,
+	"cartoon": true,
+	"carving": true,
+	"carwash": true,
+	"cascade": true,
+	"catalog": true,
+	"catcall": true,
+	"catcher": true,
+	"caterer": true,
+	"catfish": true,
+	"catlike": true,
+	"cattail": true,
+	"catwalk": true,
+	"causing": true,
+	"caution": true,
+	"cavalry": true,
+	"ceiling": true,
+	"central": true,
+	"century": true,
+	"certain": true,
+	"certify": true,
+	"chalice": true,
+	"chamber": true,
+	"chamois": true,
+	"channel": true,
+	"chapped": true,
+	"chapter": true,
+	"charger": true,
+	"chariot": true,
+	"charity": true,
+	"charmed": true,
+	"charred": true,
+	"charter": true,
+	"chasing": true,
+	"chatter": true,
+	"cheaply": true,
+	"cheddar": true,
+	"cheetah": true,
+	"chemist": true,
+	"chevron": true,
+	"chewing": true,
+	"chicken": true,
+	"chigger": true,
+	"chimney": true,
+	"choking": true,
+	"chooser": true,
+	"chowder": true,
+	"chronic": true,
+	"chuckle": true,
+	"citable": true,
+	"citadel": true,
+	"citizen": true,
+	"clapped": true,
+	"clapper": true,
+	"clarify": true,
+	"clarity": true,
+	"classic": true,
+	"clatter": true,
+	"cleanly": true,
+	"clearly": true,
+	"cleaver": true,
+	"clicker": true,
+	"climate": true,
+	"clobber": true,
+	"cloning": true,
+	"closely": true,
+	"closing": true,
+	"closure": true,
+	"clothes": true,
+	"clubbed": true,
+	"cluster": true,
+	"clutter": true,
+	"coastal": true,
+	"coaster": true,
+	"cobbler": true,
+	"coconut": true,
+	"coexist": true,
+	"collage": true,
+	"collect": true,
+	"collide": true,
+	"combine": true,
+	"comfort": true,
+	"commend": true,
+	"comment": true,
+	"commode": true,
+	"commute": true,
+	"company": true,
+	"compare": true,
+	"compile": true,
+	"compost": true,
+	"comrade": true,
+	"concave": true,
+	"conceal": true,
+	"concept": true,
+	"concert": true,
+	"concise": true,
+	"condone": true,
+	"conduct": true,
+	"conduit": true,
+	"confess": true,
+	"confirm": true,
+	"conform": true,
+	"conical": true,
+	"conjure": true,
+	"connect": true,
+	"consent": true,
+	"console": true,
+	"consult": true,
+	"contact": true,
+	"contend": true,
+	"content": true,
+	"contest": true,
+	"context": true,
+	"contort": true,
+	"contour": true,
+	"control": true,
+	"convene": true,
+	"convent": true,
+	"copilot": true,
+	"copious": true,
+	"corncob": true,
+	"coroner": true,
+	"correct": true,
+	"corrode": true,
+	"corsage": true,
+	"cottage": true,
+	"country": true,
+	"courier": true,
+	"coveted": true,
+	"cowbird": true,
+	"coyness": true,
+	"crafter": true,
+	"cranial": true,
+	"cranium": true,
+	"crappie": true,
+	"craving": true,
+	"crawdad": true,
+	"crazily": true,
+	"creamed": true,
+	"creamer": true,
+	"crested": true,
+	"crevice": true,
+	"crewman": true,
+	"cricket": true,
+	"crimson": true,
+	"crinkle": true,
+	"crinkly": true,
+	"crisped": true,
+	"crisply": true,
+	"critter": true,
+	"crouton": true,
+	"crowbar": true,
+	"crucial": true,
+	"crudely": true,
+	"cruelly": true,
+	"cruelty": true,
+	"crumble": true,
+	"crumpet": true,
+	"crunchy": true,
+	"crushed": true,
+	"crusher": true,
+	"cryptic": true,
+	"crystal": true,
+	"cubical": true,
+	"cubicle": true,
+	"cuisine": true,
+	"culprit": true,
+	"culture": true,
+	"cunning": true,
+	"cupcake": true,
+	"cupping": true,
+	"curable": true,
+	"curator": true,
+	"curious": true,
+	"curling": true,
+	"current": true,
+	"cursive": true,
+	"curtain": true,
+	"cushion": true,
+	"custard": true,
+	"custody": true,
+	"customs": true,
+	"cuticle": true,
+	"cycling": true,
+	"cyclist": true,
+	"cypress": true,
+	"dancing": true,
+	"darkish": true,
+	"darling": true,
+	"dashing": true,
+	"dawdler": true,
+	"daycare": true,
+	"daylong": true,
+	"dayroom": true,
+	"daytime": true,
+	"dazzler": true,
+	"dealing": true,
+	"debrief": true,
+	"decency": true,
+	"decibel": true,
+	"decimal": true,
+	"decline": true,
+	"default": true,
+	"defense": true,
+	"defiant": true,
+	"deflate": true,
+	"defraud": true,
+	"defrost": true,
+	"deliver": true,
+	"delouse": true,
+	"density": true,
+	"dentist": true,
+	"denture": true,
+	"deplete": true,
+	"deposit": true,
+	"depress": true,
+	"deprive": true,
+	"derived": true,
+	"deserve": true,
+	"desired": true,
+	"desktop": true,
+	"despair": true,
+	"despise": true,
+	"despite": true,
+	"destiny": true,
+	"destroy": true,
+	"detract": true,
+	"devalue": true,
+	"develop": true,
+	"deviant": true,
+	"deviate": true,
+	"devious": true,
+	"devoted": true,
+	"devotee": true,
+	"diagram": true,
+	"diamond": true,
+	"dictate": true,
+	"digital": true,
+	"dignity": true,
+	"dilated": true,
+	"dilemma": true,
+	"dimness": true,
+	"dingbat": true,
+	"diocese": true,
+	"dioxide": true,
+	"diploma": true,
+	"dipping": true,
+	"disband": true,
+	"discard": true,
+	"discern": true,
+	"discuss": true,
+	"disdain": true,
+	"disease": true,
+	"disjoin": true,
+	"dislike": true,
+	"dismiss": true,
+	"disobey": true,
+	"display": true,
+	"dispose": true,
+	"dispute": true,
+	"disrupt": true,
+	"distant": true,
+	"distill": true,
+	"distort": true,
+	"diverse": true,
+	"divided": true,
+	"divorce": true,
+	"dogfish": true,
+	"dolphin": true,
+	"donated": true,
+	"donator": true,
+	"doorman": true,
+	"doormat": true,
+	"doorway": true,
+	"drained": true,
+	"drainer": true,
+	"drapery": true,
+	"drastic": true,
+	"dreaded": true,
+	"dresser": true,
+	"dribble": true,
+	"driller": true,
+	"driving": true,
+	"drizzle": true,
+	"drizzly": true,
+	"dropbox": true,
+	"droplet": true,
+	"dropout": true,
+	"dropper": true,
+	"duchess": true,
+	"ducking": true,
+	"dumping": true,
+	"durable": true,
+	"durably": true,
+	"dustpan": true,
+	"dutiful": true,
+	"dwelled": true,
+	"dweller": true,
+	"dwindle": true,
+	"dynamic": true,
+	"dynasty": true,
+	"eagerly": true,
+	"earache": true,
+	"eardrum": true,
+	"earflap": true,
+	"earlobe": true,
+	"earmark": true,
+	"earmuff": true,
+	"earring": true,
+	"earshot": true,
+	"earthen": true,
+	"earthly": true,
+	"easeful": true,
+	"easiest": true,
+	"eatable": true,
+	"eclipse": true,
+	"ecology": true,
+	"economy": true,
+	"ecstasy": true,
+	"edition": true,
+	"educate": true,
+	"eelworm": true,
+	"effects": true,
+	"egotism": true,
+	"elastic": true,
+	"elderly": true,
+	"elegant": true,
+	"element": true,
+	"elevate": true,
+	"elitism": true,
+	"ellipse": true,
+	"elusive": true,
+	"embargo": true,
+	"embassy": true,
+	"emblaze": true,
+	"embrace": true,
+	"emerald": true,
+	"eminent": true,
+	"emotion": true,
+	"empathy": true,
+	"emperor": true,
+	"empower": true,
+	"emptier": true,
+	"emulate": true,
+	"enabled": true,
+	"enclose": true,
+	"encrust": true,
+	"encrypt": true,
+	"endless": true,
+	"endnote": true,
+	"endorse": true,
+	"enforce": true,
+	"engaged": true,
+	"engorge": true,
+	"engross": true,
+	"enhance": true,
+	"enjoyed": true,
+	"enjoyer": true,
+	"enslave": true,
+	"ensnare": true,
+	"entitle": true,
+	"entrust": true,
+	"entwine": true,
+	"envious": true,
+	"episode": true,
+	"equally": true,
+	"equator": true,
+	"equinox": true,
+	"erasure": true,
+	"erosion": true,
+	"erratic": true,
+	"esquire": true,
+	"essence": true,
+	"etching": true,
+	"eternal": true,
+	"ethanol": true,
+	"ethical": true,
+	"evacuee": true,
+	"evasion": true,
+	"evasive": true,
+	"evident": true,
+	"evolved": true,
+	"exactly": true,
+	"exalted": true,
+	"example": true,
+	"excerpt": true,
+	"excited": true,
+	"exclaim": true,
+	"exclude": true,
+	"execute": true,
+	"exhaust": true,
+	"exhibit": true,
+	"expanse": true,
+	"explain": true,
+	"explode": true,
+	"exploit": true,
+	"explore": true,
+	"express": true,
+	"extinct": true,
+	"extrude": true,
+	"eyebrow": true,
+	"faceted": true,
+	"faction": true,
+	"factoid": true,
+	"factory": true,
+	"factual": true,
+	"faculty": true,
+	"failing": true,
+	"falsify": true,
+	"fanatic": true,
+	"fancied": true,
+	"fanfare": true,
+	"fanning": true,
+	"fantasy": true,
+	"fascism": true,
+	"fashion": true,
+	"fasting": true,
+	"fatigue": true,
+	"favored": true,
+	"feature": true,
+	"federal": true,
+	"feigned": true,
+	"fencing": true,
+	"ferment": true,
+	"festive": true,
+	"fiction": true,
+	"fidgety": true,
+	"fifteen": true,
+	"figment": true,
+	"filling": true,
+	"finally": true,
+	"finance": true,
+	"finicky": true,
+	"finless": true,
+	"finlike": true,
+	"firefly": true,
+	"firstly": true,
+	"fitness": true,
+	"fitting": true,
+	"fixture": true,
+	"flaccid": true,
+	"flagman": true,
+	"flakily": true,
+	"flanked": true,
+	"flaring": true,
+	"flatbed": true,
+	"flatten": true,
+	"flattop": true,
+	"fleshed": true,
+	"florist": true,
+	"flowing": true,
+	"flyable": true,
+	"flyaway": true,
+	"flyover": true,
+	"footage": true,
+	"footing": true,
+	"footman": true,
+	"footpad": true,
+	"footsie": true,
+	"fortune": true,
+	"forward": true,
+	"founder": true,
+	"fragile": true,
+	"framing": true,
+	"frankly": true,
+	"frantic": true,
+	"fraying": true,
+	"freebee": true,
+	"freebie": true,
+	"freedom": true,
+	"freeing": true,
+	"freeway": true,
+	"freight": true,
+	"fretful": true,
+	"fretted": true,
+	"frisbee": true,
+	"fritter": true,
+	"frosted": true,
+	"furnace": true,
+	"gaining": true,
+	"gallery": true,
+	"gallows": true,
+	"gangway": true,
+	"garbage": true,
+	"garfish": true,
+	"garland": true,
+	"garment": true,
+	"garnish": true,
+	"gauging": true,
+	"gazelle": true,
+	"gearbox": true,
+	"gelding": true,
+	"general": true,
+	"generic": true,
+	"gentile": true,
+	"genuine": true,
+	"geology": true,
+	"gestate": true,
+	"gesture": true,
+	"getaway": true,
+	"getting": true,
+	"ghastly": true,
+	"giddily": true,
+	"gimmick": true,
+	"giraffe": true,
+	"gizzard": true,
+	"glacial": true,
+	"glacier": true,
+	"glamour": true,
+	"glaring": true,
+	"glasses": true,
+	"glazing": true,
+	"gleeful": true,
+	"gliding": true,
+	"glimmer": true,
+	"glimpse": true,
+	"glisten": true,
+	"glitter": true,
+	"gloater": true,
+	"glorify": true,
+	"glowing": true,
+	"glucose": true,
+	"glutton": true,
+	"gnomish": true,
+	"gobbler": true,
+	"goddess": true,
+	"goggles": true,
+	"goliath": true,
+	"gondola": true,
+	"gorilla": true,
+	"goshawk": true,
+	"gosling": true,
+	"gourmet": true,
+	"grackle": true,
+	"grading": true,
+	"grafted": true,
+	"grandly": true,
+	"grandma": true,
+	"grandpa": true,
+	"granite": true,
+	"granola": true,
+	"grapple": true,
+	"gratify": true,
+	"grating": true,
+	"gravity": true,
+	"grazing": true,
+	"greatly": true,
+	"greeter": true,
+	"griffon": true,
+	"grimace": true,
+	"gristle": true,
+	"grizzly": true,
+	"grocery": true,
+	"grossly": true,
+	"grouped": true,
+	"grouper": true,
+	"growing": true,
+	"gruffly": true,
+	"grumble": true,
+	"grumbly": true,
+	"guiding": true,
+	"gumball": true,
+	"gumdrop": true,
+	"gumming": true,
+	"gutless": true,
+	"guzzler": true,
+	"gymnast": true,
+	"habitat": true,
+	"hacking": true,
+	"hacksaw": true,
+	"haddock": true,
+	"hagfish": true,
+	"haggard": true,
+	"haggler": true,
+	"halibut": true,
+	"halogen": true,
+	"hammock": true,
+	"hamster": true,
+	"handbag": true,
+	"handful": true,
+	"handgun": true,
+	"handled": true,
+	"handler": true,
+	"handoff": true,
+	"handsaw": true,
+	"handset": true,
+	"hangout": true,
+	"happier": true,
+	"happily": true,
+	"hardhat": true,
+	"harmful": true,
+	"harmony": true,
+	"harness": true,
+	"harpist": true,
+	"harvest": true,
+	"hastily": true,
+	"hatchet": true,
+	"hatless": true,
+	"haughty": true,
+	"heading": true,
+	"headset": true,
+	"headway": true,
+	"healthy": true,
+	"heavily": true,
+	"heaving": true,
+	"hedging": true,
+	"helpful": true,
+	"helping": true,
+	"hemlock": true,
+	"heroics": true,
+	"heroism": true,
+	"herring": true,
+	"herself": true,
+	"hexagon": true,
+	"history": true,
+	"holiday": true,
+	"hopeful": true,
+	"humming": true,
+	"hundred": true,
+	"hunting": true,
+	"hurling": true,
+	"hurried": true,
+	"husband": true,
+	"hushing": true,
+	"hydrant": true,
+	"icepack": true,
+	"iciness": true,
+	"ideally": true,
+	"illegal": true,
+	"illness": true,
+	"imaging": true,
+	"imitate": true,
+	"immense": true,
+	"immerse": true,
+	"impeach": true,
+	"implant": true,
+	"implode": true,
+	"impound": true,
+	"imprint": true,
+	"improve": true,
+	"impulse": true,
+	"include": true,
+	"inflict": true,
+	"inherit": true,
+	"initial": true,
+	"inquiry": true,
+	"inspire": true,
+	"install": true,
+	"intense": true,
+	"involve": true,
+	"irksome": true,
+	"islamic": true,
+	"isolate": true,
+	"isotope": true,
+	"issuing": true,
+	"italics": true,
+	"jackass": true,
+	"jackpot": true,
+	"janitor": true,
+	"january": true,
+	"jarring": true,
+	"jasmine": true,
+	"javelin": true,
+	"jawfish": true,
+	"jawless": true,
+	"jawline": true,
+	"jaybird": true,
+	"jealous": true,
+	"jellied": true,
+	"jezebel": true,
+	"jitters": true,
+	"jittery": true,
+	"jogging": true,
+	"joining": true,
+	"jointly": true,
+	"journal": true,
+	"journey": true,
+	"joyride": true,
+	"jugular": true,
+	"jujitsu": true,
+	"jukebox": true,
+	"juniper": true,
+	"junkman": true,
+	"justice": true,
+	"justify": true,
+	"karaoke": true,
+	"katydid": true,
+	"ketchup": true,
+	"kindred": true,
+	"kinetic": true,
+	"kinfolk": true,
+	"kingdom": true,
+	"kinship": true,
+	"kinsman": true,
+	"kissing": true,
+	"kitchen": true,
+	"kleenex": true,
+	"kneecap": true,
+	"knowing": true,
+	"krypton": true,
+	"labored": true,
+	"laborer": true,
+	"ladybug": true,
+	"lagging": true,
+	"lamprey": true,
+	"landing": true,
+	"lantern": true,
+	"lapping": true,
+	"largely": true,
+	"lasagna": true,
+	"lasting": true,
+	"latrine": true,
+	"launder": true,
+	"laundry": true,
+	"lawsuit": true,
+	"leading": true,
+	"lecture": true,
+	"legally": true,
+	"legible": true,
+	"legibly": true,
+	"legroom": true,
+	"legwork": true,
+	"leisure": true,
+	"lemming": true,
+	"lenient": true,
+	"leopard": true,
+	"leotard": true,
+	"letdown": true,
+	"lettuce": true,
+	"liberal": true,
+	"liberty": true,
+	"library": true,
+	"license": true,
+	"licking": true,
+	"lifting": true,
+	"liftoff": true,
+	"lightly": true,
+	"limeade": true,
+	"limping": true,
+	"linseed": true,
+	"lioness": true,
+	"liquefy": true,
+	"liqueur": true,
+	"livable": true,
+	"lividly": true,
+	"lobster": true,
+	"locally": true,
+	"logical": true,
+	"loosely": true,
+	"lottery": true,
+	"luckily": true,
+	"luggage": true,
+	"lullaby": true,
+	"lumping": true,
+	"lumpish": true,
+	"lustily": true,
+	"macaque": true,
+	"machine": true,
+	"maestro": true,
+	"magenta": true,
+	"magical": true,
+	"magnify": true,
+	"majesty": true,
+	"mallard": true,
+	"mammary": true,
+	"mammoth": true,
+	"manager": true,
+	"manatee": true,
+	"mandate": true,
+	"manhole": true,
+	"manhood": true,
+	"manhunt": true,
+	"mankind": true,
+	"manlike": true,
+	"manmade": true,
+	"mannish": true,
+	"mansion": true,
+	"marbled": true,
+	"marbles": true,
+	"marital": true,
+	"married": true,
+	"marxism": true,
+	"mashing": true,
+	"massive": true,
+	"mastiff": true,
+	"matador": true,
+	"matcher": true,
+	"maximum": true,
+	"measure": true,
+	"meerkat": true,
+	"mention": true,
+	"message": true,
+	"million": true,
+	"minimum": true,
+	"miracle": true,
+	"mistake": true,
+	"mixture": true,
+	"moaning": true,
+	"mobster": true,
+	"modular": true,
+	"moisten": true,
+	"mollusk": true,
+	"monarch": true,
+	"mongrel": true,
+	"monitor": true,
+	"monsoon": true,
+	"monster": true,
+	"monthly": true,
+	"moocher": true,
+	"moonlit": true,
+	"morally": true,
+	"morning": true,
+	"mortify": true,
+	"mounted": true,
+	"mourner": true,
+	"movable": true,
+	"mudfish": true,
+	"mudflow": true,
+	"mugshot": true,
+	"mullets": true,
+	"mummify": true,
+	"mundane": true,
+	"mushily": true,
+	"musical": true,
+	"muskrat": true,
+	"mustang": true,
+	"mustard": true,
+	"mutable": true,
+	"myspace": true,
+	"mystery": true,
+	"mystify": true,
+	"napping": true,
+	"narwhal": true,
+	"nastily": true,
+	"natives": true,
+	"natural": true,
+	"nearest": true,
+	"neglect": true,
+	"neither": true,
+	"nemeses": true,
+	"nemesis": true,
+	"nervous": true,
+	"netting": true,
+	"network": true,
+	"neutral": true,
+	"neutron": true,
+	"nirvana": true,
+	"nominee": true,
+	"notable": true,
+	"notably": true,
+	"nothing": true,
+	"nuclear": true,
+	"nucleus": true,
+	"nullify": true,
+	"numbing": true,
+	"numeral": true,
+	"numeric": true,
+	"nursery": true,
+	"nursing": true,
+	"nurture": true,
+	"nutcase": true,
+	"nutlike": true,
+	"oarfish": true,
+	"obliged": true,
+	"obscure": true,
+	"observe": true,
+	"obvious": true,
+	"oceanic": true,
+	"octagon": true,
+	"october": true,
+	"octopus": true,
+	"olympic": true,
+	"ominous": true,
+	"onboard": true,
+	"ongoing": true,
+	"onshore": true,
+	"onstage": true,
+	"opacity": true,
+	"operate": true,
+	"opinion": true,
+	"opossum": true,
+	"optical": true,
+	"optimal": true,
+	"optimum": true,
+	"orchard": true,
+	"organic": true,
+	"osmosis": true,
+	"ostrich": true,
+	"outback": true,
+	"outcast": true,
+	"outcome": true,
+	"outdoor": true,
+	"outgrow": true,
+	"outlast": true,
+	"outline": true,
+	"outlook": true,
+	"outmost": true,
+	"outpost": true,
+	"outpour": true,
+	"outrage": true,
+	"outrank": true,
+	"outsell": true,
+	"outside": true,
+	"outward": true,
+	"ovation": true,
+	"overact": true,
+	"overall": true,
+	"overbid": true,
+	"overdue": true,
+	"overfed": true,
+	"overlap": true,
+	"overlay": true,
+	"overpay": true,
+	"overrun": true,
+	"overtly": true,
+	"overuse": true,
+	"oxidant": true,
+	"oxidize": true,
+	"pacific": true,
+	"padding": true,
+	"padlock": true,
+	"pageant": true,
+	"pajamas": true,
+	"pampers": true,
+	"pancake": true,
+	"panning": true,
+	"panther": true,
+	"paprika": true,
+	"papyrus": true,
+	"paradox": true,
+	"parched": true,
+	"parking": true,
+	"parkway": true,
+	"parsley": true,
+	"parsnip": true,
+	"partake": true,
+	"parting": true,
+	"partner": true,
+	"passage": true,
+	"passing": true,
+	"passion": true,
+	"passive": true,
+	"pastime": true,
+	"pasture": true,
+	"patient": true,
+	"patriot": true,
+	"pattern": true,
+	"payable": true,
+	"payback": true,
+	"payment": true,
+	"payroll": true,
+	"peacock": true,
+	"peasant": true,
+	"pegasus": true,
+	"pelican": true,
+	"penalty": true,
+	"pendant": true,
+	"pending": true,
+	"penguin": true,
+	"pennant": true,
+	"pension": true,
+	"percent": true,
+	"perfect": true,
+	"perfume": true,
+	"perjury": true,
+	"petunia": true,
+	"phantom": true,
+	"phoenix": true,
+	"phonics": true,
+	"picture": true,
+	"pioneer": true,
+	"piranha": true,
+	"placard": true,
+	"placate": true,
+	"plainly": true,
+	"planner": true,
+	"plaster": true,
+	"plastic": true,
+	"plating": true,
+	"platter": true,
+	"playful": true,
+	"playing": true,
+	"playoff": true,
+	"playpen": true,
+	"playset": true,
+	"pleased": true,
+	"pliable": true,
+	"plotted": true,
+	"plunder": true,
+	"plywood": true,
+	"pointed": true,
+	"pointer": true,
+	"polecat": true,
+	"polygon": true,
+	"polymer": true,
+	"popcorn": true,
+	"popular": true,
+	"portion": true,
+	"postage": true,
+	"postbox": true,
+	"posting": true,
+	"posture": true,
+	"postwar": true,
+	"pottery": true,
+	"poultry": true,
+	"pouring": true,
+	"poverty": true,
+	"powdery": true,
+	"prairie": true,
+	"pranker": true,
+	"praying": true,
+	"preachy": true,
+	"precise": true,
+	"precook": true,
+	"predict": true,
+	"preface": true,
+	"pregame": true,
+	"prelude": true,
+	"premium": true,
+	"prepaid": true,
+	"prepare": true,
+	"preplan": true,
+	"present": true,
+	"preshow": true,
+	"presoak": true,
+	"presume": true,
+	"preteen": true,
+	"pretext": true,
+	"pretzel": true,
+	"prevail": true,
+	"prevent": true,
+	"preview": true,
+	"primary": true,
+	"primate": true,
+	"privacy": true,
+	"private": true,
+	"probing": true,
+	"problem": true,
+	"process": true,
+	"prodigy": true,
+	"produce": true,
+	"product": true,
+	"profane": true,
+	"profile": true,
+	"progeny": true,
+	"program": true,
+	"project": true,
+	"promote": true,
+	"propose": true,
+	"prorate": true,
+	"prosper": true,
+	"protect": true,
+	"provide": true,
+	"proving": true,
+	"provoke": true,
+	"prowess": true,
+	"prowler": true,
+	"pruning": true,
+	"psychic": true,
+	"pudding": true,
+	"pulsate": true,
+	"pumpkin": true,
+	"pungent": true,
+	"purging": true,
+	"puritan": true,
+	"purpose": true,
+	"pursuit": true,
+	"pushing": true,
+	"pushpin": true,
+	"putdown": true,
+	"pyramid": true,
+	"quaking": true,
+	"qualify": true,
+	"quality": true,
+	"quantum": true,
+	"quarrel": true,
+	"quarter": true,
+	"quartet": true,
+	"quetzal": true,
+	"quicken": true,
+	"quickly": true,
+	"quietly": true,
+	"quintet": true,
+	"raccoon": true,
+	"ragweed": true,
+	"railcar": true,
+	"railing": true,
+	"railway": true,
+	"ranging": true,
+	"ranking": true,
+	"ransack": true,
+	"ranting": true,
+	"rapidly": true,
+	"rasping": true,
+	"rattler": true,
+	"ravioli": true,
+	"reactor": true,
+	"readily": true,
+	"reapply": true,
+	"reawake": true,
+	"rebirth": true,
+	"rebound": true,
+	"rebuild": true,
+	"rebuilt": true,
+	"receipt": true,
+	"receive": true,
+	"recital": true,
+	"reclaim": true,
+	"recluse": true,
+	"recolor": true,
+	"recount": true,
+	"rectify": true,
+	"recycle": true,
+	"redbird": true,
+	"redfish": true,
+	"reenact": true,
+	"reenter": true,
+	"reentry": true,
+	"referee": true,
+	"refined": true,
+	"reflect": true,
+	"refocus": true,
+	"refract": true,
+	"refrain": true,
+	"refresh": true,
+	"refried": true,
+	"refusal": true,
+	"regalia": true,
+	"regally": true,
+	"regress": true,
+	"regroup": true,
+	"regular": true,
+	"reissue": true,
+	"rejoice": true,
+	"relapse": true,
+	"related": true,
+	"relaxed": true,
+	"relearn": true,
+	"release": true,
+	"reliant": true,
+	"relieve": true,
+	"relight": true,
+	"remarry": true,
+	"rematch": true,
+	"remnant": true,
+	"remorse": true,
+	"removal": true,
+	"removed": true,
+	"remover": true,
+	"renewal": true,
+	"renewed": true,
+	"reoccur": true,
+	"reorder": true,
+	"repaint": true,
+	"replace": true,
+	"replica": true,
+	"reprint": true,
+	"reprise": true,
+	"reptile": true,
+	"request": true,
+	"require": true,
+	"reroute": true,
+	"rescuer": true,
+	"reshape": true,
+	"reshoot": true,
+	"residue": true,
+	"respect": true,
+	"rethink": true,
+	"retinal": true,
+	"retired": true,
+	"retiree": true,
+	"retouch": true,
+	"retrace": true,
+	"retract": true,
+	"retrain": true,
+	"retread": true,
+	"retreat": true,
+	"retrial": true,
+	"retying": true,
+	"reunion": true,
+	"reunite": true,
+	"reveler": true,
+	"revenge": true,
+	"revenue": true,
+	"revered": true,
+	"reverse": true,
+	"revisit": true,
+	"revival": true,
+	"reviver": true,
+	"rewrite": true,
+	"rhubarb": true,
+	"ribcage": true,
+	"rickety": true,
+	"ricotta": true,
+	"rifling": true,
+	"rigging": true,
+	"rightly": true,
+	"rimless": true,
+	"rinsing": true,
+	"ripcord": true,
+	"ripping": true,
+	"riptide": true,
+	"riskily": true,
+	"risotto": true,
+	"ritalin": true,
+	"riveter": true,
+	"roaming": true,
+	"robbing": true,
+	"rocking": true,
+	"romance": true,
+	"rooster": true,
+	"rotting": true,
+	"rotunda": true,
+	"roughly": true,
+	"roundup": true,
+	"routine": true,
+	"routing": true,
+	"rubbing": true,
+	"rubdown": true,
+	"rummage": true,
+	"rundown": true,
+	"running": true,
+	"rupture": true,
+	"sabbath": true,
+	"saddled": true,
+	"sadness": true,
+	"saffron": true,
+	"sagging": true,
+	"salvage": true,
+	"samurai": true,
+	"sandbag": true,
+	"sandbar": true,
+	"sandbox": true,
+	"sanding": true,
+	"sandlot": true,
+	"sandpit": true,
+	"sapling": true,
+	"sarcasm": true,
+	"sardine": true,
+	"satchel": true,
+	"satisfy": true,
+	"satoshi": true,
+	"sausage": true,
+	"savanna": true,
+	"savings": true,
+	"sawfish": true,
+	"scabbed": true,
+	"scalded": true,
+	"scaling": true,
+	"scallop": true,
+	"scandal": true,
+	"scanner": true,
+	"scarily": true,
+	"scatter": true,
+	"scholar": true,
+	"science": true,
+	"scooter": true,
+	"scoring": true,
+	"scoured": true,
+	"scratch": true,
+	"scrawny": true,
+	"scrooge": true,
+	"scruffy": true,
+	"scrunch": true,
+	"sculpin": true,
+	"scuttle": true,
+	"seagull": true,
+	"secrecy": true,
+	"section": true,
+	"secular": true,
+	"segment": true,
+	"seismic": true,
+	"seizing": true,
+	"seltzer": true,
+	"seminar": true,
+	"senator": true,
+	"serpent": true,
+	"service": true,
+	"serving": true,
+	"session": true,
+	"setback": true,
+	"setting": true,
+	"settled": true,
+	"settler": true,
+	"seventh": true,
+	"seventy": true,
+	"shadily": true,
+	"shading": true,
+	"shakily": true,
+	"shaking": true,
+	"shallot": true,
+	"shallow": true,
+	"shampoo": true,
+	"shaping": true,
+	"sharing": true,
+	"sharper": true,
+	"sharpie": true,
+	"sharply": true,
+	"shelter": true,
+	"sheriff": true,
+	"shifter": true,
+	"shimmer": true,
+	"shindig": true,
+	"shingle": true,
+	"shining": true,
+	"shopper": true,
+	"shorten": true,
+	"shorter": true,
+	"shortly": true,
+	"showbiz": true,
+	"showing": true,
+	"showman": true,
+	"showoff": true,
+	"shrivel": true,
+	"shudder": true,
+	"shuffle": true,
+	"shuttle": true,
+	"shyness": true,
+	"siamese": true,
+	"sibling": true,
+	"sighing": true,
+	"silicon": true,
+	"similar": true,
+	"sincere": true,
+	"singing": true,
+	"singles": true,
+	"sinless": true,
+	"sinuous": true,
+	"sitting": true,
+	"situate": true,
+	"sixfold": true,
+	"sixteen": true,
+	"sixties": true,
+	"sizable": true,
+	"sizably": true,
+	"skating": true,
+	"skeptic": true,
+	"skilled": true,
+	"skillet": true,
+	"skimmed": true,
+	"skimmer": true,
+	"skipper": true,
+	"skittle": true,
+	"skylark": true,
+	"skyline": true,
+	"skyward": true,
+	"slacked": true,
+	"slacker": true,
+	"slander": true,
+	"slashed": true,
+	"slather": true,
+	"slender": true,
+	"slicing": true,
+	"sliding": true,
+	"sloping": true,
+	"slouchy": true,
+	"smartly": true,
+	"smasher": true,
+	"smashup": true,
+	"smiling": true,
+	"smitten": true,
+	"smoking": true,
+	"smolder": true,
+	"smother": true,
+	"snagged": true,
+	"snaking": true,
+	"snapper": true,
+	"snippet": true,
+	"snooper": true,
+	"snoring": true,
+	"snorkel": true,
+	"snowcap": true,
+	"snowman": true,
+	"snuggle": true,
+	"soldier": true,
+	"someone": true,
+	"spaniel": true,
+	"sparrow": true,
+	"spatial": true,
+	"special": true,
+	"species": true,
+	"specked": true,
+	"speller": true,
+	"spender": true,
+	"spinach": true,
+	"spindle": true,
+	"spinner": true,
+	"spinout": true,
+	"spirits": true,
+	"splashy": true,
+	"splurge": true,
+	"spoiled": true,
+	"spoiler": true,
+	"sponsor": true,
+	"spotted": true,
+	"spotter": true,
+	"spousal": true,
+	"sputter": true,
+	"squeeze": true,
+	"squishy": true,
+	"stadium": true,
+	"staging": true,
+	"stained": true,
+	"stamina": true,
+	"stammer": true,
+	"stardom": true,
+	"staring": true,
+	"starlet": true,
+	"starlit": true,
+	"starter": true,
+	"startle": true,
+	"startup": true,
+	"starved": true,
+	"stature": true,
+	"statute": true,
+	"staunch": true,
+	"stellar": true,
+	"stencil": true,
+	"sterile": true,
+	"sternum": true,
+	"stiffen": true,
+	"stiffly": true,
+	"stimuli": true,
+	"stinger": true,
+	"stipend": true,
+	"stirred": true,
+	"stomach": true,
+	"stoning": true,
+	"stopped": true,
+	"stopper": true,
+	"storage": true,
+	"stowing": true,
+	"stratus": true,
+	"stretch": true,
+	"strings": true,
+	"strudel": true,
+	"stubbed": true,
+	"stubble": true,
+	"stubbly": true,
+	"student": true,
+	"studied": true,
+	"stuffed": true,
+	"stumble": true,
+	"stunned": true,
+	"stunner": true,
+	"styling": true,
+	"stylist": true,
+	"subdued": true,
+	"subject": true,
+	"sublime": true,
+	"subplot": true,
+	"subside": true,
+	"subsidy": true,
+	"subsoil": true,
+	"subtext": true,
+	"subtype": true,
+	"subzero": true,
+	"success": true,
+	"suction": true,
+	"suffice": true,
+	"suggest": true,
+	"sulfate": true,
+	"sulfide": true,
+	"sulfite": true,
+	"sulphur": true,
+	"summary": true,
+	"sunbeam": true,
+	"sunbird": true,
+	"sunfish": true,
+	"support": true,
+	"supreme": true,
+	"surface": true,
+	"surgery": true,
+	"surging": true,
+	"surname": true,
+	"surpass": true,
+	"surplus": true,
+	"surreal": true,
+	"survive": true,
+	"suspect": true,
+	"suspend": true,
+	"sustain": true,
+	"swagger": true,
+	"swallow": true,
+	"swifter": true,
+	"swiftly": true,
+	"swimmer": true,
+	"swinger": true,
+	"swizzle": true,
+	"swooned": true,
+	"symptom": true,
+	"synapse": true,
+	"synergy": true,
+	"tabasco": true,
+	"tabloid": true,
+	"tacking": true,
+	"tactful": true,
+	"tactics": true,
+	"tactile": true,
+	"tadpole": true,
+	"tainted": true,
+	"takeout": true,
+	"tannery": true,
+	"tanning": true,
+	"tantrum": true,
+	"tapered": true,
+	"tapioca": true,
+	"tapping": true,
+	"tarnish": true,
+	"tasting": true,
+	"termite": true,
+	"terrier": true,
+	"theater": true,
+	"thermal": true,
+	"thermos": true,
+	"thicken": true,
+	"thicket": true,
+	"thimble": true,
+	"thinner": true,
+	"thirsty": true,
+	"thought": true,
+	"thrower": true,
+	"thunder": true,
+	"thyself": true,
+	"tidings": true,
+	"tighten": true,
+	"tightly": true,
+	"tigress": true,
+	"timothy": true,
+	"tinfoil": true,
+	"tinwork": true,
+	"tipping": true,
+	"tobacco": true,
+	"toddler": true,
+	"tonight": true,
+	"topical": true,
+	"tornado": true,
+	"totally": true,
+	"touched": true,
+	"tourist": true,
+	"tracing": true,
+	"tractor": true,
+	"trading": true,
+	"traffic": true,
+	"tragedy": true,
+	"traitor": true,
+	"trapeze": true,
+	"trapped": true,
+	"trapper": true,
+	"treason": true,
+	"trekker": true,
+	"tremble": true,
+	"tribune": true,
+	"tribute": true,
+	"triceps": true,
+	"trickle": true,
+	"trident": true,
+	"trigger": true,
+	"trilogy": true,
+	"trimmer": true,
+	"trinity": true,
+	"triumph": true,
+	"trivial": true,
+	"trodden": true,
+	"tropics": true,
+	"trouble": true,
+	"truffle": true,
+	"trumpet": true,
+	"trusted": true,
+	"trustee": true,
+	"tubular": true,
+	"tucking": true,
+	"tuesday": true,
+	"tugboat": true,
+	"tuition": true,
+	"turbine": true,
+	"turmoil": true,
+	"twiddle": true,
+	"twisted": true,
+	"twister": true,
+	"twitter": true,
+	"typical": true,
+	"unaired": true,
+	"unawake": true,
+	"unaware": true,
+	"unbaked": true,
+	"unblock": true,
+	"unboxed": true,
+	"uncanny": true,
+	"unchain": true,
+	"uncheck": true,
+	"uncivil": true,
+	"unclasp": true,
+	"uncloak": true,
+	"uncouth": true,
+	"uncover": true,
+	"uncross": true,
+	"uncrown": true,
+	"uncured": true,
+	"undated": true,
+	"undergo": true,
+	"undoing": true,
+	"undress": true,
+	"undying": true,
+	"unearth": true,
+	"uneaten": true,
+	"unequal": true,
+	"unfazed": true,
+	"unfiled": true,
+	"unfixed": true,
+	"ungodly": true,
+	"unhappy": true,
+	"unheard": true,
+	"unhinge": true,
+	"unicorn": true,
+	"unified": true,
+	"unifier": true,
+	"uniform": true,
+	"unkempt": true,
+	"unknown": true,
+	"unlaced": true,
+	"unlatch": true,
+	"unleash": true,
+	"unlined": true,
+	"unloved": true,
+	"unlucky": true,
+	"unmixed": true,
+	"unmoral": true,
+	"unmoved": true,
+	"unnamed": true,
+	"unnerve": true,
+	"unpaved": true,
+	"unquote": true,
+	"unrated": true,
+	"unrobed": true,
+	"unsaved": true,
+	"unscrew": true,
+	"unstuck": true,
+	"unsworn": true,
+	"untaken": true,
+	"untamed": true,
+	"untaxed": true,
+	"untimed": true,
+	"untried": true,
+	"untruth": true,
+	"untwist": true,
+	"untying": true,
+	"unusual": true,
+	"unvocal": true,
+	"unweave": true,
+	"unwired": true,
+	"unwound": true,
+	"unwoven": true,
+	"upchuck": true,
+	"upfront": true,
+	"upgrade": true,
+	"upright": true,
+	"upriver": true,
+	"upscale": true,
+	"upstage": true,
+	"upstart": true,
+	"upstate": true,
+	"upswing": true,
+	"uptight": true,
+	"uranium": true,
+	"urgency": true,
+	"urology": true,
+	"useable": true,
+	"useless": true,
+	"usually": true,
+	"utensil": true,
+	"utility": true,
+	"utilize": true,
+	"utterly": true,
+	"vacancy": true,
+	"vaguely": true,
+	"valiant": true,
+	"vanilla": true,
+	"vantage": true,
+	"variety": true,
+	"various": true,
+	"varmint": true,
+	"varnish": true,
+	"varsity": true,
+	"varying": true,
+	"vehicle": true,
+	"vending": true,
+	"venture": true,
+	"verbose": true,
+	"verdict": true,
+	"version": true,
+	"vertigo": true,
+	"veteran": true,
+	"vibrant": true,
+	"vicious": true,
+	"victory": true,
+	"viewing": true,
+	"village": true,
+	"villain": true,
+	"vinegar": true,
+	"vintage": true,
+	"violate": true,
+	"virtual": true,
+	"viscous": true,
+	"visible": true,
+	"visibly": true,
+	"visitor": true,
+	"vitally": true,
+	"vividly": true,
+	"vocally": true,
+	"voicing": true,
+	"volcano": true,
+	"voltage": true,
+	"volumes": true,
+	"voucher": true,
+	"vulture": true,
+	"wallaby": true,
+	"walleye": true,
+	"walmart": true,
+	"wannabe": true,
+	"wanting": true,
+	"warfare": true,
+	"warrior": true,
+	"warthog": true,
+	"washday": true,
+	"washing": true,
+	"washout": true,
+	"washtub": true,
+	"wasting": true,
+	"wealthy": true,
+	"weather": true,
+	"wedding": true,
+	"weekend": true,
+	"welcome": true,
+	"whippet": true,
+	"whisper": true,
+	"whoever": true,
+	"whoopee": true,
+	"wielder": true,
+	"wildcat": true,
+	"willing": true,
+	"wincing": true,
+	"winking": true,
+	"winning": true,
+	"wipeout": true,
+	"wistful": true,
+	"witness": true,
+	"womanly": true,
+	"working": true,
+	"worried": true,
+	"worrier": true,
+	"wrangle": true,
+	"wrecker": true,
+	"wrestle": true,
+	"wriggle": true,
+	"wriggly": true,
+	"wrinkle": true,
+	"wrinkly": true,
+	"writing": true,
+	"written": true,
+	"wronged": true,
+	"wrongly": true,
+	"wrought": true,
+	"yanking": true,
+	"yapping": true,
+	"yelling": true,
+	"yiddish": true,
+	"zealous": true,
+	"zillion": true,
+	"zipfile": true,
+	"zipping": true,
+	"zoology": true,
+}
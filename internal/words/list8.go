@@ -0,0 +1,2419 @@
+package words
+
+// All possible eight-letter answers
+var answerList8 = [...]string{
+	"aardvark",
+	"abnormal",
+	"abrasion",
+	"abstract",
+	"abundant",
+	"accident",
+	"achiness",
+	"acoustic",
+	"agnostic",
+	"agreeing",
+	"alkaline",
+	"alphabet",
+	"altitude",
+	"aluminum",
+	"announce",
+	"antelope",
+	"anyplace",
+	"arachnid",
+	"ardently",
+	"argument",
+	"armchair",
+	"aromatic",
+	"arsonist",
+	"artefact",
+	"asbestos",
+	"attitude",
+	"atypical",
+	"babbling",
+	"bachelor",
+	"backpack",
+	"bathrobe",
+	"boatyard",
+	"bohemian",
+	"broccoli",
+	"buddhist",
+	"bullfrog",
+	"business",
+	"cadillac",
+	"cajoling",
+	"cakewalk",
+	"canister",
+	"category",
+	"ceramics",
+	"cesspool",
+	"champion",
+	"chlorine",
+	"cilantro",
+	"cinnamon",
+	"civilian",
+	"codeword",
+	"computer",
+	"confetti",
+	"congress",
+	"consider",
+	"convince",
+	"coziness",
+	"crawfish",
+	"cucumber",
+	"cufflink",
+	"cupboard",
+	"cylinder",
+	"cynicism",
+	"daffodil",
+	"datebook",
+	"daughter",
+	"december",
+	"decorate",
+	"decrease",
+	"dedicate",
+	"deepness",
+	"democrat",
+	"deranged",
+	"describe",
+	"diffuser",
+	"dinosaur",
+	"disagree",
+	"discover",
+	"disorder",
+	"distance",
+	"dividers",
+	"document",
+	"dominoes",
+	"doorstep",
+	"duckling",
+	"dumpster",
+	"dwarfism",
+	"dwelling",
+	"dynamite",
+	"dyslexia",
+	"echoless",
+	"educator",
+	"ejection",
+	"electric",
+	"elephant",
+	"elevator",
+	"elfishly",
+	"emission",
+	"emoticon",
+	"enforcer",
+	"enlarged",
+	"enormous",
+	"enquirer",
+	"ensemble",
+	"entryway",
+	"envelope",
+	"epidemic",
+	"erasable",
+	"eruption",
+	"espresso",
+	"estrogen",
+	"evidence",
+	"exchange",
+	"exercise",
+	"exorcist",
+	"exterior",
+	"failsafe",
+	"favorite",
+	"feasibly",
+	"february",
+	"feedback",
+	"festival",
+	"feverish",
+	"figurine",
+	"finalist",
+	"fiscally",
+	"flypaper",
+	"foamless",
+	"folksong",
+	"footpath",
+	"fountain",
+	"fragment",
+	"frequent",
+	"frosting",
+	"gaslight",
+	"gauntlet",
+	"giftshop",
+	"gigabyte",
+	"giveaway",
+	"glycerin",
+	"goatskin",
+	"goldfish",
+	"gorgeous",
+	"governor",
+	"gullible",
+	"gurgling",
+	"gyration",
+	"hazelnut",
+	"headband",
+	"hedgehog",
+	"hospital",
+	"huddling",
+	"hugeness",
+	"hyacinth",
+	"identify",
+	"ignition",
+	"imbecile",
+	"imitator",
+	"increase",
+	"indicate",
+	"industry",
+	"innocent",
+	"interest",
+	"itemizer",
+	"jalapeno",
+	"jamboree",
+	"jaundice",
+	"jingling",
+	"joinable",
+	"jokingly",
+	"joystick",
+	"jubilant",
+	"junkyard",
+	"juvenile",
+	"kamikaze",
+	"kangaroo",
+	"keepsake",
+	"kerosene",
+	"kilogram",
+	"knapsack",
+	"knickers",
+	"language",
+	"lavender",
+	"laxative",
+	"lecturer",
+	"leftover",
+	"leggings",
+	"leukemia",
+	"lewdness",
+	"licorice",
+	"lifeboat",
+	"likewise",
+	"lipstick",
+	"listless",
+	"lucidity",
+	"lukewarm",
+	"lunchbox",
+	"luscious",
+	"macaroni",
+	"magazine",
+	"mahogany",
+	"majority",
+	"makeover",
+	"mapmaker",
+	"marriage",
+	"massager",
+	"material",
+	"maverick",
+	"mechanic",
+	"midnight",
+	"mobilize",
+	"moccasin",
+	"moisture",
+	"molecule",
+	"momentum",
+	"mortuary",
+	"mosquito",
+	"mountain",
+	"multiply",
+	"mushroom",
+	"mutation",
+	"namesake",
+	"narrator",
+	"navigate",
+	"negative",
+	"nextdoor",
+	"nicotine",
+	"nintendo",
+	"nuisance",
+	"nuptials",
+	"obituary",
+	"observer",
+	"oiliness",
+	"ointment",
+	"olympics",
+	"oncoming",
+	"onlooker",
+	"opaquely",
+	"opponent",
+	"ordinary",
+	"original",
+	"outhouse",
+	"pamphlet",
+	"parakeet",
+	"passport",
+	"pavement",
+	"payphone",
+	"pegboard",
+	"peroxide",
+	"pharmacy",
+	"pheasant",
+	"phrasing",
+	"physical",
+	"position",
+	"possible",
+	"postcard",
+	"practice",
+	"princess",
+	"priority",
+	"property",
+	"purchase",
+	"quarters",
+	"question",
+	"railroad",
+	"refinery",
+	"register",
+	"rekindle",
+	"remember",
+	"reporter",
+	"resemble",
+	"resource",
+	"response",
+	"revolver",
+	"rhapsody",
+	"rhetoric",
+	"romancer",
+	"ropelike",
+	"rulebook",
+	"sabotage",
+	"sandworm",
+	"sapphire",
+	"scenario",
+	"scissors",
+	"scorpion",
+	"security",
+	"sedative",
+	"selected",
+	"sentence",
+	"sequence",
+	"severely",
+	"shoulder",
+	"skeleton",
+	"skylight",
+	"slapping",
+	"snapshot",
+	"sneezing",
+	"snowsuit",
+	"snugness",
+	"solution",
+	"speakers",
+	"spyglass",
+	"squirrel",
+	"stingray",
+	"strategy",
+	"struggle",
+	"suitcase",
+	"surprise",
+	"surround",
+	"swimwear",
+	"sycamore",
+	"syllable",
+	"symphony",
+	"syringes",
+	"tagalong",
+	"tallness",
+	"tapestry",
+	"tastebud",
+	"together",
+	"tomorrow",
+	"tortoise",
+	"transfer",
+	"trousers",
+	"tubeless",
+	"tutorial",
+	"tweezers",
+	"umbrella",
+	"ungloved",
+	"unicycle",
+	"universe",
+	"unjustly",
+	"unmarked",
+	"unopened",
+	"unveiled",
+	"uppercut",
+	"upstairs",
+	"urethane",
+	"username",
+	"vagrancy",
+	"vaseline",
+	"vexingly",
+	"vicinity",
+	"vitamins",
+	"vocalist",
+	"wanderer",
+	"wifeless",
+	"windmill",
+	"wishbone",
+	"wizardry",
+	"wreckage",
+	"yearbook",
+	"zeppelin",
+	"zucchini",
+}
+
+// Allowed eight-letter words (includes answers from above)
+var allowList8 = map[string]bool{
+	"aardvark": true,
+	"abnormal": true,
+	"abrasion": true,
+	"abrasive": true,
+	"abruptly": true,
+	"absentee": true,
+	"absently": true,
+	"absinthe": true,
+	"absolute": true,
+	"abstract": true,
+	"abundant": true,
+	"accepted": true,
+	"accident": true,
+	"accuracy": true,
+	"accurate": true,
+	"accustom": true,
+	"achiness": true,
+	"acoustic": true,
+	"acquaint": true,
+	"activate": true,
+	"actively": true,
+	"activism": true,
+	"activist": true,
+	"activity": true,
+	"actually": true,
+	"adapting": true,
+	"adequate": true,
+	"adjusted": true,
+	"advanced": true,
+	"aeration": true,
+	"aerobics": true,
+	"affected": true,
+	"affluent": true,
+	"aflutter": true,
+	"agnostic": true,
+	"agreeing": true,
+	"airedale": true,
+	"albacore": true,
+	"alienate": true,
+	"alkaline": true,
+	"alkalize": true,
+	"allowing": true,
+	"almighty": true,
+	"alphabet": true,
+	"although": true,
+	"altitude": true,
+	"aluminum": true,
+	"amaretto": true,
+	"ambiance": true,
+	"ambition": true,
+	"amicably": true,
+	"ammonium": true,
+	"amniotic": true,
+	"amperage": true,
+	"amusable": true,
+	"anaconda": true,
+	"aneurism": true,
+	"animator": true,
+	"annotate": true,
+	"announce": true,
+	"annoying": true,
+	"annually": true,
+	"anointer": true,
+	"anteater": true,
+	"antelope": true,
+	"antennae": true,
+	"antibody": true,
+	"antidote": true,
+	"antihero": true,
+	"antiques": true,
+	"antirust": true,
+	"anyplace": true,
+	"anything": true,
+	"anywhere": true,
+	"apparent": true,
+	"appendix": true,
+	"appetite": true,
+	"applause": true,
+	"approach": true,
+	"approval": true,
+	"aptitude": true,
+	"aqueduct": true,
+	"arachnid": true,
+	"ardently": true,
+	"arguable": true,
+	"arguably": true,
+	"argument": true,
+	"armchair": true,
+	"aromatic": true,
+	"arriving": true,
+	"arrogant": true,
+	"arsonist": true,
+	"artefact": true,
+	"artistic": true,
+	"asbestos": true,
+	"aspirate": true,
+	"assuring": true,
+	"astonish": true,
+	"atlantic": true,
+	"atonable": true,
+	"attendee": true,
+	"attitude": true,
+	"atypical": true,
+	"audacity": true,
+	"audience": true,
+	"audition": true,
+	"autistic": true,
+	"avenging": true,
+	"aversion": true,
+	"aviation": true,
+	"babbling": true,
+	"bachelor": true,
+	"backache": true,
+	"backdrop": true,
+	"backfire": true,
+	"backhand": true,
+	"backlash": true,
+	"backless": true,
+	"backpack": true,
+	"backrest": true,
+	"backroom": true,
+	"backside": true,
+	"backslid": true,
+	"backspin": true,
+	"backstab": true,
+	"backtalk": true,
+	"backward": true,
+	"backwash": true,
+	"backyard": true,
+	"bacteria": true,
+	"baffling": true,
+	"baguette": true,
+	"bakeshop": true,
+	"balanced": true,
+	"balsamic": true,
+	"banister": true,
+	"bankable": true,
+	"bankbook": true,
+	"banknote": true,
+	"bankroll": true,
+	"barbecue": true,
+	"bargraph": true,
+	"baritone": true,
+	"barnacle": true,
+	"barrette": true,
+	"barstool": true,
+	"barterer": true,
+	"basilisk": true,
+	"bathrobe": true,
+	"battered": true,
+	"becoming": true,
+	"blatancy": true,
+	"blighted": true,
+	"blinking": true,
+	"blissful": true,
+	"blizzard": true,
+	"bloating": true,
+	"bloomers": true,
+	"blooming": true,
+	"blowfish": true,
+	"bluebird": true,
+	"bluegill": true,
+	"blustery": true,
+	"boastful": true,
+	"boasting": true,
+	"boatyard": true,
+	"bohemian": true,
+	"bondless": true,
+	"bonefish": true,
+	"bonehead": true,
+	"boneless": true,
+	"bonelike": true,
+	"bootlace": true,
+	"borrower": true,
+	"botanist": true,
+	"bottling": true,
+	"bouncing": true,
+	"bounding": true,
+	"breeches": true,
+	"breeding": true,
+	"brethren": true,
+	"brightly": true,
+	"broccoli": true,
+	"broiling": true,
+	"bronzing": true,
+	"browbeat": true,
+	"browsing": true,
+	"bruising": true,
+	"brunette": true,
+	"brussels": true,
+	"bubbling": true,
+	"buckshot": true,
+	"buckskin": true,
+	"buddhism": true,
+	"buddhist": true,
+	"bullfrog": true,
+	"bullhorn": true,
+	"bullring": true,
+	"bullseye": true,
+	"bullwhip": true,
+	"bunkmate": true,
+	"bursting": true,
+	"business": true,
+	"busybody": true,
+	"cadillac": true,
+	"cajoling": true,
+	"cakewalk": true,
+	"calamari": true,
+	"calamity": true,
+	"calculus": true,
+	"camisole": true,
+	"campfire": true,
+	"campsite": true,
+	"canister": true,
+	"cannabis": true,
+	"capacity": true,
+	"cardigan": true,
+	"cardinal": true,
+	"careless": true,
+	"carmaker": true,
+	"carnival": true,
+	"cartload": true,
+	"cassette": true,
+	"casually": true,
+	"casualty": true,
+	"catacomb": true,
+	"catalyst": true,
+	"catalyze": true,
+	"catapult": true,
+	"cataract": true,
+	"catching": true,
+	"category": true,
+	"catering": true,
+	"catfight": true,
+	"cathouse": true,
+	"cautious": true,
+	"cavalier": true,
+	"celibacy": true,
+	"celibate": true,
+	"ceramics": true,
+	"ceremony": true,
+	"cesarean": true,
+	"cesspool": true,
+	"chaffing": true,
+	"champion": true,
+	"chaplain": true,
+	"charcoal": true,
+	"charging": true,
+	"charming": true,
+	"charting": true,
+	"chastise": true,
+	"chastity": true,
+	"chatroom": true,
+	"chatting": true,
+	"cheating": true,
+	"cheerful": true,
+	"chewable": true,
+	"childish": true,
+	"chipmunk": true,
+	"chirping": true,
+	"chitchat": true,
+	"chivalry": true,
+	"chloride": true,
+	"chlorine": true,
+	"choosing": true,
+	"chowtime": true,
+	"cilantro": true,
+	"cinnamon": true,
+	"circling": true,
+	"circular": true,
+	"citation": true,
+	"civilian": true,
+	"clambake": true,
+	"clanking": true,
+	"clapping": true,
+	"clarinet": true,
+	"clavicle": true,
+	"clerical": true,
+	"climatic": true,
+	"climbing": true,
+	"clinking": true,
+	"closable": true,
+	"clothing": true,
+	"clubbing": true,
+	"clumsily": true,
+	"coasting": true,
+	"coauthor": true,
+	"cockatoo": true,
+	"codeword": true,
+	"coeditor": true,
+	"cogwheel": true,
+	"coherent": true,
+	"cohesive": true,
+	"coleslaw": true,
+	"coliseum": true,
+	"collapse": true,
+	"colonial": true,
+	"colonist": true,
+	"colonize": true,
+	"colossal": true,
+	"commence": true,
+	"commerce": true,
+	"commonly": true,
+	"communal": true,
+	"complete": true,
+	"composed": true,
+	"composer": true,
+	"compound": true,
+	"compress": true,
+	"computer": true,
+	"conceded": true,
+	"conclude": true,
+	"concrete": true,
+	"condense": true,
+	"confetti": true,
+	"confider": true,
+	"confined": true,
+	"conflict": true,
+	"confound": true,
+	"confront": true,
+	"confused": true,
+	"congrats": true,
+	"congress": true,
+	"conjuror": true,
+	"consider": true,
+	"constant": true,
+	"consumer": true,
+	"contempt": true,
+	"contents": true,
+	"contrite": true,
+	"convince": true,
+	"cornball": true,
+	"cornhusk": true,
+	"cornmeal": true,
+	"coronary": true,
+	"corporal": true,
+	"corridor": true,
+	"cosigner": true,
+	"counting": true,
+	"covenant": true,
+	"coveting": true,
+	"coziness": true,
+	"crabbing": true,
+	"crablike": true,
+	"crabmeat": true,
+	"cradling": true,
+	"craftily": true,
+	"crawfish": true,
+	"crawlers": true,
+	"crawling": true,
+	"crayfish": true,
+	"creasing": true,
+	"creation": true,
+	"creative": true,
+	"creature": true,
+	"credible": true,
+	"credibly": true,
+	"crescent": true,
+	"cresting": true,
+	"crewless": true,
+	"crewmate": true,
+	"cringing": true,
+	"crisping": true,
+	"criteria": true,
+	"crumpled": true,
+	"cruncher": true,
+	"crusader": true,
+	"crushing": true,
+	"cucumber": true,
+	"cufflink": true,
+	"culinary": true,
+	"culpable": true,
+	"cultural": true,
+	"cupboard": true,
+	"customer": true,
+	"cylinder": true,
+	"cynicism": true,
+	"daffodil": true,
+	"daintily": true,
+	"dallying": true,
+	"dandruff": true,
+	"dangling": true,
+	"daringly": true,
+	"darkened": true,
+	"darkness": true,
+	"darkroom": true,
+	"datebook": true,
+	"daughter": true,
+	"daunting": true,
+	"daybreak": true,
+	"daydream": true,
+	"daylight": true,
+	"dazzling": true,
+	"deafness": true,
+	"debating": true,
+	"debtless": true,
+	"deceased": true,
+	"deceiver": true,
+	"december": true,
+	"deciding": true,
+	"decipher": true,
+	"declared": true,
+	"decorate": true,
+	"decrease": true,
+	"dedicate": true,
+	"deepness": true,
+	"defacing": true,
+	"defender": true,
+	"deferral": true,
+	"deferred": true,
+	"defiance": true,
+	"defiling": true,
+	"definite": true,
+	"deflator": true,
+	"deforest": true,
+	"degraded": true,
+	"degrease": true,
+	"dejected": true,
+	"delegate": true,
+	"deletion": true,
+	"delicacy": true,
+	"delicate": true,
+	"delirium": true,
+	"delivery": true,
+	"delusion": true,
+	"demeanor": true,
+	"democrat": true,
+	"demotion": true,
+	"deniable": true,
+	"departed": true,
+	"deplored": true,
+	"depraved": true,
+	"deputize": true,
+	"deranged": true,
+	"describe": true,
+	"designed": true,
+	"designer": true,
+	"deskwork": true,
+	"desolate": true,
+	"destined": true,
+	"destruct": true,
+	"detached": true,
+	"detector": true,
+	"detonate": true,
+	"detoxify": true,
+	"deviancy": true,
+	"deviator": true,
+	"devotion": true,
+	"devourer": true,
+	"devoutly": true,
+	"diabetes": true,
+	"diabetic": true,
+	"diabolic": true,
+	"diameter": true,
+	"dictator": true,
+	"diffused": true,
+	"diffuser": true,
+	"dilation": true,
+	"diligent": true,
+	"diminish": true,
+	"dinosaur": true,
+	"directed": true,
+	"directly": true,
+	"direness": true,
+	"disabled": true,
+	"disagree": true,
+	"disallow": true,
+	"disarray": true,
+	"disaster": true,
+	"disburse": true,
+	"disclose": true,
+	"discolor": true,
+	"discount": true,
+	"discover": true,
+	"discrete": true,
+	"disgrace": true,
+	"dislodge": true,
+	"disloyal": true,
+	"dismount": true,
+	"disorder": true,
+	"dispatch": true,
+	"dispense": true,
+	"displace": true,
+	"disposal": true,
+	"disprove": true,
+	"dissuade": true,
+	"distance": true,
+	"distaste": true,
+	"distinct": true,
+	"distract": true,
+	"distress": true,
+	"district": true,
+	"distrust": true,
+	"dividend": true,
+	"dividers": true,
+	"dividing": true,
+	"divinely": true,
+	"divinity": true,
+	"division": true,
+	"divisive": true,
+	"divorcee": true,
+	"doberman": true,
+	"doctrine": true,
+	"document": true,
+	"domelike": true,
+	"domestic": true,
+	"dominant": true,
+	"dominion": true,
+	"dominoes": true,
+	"donation": true,
+	"doorbell": true,
+	"doorknob": true,
+	"doornail": true,
+	"doorpost": true,
+	"doorstep": true,
+	"doorstop": true,
+	"doubling": true,
+	"dragging": true,
+	"dragster": true,
+	"drainage": true,
+	"dramatic": true,
+	"dreadful": true,
+	"dreamily": true,
+	"drearily": true,
+	"drilling": true,
+	"drinking": true,
+	"dripping": true,
+	"drivable": true,
+	"driveway": true,
+	"dropkick": true,
+	"drowsily": true,
+	"duckbill": true,
+	"duckling": true,
+	"ducktail": true,
+	"dullness": true,
+	"dumpling": true,
+	"dumpster": true,
+	"duration": true,
+	"dwarfism": true,
+	"dwelling": true,
+	"dynamite": true,
+	"dyslexia": true,
+	"dyslexic": true,
+	"earphone": true,
+	"earpiece": true,
+	"earplugs": true,
+	"easiness": true,
+	"eastward": true,
+	"echoless": true,
+	"economic": true,
+	"edginess": true,
+	"educated": true,
+	"educator": true,
+	"eggplant": true,
+	"eggshell": true,
+	"ejection": true,
+	"election": true,
+	"elective": true,
+	"electric": true,
+	"elephant": true,
+	"elevator": true,
+	"elfishly": true,
+	"eligible": true,
+	"eligibly": true,
+	"elliptic": true,
+	"eloquent": true,
+	"embezzle": true,
+	"embolism": true,
+	"emerging": true,
+	"emission": true,
+	"emoticon": true,
+	"empathic": true,
+	"emphases": true,
+	"emphasis": true,
+	"emphatic": true,
+	"employed": true,
+	"employee": true,
+	"employer": true,
+	"emporium": true,
+	"enabling": true,
+	"encircle": true,
+	"encroach": true,
+	"endanger": true,
+	"endeared": true,
+	"endpoint": true,
+	"enduring": true,
+	"energize": true,
+	"enforced": true,
+	"enforcer": true,
+	"engaging": true,
+	"engraved": true,
+	"engraver": true,
+	"enhanced": true,
+	"enjoying": true,
+	"enlarged": true,
+	"enlisted": true,
+	"enormous": true,
+	"enquirer": true,
+	"ensemble": true,
+	"entering": true,
+	"enticing": true,
+	"entirely": true,
+	"entrench": true,
+	"entryway": true,
+	"envelope": true,
+	"enviable": true,
+	"enviably": true,
+	"envision": true,
+	"epidemic": true,
+	"epidural": true,
+	"epilepsy": true,
+	"epilogue": true,
+	"epiphany": true,
+	"equation": true,
+	"equipped": true,
+	"erasable": true,
+	"eruption": true,
+	"escalate": true,
+	"escapade": true,
+	"escapist": true,
+	"escargot": true,
+	"espresso": true,
+	"esteemed": true,
+	"estimate": true,
+	"estrogen": true,
+	"eternity": true,
+	"evacuate": true,
+	"evaluate": true,
+	"everyday": true,
+	"everyone": true,
+	"evidence": true,
+	"evolving": true,
+	"excavate": true,
+	"exchange": true,
+	"exciting": true,
+	"exercise": true,
+	"existing": true,
+	"exorcism": true,
+	"exorcist": true,
+	"expenses": true,
+	"expiring": true,
+	"explicit": true,
+	"exponent": true,
+	"exporter": true,
+	"exposure": true,
+	"extended": true,
+	"exterior": true,
+	"external": true,
+	"fabulous": true,
+	"facebook": true,
+	"facedown": true,
+	"faceless": true,
+	"facelift": true,
+	"facility": true,
+	"failsafe": true,
+	"faithful": true,
+	"familiar": true,
+	"famished": true,
+	"fastball": true,
+	"fastness": true,
+	"favoring": true,
+	"favorite": true,
+	"feasible": true,
+	"feasibly": true,
+	"february": true,
+	"feedback": true,
+	"feminine": true,
+	"feminism": true,
+	"feminist": true,
+	"feminize": true,
+	"fernlike": true,
+	"ferocity": true,
+	"festival": true,
+	"feverish": true,
+	"fiddling": true,
+	"fidelity": true,
+	"fiftieth": true,
+	"figurine": true,
+	"filtrate": true,
+	"finalist": true,
+	"finalize": true,
+	"fineness": true,
+	"finished": true,
+	"finisher": true,
+	"fiscally": true,
+	"flagpole": true,
+	"flagship": true,
+	"flamingo": true,
+	"flanking": true,
+	"flannels": true,
+	"flashily": true,
+	"flashing": true,
+	"flatfoot": true,
+	"flatness": true,
+	"flattery": true,
+	"flatware": true,
+	"flatworm": true,
+	"flavored": true,
+	"flaxseed": true,
+	"flexible": true,
+	"flogging": true,
+	"flounder": true,
+	"flypaper": true,
+	"foamless": true,
+	"folksong": true,
+	"follicle": true,
+	"fondling": true,
+	"fondness": true,
+	"football": true,
+	"footbath": true,
+	"footgear": true,
+	"foothill": true,
+	"foothold": true,
+	"footless": true,
+	"footnote": true,
+	"footpath": true,
+	"footrest": true,
+	"footsore": true,
+	"footwear": true,
+	"footwork": true,
+	"forcibly": true,
+	"formally": true,
+	"formerly": true,
+	"founding": true,
+	"fountain": true,
+	"foxhound": true,
+	"fraction": true,
+	"fracture": true,
+	"fragment": true,
+	"fragrant": true,
+	"freckled": true,
+	"freckles": true,
+	"freebase": true,
+	"freefall": true,
+	"freehand": true,
+	"freeload": true,
+	"freeness": true,
+	"freeware": true,
+	"freewill": true,
+	"freezing": true,
+	"frenzied": true,
+	"frequent": true,
+	"friction": true,
+	"friendly": true,
+	"frighten": true,
+	"frigidly": true,
+	"frostily": true,
+	"frosting": true,
+	"fructose": true,
+	"frugally": true,
+	"galleria": true,
+	"gambling": true,
+	"gangrene": true,
+	"gaslight": true,
+	"gatherer": true,
+	"gauntlet": true,
+	"generous": true,
+	"genetics": true,
+	"geologic": true,
+	"geometry": true,
+	"geranium": true,
+	"germless": true,
+	"giftshop": true,
+	"gigabyte": true,
+	"gigantic": true,
+	"giggling": true,
+	"giveaway": true,
+	"glancing": true,
+	"glaucoma": true,
+	"gleaming": true,
+	"gloating": true,
+	"globally": true,
+	"gloomily": true,
+	"glorious": true,
+	"glowworm": true,
+	"glycerin": true,
+	"goatskin": true,
+	"goldfish": true,
+	"goldmine": true,
+	"goofball": true,
+	"gorgeous": true,
+	"governor": true,
+	"graceful": true,
+	"gracious": true,
+	"gradient": true,
+	"graduate": true,
+	"graffiti": true,
+	"grafting": true,
+	"granddad": true,
+	"grandkid": true,
+	"grandson": true,
+	"granular": true,
+	"grateful": true,
+	"gratuity": true,
+	"greasily": true,
+	"greedily": true,
+	"greeting": true,
+	"grieving": true,
+	"grievous": true,
+	"grinning": true,
+	"groggily": true,
+	"grooving": true,
+	"grubworm": true,
+	"grudging": true,
+	"grueling": true,
+	"grumpily": true,
+	"guidable": true,
+	"guidance": true,
+	"gullible": true,
+	"gurgling": true,
+	"gyration": true,
+	"habitant": true,
+	"habitual": true,
+	"handball": true,
+	"handbook": true,
+	"handcart": true,
+	"handclap": true,
+	"handcuff": true,
+	"handgrip": true,
+	"handheld": true,
+	"handling": true,
+	"handmade": true,
+	"handpick": true,
+	"handrail": true,
+	"handwash": true,
+	"handwork": true,
+	"handyman": true,
+	"hangnail": true,
+	"hangover": true,
+	"happiest": true,
+	"hardcopy": true,
+	"hardcore": true,
+	"harddisk": true,
+	"hardened": true,
+	"hardener": true,
+	"hardhead": true,
+	"hardness": true,
+	"hardship": true,
+	"hardware": true,
+	"hardwood": true,
+	"harmless": true,
+	"hatchery": true,
+	"hatching": true,
+	"hazelnut": true,
+	"haziness": true,
+	"headache": true,
+	"headband": true,
+	"headgear": true,
+	"headlamp": true,
+	"headless": true,
+	"headlock": true,
+	"headrest": true,
+	"headroom": true,
+	"headsman": true,
+	"headwear": true,
+	"heartily": true,
+	"hedgehog": true,
+	"helpless": true,
+	"helpline": true,
+	"henchman": true,
+	"heritage": true,
+	"hesitant": true,
+	"hesitate": true,
+	"hexagram": true,
+	"honestly": true,
+	"honeybee": true,
+	"hookworm": true,
+	"horribly": true,
+	"hospital": true,
+	"huddling": true,
+	"hugeness": true,
+	"humbling": true,
+	"humility": true,
+	"humorist": true,
+	"humorous": true,
+	"humpback": true,
+	"hungrily": true,
+	"huntress": true,
+	"huntsman": true,
+	"hyacinth": true,
+	"hydrated": true,
+	"hydrogen": true,
+	"hypnoses": true,
+	"hypnosis": true,
+	"hypnotic": true,
+	"idealism": true,
+	"idealist": true,
+	"idealize": true,
+	"identify": true,
+	"identity": true,
+	"ideology": true,
+	"ignition": true,
+	"illusion": true,
+	"illusive": true,
+	"imagines": true,
+	"imbecile": true,
+	"imitator": true,
+	"immature": true,
+	"imminent": true,
+	"immobile": true,
+	"immodest": true,
+	"immortal": true,
+	"immunity": true,
+	"immunize": true,
+	"impaired": true,
+	"impeding": true,
+	"imperial": true,
+	"implicit": true,
+	"impolite": true,
+	"importer": true,
+	"imposing": true,
+	"impotent": true,
+	"imprison": true,
+	"improper": true,
+	"improved": true,
+	"impurity": true,
+	"included": true,
+	"increase": true,
+	"indicate": true,
+	"industry": true,
+	"infinite": true,
+	"informed": true,
+	"innocent": true,
+	"inspired": true,
+	"integral": true,
+	"interest": true,
+	"internal": true,
+	"intimate": true,
+	"inviting": true,
+	"irrigate": true,
+	"irritant": true,
+	"irritate": true,
+	"islamist": true,
+	"isolated": true,
+	"itemizer": true,
+	"jailbird": true,
+	"jalapeno": true,
+	"jamboree": true,
+	"jaundice": true,
+	"jingling": true,
+	"joinable": true,
+	"jokester": true,
+	"jokingly": true,
+	"joyfully": true,
+	"joystick": true,
+	"jubilant": true,
+	"judicial": true,
+	"juggling": true,
+	"junction": true,
+	"juncture": true,
+	"junkyard": true,
+	"justness": true,
+	"juvenile": true,
+	"kamikaze": true,
+	"kangaroo": true,
+	"keenness": true,
+	"keepsake": true,
+	"kerchief": true,
+	"kerosene": true,
+	"killdeer": true,
+	"kilobyte": true,
+	"kilogram": true,
+	"kilowatt": true,
+	"kindling": true,
+	"kindness": true,
+	"kingfish": true,
+	"kissable": true,
+	"knapsack": true,
+	"knickers": true,
+	"laboring": true,
+	"labrador": true,
+	"lacewing": true,
+	"ladybird": true,
+	"ladylike": true,
+	"landfall": true,
+	"landfill": true,
+	"landlady": true,
+	"landless": true,
+	"landline": true,
+	"landlord": true,
+	"landmark": true,
+	"landmass": true,
+	"landmine": true,
+	"landside": true,
+	"language": true,
+	"latitude": true,
+	"latticed": true,
+	"lavender": true,
+	"laxative": true,
+	"laziness": true,
+	"learning": true,
+	"lecturer": true,
+	"leftover": true,
+	"leggings": true,
+	"lethargy": true,
+	"leukemia": true,
+	"leverage": true,
+	"levitate": true,
+	"lewdness": true,
+	"licorice": true,
+	"lifeboat": true,
+	"ligament": true,
+	"likeness": true,
+	"likewise": true,
+	"limpness": true,
+	"linguini": true,
+	"linguist": true,
+	"linoleum": true,
+	"lionfish": true,
+	"lipstick": true,
+	"listless": true,
+	"literate": true,
+	"litigate": true,
+	"longhorn": true,
+	"lucidity": true,
+	"luckless": true,
+	"lukewarm": true,
+	"luminous": true,
+	"lunchbox": true,
+	"luncheon": true,
+	"luscious": true,
+	"lushness": true,
+	"lustrous": true,
+	"lyricism": true,
+	"lyricist": true,
+	"macarena": true,
+	"macaroni": true,
+	"mackerel": true,
+	"magazine": true,
+	"magician": true,
+	"magnetic": true,
+	"magnolia": true,
+	"mahogany": true,
+	"majestic": true,
+	"majority": true,
+	"makeover": true,
+	"malamute": true,
+	"managing": true,
+	"mandarin": true,
+	"mandolin": true,
+	"manicure": true,
+	"manpower": true,
+	"manually": true,
+	"mapmaker": true,
+	"marathon": true,
+	"marbling": true,
+	"marigold": true,
+	"maritime": true,
+	"marmoset": true,
+	"marriage": true,
+	"massager": true,
+	"mastodon": true,
+	"matchbox": true,
+	"matching": true,
+	"material": true,
+	"maternal": true,
+	"maturely": true,
+	"maturing": true,
+	"maturity": true,
+	"maverick": true,
+	"maximize": true,
+	"measured": true,
+	"mechanic": true,
+	"mentally": true,
+	"midnight": true,
+	"mobility": true,
+	"mobilize": true,
+	"moccasin": true,
+	"modified": true,
+	"moisture": true,
+	"molasses": true,
+	"molecule": true,
+	"molehill": true,
+	"momentum": true,
+	"monetary": true,
+	"monetize": true,
+	"mongoose": true,
+	"monkfish": true,
+	"monkhood": true,
+	"monogamy": true,
+	"monogram": true,
+	"monopoly": true,
+	"monorail": true,
+	"monotone": true,
+	"monotype": true,
+	"monoxide": true,
+	"monsieur": true,
+	"monument": true,
+	"moonbeam": true,
+	"moonlike": true,
+	"moonrise": true,
+	"moonwalk": true,
+	"morality": true,
+	"morbidly": true,
+	"morphine": true,
+	"morphing": true,
+	"mortally": true,
+	"mortuary": true,
+	"mosquito": true,
+	"mothball": true,
+	"motivate": true,
+	"mountain": true,
+	"mounting": true,
+	"mournful": true,
+	"mulberry": true,
+	"multiple": true,
+	"multiply": true,
+	"mumbling": true,
+	"munchkin": true,
+	"muscular": true,
+	"mushroom": true,
+	"mutation": true,
+	"mutually": true,
+	"namesake": true,
+	"narrator": true,
+	"national": true,
+	"nativity": true,
+	"naturist": true,
+	"nautical": true,
+	"navigate": true,
+	"nearness": true,
+	"neatness": true,
+	"negation": true,
+	"negative": true,
+	"negligee": true,
+	"neurosis": true,
+	"neurotic": true,
+	"nextdoor": true,
+	"nickname": true,
+	"nicotine": true,
+	"nineteen": true,
+	"nintendo": true,
+	"normally": true,
+	"nuisance": true,
+	"numbness": true,
+	"numerate": true,
+	"numerous": true,
+	"nuptials": true,
+	"nutrient": true,
+	"nutshell": true,
+	"obedient": true,
+	"obituary": true,
+	"obligate": true,
+	"obliging": true,
+	"oblivion": true,
+	"observer": true,
+	"obsessed": true,
+	"obsolete": true,
+	"obstacle": true,
+	"obstruct": true,
+	"occupant": true,
+	"occupier": true,
+	"oiliness": true,
+	"ointment": true,
+	"olympics": true,
+	"omission": true,
+	"omnivore": true,
+	"oncoming": true,
+	"onlooker": true,
+	"onscreen": true,
+	"opaquely": true,
+	"operable": true,
+	"operator": true,
+	"opponent": true,
+	"opposing": true,
+	"opposite": true,
+	"ordinary": true,
+	"oriented": true,
+	"original": true,
+	"outboard": true,
+	"outbound": true,
+	"outbreak": true,
+	"outburst": true,
+	"outclass": true,
+	"outdated": true,
+	"outdoors": true,
+	"outfield": true,
+	"outflank": true,
+	"outgoing": true,
+	"outhouse": true,
+	"outlying": true,
+	"outmatch": true,
+	"outreach": true,
+	"outright": true,
+	"outscore": true,
+	"outshine": true,
+	"outshoot": true,
+	"outsider": true,
+	"outsmart": true,
+	"outtakes": true,
+	"outthink": true,
+	"outweigh": true,
+	"overarch": true,
+	"overbill": true,
+	"overbite": true,
+	"overbook": true,
+	"overcast": true,
+	"overcoat": true,
+	"overcome": true,
+	"overcook": true,
+	"overfeed": true,
+	"overfill": true,
+	"overflow": true,
+	"overfull": true,
+	"overhand": true,
+	"overhang": true,
+	"overhaul": true,
+	"overhead": true,
+	"overhear": true,
+	"overheat": true,
+	"overhung": true,
+	"overkill": true,
+	"overlaid": true,
+	"overload": true,
+	"overlook": true,
+	"overlord": true,
+	"overpass": true,
+	"overplay": true,
+	"overrate": true,
+	"override": true,
+	"overripe": true,
+	"overrule": true,
+	"overshot": true,
+	"oversold": true,
+	"overstay": true,
+	"overstep": true,
+	"overtake": true,
+	"overtime": true,
+	"overtone": true,
+	"overture": true,
+	"overturn": true,
+	"overview": true,
+	"oxymoron": true,
+	"pacifier": true,
+	"pacifism": true,
+	"pacifist": true,
+	"paddling": true,
+	"palpable": true,
+	"pampered": true,
+	"pamperer": true,
+	"pamphlet": true,
+	"pancreas": true,
+	"pandemic": true,
+	"pangolin": true,
+	"panorama": true,
+	"parabola": true,
+	"parakeet": true,
+	"paralyze": true,
+	"parasail": true,
+	"parasite": true,
+	"parmesan": true,
+	"passable": true,
+	"passably": true,
+	"passcode": true,
+	"passerby": true,
+	"passover": true,
+	"passport": true,
+	"password": true,
+	"pastrami": true,
+	"paternal": true,
+	"patience": true,
+	"pavement": true,
+	"pavilion": true,
+	"paycheck": true,
+	"payphone": true,
+	"peaceful": true,
+	"peculiar": true,
+	"peddling": true,
+	"pedicure": true,
+	"pedigree": true,
+	"pegboard": true,
+	"penalize": true,
+	"penknife": true,
+	"pentagon": true,
+	"perceive": true,
+	"perjurer": true,
+	"peroxide": true,
+	"petition": true,
+	"pharmacy": true,
+	"pheasant": true,
+	"phrasing": true,
+	"physical": true,
+	"pipefish": true,
+	"placidly": true,
+	"platform": true,
+	"platinum": true,
+	"platonic": true,
+	"platypus": true,
+	"playable": true,
+	"playback": true,
+	"playlist": true,
+	"playmate": true,
+	"playroom": true,
+	"playtime": true,
+	"pleading": true,
+	"pleasant": true,
+	"pleasing": true,
+	"plethora": true,
+	"plunging": true,
+	"pointing": true,
+	"polished": true,
+	"politely": true,
+	"polliwog": true,
+	"popsicle": true,
+	"populace": true,
+	"populate": true,
+	"porpoise": true,
+	"porridge": true,
+	"portable": true,
+	"porthole": true,
+	"portside": true,
+	"position": true,
+	"positive": true,
+	"possible": true,
+	"possibly": true,
+	"postcard": true,
+	"pouncing": true,
+	"powdered": true,
+	"powerful": true,
+	"practice": true,
+	"praising": true,
+	"prancing": true,
+	"prankish": true,
+	"preacher": true,
+	"preamble": true,
+	"precinct": true,
+	"precious": true,
+	"predator": true,
+	"pregnant": true,
+	"premiere": true,
+	"premises": true,
+	"prenatal": true,
+	"preorder": true,
+	"prepared": true,
+	"pretense": true,
+	"previous": true,
+	"prideful": true,
+	"princess": true,
+	"priority": true,
+	"pristine": true,
+	"probable": true,
+	"probably": true,
+	"proclaim": true,
+	"procurer": true,
+	"prodigal": true,
+	"profound": true,
+	"progress": true,
+	"prologue": true,
+	"promoted": true,
+	"promoter": true,
+	"prompter": true,
+	"promptly": true,
+	"proofing": true,
+	"properly": true,
+	"property": true,
+	"proposal": true,
+	"protegee": true,
+	"protract": true,
+	"protrude": true,
+	"provable": true,
+	"provided": true,
+	"provider": true,
+	"province": true,
+	"prowling": true,
+	"publicly": true,
+	"punctual": true,
+	"punisher": true,
+	"purchase": true,
+	"purebred": true,
+	"pureness": true,
+	"purifier": true,
+	"purplish": true,
+	"pursuant": true,
+	"purveyor": true,
+	"pushcart": true,
+	"pushover": true,
+	"puzzling": true,
+	"quadrant": true,
+	"quaintly": true,
+	"quarters": true,
+	"question": true,
+	"quotable": true,
+	"radiance": true,
+	"radiated": true,
+	"radiator": true,
+	"railroad": true,
+	"rambling": true,
+	"randomly": true,
+	"rational": true,
+	"reabsorb": true,
+	"reaction": true,
+	"reactive": true,
+	"reaffirm": true,
+	"reappear": true,
+	"rearview": true,
+	"reassign": true,
+	"reassure": true,
+	"reattach": true,
+	"reburial": true,
+	"rebuttal": true,
+	"recently": true,
+	"reckless": true,
+	"recliner": true,
+	"recovery": true,
+	"recreate": true,
+	"recycled": true,
+	"recycler": true,
+	"reemerge": true,
+	"refinery": true,
+	"refining": true,
+	"refinish": true,
+	"reforest": true,
+	"reformat": true,
+	"reformed": true,
+	"reformer": true,
+	"refreeze": true,
+	"refusing": true,
+	"register": true,
+	"registry": true,
+	"regulate": true,
+	"reindeer": true,
+	"rekindle": true,
+	"relation": true,
+	"relative": true,
+	"relaxing": true,
+	"relevant": true,
+	"reliable": true,
+	"reliably": true,
+	"reliance": true,
+	"relieved": true,
+	"relocate": true,
+	"remedial": true,
+	"remember": true,
+	"reminder": true,
+	"remotely": true,
+	"removing": true,
+	"renderer": true,
+	"renegade": true,
+	"renewing": true,
+	"renounce": true,
+	"renovate": true,
+	"rentable": true,
+	"reoccupy": true,
+	"repaying": true,
+	"repeated": true,
+	"repeater": true,
+	"rephrase": true,
+	"reporter": true,
+	"reproach": true,
+	"resample": true,
+	"research": true,
+	"reselect": true,
+	"reseller": true,
+	"resemble": true,
+	"resident": true,
+	"residual": true,
+	"resigned": true,
+	"resolute": true,
+	"resolved": true,
+	"resonant": true,
+	"resonate": true,
+	"resource": true,
+	"response": true,
+	"resubmit": true,
+	"resupply": true,
+	"retainer": true,
+	"retiring": true,
+	"retorted": true,
+	"reusable": true,
+	"reverend": true,
+	"reversal": true,
+	"revision": true,
+	"reviving": true,
+	"revolver": true,
+	"rhapsody": true,
+	"rhetoric": true,
+	"richness": true,
+	"riddance": true,
+	"ringtail": true,
+	"ripeness": true,
+	"ripening": true,
+	"rippling": true,
+	"riverbed": true,
+	"riveting": true,
+	"robotics": true,
+	"rockband": true,
+	"rockfish": true,
+	"rocklike": true,
+	"rockstar": true,
+	"romancer": true,
+	"romantic": true,
+	"ropelike": true,
+	"roulette": true,
+	"rounding": true,
+	"roundish": true,
+	"rulebook": true,
+	"rumbling": true,
+	"sabotage": true,
+	"saddling": true,
+	"safeness": true,
+	"sailfish": true,
+	"salaried": true,
+	"salutary": true,
+	"sampling": true,
+	"sanction": true,
+	"sanctity": true,
+	"sandbank": true,
+	"sandfish": true,
+	"sandworm": true,
+	"sanitary": true,
+	"sapphire": true,
+	"satiable": true,
+	"saturate": true,
+	"saturday": true,
+	"scalding": true,
+	"scallion": true,
+	"scalping": true,
+	"scanning": true,
+	"scarcely": true,
+	"scarcity": true,
+	"scarring": true,
+	"scenario": true,
+	"schedule": true,
+	"scheming": true,
+	"schnapps": true,
+	"scissors": true,
+	"scolding": true,
+	"scorpion": true,
+	"scouring": true,
+	"scouting": true,
+	"scowling": true,
+	"scrabble": true,
+	"scraggly": true,
+	"scribble": true,
+	"scribing": true,
+	"scrubbed": true,
+	"scrubber": true,
+	"scrutiny": true,
+	"sculptor": true,
+	"seahorse": true,
+	"seasnail": true,
+	"secluded": true,
+	"secondly": true,
+	"secretly": true,
+	"securely": true,
+	"security": true,
+	"sedation": true,
+	"sedative": true,
+	"sediment": true,
+	"seducing": true,
+	"selected": true,
+	"selector": true,
+	"semantic": true,
+	"semester": true,
+	"semisoft": true,
+	"senorita": true,
+	"sensible": true,
+	"sensibly": true,
+	"sensuous": true,
+	"sentence": true,
+	"sequence": true,
+	"serrated": true,
+	"sessions": true,
+	"settling": true,
+	"severely": true,
+	"severity": true,
+	"shakable": true,
+	"shamrock": true,
+	"sheepdog": true,
+	"shelving": true,
+	"shepherd": true,
+	"shifting": true,
+	"shoplift": true,
+	"shopping": true,
+	"shoptalk": true,
+	"shortage": true,
+	"shortcut": true,
+	"shoulder": true,
+	"showcase": true,
+	"showdown": true,
+	"showgirl": true,
+	"showroom": true,
+	"shrapnel": true,
+	"shredder": true,
+	"shrewdly": true,
+	"shrouded": true,
+	"shucking": true,
+	"siberian": true,
+	"silenced": true,
+	"silencer": true,
+	"silkworm": true,
+	"simplify": true,
+	"singular": true,
+	"sinister": true,
+	"situated": true,
+	"sixtieth": true,
+	"sizzling": true,
+	"skeletal": true,
+	"skeleton": true,
+	"skillful": true,
+	"skimming": true,
+	"skimpily": true,
+	"skincare": true,
+	"skinhead": true,
+	"skinless": true,
+	"skinning": true,
+	"skipping": true,
+	"skirmish": true,
+	"skydiver": true,
+	"skylight": true,
+	"slacking": true,
+	"slapping": true,
+	"slashing": true,
+	"slighted": true,
+	"slightly": true,
+	"slimness": true,
+	"slinging": true,
+	"slobbery": true,
+	"sloppily": true,
+	"smashing": true,
+	"smelting": true,
+	"smoothly": true,
+	"smuggler": true,
+	"smugness": true,
+	"snapshot": true,
+	"sneezing": true,
+	"snipping": true,
+	"snowbird": true,
+	"snowdrop": true,
+	"snowfall": true,
+	"snowless": true,
+	"snowplow": true,
+	"snowshoe": true,
+	"snowsuit": true,
+	"snugness": true,
+	"socially": true,
+	"solution": true,
+	"speakers": true,
+	"spearman": true,
+	"specimen": true,
+	"speckled": true,
+	"spectrum": true,
+	"spelling": true,
+	"spending": true,
+	"spinning": true,
+	"spinster": true,
+	"spirited": true,
+	"splashed": true,
+	"splatter": true,
+	"splendid": true,
+	"splendor": true,
+	"splicing": true,
+	"splinter": true,
+	"splotchy": true,
+	"spoilage": true,
+	"spoiling": true,
+	"spookily": true,
+	"sporting": true,
+	"spotless": true,
+	"spotting": true,
+	"spyglass": true,
+	"squabble": true,
+	"squander": true,
+	"squatted": true,
+	"squatter": true,
+	"squealer": true,
+	"squeegee": true,
+	"squiggle": true,
+	"squiggly": true,
+	"squirrel": true,
+	"stagnant": true,
+	"stagnate": true,
+	"staining": true,
+	"stalling": true,
+	"stallion": true,
+	"stapling": true,
+	"stardust": true,
+	"starfish": true,
+	"starless": true,
+	"starling": true,
+	"starring": true,
+	"starship": true,
+	"starting": true,
+	"starving": true,
+	"steadier": true,
+	"steadily": true,
+	"steering": true,
+	"sterling": true,
+	"stifling": true,
+	"stimulus": true,
+	"stingily": true,
+	"stinging": true,
+	"stingray": true,
+	"stinkbug": true,
+	"stinking": true,
+	"stirring": true,
+	"stoppage": true,
+	"stopping": true,
+	"storable": true,
+	"stowaway": true,
+	"straddle": true,
+	"strained": true,
+	"strainer": true,
+	"stranger": true,
+	"strangle": true,
+	"strategy": true,
+	"strength": true,
+	"stricken": true,
+	"strictly": true,
+	"striking": true,
+	"striving": true,
+	"stroller": true,
+	"strongly": true,
+	"struggle": true,
+	"stubborn": true,
+	"stuffing": true,
+	"stunning": true,
+	"sturdily": true,
+	"sturgeon": true,
+	"stylized": true,
+	"subduing": true,
+	"subfloor": true,
+	"subgroup": true,
+	"sublease": true,
+	"sublevel": true,
+	"submerge": true,
+	"subpanel": true,
+	"subprime": true,
+	"subsonic": true,
+	"subtitle": true,
+	"subtotal": true,
+	"subtract": true,
+	"suddenly": true,
+	"sufferer": true,
+	"suffrage": true,
+	"suitable": true,
+	"suitably": true,
+	"suitcase": true,
+	"sulphate": true,
+	"superior": true,
+	"superjet": true,
+	"superman": true,
+	"supermom": true,
+	"supplier": true,
+	"sureness": true,
+	"surgical": true,
+	"surprise": true,
+	"surround": true,
+	"survival": true,
+	"survivor": true,
+	"suspense": true,
+	"swapping": true,
+	"sweeping": true,
+	"swimming": true,
+	"swimsuit": true,
+	"swimwear": true,
+	"swinging": true,
+	"sycamore": true,
+	"syllable": true,
+	"sympathy": true,
+	"symphony": true,
+	"syndrome": true,
+	"synopses": true,
+	"synopsis": true,
+	"syringes": true,
+	"tableful": true,
+	"tackling": true,
+	"tactical": true,
+	"tactless": true,
+	"tagalong": true,
+	"talented": true,
+	"talisman": true,
+	"tallness": true,
+	"tameness": true,
+	"tapeless": true,
+	"tapering": true,
+	"tapestry": true,
+	"tartness": true,
+	"tastebud": true,
+	"tattered": true,
+	"tattling": true,
+	"teaching": true,
+	"terrapin": true,
+	"terribly": true,
+	"thankful": true,
+	"theology": true,
+	"theorize": true,
+	"thespian": true,
+	"thieving": true,
+	"thievish": true,
+	"thinness": true,
+	"thinning": true,
+	"thirteen": true,
+	"thorough": true,
+	"thousand": true,
+	"threaten": true,
+	"thriving": true,
+	"throttle": true,
+	"throwing": true,
+	"thumping": true,
+	"thursday": true,
+	"tidiness": true,
+	"tightwad": true,
+	"tingling": true,
+	"tinkling": true,
+	"tinsmith": true,
+	"titmouse": true,
+	"together": true,
+	"tolerant": true,
+	"tomorrow": true,
+	"tortoise": true,
+	"touching": true,
+	"traction": true,
+	"trailing": true,
+	"tranquil": true,
+	"transfer": true,
+	"trapdoor": true,
+	"trapping": true,
+	"traverse": true,
+	"travesty": true,
+	"treading": true,
+	"treefrog": true,
+	"trespass": true,
+	"triangle": true,
+	"tribunal": true,
+	"trickery": true,
+	"trickily": true,
+	"tricking": true,
+	"tricolor": true,
+	"tricycle": true,
+	"trillion": true,
+	"trimming": true,
+	"trimness": true,
+	"tripping": true,
+	"trolling": true,
+	"trombone": true,
+	"tropical": true,
+	"trousers": true,
+	"trustful": true,
+	"trusting": true,
+	"tubeless": true,
+	"tumbling": true,
+	"turbofan": true,
+	"turbojet": true,
+	"tutorial": true,
+	"tweezers": true,
+	"twilight": true,
+	"twisting": true,
+	"ultimate": true,
+	"umbrella": true,
+	"unafraid": true,
+	"unbeaten": true,
+	"unbiased": true,
+	"unbitten": true,
+	"unbolted": true,
+	"unbridle": true,
+	"unbroken": true,
+	"unbundle": true,
+	"unburned": true,
+	"unbutton": true,
+	"uncapped": true,
+	"uncaring": true,
+	"uncoated": true,
+	"uncoiled": true,
+	"uncombed": true,
+	"uncommon": true,
+	"uncooked": true,
+	"uncouple": true,
+	"uncurled": true,
+	"underage": true,
+	"underarm": true,
+	"undercut": true,
+	"underdog": true,
+	"underfed": true,
+	"underpay": true,
+	"undertow": true,
+	"underuse": true,
+	"undocked": true,
+	"undusted": true,
+	"unearned": true,
+	"uneasily": true,
+	"unedited": true,
+	"unending": true,
+	"unenvied": true,
+	"unfasten": true,
+	"unfilled": true,
+	"unfitted": true,
+	"unflawed": true,
+	"unframed": true,
+	"unfreeze": true,
+	"unfrozen": true,
+	"unfunded": true,
+	"unglazed": true,
+	"ungloved": true,
+	"ungraded": true,
+	"unguided": true,
+	"unharmed": true,
+	"unheated": true,
+	"unhidden": true,
+	"unicycle": true,
+	"uniquely": true,
+	"unissued": true,
+	"universe": true,
+	"unjustly": true,
+	"unlawful": true,
+	"unleaded": true,
+	"unlikely": true,
+	"unlinked": true,
+	"unlisted": true,
+	"unloaded": true,
+	"unloader": true,
+	"unlocked": true,
+	"unlovely": true,
+	"unloving": true,
+	"unmanned": true,
+	"unmapped": true,
+	"unmarked": true,
+	"unmasked": true,
+	"unmolded": true,
+	"unmoving": true,
+	"unneeded": true,
+	"unopened": true,
+	"unpadded": true,
+	"unpaired": true,
+	"unpeeled": true,
+	"unpicked": true,
+	"unpinned": true,
+	"unplowed": true,
+	"unproven": true,
+	"unranked": true,
+	"unrented": true,
+	"unrigged": true,
+	"unrushed": true,
+	"unsaddle": true,
+	"unsalted": true,
+	"unsavory": true,
+	"unsealed": true,
+	"unseated": true,
+	"unseeing": true,
+	"unseemly": true,
+	"unselect": true,
+	"unshaken": true,
+	"unshaved": true,
+	"unshaven": true,
+	"unsigned": true,
+	"unsliced": true,
+	"unsmooth": true,
+	"unsocial": true,
+	"unsoiled": true,
+	"unsolved": true,
+	"unsorted": true,
+	"unspoken": true,
+	"unstable": true,
+	"unsteady": true,
+	"unstitch": true,
+	"unsubtle": true,
+	"unsubtly": true,
+	"unsuited": true,
+	"untagged": true,
+	"untapped": true,
+	"unthawed": true,
+	"unthread": true,
+	"untimely": true,
+	"untitled": true,
+	"unturned": true,
+	"unusable": true,
+	"unvalued": true,
+	"unvaried": true,
+	"unveiled": true,
+	"unvented": true,
+	"unviable": true,
+	"unwanted": true,
+	"unwashed": true,
+	"unwieldy": true,
+	"unworthy": true,
+	"upcoming": true,
+	"upheaval": true,
+	"uplifted": true,
+	"uppercut": true,
+	"uprising": true,
+	"upstairs": true,
+	"upstream": true,
+	"upstroke": true,
+	"upturned": true,
+	"urethane": true,
+	"urgently": true,
+	"usefully": true,
+	"username": true,
+	"vacation": true,
+	"vagabond": true,
+	"vagrancy": true,
+	"vanquish": true,
+	"variable": true,
+	"variably": true,
+	"vascular": true,
+	"vaseline": true,
+	"vastness": true,
+	"velocity": true,
+	"vendetta": true,
+	"vengeful": true,
+	"venomous": true,
+	"verbally": true,
+	"verified": true,
+	"vertical": true,
+	"vexingly": true,
+	"vicinity": true,
+	"viewable": true,
+	"viewless": true,
+	"vigorous": true,
+	"vineyard": true,
+	"violator": true,
+	"virtuous": true,
+	"viselike": true,
+	"visiting": true,
+	"visually": true,
+	"vitality": true,
+	"vitalize": true,
+	"vitamins": true,
+	"vocalist": true,
+	"vocalize": true,
+	"vocation": true,
+	"volatile": true,
+	"wanderer": true,
+	"washable": true,
+	"washbowl": true,
+	"washroom": true,
+	"waviness": true,
+	"welcomed": true,
+	"werewolf": true,
+	"whacking": true,
+	"whenever": true,
+	"whisking": true,
+	"whomever": true,
+	"whooping": true,
+	"wifeless": true,
+	"wildcard": true,
+	"wildfire": true,
+	"wildfowl": true,
+	"wildland": true,
+	"wildlife": true,
+	"wildness": true,
+	"windmill": true,
+	"winnings": true,
+	"wireless": true,
+	"wishbone": true,
+	"wisplike": true,
+	"wizardry": true,
+	"wobbling": true,
+	"wondrous": true,
+	"woodcock": true,
+	"workable": true,
+	"wreckage": true,
+	"wrecking": true,
+	"wrongful": true,
+	"yearbook": true,
+	"yearling": true,
+	"yearning": true,
+	"zeppelin": true,
+	"zucchini": true,
+}
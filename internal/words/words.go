@@ -1,15307 +1,121 @@
 package words
 
-import "math/rand/v2"
+import (
+	"fmt"
+	"math/rand/v2"
+	"unicode/utf8"
+)
 
-func RandomAnswer() string {
-	randomInt := rand.IntN(len(answerList) + 1)
-	return answerList[randomInt]
+// Shortest, longest and default supported word lengths.
+const (
+	MinLength     = 4
+	MaxLength     = 8
+	DefaultLength = 5
+)
+
+type list struct {
+	answers []string
+	allowed map[string]bool
+}
+
+// Built-in word lists by language code, then by word length.
+var builtin = map[string]map[int]list{
+	"en": {
+		4: {answerList4[:], allowList4},
+		5: {answerList5[:], allowList5},
+		6: {answerList6[:], allowList6},
+		7: {answerList7[:], allowList7},
+		8: {answerList8[:], allowList8},
+	},
+	"es": byLength(spanishAnswers[:], spanishAllowed[:]),
+	"de": byLength(germanAnswers[:], germanAllowed[:]),
+	"pt": byLength(portugueseAnswers[:], portugueseAllowed[:]),
+	"tr": byLength(turkishAnswers[:], turkishAllowed[:]),
+}
+
+// Word lists in use, by word length, and the dictionary of them.
+var (
+	lists = builtin["en"]
+	dict  = &Dictionary{lists}
+)
+
+// Sorts answers and other allowed words by length. Answers are allowed too.
+func byLength(answers, allowed []string) map[int]list {
+	sorted := map[int]list{}
+	add := func(word string, answer bool) {
+		n := utf8.RuneCountInString(word)
+		l := sorted[n]
+		if l.allowed == nil {
+			l.allowed = map[string]bool{}
+		}
+		if answer {
+			l.answers = append(l.answers, word)
+		}
+		l.allowed[word] = true
+		sorted[n] = l
+	}
+
+	for _, word := range answers {
+		add(word, true)
+	}
+	for _, word := range allowed {
+		add(word, false)
+	}
+	return sorted
 }
 
+// Check returns an error if there are no answers of the given length.
+func Check(length int) error {
+	l, ok := lists[length]
+	if !ok {
+		return fmt.Errorf("word length must be between %d and %d", MinLength, MaxLength)
+	}
+	if len(l.answers) == 0 {
+		return fmt.Errorf("there are no %d-letter answers in the word list", length)
+	}
+	return nil
+}
+
+// Picker picks answers from a seeded random source, so the same seed always
+// picks the same answers.
+type Picker struct {
+	rng *rand.Rand
+}
+
+// Returns a picker for the given seed.
+func NewPicker(seed uint64) *Picker {
+	return &Picker{rng: rand.New(rand.NewPCG(seed, 0x776f72646c65))}
+}
+
+// Returns a random answer of the given length.
+func (p *Picker) Answer(length int) string {
+	answers := lists[length].answers
+	return answers[p.rng.IntN(len(answers))]
+}
+
+// Returns every answer of the given length.
+func Answers(length int) []string {
+	return lists[length].answers
+}
+
+// Returns true if the word may be guessed. The list is picked by the number
+// of letters in the word.
 func IsValidWord(word string) bool {
-	return allowList[word]
+	return dict.IsValid(word)
+}
+
+// Dictionary is the words that may be guessed with some word lists. Games are
+// given one, so that they keep their words when other lists come in use.
+type Dictionary struct {
+	lists map[int]list
 }
 
-// All possible answers
-var answerList = [...]string{
-	"aback",
-	"abase",
-	"abate",
-	"abbey",
-	"abbot",
-	"abhor",
-	"abide",
-	"abled",
-	"abode",
-	"abort",
-	"about",
-	"above",
-	"abuse",
-	"abyss",
-	"acorn",
-	"acrid",
-	"actor",
-	"acute",
-	"adage",
-	"adapt",
-	"adept",
-	"admin",
-	"admit",
-	"adobe",
-	"adopt",
-	"adore",
-	"adorn",
-	"adult",
-	"affix",
-	"afire",
-	"afoot",
-	"afoul",
-	"after",
-	"again",
-	"agape",
-	"agate",
-	"agent",
-	"agile",
-	"aging",
-	"aglow",
-	"agony",
-	"agora",
-	"agree",
-	"ahead",
-	"aider",
-	"aisle",
-	"alarm",
-	"album",
-	"alert",
-	"algae",
-	"alibi",
-	"alien",
-	"align",
-	"alike",
-	"alive",
-	"allay",
-	"alley",
-	"allot",
-	"allow",
-	"alloy",
-	"aloft",
-	"alone",
-	"along",
-	"aloof",
-	"aloud",
-	"alpha",
-	"altar",
-	"alter",
-	"amass",
-	"amaze",
-	"amber",
-	"amble",
-	"amend",
-	"amiss",
-	"amity",
-	"among",
-	"ample",
-	"amply",
-	"amuse",
-	"angel",
-	"anger",
-	"angle",
-	"angry",
-	"angst",
-	"anime",
-	"ankle",
-	"annex",
-	"annoy",
-	"annul",
-	"anode",
-	"antic",
-	"anvil",
-	"aorta",
-	"apart",
-	"aphid",
-	"aping",
-	"apnea",
-	"apple",
-	"apply",
-	"apron",
-	"aptly",
-	"arbor",
-	"ardor",
-	"arena",
-	"argue",
-	"arise",
-	"armor",
-	"aroma",
-	"arose",
-	"array",
-	"arrow",
-	"arson",
-	"artsy",
-	"ascot",
-	"ashen",
-	"aside",
-	"askew",
-	"assay",
-	"asset",
-	"atoll",
-	"atone",
-	"attic",
-	"audio",
-	"audit",
-	"augur",
-	"aunty",
-	"avail",
-	"avert",
-	"avian",
-	"avoid",
-	"await",
-	"awake",
-	"award",
-	"aware",
-	"awash",
-	"awful",
-	"awoke",
-	"axial",
-	"axiom",
-	"axion",
-	"azure",
-	"bacon",
-	"badge",
-	"badly",
-	"bagel",
-	"baggy",
-	"baker",
-	"baler",
-	"balmy",
-	"banal",
-	"banjo",
-	"barge",
-	"baron",
-	"basal",
-	"basic",
-	"basil",
-	"basin",
-	"basis",
-	"baste",
-	"batch",
-	"bathe",
-	"baton",
-	"batty",
-	"bawdy",
-	"bayou",
-	"beach",
-	"beady",
-	"beard",
-	"beast",
-	"beech",
-	"beefy",
-	"befit",
-	"began",
-	"begat",
-	"beget",
-	"begin",
-	"begun",
-	"being",
-	"belch",
-	"belie",
-	"belle",
-	"belly",
-	"below",
-	"bench",
-	"beret",
-	"berry",
-	"berth",
-	"beset",
-	"betel",
-	"bevel",
-	"bezel",
-	"bible",
-	"bicep",
-	"biddy",
-	"bigot",
-	"bilge",
-	"billy",
-	"binge",
-	"bingo",
-	"biome",
-	"birch",
-	"birth",
-	"bison",
-	"bitty",
-	"black",
-	"blade",
-	"blame",
-	"bland",
-	"blank",
-	"blare",
-	"blast",
-	"blaze",
-	"bleak",
-	"bleat",
-	"bleed",
-	"bleep",
-	"blend",
-	"bless",
-	"blimp",
-	"blind",
-	"blink",
-	"bliss",
-	"blitz",
-	"bloat",
-	"block",
-	"bloke",
-	"blond",
-	"blood",
-	"bloom",
-	"blown",
-	"bluer",
-	"bluff",
-	"blunt",
-	"blurb",
-	"blurt",
-	"blush",
-	"board",
-	"boast",
-	"bobby",
-	"boney",
-	"bongo",
-	"bonus",
-	"booby",
-	"boost",
-	"booth",
-	"booty",
-	"booze",
-	"boozy",
-	"borax",
-	"borne",
-	"bosom",
-	"bossy",
-	"botch",
-	"bough",
-	"boule",
-	"bound",
-	"bowel",
-	"boxer",
-	"brace",
-	"braid",
-	"brain",
-	"brake",
-	"brand",
-	"brash",
-	"brass",
-	"brave",
-	"bravo",
-	"brawl",
-	"brawn",
-	"bread",
-	"break",
-	"breed",
-	"briar",
-	"bribe",
-	"brick",
-	"bride",
-	"brief",
-	"brine",
-	"bring",
-	"brink",
-	"briny",
-	"brisk",
-	"broad",
-	"broil",
-	"broke",
-	"brood",
-	"brook",
-	"broom",
-	"broth",
-	"brown",
-	"brunt",
-	"brush",
-	"brute",
-	"buddy",
-	"budge",
-	"buggy",
-	"bugle",
-	"build",
-	"built",
-	"bulge",
-	"bulky",
-	"bully",
-	"bunch",
-	"bunny",
-	"burly",
-	"burnt",
-	"burst",
-	"bused",
-	"bushy",
-	"butch",
-	"butte",
-	"buxom",
-	"buyer",
-	"bylaw",
-	"cabal",
-	"cabby",
-	"cabin",
-	"cable",
-	"cacao",
-	"cache",
-	"cacti",
-	"caddy",
-	"cadet",
-	"cagey",
-	"cairn",
-	"camel",
-	"cameo",
-	"canal",
-	"candy",
-	"canny",
-	"canoe",
-	"canon",
-	"caper",
-	"caput",
-	"carat",
-	"cargo",
-	"carol",
-	"carry",
-	"carve",
-	"caste",
-	"catch",
-	"cater",
-	"catty",
-	"caulk",
-	"cause",
-	"cavil",
-	"cease",
-	"cedar",
-	"cello",
-	"chafe",
-	"chaff",
-	"chain",
-	"chair",
-	"chalk",
-	"champ",
-	"chant",
-	"chaos",
-	"chard",
-	"charm",
-	"chart",
-	"chase",
-	"chasm",
-	"cheap",
-	"cheat",
-	"check",
-	"cheek",
-	"cheer",
-	"chess",
-	"chest",
-	"chick",
-	"chide",
-	"chief",
-	"child",
-	"chili",
-	"chill",
-	"chime",
-	"china",
-	"chirp",
-	"chock",
-	"choir",
-	"choke",
-	"chord",
-	"chore",
-	"chose",
-	"chuck",
-	"chump",
-	"chunk",
-	"churn",
-	"chute",
-	"cider",
-	"cigar",
-	"cinch",
-	"circa",
-	"civic",
-	"civil",
-	"clack",
-	"claim",
-	"clamp",
-	"clang",
-	"clank",
-	"clash",
-	"clasp",
-	"class",
-	"clean",
-	"clear",
-	"cleat",
-	"cleft",
-	"clerk",
-	"click",
-	"cliff",
-	"climb",
-	"cling",
-	"clink",
-	"cloak",
-	"clock",
-	"clone",
-	"close",
-	"cloth",
-	"cloud",
-	"clout",
-	"clove",
-	"clown",
-	"cluck",
-	"clued",
-	"clump",
-	"clung",
-	"coach",
-	"coast",
-	"cobra",
-	"cocoa",
-	"colon",
-	"color",
-	"comet",
-	"comfy",
-	"comic",
-	"comma",
-	"conch",
-	"condo",
-	"conic",
-	"copse",
-	"coral",
-	"corer",
-	"corny",
-	"couch",
-	"cough",
-	"could",
-	"count",
-	"coupe",
-	"court",
-	"coven",
-	"cover",
-	"covet",
-	"covey",
-	"cower",
-	"coyly",
-	"crack",
-	"craft",
-	"cramp",
-	"crane",
-	"crank",
-	"crash",
-	"crass",
-	"crate",
-	"crave",
-	"crawl",
-	"craze",
-	"crazy",
-	"creak",
-	"cream",
-	"credo",
-	"creed",
-	"creek",
-	"creep",
-	"creme",
-	"crepe",
-	"crept",
-	"cress",
-	"crest",
-	"crick",
-	"cried",
-	"crier",
-	"crime",
-	"crimp",
-	"crisp",
-	"croak",
-	"crock",
-	"crone",
-	"crony",
-	"crook",
-	"cross",
-	"croup",
-	"crowd",
-	"crown",
-	"crude",
-	"cruel",
-	"crumb",
-	"crump",
-	"crush",
-	"crust",
-	"crypt",
-	"cubic",
-	"cumin",
-	"curio",
-	"curly",
-	"curry",
-	"curse",
-	"curve",
-	"curvy",
-	"cutie",
-	"cyber",
-	"cycle",
-	"cynic",
-	"daddy",
-	"daily",
-	"dairy",
-	"daisy",
-	"dally",
-	"dance",
-	"dandy",
-	"datum",
-	"daunt",
-	"dealt",
-	"death",
-	"debar",
-	"debit",
-	"debug",
-	"debut",
-	"decal",
-	"decay",
-	"decor",
-	"decoy",
-	"decry",
-	"defer",
-	"deign",
-	"deity",
-	"delay",
-	"delta",
-	"delve",
-	"demon",
-	"demur",
-	"denim",
-	"dense",
-	"depot",
-	"depth",
-	"derby",
-	"deter",
-	"detox",
-	"deuce",
-	"devil",
-	"diary",
-	"dicey",
-	"digit",
-	"dilly",
-	"dimly",
-	"diner",
-	"dingo",
-	"dingy",
-	"diode",
-	"dirge",
-	"dirty",
-	"disco",
-	"ditch",
-	"ditto",
-	"ditty",
-	"diver",
-	"dizzy",
-	"dodge",
-	"dodgy",
-	"dogma",
-	"doing",
-	"dolly",
-	"donor",
-	"donut",
-	"dopey",
-	"doubt",
-	"dough",
-	"dowdy",
-	"dowel",
-	"downy",
-	"dowry",
-	"dozen",
-	"draft",
-	"drain",
-	"drake",
-	"drama",
-	"drank",
-	"drape",
-	"drawl",
-	"drawn",
-	"dread",
-	"dream",
-	"dress",
-	"dried",
-	"drier",
-	"drift",
-	"drill",
-	"drink",
-	"drive",
-	"droit",
-	"droll",
-	"drone",
-	"drool",
-	"droop",
-	"dross",
-	"drove",
-	"drown",
-	"druid",
-	"drunk",
-	"dryer",
-	"dryly",
-	"duchy",
-	"dully",
-	"dummy",
-	"dumpy",
-	"dunce",
-	"dusky",
-	"dusty",
-	"dutch",
-	"duvet",
-	"dwarf",
-	"dwell",
-	"dwelt",
-	"dying",
-	"eager",
-	"eagle",
-	"early",
-	"earth",
-	"easel",
-	"eaten",
-	"eater",
-	"ebony",
-	"eclat",
-	"edict",
-	"edify",
-	"eerie",
-	"egret",
-	"eight",
-	"eject",
-	"eking",
-	"elate",
-	"elbow",
-	"elder",
-	"elect",
-	"elegy",
-	"elfin",
-	"elide",
-	"elite",
-	"elope",
-	"elude",
-	"email",
-	"embed",
-	"ember",
-	"emcee",
-	"empty",
-	"enact",
-	"endow",
-	"enema",
-	"enemy",
-	"enjoy",
-	"ennui",
-	"ensue",
-	"enter",
-	"entry",
-	"envoy",
-	"epoch",
-	"epoxy",
-	"equal",
-	"equip",
-	"erase",
-	"erect",
-	"erode",
-	"error",
-	"erupt",
-	"essay",
-	"ester",
-	"ether",
-	"ethic",
-	"ethos",
-	"etude",
-	"evade",
-	"event",
-	"every",
-	"evict",
-	"evoke",
-	"exact",
-	"exalt",
-	"excel",
-	"exert",
-	"exile",
-	"exist",
-	"expel",
-	"extol",
-	"extra",
-	"exult",
-	"eying",
-	"fable",
-	"facet",
-	"faint",
-	"fairy",
-	"faith",
-	"false",
-	"fancy",
-	"fanny",
-	"farce",
-	"fatal",
-	"fatty",
-	"fault",
-	"fauna",
-	"favor",
-	"feast",
-	"fecal",
-	"feign",
-	"fella",
-	"felon",
-	"femme",
-	"femur",
-	"fence",
-	"feral",
-	"ferry",
-	"fetal",
-	"fetch",
-	"fetid",
-	"fetus",
-	"fever",
-	"fewer",
-	"fiber",
-	"fibre",
-	"ficus",
-	"field",
-	"fiend",
-	"fiery",
-	"fifth",
-	"fifty",
-	"fight",
-	"filer",
-	"filet",
-	"filly",
-	"filmy",
-	"filth",
-	"final",
-	"finch",
-	"finer",
-	"first",
-	"fishy",
-	"fixer",
-	"fizzy",
-	"fjord",
-	"flack",
-	"flail",
-	"flair",
-	"flake",
-	"flaky",
-	"flame",
-	"flank",
-	"flare",
-	"flash",
-	"flask",
-	"fleck",
-	"fleet",
-	"flesh",
-	"flick",
-	"flier",
-	"fling",
-	"flint",
-	"flirt",
-	"float",
-	"flock",
-	"flood",
-	"floor",
-	"flora",
-	"floss",
-	"flour",
-	"flout",
-	"flown",
-	"fluff",
-	"fluid",
-	"fluke",
-	"flume",
-	"flung",
-	"flunk",
-	"flush",
-	"flute",
-	"flyer",
-	"foamy",
-	"focal",
-	"focus",
-	"foggy",
-	"foist",
-	"folio",
-	"folly",
-	"foray",
-	"force",
-	"forge",
-	"forgo",
-	"forte",
-	"forth",
-	"forty",
-	"forum",
-	"found",
-	"foyer",
-	"frail",
-	"frame",
-	"frank",
-	"fraud",
-	"freak",
-	"freed",
-	"freer",
-	"fresh",
-	"friar",
-	"fried",
-	"frill",
-	"frisk",
-	"fritz",
-	"frock",
-	"frond",
-	"front",
-	"frost",
-	"froth",
-	"frown",
-	"froze",
-	"fruit",
-	"fudge",
-	"fugue",
-	"fully",
-	"fungi",
-	"funky",
-	"funny",
-	"furor",
-	"furry",
-	"fussy",
-	"fuzzy",
-	"gaffe",
-	"gaily",
-	"gamer",
-	"gamma",
-	"gamut",
-	"gassy",
-	"gaudy",
-	"gauge",
-	"gaunt",
-	"gauze",
-	"gavel",
-	"gawky",
-	"gayer",
-	"gayly",
-	"gazer",
-	"gecko",
-	"geeky",
-	"geese",
-	"genie",
-	"genre",
-	"ghost",
-	"ghoul",
-	"giant",
-	"giddy",
-	"gipsy",
-	"girly",
-	"girth",
-	"given",
-	"giver",
-	"glade",
-	"gland",
-	"glare",
-	"glass",
-	"glaze",
-	"gleam",
-	"glean",
-	"glide",
-	"glint",
-	"gloat",
-	"globe",
-	"gloom",
-	"glory",
-	"gloss",
-	"glove",
-	"glyph",
-	"gnash",
-	"gnome",
-	"godly",
-	"going",
-	"golem",
-	"golly",
-	"gonad",
-	"goner",
-	"goody",
-	"gooey",
-	"goofy",
-	"goose",
-	"gorge",
-	"gouge",
-	"gourd",
-	"grace",
-	"grade",
-	"graft",
-	"grail",
-	"grain",
-	"grand",
-	"grant",
-	"grape",
-	"graph",
-	"grasp",
-	"grass",
-	"grate",
-	"grave",
-	"gravy",
-	"graze",
-	"great",
-	"greed",
-	"green",
-	"greet",
-	"grief",
-	"grill",
-	"grime",
-	"grimy",
-	"grind",
-	"gripe",
-	"groan",
-	"groin",
-	"groom",
-	"grope",
-	"gross",
-	"group",
-	"grout",
-	"grove",
-	"growl",
-	"grown",
-	"gruel",
-	"gruff",
-	"grunt",
-	"guard",
-	"guava",
-	"guess",
-	"guest",
-	"guide",
-	"guild",
-	"guile",
-	"guilt",
-	"guise",
-	"gulch",
-	"gully",
-	"gumbo",
-	"gummy",
-	"guppy",
-	"gusto",
-	"gusty",
-	"gypsy",
-	"habit",
-	"hairy",
-	"halve",
-	"handy",
-	"happy",
-	"hardy",
-	"harem",
-	"harpy",
-	"harry",
-	"harsh",
-	"haste",
-	"hasty",
-	"hatch",
-	"hater",
-	"haunt",
-	"haute",
-	"haven",
-	"havoc",
-	"hazel",
-	"heady",
-	"heard",
-	"heart",
-	"heath",
-	"heave",
-	"heavy",
-	"hedge",
-	"hefty",
-	"heist",
-	"helix",
-	"hello",
-	"hence",
-	"heron",
-	"hilly",
-	"hinge",
-	"hippo",
-	"hippy",
-	"hitch",
-	"hoard",
-	"hobby",
-	"hoist",
-	"holly",
-	"homer",
-	"honey",
-	"honor",
-	"horde",
-	"horny",
-	"horse",
-	"hotel",
-	"hotly",
-	"hound",
-	"house",
-	"hovel",
-	"hover",
-	"howdy",
-	"human",
-	"humid",
-	"humor",
-	"humph",
-	"humus",
-	"hunch",
-	"hunky",
-	"hurry",
-	"husky",
-	"hussy",
-	"hutch",
-	"hydro",
-	"hyena",
-	"hymen",
-	"hyper",
-	"icily",
-	"icing",
-	"ideal",
-	"idiom",
-	"idiot",
-	"idler",
-	"idyll",
-	"igloo",
-	"iliac",
-	"image",
-	"imbue",
-	"impel",
-	"imply",
-	"inane",
-	"inbox",
-	"incur",
-	"index",
-	"inept",
-	"inert",
-	"infer",
-	"ingot",
-	"inlay",
-	"inlet",
-	"inner",
-	"input",
-	"inter",
-	"intro",
-	"ionic",
-	"irate",
-	"irony",
-	"islet",
-	"issue",
-	"itchy",
-	"ivory",
-	"jaunt",
-	"jazzy",
-	"jelly",
-	"jerky",
-	"jetty",
-	"jewel",
-	"jiffy",
-	"joint",
-	"joist",
-	"joker",
-	"jolly",
-	"joust",
-	"judge",
-	"juice",
-	"juicy",
-	"jumbo",
-	"jumpy",
-	"junta",
-	"junto",
-	"juror",
-	"kappa",
-	"karma",
-	"kayak",
-	"kebab",
-	"khaki",
-	"kinky",
-	"kiosk",
-	"kitty",
-	"knack",
-	"knave",
-	"knead",
-	"kneed",
-	"kneel",
-	"knelt",
-	"knife",
-	"knock",
-	"knoll",
-	"known",
-	"koala",
-	"krill",
-	"label",
-	"labor",
-	"laden",
-	"ladle",
-	"lager",
-	"lance",
-	"lanky",
-	"lapel",
-	"lapse",
-	"large",
-	"larva",
-	"lasso",
-	"latch",
-	"later",
-	"lathe",
-	"latte",
-	"laugh",
-	"layer",
-	"leach",
-	"leafy",
-	"leaky",
-	"leant",
-	"leapt",
-	"learn",
-	"lease",
-	"leash",
-	"least",
-	"leave",
-	"ledge",
-	"leech",
-	"leery",
-	"lefty",
-	"legal",
-	"leggy",
-	"lemon",
-	"lemur",
-	"leper",
-	"level",
-	"lever",
-	"libel",
-	"liege",
-	"light",
-	"liken",
-	"lilac",
-	"limbo",
-	"limit",
-	"linen",
-	"liner",
-	"lingo",
-	"lipid",
-	"lithe",
-	"liver",
-	"livid",
-	"llama",
-	"loamy",
-	"loath",
-	"lobby",
-	"local",
-	"locus",
-	"lodge",
-	"lofty",
-	"logic",
-	"login",
-	"loopy",
-	"loose",
-	"lorry",
-	"loser",
-	"louse",
-	"lousy",
-	"lover",
-	"lower",
-	"lowly",
-	"loyal",
-	"lucid",
-	"lucky",
-	"lumen",
-	"lumpy",
-	"lunar",
-	"lunch",
-	"lunge",
-	"lupus",
-	"lurch",
-	"lurid",
-	"lusty",
-	"lying",
-	"lymph",
-	"lynch",
-	"lyric",
-	"macaw",
-	"macho",
-	"macro",
-	"madam",
-	"madly",
-	"mafia",
-	"magic",
-	"magma",
-	"maize",
-	"major",
-	"maker",
-	"mambo",
-	"mamma",
-	"mammy",
-	"manga",
-	"mange",
-	"mango",
-	"mangy",
-	"mania",
-	"manic",
-	"manly",
-	"manor",
-	"maple",
-	"march",
-	"marry",
-	"marsh",
-	"mason",
-	"masse",
-	"match",
-	"matey",
-	"mauve",
-	"maxim",
-	"maybe",
-	"mayor",
-	"mealy",
-	"meant",
-	"meaty",
-	"mecca",
-	"medal",
-	"media",
-	"medic",
-	"melee",
-	"melon",
-	"mercy",
-	"merge",
-	"merit",
-	"merry",
-	"metal",
-	"meter",
-	"metro",
-	"micro",
-	"midge",
-	"midst",
-	"might",
-	"milky",
-	"mimic",
-	"mince",
-	"miner",
-	"minim",
-	"minor",
-	"minty",
-	"minus",
-	"mirth",
-	"miser",
-	"missy",
-	"mocha",
-	"modal",
-	"model",
-	"modem",
-	"mogul",
-	"moist",
-	"molar",
-	"moldy",
-	"money",
-	"month",
-	"moody",
-	"moose",
-	"moral",
-	"moron",
-	"morph",
-	"mossy",
-	"motel",
-	"motif",
-	"motor",
-	"motto",
-	"moult",
-	"mound",
-	"mount",
-	"mourn",
-	"mouse",
-	"mouth",
-	"mover",
-	"movie",
-	"mower",
-	"mucky",
-	"mucus",
-	"muddy",
-	"mulch",
-	"mummy",
-	"munch",
-	"mural",
-	"murky",
-	"mushy",
-	"music",
-	"musky",
-	"musty",
-	"myrrh",
-	"nadir",
-	"naive",
-	"nanny",
-	"nasal",
-	"nasty",
-	"natal",
-	"naval",
-	"navel",
-	"needy",
-	"neigh",
-	"nerdy",
-	"nerve",
-	"never",
-	"newer",
-	"newly",
-	"nicer",
-	"niche",
-	"niece",
-	"night",
-	"ninja",
-	"ninny",
-	"ninth",
-	"noble",
-	"nobly",
-	"noise",
-	"noisy",
-	"nomad",
-	"noose",
-	"north",
-	"nosey",
-	"notch",
-	"novel",
-	"nudge",
-	"nurse",
-	"nutty",
-	"nylon",
-	"nymph",
-	"oaken",
-	"obese",
-	"occur",
-	"ocean",
-	"octal",
-	"octet",
-	"odder",
-	"oddly",
-	"offal",
-	"offer",
-	"often",
-	"olden",
-	"older",
-	"olive",
-	"ombre",
-	"omega",
-	"onion",
-	"onset",
-	"opera",
-	"opine",
-	"opium",
-	"optic",
-	"orbit",
-	"order",
-	"organ",
-	"other",
-	"otter",
-	"ought",
-	"ounce",
-	"outdo",
-	"outer",
-	"outgo",
-	"ovary",
-	"ovate",
-	"overt",
-	"ovine",
-	"ovoid",
-	"owing",
-	"owner",
-	"oxide",
-	"ozone",
-	"paddy",
-	"pagan",
-	"paint",
-	"paler",
-	"palsy",
-	"panel",
-	"panic",
-	"pansy",
-	"papal",
-	"paper",
-	"parer",
-	"parka",
-	"parry",
-	"parse",
-	"party",
-	"pasta",
-	"paste",
-	"pasty",
-	"patch",
-	"patio",
-	"patsy",
-	"patty",
-	"pause",
-	"payee",
-	"payer",
-	"peace",
-	"peach",
-	"pearl",
-	"pecan",
-	"pedal",
-	"penal",
-	"pence",
-	"penne",
-	"penny",
-	"perch",
-	"peril",
-	"perky",
-	"pesky",
-	"pesto",
-	"petal",
-	"petty",
-	"phase",
-	"phone",
-	"phony",
-	"photo",
-	"piano",
-	"picky",
-	"piece",
-	"piety",
-	"piggy",
-	"pilot",
-	"pinch",
-	"piney",
-	"pinky",
-	"pinto",
-	"piper",
-	"pique",
-	"pitch",
-	"pithy",
-	"pivot",
-	"pixel",
-	"pixie",
-	"pizza",
-	"place",
-	"plaid",
-	"plain",
-	"plait",
-	"plane",
-	"plank",
-	"plant",
-	"plate",
-	"plaza",
-	"plead",
-	"pleat",
-	"plied",
-	"plier",
-	"pluck",
-	"plumb",
-	"plume",
-	"plump",
-	"plunk",
-	"plush",
-	"poesy",
-	"point",
-	"poise",
-	"poker",
-	"polar",
-	"polka",
-	"polyp",
-	"pooch",
-	"poppy",
-	"porch",
-	"poser",
-	"posit",
-	"posse",
-	"pouch",
-	"pound",
-	"pouty",
-	"power",
-	"prank",
-	"prawn",
-	"preen",
-	"press",
-	"price",
-	"prick",
-	"pride",
-	"pried",
-	"prime",
-	"primo",
-	"print",
-	"prior",
-	"prism",
-	"privy",
-	"prize",
-	"probe",
-	"prone",
-	"prong",
-	"proof",
-	"prose",
-	"proud",
-	"prove",
-	"prowl",
-	"proxy",
-	"prude",
-	"prune",
-	"psalm",
-	"pubic",
-	"pudgy",
-	"puffy",
-	"pulpy",
-	"pulse",
-	"punch",
-	"pupal",
-	"pupil",
-	"puppy",
-	"puree",
-	"purer",
-	"purge",
-	"purse",
-	"pushy",
-	"putty",
-	"pygmy",
-	"quack",
-	"quail",
-	"quake",
-	"qualm",
-	"quark",
-	"quart",
-	"quash",
-	"quasi",
-	"queen",
-	"queer",
-	"quell",
-	"query",
-	"quest",
-	"queue",
-	"quick",
-	"quiet",
-	"quill",
-	"quilt",
-	"quirk",
-	"quite",
-	"quota",
-	"quote",
-	"quoth",
-	"rabbi",
-	"rabid",
-	"racer",
-	"radar",
-	"radii",
-	"radio",
-	"rainy",
-	"raise",
-	"rajah",
-	"rally",
-	"ralph",
-	"ramen",
-	"ranch",
-	"randy",
-	"range",
-	"rapid",
-	"rarer",
-	"raspy",
-	"ratio",
-	"ratty",
-	"raven",
-	"rayon",
-	"razor",
-	"reach",
-	"react",
-	"ready",
-	"realm",
-	"rearm",
-	"rebar",
-	"rebel",
-	"rebus",
-	"rebut",
-	"recap",
-	"recur",
-	"recut",
-	"reedy",
-	"refer",
-	"refit",
-	"regal",
-	"rehab",
-	"reign",
-	"relax",
-	"relay",
-	"relic",
-	"remit",
-	"renal",
-	"renew",
-	"repay",
-	"repel",
-	"reply",
-	"rerun",
-	"reset",
-	"resin",
-	"retch",
-	"retro",
-	"retry",
-	"reuse",
-	"revel",
-	"revue",
-	"rhino",
-	"rhyme",
-	"rider",
-	"ridge",
-	"rifle",
-	"right",
-	"rigid",
-	"rigor",
-	"rinse",
-	"ripen",
-	"riper",
-	"risen",
-	"riser",
-	"risky",
-	"rival",
-	"river",
-	"rivet",
-	"roach",
-	"roast",
-	"robin",
-	"robot",
-	"rocky",
-	"rodeo",
-	"roger",
-	"rogue",
-	"roomy",
-	"roost",
-	"rotor",
-	"rouge",
-	"rough",
-	"round",
-	"rouse",
-	"route",
-	"rover",
-	"rowdy",
-	"rower",
-	"royal",
-	"ruddy",
-	"ruder",
-	"rugby",
-	"ruler",
-	"rumba",
-	"rumor",
-	"rupee",
-	"rural",
-	"rusty",
-	"sadly",
-	"safer",
-	"saint",
-	"salad",
-	"sally",
-	"salon",
-	"salsa",
-	"salty",
-	"salve",
-	"salvo",
-	"sandy",
-	"saner",
-	"sappy",
-	"sassy",
-	"satin",
-	"satyr",
-	"sauce",
-	"saucy",
-	"sauna",
-	"saute",
-	"savor",
-	"savoy",
-	"savvy",
-	"scald",
-	"scale",
-	"scalp",
-	"scaly",
-	"scamp",
-	"scant",
-	"scare",
-	"scarf",
-	"scary",
-	"scene",
-	"scent",
-	"scion",
-	"scoff",
-	"scold",
-	"scone",
-	"scoop",
-	"scope",
-	"score",
-	"scorn",
-	"scour",
-	"scout",
-	"scowl",
-	"scram",
-	"scrap",
-	"scree",
-	"screw",
-	"scrub",
-	"scrum",
-	"scuba",
-	"sedan",
-	"seedy",
-	"segue",
-	"seize",
-	"semen",
-	"sense",
-	"sepia",
-	"serif",
-	"serum",
-	"serve",
-	"setup",
-	"seven",
-	"sever",
-	"sewer",
-	"shack",
-	"shade",
-	"shady",
-	"shaft",
-	"shake",
-	"shaky",
-	"shale",
-	"shall",
-	"shalt",
-	"shame",
-	"shank",
-	"shape",
-	"shard",
-	"share",
-	"shark",
-	"sharp",
-	"shave",
-	"shawl",
-	"shear",
-	"sheen",
-	"sheep",
-	"sheer",
-	"sheet",
-	"sheik",
-	"shelf",
-	"shell",
-	"shied",
-	"shift",
-	"shine",
-	"shiny",
-	"shire",
-	"shirk",
-	"shirt",
-	"shoal",
-	"shock",
-	"shone",
-	"shook",
-	"shoot",
-	"shore",
-	"shorn",
-	"short",
-	"shout",
-	"shove",
-	"shown",
-	"showy",
-	"shrew",
-	"shrub",
-	"shrug",
-	"shuck",
-	"shunt",
-	"shush",
-	"shyly",
-	"siege",
-	"sieve",
-	"sight",
-	"sigma",
-	"silky",
-	"silly",
-	"since",
-	"sinew",
-	"singe",
-	"siren",
-	"sissy",
-	"sixth",
-	"sixty",
-	"skate",
-	"skier",
-	"skiff",
-	"skill",
-	"skimp",
-	"skirt",
-	"skulk",
-	"skull",
-	"skunk",
-	"slack",
-	"slain",
-	"slang",
-	"slant",
-	"slash",
-	"slate",
-	"slave",
-	"sleek",
-	"sleep",
-	"sleet",
-	"slept",
-	"slice",
-	"slick",
-	"slide",
-	"slime",
-	"slimy",
-	"sling",
-	"slink",
-	"sloop",
-	"slope",
-	"slosh",
-	"sloth",
-	"slump",
-	"slung",
-	"slunk",
-	"slurp",
-	"slush",
-	"slyly",
-	"smack",
-	"small",
-	"smart",
-	"smash",
-	"smear",
-	"smell",
-	"smelt",
-	"smile",
-	"smirk",
-	"smite",
-	"smith",
-	"smock",
-	"smoke",
-	"smoky",
-	"smote",
-	"snack",
-	"snail",
-	"snake",
-	"snaky",
-	"snare",
-	"snarl",
-	"sneak",
-	"sneer",
-	"snide",
-	"sniff",
-	"snipe",
-	"snoop",
-	"snore",
-	"snort",
-	"snout",
-	"snowy",
-	"snuck",
-	"snuff",
-	"soapy",
-	"sober",
-	"soggy",
-	"solar",
-	"solid",
-	"solve",
-	"sonar",
-	"sonic",
-	"sooth",
-	"sooty",
-	"sorry",
-	"sound",
-	"south",
-	"sower",
-	"space",
-	"spade",
-	"spank",
-	"spare",
-	"spark",
-	"spasm",
-	"spawn",
-	"speak",
-	"spear",
-	"speck",
-	"speed",
-	"spell",
-	"spelt",
-	"spend",
-	"spent",
-	"sperm",
-	"spice",
-	"spicy",
-	"spied",
-	"spiel",
-	"spike",
-	"spiky",
-	"spill",
-	"spilt",
-	"spine",
-	"spiny",
-	"spire",
-	"spite",
-	"splat",
-	"split",
-	"spoil",
-	"spoke",
-	"spoof",
-	"spook",
-	"spool",
-	"spoon",
-	"spore",
-	"sport",
-	"spout",
-	"spray",
-	"spree",
-	"sprig",
-	"spunk",
-	"spurn",
-	"spurt",
-	"squad",
-	"squat",
-	"squib",
-	"stack",
-	"staff",
-	"stage",
-	"staid",
-	"stain",
-	"stair",
-	"stake",
-	"stale",
-	"stalk",
-	"stall",
-	"stamp",
-	"stand",
-	"stank",
-	"stare",
-	"stark",
-	"start",
-	"stash",
-	"state",
-	"stave",
-	"stead",
-	"steak",
-	"steal",
-	"steam",
-	"steed",
-	"steel",
-	"steep",
-	"steer",
-	"stein",
-	"stern",
-	"stick",
-	"stiff",
-	"still",
-	"stilt",
-	"sting",
-	"stink",
-	"stint",
-	"stock",
-	"stoic",
-	"stoke",
-	"stole",
-	"stomp",
-	"stone",
-	"stony",
-	"stood",
-	"stool",
-	"stoop",
-	"store",
-	"stork",
-	"storm",
-	"story",
-	"stout",
-	"stove",
-	"strap",
-	"straw",
-	"stray",
-	"strip",
-	"strut",
-	"stuck",
-	"study",
-	"stuff",
-	"stump",
-	"stung",
-	"stunk",
-	"stunt",
-	"style",
-	"suave",
-	"sugar",
-	"suing",
-	"suite",
-	"sulky",
-	"sully",
-	"sumac",
-	"sunny",
-	"super",
-	"surer",
-	"surge",
-	"surly",
-	"sushi",
-	"swami",
-	"swamp",
-	"swarm",
-	"swash",
-	"swath",
-	"swear",
-	"sweat",
-	"sweep",
-	"sweet",
-	"swell",
-	"swept",
-	"swift",
-	"swill",
-	"swine",
-	"swing",
-	"swirl",
-	"swish",
-	"swoon",
-	"swoop",
-	"sword",
-	"swore",
-	"sworn",
-	"swung",
-	"synod",
-	"syrup",
-	"tabby",
-	"table",
-	"taboo",
-	"tacit",
-	"tacky",
-	"taffy",
-	"taint",
-	"taken",
-	"taker",
-	"tally",
-	"talon",
-	"tamer",
-	"tango",
-	"tangy",
-	"taper",
-	"tapir",
-	"tardy",
-	"tarot",
-	"taste",
-	"tasty",
-	"tatty",
-	"taunt",
-	"tawny",
-	"teach",
-	"teary",
-	"tease",
-	"teddy",
-	"teeth",
-	"tempo",
-	"tenet",
-	"tenor",
-	"tense",
-	"tenth",
-	"tepee",
-	"tepid",
-	"terra",
-	"terse",
-	"testy",
-	"thank",
-	"theft",
-	"their",
-	"theme",
-	"there",
-	"these",
-	"theta",
-	"thick",
-	"thief",
-	"thigh",
-	"thing",
-	"think",
-	"third",
-	"thong",
-	"thorn",
-	"those",
-	"three",
-	"threw",
-	"throb",
-	"throw",
-	"thrum",
-	"thumb",
-	"thump",
-	"thyme",
-	"tiara",
-	"tibia",
-	"tidal",
-	"tiger",
-	"tight",
-	"tilde",
-	"timer",
-	"timid",
-	"tipsy",
-	"titan",
-	"tithe",
-	"title",
-	"toast",
-	"today",
-	"toddy",
-	"token",
-	"tonal",
-	"tonga",
-	"tonic",
-	"tooth",
-	"topaz",
-	"topic",
-	"torch",
-	"torso",
-	"torus",
-	"total",
-	"totem",
-	"touch",
-	"tough",
-	"towel",
-	"tower",
-	"toxic",
-	"toxin",
-	"trace",
-	"track",
-	"tract",
-	"trade",
-	"trail",
-	"train",
-	"trait",
-	"tramp",
-	"trash",
-	"trawl",
-	"tread",
-	"treat",
-	"trend",
-	"triad",
-	"trial",
-	"tribe",
-	"trice",
-	"trick",
-	"tried",
-	"tripe",
-	"trite",
-	"troll",
-	"troop",
-	"trope",
-	"trout",
-	"trove",
-	"truce",
-	"truck",
-	"truer",
-	"truly",
-	"trump",
-	"trunk",
-	"truss",
-	"trust",
-	"truth",
-	"tryst",
-	"tubal",
-	"tuber",
-	"tulip",
-	"tulle",
-	"tumor",
-	"tunic",
-	"turbo",
-	"tutor",
-	"twang",
-	"tweak",
-	"tweed",
-	"tweet",
-	"twice",
-	"twine",
-	"twirl",
-	"twist",
-	"twixt",
-	"tying",
-	"udder",
-	"ulcer",
-	"ultra",
-	"umbra",
-	"uncle",
-	"uncut",
-	"under",
-	"undid",
-	"undue",
-	"unfed",
-	"unfit",
-	"unify",
-	"union",
-	"unite",
-	"unity",
-	"unlit",
-	"unmet",
-	"unset",
-	"untie",
-	"until",
-	"unwed",
-	"unzip",
-	"upper",
-	"upset",
-	"urban",
-	"urine",
-	"usage",
-	"usher",
-	"using",
-	"usual",
-	"usurp",
-	"utile",
-	"utter",
-	"vague",
-	"valet",
-	"valid",
-	"valor",
-	"value",
-	"valve",
-	"vapid",
-	"vapor",
-	"vault",
-	"vaunt",
-	"vegan",
-	"venom",
-	"venue",
-	"verge",
-	"verse",
-	"verso",
-	"verve",
-	"vicar",
-	"video",
-	"vigil",
-	"vigor",
-	"villa",
-	"vinyl",
-	"viola",
-	"viper",
-	"viral",
-	"virus",
-	"visit",
-	"visor",
-	"vista",
-	"vital",
-	"vivid",
-	"vixen",
-	"vocal",
-	"vodka",
-	"vogue",
-	"voice",
-	"voila",
-	"vomit",
-	"voter",
-	"vouch",
-	"vowel",
-	"vying",
-	"wacky",
-	"wafer",
-	"wager",
-	"wagon",
-	"waist",
-	"waive",
-	"waltz",
-	"warty",
-	"waste",
-	"watch",
-	"water",
-	"waver",
-	"waxen",
-	"weary",
-	"weave",
-	"wedge",
-	"weedy",
-	"weigh",
-	"weird",
-	"welch",
-	"welsh",
-	"wench",
-	"whack",
-	"whale",
-	"wharf",
-	"wheat",
-	"wheel",
-	"whelp",
-	"where",
-	"which",
-	"whiff",
-	"while",
-	"whine",
-	"whiny",
-	"whirl",
-	"whisk",
-	"white",
-	"whole",
-	"whoop",
-	"whose",
-	"widen",
-	"wider",
-	"widow",
-	"width",
-	"wield",
-	"wight",
-	"willy",
-	"wimpy",
-	"wince",
-	"winch",
-	"windy",
-	"wiser",
-	"wispy",
-	"witch",
-	"witty",
-	"woken",
-	"woman",
-	"women",
-	"woody",
-	"wooer",
-	"wooly",
-	"woozy",
-	"wordy",
-	"world",
-	"worry",
-	"worse",
-	"worst",
-	"worth",
-	"would",
-	"wound",
-	"woven",
-	"wrack",
-	"wrath",
-	"wreak",
-	"wreck",
-	"wrest",
-	"wring",
-	"wrist",
-	"write",
-	"wrong",
-	"wrote",
-	"wrung",
-	"wryly",
-	"yacht",
-	"yearn",
-	"yeast",
-	"yield",
-	"young",
-	"youth",
-	"zebra",
-	"zesty",
-	"zonal",
+// Dict returns the dictionary of the word lists in use.
+func Dict() *Dictionary {
+	return dict
 }
 
-// Allowed words (includes answers from above)
-var allowList = map[string]bool{
-	"aahed": true,
-	"aalii": true,
-	"aargh": true,
-	"aarti": true,
-	"abaca": true,
-	"abaci": true,
-	"aback": true,
-	"abacs": true,
-	"abaft": true,
-	"abaka": true,
-	"abamp": true,
-	"aband": true,
-	"abase": true,
-	"abash": true,
-	"abask": true,
-	"abate": true,
-	"abaya": true,
-	"abbas": true,
-	"abbed": true,
-	"abbes": true,
-	"abbey": true,
-	"abbot": true,
-	"abcee": true,
-	"abeam": true,
-	"abear": true,
-	"abele": true,
-	"abers": true,
-	"abets": true,
-	"abhor": true,
-	"abide": true,
-	"abies": true,
-	"abled": true,
-	"abler": true,
-	"ables": true,
-	"ablet": true,
-	"ablow": true,
-	"abmho": true,
-	"abode": true,
-	"abohm": true,
-	"aboil": true,
-	"aboma": true,
-	"aboon": true,
-	"abord": true,
-	"abore": true,
-	"abort": true,
-	"about": true,
-	"above": true,
-	"abram": true,
-	"abray": true,
-	"abrim": true,
-	"abrin": true,
-	"abris": true,
-	"absey": true,
-	"absit": true,
-	"abuna": true,
-	"abune": true,
-	"abuse": true,
-	"abuts": true,
-	"abuzz": true,
-	"abyes": true,
-	"abysm": true,
-	"abyss": true,
-	"acais": true,
-	"acari": true,
-	"accas": true,
-	"accoy": true,
-	"acerb": true,
-	"acers": true,
-	"aceta": true,
-	"achar": true,
-	"ached": true,
-	"aches": true,
-	"achoo": true,
-	"acids": true,
-	"acidy": true,
-	"acing": true,
-	"acini": true,
-	"ackee": true,
-	"acker": true,
-	"acmes": true,
-	"acmic": true,
-	"acned": true,
-	"acnes": true,
-	"acock": true,
-	"acold": true,
-	"acorn": true,
-	"acred": true,
-	"acres": true,
-	"acrid": true,
-	"acros": true,
-	"acted": true,
-	"actin": true,
-	"acton": true,
-	"actor": true,
-	"acute": true,
-	"acyls": true,
-	"adage": true,
-	"adapt": true,
-	"adaws": true,
-	"adays": true,
-	"adbot": true,
-	"addax": true,
-	"added": true,
-	"adder": true,
-	"addio": true,
-	"addle": true,
-	"adeem": true,
-	"adept": true,
-	"adhan": true,
-	"adieu": true,
-	"adios": true,
-	"adits": true,
-	"adman": true,
-	"admen": true,
-	"admin": true,
-	"admit": true,
-	"admix": true,
-	"adobe": true,
-	"adobo": true,
-	"adopt": true,
-	"adore": true,
-	"adorn": true,
-	"adown": true,
-	"adoze": true,
-	"adrad": true,
-	"adred": true,
-	"adsum": true,
-	"aduki": true,
-	"adult": true,
-	"adunc": true,
-	"adust": true,
-	"advew": true,
-	"adyta": true,
-	"adzed": true,
-	"adzes": true,
-	"aecia": true,
-	"aedes": true,
-	"aegis": true,
-	"aeons": true,
-	"aerie": true,
-	"aeros": true,
-	"aesir": true,
-	"afald": true,
-	"afara": true,
-	"afars": true,
-	"afear": true,
-	"affix": true,
-	"afire": true,
-	"aflaj": true,
-	"afoot": true,
-	"afore": true,
-	"afoul": true,
-	"afrit": true,
-	"afros": true,
-	"after": true,
-	"again": true,
-	"agama": true,
-	"agami": true,
-	"agape": true,
-	"agars": true,
-	"agast": true,
-	"agate": true,
-	"agave": true,
-	"agaze": true,
-	"agene": true,
-	"agent": true,
-	"agers": true,
-	"agger": true,
-	"aggie": true,
-	"aggri": true,
-	"aggro": true,
-	"aggry": true,
-	"aghas": true,
-	"agila": true,
-	"agile": true,
-	"aging": true,
-	"agios": true,
-	"agism": true,
-	"agist": true,
-	"agita": true,
-	"aglee": true,
-	"aglet": true,
-	"agley": true,
-	"agloo": true,
-	"aglow": true,
-	"aglus": true,
-	"agmas": true,
-	"agoge": true,
-	"agone": true,
-	"agons": true,
-	"agony": true,
-	"agood": true,
-	"agora": true,
-	"agree": true,
-	"agria": true,
-	"agrin": true,
-	"agros": true,
-	"agued": true,
-	"agues": true,
-	"aguna": true,
-	"aguti": true,
-	"ahead": true,
-	"aheap": true,
-	"ahent": true,
-	"ahigh": true,
-	"ahind": true,
-	"ahing": true,
-	"ahint": true,
-	"ahold": true,
-	"ahull": true,
-	"ahuru": true,
-	"aidas": true,
-	"aided": true,
-	"aider": true,
-	"aides": true,
-	"aidoi": true,
-	"aidos": true,
-	"aiery": true,
-	"aigas": true,
-	"aight": true,
-	"ailed": true,
-	"aimed": true,
-	"aimer": true,
-	"ainee": true,
-	"ainga": true,
-	"aioli": true,
-	"aired": true,
-	"airer": true,
-	"airns": true,
-	"airth": true,
-	"airts": true,
-	"aisle": true,
-	"aitch": true,
-	"aitus": true,
-	"aiver": true,
-	"aiyee": true,
-	"aizle": true,
-	"ajies": true,
-	"ajiva": true,
-	"ajuga": true,
-	"ajwan": true,
-	"akees": true,
-	"akela": true,
-	"akene": true,
-	"aking": true,
-	"akita": true,
-	"akkas": true,
-	"alaap": true,
-	"alack": true,
-	"alamo": true,
-	"aland": true,
-	"alane": true,
-	"alang": true,
-	"alans": true,
-	"alant": true,
-	"alapa": true,
-	"alaps": true,
-	"alarm": true,
-	"alary": true,
-	"alate": true,
-	"alays": true,
-	"albas": true,
-	"albee": true,
-	"album": true,
-	"alcid": true,
-	"alcos": true,
-	"aldea": true,
-	"alder": true,
-	"aldol": true,
-	"aleck": true,
-	"alecs": true,
-	"alefs": true,
-	"aleft": true,
-	"aleph": true,
-	"alert": true,
-	"alews": true,
-	"aleye": true,
-	"alfas": true,
-	"algae": true,
-	"algal": true,
-	"algas": true,
-	"algid": true,
-	"algin": true,
-	"algor": true,
-	"algum": true,
-	"alias": true,
-	"alibi": true,
-	"alien": true,
-	"alifs": true,
-	"align": true,
-	"alike": true,
-	"aline": true,
-	"alist": true,
-	"alive": true,
-	"aliya": true,
-	"alkie": true,
-	"alkos": true,
-	"alkyd": true,
-	"alkyl": true,
-	"allay": true,
-	"allee": true,
-	"allel": true,
-	"alley": true,
-	"allis": true,
-	"allod": true,
-	"allot": true,
-	"allow": true,
-	"alloy": true,
-	"allyl": true,
-	"almah": true,
-	"almas": true,
-	"almeh": true,
-	"almes": true,
-	"almud": true,
-	"almug": true,
-	"alods": true,
-	"aloed": true,
-	"aloes": true,
-	"aloft": true,
-	"aloha": true,
-	"aloin": true,
-	"alone": true,
-	"along": true,
-	"aloof": true,
-	"aloos": true,
-	"aloud": true,
-	"alowe": true,
-	"alpha": true,
-	"altar": true,
-	"alter": true,
-	"altho": true,
-	"altos": true,
-	"alula": true,
-	"alums": true,
-	"alure": true,
-	"alvar": true,
-	"alway": true,
-	"amahs": true,
-	"amain": true,
-	"amass": true,
-	"amate": true,
-	"amaut": true,
-	"amaze": true,
-	"amban": true,
-	"amber": true,
-	"ambit": true,
-	"amble": true,
-	"ambos": true,
-	"ambry": true,
-	"ameba": true,
-	"ameer": true,
-	"amend": true,
-	"amene": true,
-	"amens": true,
-	"ament": true,
-	"amias": true,
-	"amice": true,
-	"amici": true,
-	"amide": true,
-	"amido": true,
-	"amids": true,
-	"amies": true,
-	"amiga": true,
-	"amigo": true,
-	"amine": true,
-	"amino": true,
-	"amins": true,
-	"amirs": true,
-	"amiss": true,
-	"amity": true,
-	"amlas": true,
-	"amman": true,
-	"ammon": true,
-	"ammos": true,
-	"amnia": true,
-	"amnic": true,
-	"amnio": true,
-	"amoks": true,
-	"amole": true,
-	"among": true,
-	"amort": true,
-	"amour": true,
-	"amove": true,
-	"amowt": true,
-	"amped": true,
-	"ample": true,
-	"amply": true,
-	"ampul": true,
-	"amrit": true,
-	"amuck": true,
-	"amuse": true,
-	"amyls": true,
-	"anana": true,
-	"anata": true,
-	"ancho": true,
-	"ancle": true,
-	"ancon": true,
-	"andro": true,
-	"anear": true,
-	"anele": true,
-	"anent": true,
-	"angas": true,
-	"angel": true,
-	"anger": true,
-	"angle": true,
-	"anglo": true,
-	"angry": true,
-	"angst": true,
-	"anigh": true,
-	"anile": true,
-	"anils": true,
-	"anima": true,
-	"anime": true,
-	"animi": true,
-	"anion": true,
-	"anise": true,
-	"anker": true,
-	"ankhs": true,
-	"ankle": true,
-	"ankus": true,
-	"anlas": true,
-	"annal": true,
-	"annas": true,
-	"annat": true,
-	"annex": true,
-	"annoy": true,
-	"annul": true,
-	"anoas": true,
-	"anode": true,
-	"anole": true,
-	"anomy": true,
-	"ansae": true,
-	"antae": true,
-	"antar": true,
-	"antas": true,
-	"anted": true,
-	"antes": true,
-	"antic": true,
-	"antis": true,
-	"antra": true,
-	"antre": true,
-	"antsy": true,
-	"anura": true,
-	"anvil": true,
-	"anyon": true,
-	"aorta": true,
-	"apace": true,
-	"apage": true,
-	"apaid": true,
-	"apart": true,
-	"apayd": true,
-	"apays": true,
-	"apeak": true,
-	"apeek": true,
-	"apers": true,
-	"apert": true,
-	"apery": true,
-	"apgar": true,
-	"aphid": true,
-	"aphis": true,
-	"apian": true,
-	"aping": true,
-	"apiol": true,
-	"apish": true,
-	"apism": true,
-	"apnea": true,
-	"apode": true,
-	"apods": true,
-	"apoop": true,
-	"aport": true,
-	"appal": true,
-	"appay": true,
-	"appel": true,
-	"apple": true,
-	"apply": true,
-	"appro": true,
-	"appui": true,
-	"appuy": true,
-	"apres": true,
-	"apron": true,
-	"apses": true,
-	"apsis": true,
-	"apsos": true,
-	"apted": true,
-	"apter": true,
-	"aptly": true,
-	"aquae": true,
-	"aquas": true,
-	"araba": true,
-	"araks": true,
-	"arame": true,
-	"arars": true,
-	"arbas": true,
-	"arbor": true,
-	"arced": true,
-	"archi": true,
-	"arcos": true,
-	"arcus": true,
-	"ardeb": true,
-	"ardor": true,
-	"ardri": true,
-	"aread": true,
-	"areae": true,
-	"areal": true,
-	"arear": true,
-	"areas": true,
-	"areca": true,
-	"aredd": true,
-	"arede": true,
-	"arefy": true,
-	"areic": true,
-	"arena": true,
-	"arene": true,
-	"arepa": true,
-	"arere": true,
-	"arete": true,
-	"arets": true,
-	"arett": true,
-	"argal": true,
-	"argan": true,
-	"argil": true,
-	"argle": true,
-	"argol": true,
-	"argon": true,
-	"argot": true,
-	"argue": true,
-	"argus": true,
-	"arhat": true,
-	"arias": true,
-	"ariel": true,
-	"ariki": true,
-	"arils": true,
-	"ariot": true,
-	"arise": true,
-	"arish": true,
-	"arked": true,
-	"arled": true,
-	"arles": true,
-	"armed": true,
-	"armer": true,
-	"armet": true,
-	"armil": true,
-	"armor": true,
-	"arnas": true,
-	"arnut": true,
-	"aroba": true,
-	"aroha": true,
-	"aroid": true,
-	"aroma": true,
-	"arose": true,
-	"arpas": true,
-	"arpen": true,
-	"arrah": true,
-	"arras": true,
-	"array": true,
-	"arret": true,
-	"arris": true,
-	"arrow": true,
-	"arroz": true,
-	"arsed": true,
-	"arses": true,
-	"arsey": true,
-	"arsis": true,
-	"arson": true,
-	"artal": true,
-	"artel": true,
-	"artic": true,
-	"artis": true,
-	"artsy": true,
-	"aruhe": true,
-	"arums": true,
-	"arval": true,
-	"arvee": true,
-	"arvos": true,
-	"aryls": true,
-	"asana": true,
-	"ascon": true,
-	"ascot": true,
-	"ascus": true,
-	"asdic": true,
-	"ashed": true,
-	"ashen": true,
-	"ashes": true,
-	"ashet": true,
-	"aside": true,
-	"asked": true,
-	"asker": true,
-	"askew": true,
-	"askoi": true,
-	"askos": true,
-	"aspen": true,
-	"asper": true,
-	"aspic": true,
-	"aspie": true,
-	"aspis": true,
-	"aspro": true,
-	"assai": true,
-	"assam": true,
-	"assay": true,
-	"asses": true,
-	"asset": true,
-	"assez": true,
-	"assot": true,
-	"aster": true,
-	"astir": true,
-	"astun": true,
-	"asura": true,
-	"asway": true,
-	"aswim": true,
-	"asyla": true,
-	"ataps": true,
-	"ataxy": true,
-	"atigi": true,
-	"atilt": true,
-	"atimy": true,
-	"atlas": true,
-	"atman": true,
-	"atmas": true,
-	"atmos": true,
-	"atocs": true,
-	"atoke": true,
-	"atoks": true,
-	"atoll": true,
-	"atoms": true,
-	"atomy": true,
-	"atone": true,
-	"atony": true,
-	"atopy": true,
-	"atria": true,
-	"atrip": true,
-	"attap": true,
-	"attar": true,
-	"attic": true,
-	"atuas": true,
-	"audad": true,
-	"audio": true,
-	"audit": true,
-	"auger": true,
-	"aught": true,
-	"augur": true,
-	"aulas": true,
-	"aulic": true,
-	"auloi": true,
-	"aulos": true,
-	"aumil": true,
-	"aunes": true,
-	"aunts": true,
-	"aunty": true,
-	"aurae": true,
-	"aural": true,
-	"aurar": true,
-	"auras": true,
-	"aurei": true,
-	"aures": true,
-	"auric": true,
-	"auris": true,
-	"aurum": true,
-	"autos": true,
-	"auxin": true,
-	"avail": true,
-	"avale": true,
-	"avant": true,
-	"avast": true,
-	"avels": true,
-	"avens": true,
-	"avers": true,
-	"avert": true,
-	"avgas": true,
-	"avian": true,
-	"avine": true,
-	"avion": true,
-	"avise": true,
-	"aviso": true,
-	"avize": true,
-	"avoid": true,
-	"avows": true,
-	"avyze": true,
-	"await": true,
-	"awake": true,
-	"award": true,
-	"aware": true,
-	"awarn": true,
-	"awash": true,
-	"awato": true,
-	"awave": true,
-	"aways": true,
-	"awdls": true,
-	"aweel": true,
-	"aweto": true,
-	"awful": true,
-	"awing": true,
-	"awmry": true,
-	"awned": true,
-	"awner": true,
-	"awoke": true,
-	"awols": true,
-	"awork": true,
-	"axels": true,
-	"axial": true,
-	"axile": true,
-	"axils": true,
-	"axing": true,
-	"axiom": true,
-	"axion": true,
-	"axite": true,
-	"axled": true,
-	"axles": true,
-	"axman": true,
-	"axmen": true,
-	"axoid": true,
-	"axone": true,
-	"axons": true,
-	"ayahs": true,
-	"ayaya": true,
-	"ayelp": true,
-	"aygre": true,
-	"ayins": true,
-	"ayont": true,
-	"ayres": true,
-	"ayrie": true,
-	"azans": true,
-	"azide": true,
-	"azido": true,
-	"azine": true,
-	"azlon": true,
-	"azoic": true,
-	"azole": true,
-	"azons": true,
-	"azote": true,
-	"azoth": true,
-	"azuki": true,
-	"azure": true,
-	"azurn": true,
-	"azury": true,
-	"azygy": true,
-	"azyme": true,
-	"azyms": true,
-	"baaed": true,
-	"baals": true,
-	"babas": true,
-	"babel": true,
-	"babes": true,
-	"babka": true,
-	"baboo": true,
-	"babul": true,
-	"babus": true,
-	"bacca": true,
-	"bacco": true,
-	"baccy": true,
-	"bacha": true,
-	"bachs": true,
-	"backs": true,
-	"bacon": true,
-	"baddy": true,
-	"badge": true,
-	"badly": true,
-	"baels": true,
-	"baffs": true,
-	"baffy": true,
-	"bafts": true,
-	"bagel": true,
-	"baggy": true,
-	"baghs": true,
-	"bagie": true,
-	"bahts": true,
-	"bahus": true,
-	"bahut": true,
-	"bails": true,
-	"bairn": true,
-	"baisa": true,
-	"baith": true,
-	"baits": true,
-	"baiza": true,
-	"baize": true,
-	"bajan": true,
-	"bajra": true,
-	"bajri": true,
-	"bajus": true,
-	"baked": true,
-	"baken": true,
-	"baker": true,
-	"bakes": true,
-	"bakra": true,
-	"balas": true,
-	"balds": true,
-	"baldy": true,
-	"baled": true,
-	"baler": true,
-	"bales": true,
-	"balks": true,
-	"balky": true,
-	"balls": true,
-	"bally": true,
-	"balms": true,
-	"balmy": true,
-	"baloo": true,
-	"balsa": true,
-	"balti": true,
-	"balun": true,
-	"balus": true,
-	"bambi": true,
-	"banak": true,
-	"banal": true,
-	"banco": true,
-	"bancs": true,
-	"banda": true,
-	"bandh": true,
-	"bands": true,
-	"bandy": true,
-	"baned": true,
-	"banes": true,
-	"bangs": true,
-	"bania": true,
-	"banjo": true,
-	"banks": true,
-	"banns": true,
-	"bants": true,
-	"bantu": true,
-	"banty": true,
-	"banya": true,
-	"bapus": true,
-	"barbe": true,
-	"barbs": true,
-	"barby": true,
-	"barca": true,
-	"barde": true,
-	"bardo": true,
-	"bards": true,
-	"bardy": true,
-	"bared": true,
-	"barer": true,
-	"bares": true,
-	"barfi": true,
-	"barfs": true,
-	"barge": true,
-	"baric": true,
-	"barks": true,
-	"barky": true,
-	"barms": true,
-	"barmy": true,
-	"barns": true,
-	"barny": true,
-	"baron": true,
-	"barps": true,
-	"barra": true,
-	"barre": true,
-	"barro": true,
-	"barry": true,
-	"barye": true,
-	"basal": true,
-	"basan": true,
-	"based": true,
-	"basen": true,
-	"baser": true,
-	"bases": true,
-	"basho": true,
-	"basic": true,
-	"basij": true,
-	"basil": true,
-	"basin": true,
-	"basis": true,
-	"basks": true,
-	"bason": true,
-	"basse": true,
-	"bassi": true,
-	"basso": true,
-	"bassy": true,
-	"basta": true,
-	"baste": true,
-	"basti": true,
-	"basto": true,
-	"basts": true,
-	"batch": true,
-	"bated": true,
-	"bates": true,
-	"bathe": true,
-	"baths": true,
-	"batik": true,
-	"baton": true,
-	"batta": true,
-	"batts": true,
-	"battu": true,
-	"batty": true,
-	"bauds": true,
-	"bauks": true,
-	"baulk": true,
-	"baurs": true,
-	"bavin": true,
-	"bawds": true,
-	"bawdy": true,
-	"bawks": true,
-	"bawls": true,
-	"bawns": true,
-	"bawrs": true,
-	"bawty": true,
-	"bayed": true,
-	"bayer": true,
-	"bayes": true,
-	"bayle": true,
-	"bayou": true,
-	"bayts": true,
-	"bazar": true,
-	"bazoo": true,
-	"beach": true,
-	"beads": true,
-	"beady": true,
-	"beaks": true,
-	"beaky": true,
-	"beals": true,
-	"beams": true,
-	"beamy": true,
-	"beano": true,
-	"beans": true,
-	"beany": true,
-	"beard": true,
-	"beare": true,
-	"bears": true,
-	"beast": true,
-	"beath": true,
-	"beats": true,
-	"beaty": true,
-	"beaus": true,
-	"beaut": true,
-	"beaux": true,
-	"bebop": true,
-	"becap": true,
-	"becke": true,
-	"becks": true,
-	"bedad": true,
-	"bedel": true,
-	"bedes": true,
-	"bedew": true,
-	"bedim": true,
-	"bedye": true,
-	"beech": true,
-	"beedi": true,
-	"beefs": true,
-	"beefy": true,
-	"beeps": true,
-	"beers": true,
-	"beery": true,
-	"beets": true,
-	"befit": true,
-	"befog": true,
-	"begad": true,
-	"began": true,
-	"begar": true,
-	"begat": true,
-	"begem": true,
-	"beget": true,
-	"begin": true,
-	"begot": true,
-	"begum": true,
-	"begun": true,
-	"beige": true,
-	"beigy": true,
-	"being": true,
-	"beins": true,
-	"bekah": true,
-	"belah": true,
-	"belar": true,
-	"belay": true,
-	"belch": true,
-	"belee": true,
-	"belga": true,
-	"belie": true,
-	"belle": true,
-	"bells": true,
-	"belly": true,
-	"belon": true,
-	"below": true,
-	"belts": true,
-	"bemad": true,
-	"bemas": true,
-	"bemix": true,
-	"bemud": true,
-	"bench": true,
-	"bends": true,
-	"bendy": true,
-	"benes": true,
-	"benet": true,
-	"benga": true,
-	"benis": true,
-	"benne": true,
-	"benni": true,
-	"benny": true,
-	"bento": true,
-	"bents": true,
-	"benty": true,
-	"bepat": true,
-	"beray": true,
-	"beres": true,
-	"beret": true,
-	"bergs": true,
-	"berko": true,
-	"berks": true,
-	"berme": true,
-	"berms": true,
-	"berob": true,
-	"berry": true,
-	"berth": true,
-	"beryl": true,
-	"besat": true,
-	"besaw": true,
-	"besee": true,
-	"beses": true,
-	"beset": true,
-	"besit": true,
-	"besom": true,
-	"besot": true,
-	"besti": true,
-	"bests": true,
-	"betas": true,
-	"beted": true,
-	"betel": true,
-	"betes": true,
-	"beths": true,
-	"betid": true,
-	"beton": true,
-	"betta": true,
-	"betty": true,
-	"bevel": true,
-	"bever": true,
-	"bevor": true,
-	"bevue": true,
-	"bevvy": true,
-	"bewet": true,
-	"bewig": true,
-	"bezel": true,
-	"bezes": true,
-	"bezil": true,
-	"bezzy": true,
-	"bhais": true,
-	"bhaji": true,
-	"bhang": true,
-	"bhats": true,
-	"bhels": true,
-	"bhoot": true,
-	"bhuna": true,
-	"bhuts": true,
-	"biach": true,
-	"biali": true,
-	"bialy": true,
-	"bibbs": true,
-	"bibes": true,
-	"bible": true,
-	"biccy": true,
-	"bicep": true,
-	"bices": true,
-	"biddy": true,
-	"bided": true,
-	"bider": true,
-	"bides": true,
-	"bidet": true,
-	"bidis": true,
-	"bidon": true,
-	"bield": true,
-	"biers": true,
-	"biffo": true,
-	"biffs": true,
-	"biffy": true,
-	"bifid": true,
-	"bigae": true,
-	"biggs": true,
-	"biggy": true,
-	"bigha": true,
-	"bight": true,
-	"bigly": true,
-	"bigos": true,
-	"bigot": true,
-	"bijou": true,
-	"biked": true,
-	"biker": true,
-	"bikes": true,
-	"bikie": true,
-	"bilbo": true,
-	"bilby": true,
-	"biled": true,
-	"biles": true,
-	"bilge": true,
-	"bilgy": true,
-	"bilks": true,
-	"bills": true,
-	"billy": true,
-	"bimah": true,
-	"bimas": true,
-	"bimbo": true,
-	"binal": true,
-	"bindi": true,
-	"binds": true,
-	"biner": true,
-	"bines": true,
-	"binge": true,
-	"bingo": true,
-	"bings": true,
-	"bingy": true,
-	"binit": true,
-	"binks": true,
-	"bints": true,
-	"biogs": true,
-	"biome": true,
-	"biont": true,
-	"biota": true,
-	"biped": true,
-	"bipod": true,
-	"birch": true,
-	"birds": true,
-	"birks": true,
-	"birle": true,
-	"birls": true,
-	"biros": true,
-	"birrs": true,
-	"birse": true,
-	"birsy": true,
-	"birth": true,
-	"bises": true,
-	"bisks": true,
-	"bisom": true,
-	"bison": true,
-	"bitch": true,
-	"biter": true,
-	"bites": true,
-	"bitos": true,
-	"bitou": true,
-	"bitsy": true,
-	"bitte": true,
-	"bitts": true,
-	"bitty": true,
-	"bivia": true,
-	"bivvy": true,
-	"bizes": true,
-	"bizzo": true,
-	"bizzy": true,
-	"blabs": true,
-	"black": true,
-	"blade": true,
-	"blads": true,
-	"blady": true,
-	"blaer": true,
-	"blaes": true,
-	"blaff": true,
-	"blags": true,
-	"blahs": true,
-	"blain": true,
-	"blame": true,
-	"blams": true,
-	"bland": true,
-	"blank": true,
-	"blare": true,
-	"blart": true,
-	"blase": true,
-	"blash": true,
-	"blast": true,
-	"blate": true,
-	"blats": true,
-	"blatt": true,
-	"blaud": true,
-	"blawn": true,
-	"blaws": true,
-	"blays": true,
-	"blaze": true,
-	"bleak": true,
-	"blear": true,
-	"bleat": true,
-	"blebs": true,
-	"blech": true,
-	"bleed": true,
-	"bleep": true,
-	"blees": true,
-	"blend": true,
-	"blent": true,
-	"blert": true,
-	"bless": true,
-	"blest": true,
-	"blets": true,
-	"bleys": true,
-	"blimp": true,
-	"blimy": true,
-	"blind": true,
-	"bling": true,
-	"blini": true,
-	"blink": true,
-	"blins": true,
-	"bliny": true,
-	"blips": true,
-	"bliss": true,
-	"blist": true,
-	"blite": true,
-	"blits": true,
-	"blitz": true,
-	"blive": true,
-	"bloat": true,
-	"blobs": true,
-	"block": true,
-	"blocs": true,
-	"blogs": true,
-	"bloke": true,
-	"blond": true,
-	"blood": true,
-	"blook": true,
-	"bloom": true,
-	"bloop": true,
-	"blore": true,
-	"blots": true,
-	"blown": true,
-	"blows": true,
-	"blowy": true,
-	"blubs": true,
-	"blude": true,
-	"bluds": true,
-	"bludy": true,
-	"blued": true,
-	"bluer": true,
-	"blues": true,
-	"bluet": true,
-	"bluey": true,
-	"bluff": true,
-	"bluid": true,
-	"blume": true,
-	"blunk": true,
-	"blunt": true,
-	"blurb": true,
-	"blurs": true,
-	"blurt": true,
-	"blush": true,
-	"blype": true,
-	"boabs": true,
-	"boaks": true,
-	"board": true,
-	"boars": true,
-	"boart": true,
-	"boast": true,
-	"boats": true,
-	"bobac": true,
-	"bobak": true,
-	"bobas": true,
-	"bobby": true,
-	"bobol": true,
-	"bobos": true,
-	"bocca": true,
-	"bocce": true,
-	"bocci": true,
-	"boche": true,
-	"bocks": true,
-	"boded": true,
-	"bodes": true,
-	"bodge": true,
-	"bodhi": true,
-	"bodle": true,
-	"boeps": true,
-	"boets": true,
-	"boeuf": true,
-	"boffo": true,
-	"boffs": true,
-	"bogan": true,
-	"bogey": true,
-	"boggy": true,
-	"bogie": true,
-	"bogle": true,
-	"bogue": true,
-	"bogus": true,
-	"bohea": true,
-	"bohos": true,
-	"boils": true,
-	"boing": true,
-	"boink": true,
-	"boite": true,
-	"boked": true,
-	"bokeh": true,
-	"bokes": true,
-	"bokos": true,
-	"bolar": true,
-	"bolas": true,
-	"bolds": true,
-	"boles": true,
-	"bolix": true,
-	"bolls": true,
-	"bolos": true,
-	"bolts": true,
-	"bolus": true,
-	"bomas": true,
-	"bombe": true,
-	"bombo": true,
-	"bombs": true,
-	"bonce": true,
-	"bonds": true,
-	"boned": true,
-	"boner": true,
-	"bones": true,
-	"boney": true,
-	"bongo": true,
-	"bongs": true,
-	"bonie": true,
-	"bonks": true,
-	"bonne": true,
-	"bonny": true,
-	"bonus": true,
-	"bonza": true,
-	"bonze": true,
-	"booai": true,
-	"booay": true,
-	"boobs": true,
-	"booby": true,
-	"boody": true,
-	"booed": true,
-	"boofy": true,
-	"boogy": true,
-	"boohs": true,
-	"books": true,
-	"booky": true,
-	"bools": true,
-	"booms": true,
-	"boomy": true,
-	"boong": true,
-	"boons": true,
-	"boord": true,
-	"boors": true,
-	"boose": true,
-	"boost": true,
-	"booth": true,
-	"boots": true,
-	"booty": true,
-	"booze": true,
-	"boozy": true,
-	"boppy": true,
-	"borak": true,
-	"boral": true,
-	"boras": true,
-	"borax": true,
-	"borde": true,
-	"bords": true,
-	"bored": true,
-	"boree": true,
-	"borel": true,
-	"borer": true,
-	"bores": true,
-	"borgo": true,
-	"boric": true,
-	"borks": true,
-	"borms": true,
-	"borna": true,
-	"borne": true,
-	"boron": true,
-	"borts": true,
-	"borty": true,
-	"bortz": true,
-	"bosie": true,
-	"bosks": true,
-	"bosky": true,
-	"bosom": true,
-	"boson": true,
-	"bossy": true,
-	"bosun": true,
-	"botas": true,
-	"botch": true,
-	"botel": true,
-	"botes": true,
-	"bothy": true,
-	"botte": true,
-	"botts": true,
-	"botty": true,
-	"bouge": true,
-	"bough": true,
-	"bouks": true,
-	"boule": true,
-	"boult": true,
-	"bound": true,
-	"bouns": true,
-	"bourd": true,
-	"bourg": true,
-	"bourn": true,
-	"bouse": true,
-	"bousy": true,
-	"bouts": true,
-	"bovid": true,
-	"bowat": true,
-	"bowed": true,
-	"bowel": true,
-	"bower": true,
-	"bowes": true,
-	"bowet": true,
-	"bowie": true,
-	"bowls": true,
-	"bowne": true,
-	"bowrs": true,
-	"bowse": true,
-	"boxed": true,
-	"boxen": true,
-	"boxer": true,
-	"boxes": true,
-	"boxla": true,
-	"boxty": true,
-	"boyar": true,
-	"boyau": true,
-	"boyed": true,
-	"boyfs": true,
-	"boygs": true,
-	"boyla": true,
-	"boyos": true,
-	"boysy": true,
-	"bozos": true,
-	"braai": true,
-	"brace": true,
-	"brach": true,
-	"brack": true,
-	"bract": true,
-	"brads": true,
-	"braes": true,
-	"brags": true,
-	"braid": true,
-	"brail": true,
-	"brain": true,
-	"brake": true,
-	"braks": true,
-	"braky": true,
-	"brame": true,
-	"brand": true,
-	"brane": true,
-	"brank": true,
-	"brans": true,
-	"brant": true,
-	"brash": true,
-	"brass": true,
-	"brast": true,
-	"brats": true,
-	"brava": true,
-	"brave": true,
-	"bravi": true,
-	"bravo": true,
-	"brawl": true,
-	"brawn": true,
-	"braws": true,
-	"braxy": true,
-	"brays": true,
-	"braza": true,
-	"braze": true,
-	"bread": true,
-	"break": true,
-	"bream": true,
-	"brede": true,
-	"breds": true,
-	"breed": true,
-	"breem": true,
-	"breer": true,
-	"brees": true,
-	"breid": true,
-	"breis": true,
-	"breme": true,
-	"brens": true,
-	"brent": true,
-	"brere": true,
-	"brers": true,
-	"breve": true,
-	"brews": true,
-	"breys": true,
-	"briar": true,
-	"bribe": true,
-	"brick": true,
-	"bride": true,
-	"brief": true,
-	"brier": true,
-	"bries": true,
-	"brigs": true,
-	"briki": true,
-	"briks": true,
-	"brill": true,
-	"brims": true,
-	"brine": true,
-	"bring": true,
-	"brink": true,
-	"brins": true,
-	"briny": true,
-	"brios": true,
-	"brise": true,
-	"brisk": true,
-	"briss": true,
-	"brith": true,
-	"brits": true,
-	"britt": true,
-	"brize": true,
-	"broad": true,
-	"broch": true,
-	"brock": true,
-	"brods": true,
-	"brogh": true,
-	"brogs": true,
-	"broil": true,
-	"broke": true,
-	"brome": true,
-	"bromo": true,
-	"bronc": true,
-	"brond": true,
-	"brood": true,
-	"brook": true,
-	"brool": true,
-	"broom": true,
-	"broos": true,
-	"brose": true,
-	"brosy": true,
-	"broth": true,
-	"brown": true,
-	"brows": true,
-	"brugh": true,
-	"bruin": true,
-	"bruit": true,
-	"brule": true,
-	"brume": true,
-	"brung": true,
-	"brunt": true,
-	"brush": true,
-	"brusk": true,
-	"brust": true,
-	"brute": true,
-	"bruts": true,
-	"buats": true,
-	"buaze": true,
-	"bubal": true,
-	"bubas": true,
-	"bubba": true,
-	"bubbe": true,
-	"bubby": true,
-	"bubus": true,
-	"buchu": true,
-	"bucko": true,
-	"bucks": true,
-	"bucku": true,
-	"budas": true,
-	"buddy": true,
-	"budge": true,
-	"budis": true,
-	"budos": true,
-	"buffa": true,
-	"buffe": true,
-	"buffi": true,
-	"buffo": true,
-	"buffs": true,
-	"buffy": true,
-	"bufos": true,
-	"bufty": true,
-	"buggy": true,
-	"bugle": true,
-	"buhls": true,
-	"buhrs": true,
-	"buiks": true,
-	"build": true,
-	"built": true,
-	"buist": true,
-	"bukes": true,
-	"bulbs": true,
-	"bulge": true,
-	"bulgy": true,
-	"bulks": true,
-	"bulky": true,
-	"bulla": true,
-	"bulls": true,
-	"bully": true,
-	"bulse": true,
-	"bumbo": true,
-	"bumfs": true,
-	"bumph": true,
-	"bumps": true,
-	"bumpy": true,
-	"bunas": true,
-	"bunce": true,
-	"bunch": true,
-	"bunco": true,
-	"bunde": true,
-	"bundh": true,
-	"bunds": true,
-	"bundt": true,
-	"bundu": true,
-	"bundy": true,
-	"bungs": true,
-	"bungy": true,
-	"bunia": true,
-	"bunje": true,
-	"bunjy": true,
-	"bunko": true,
-	"bunks": true,
-	"bunns": true,
-	"bunny": true,
-	"bunts": true,
-	"bunty": true,
-	"bunya": true,
-	"buoys": true,
-	"buppy": true,
-	"buran": true,
-	"buras": true,
-	"burbs": true,
-	"burds": true,
-	"buret": true,
-	"burfi": true,
-	"burgh": true,
-	"burgs": true,
-	"burin": true,
-	"burka": true,
-	"burke": true,
-	"burks": true,
-	"burls": true,
-	"burly": true,
-	"burns": true,
-	"burnt": true,
-	"buroo": true,
-	"burps": true,
-	"burqa": true,
-	"burro": true,
-	"burrs": true,
-	"burry": true,
-	"bursa": true,
-	"burse": true,
-	"burst": true,
-	"busby": true,
-	"bused": true,
-	"buses": true,
-	"bushy": true,
-	"busks": true,
-	"busky": true,
-	"bussu": true,
-	"busti": true,
-	"busts": true,
-	"busty": true,
-	"butch": true,
-	"buteo": true,
-	"butes": true,
-	"butle": true,
-	"butoh": true,
-	"butte": true,
-	"butts": true,
-	"butty": true,
-	"butut": true,
-	"butyl": true,
-	"buxom": true,
-	"buyer": true,
-	"buzzy": true,
-	"bwana": true,
-	"bwazi": true,
-	"byded": true,
-	"bydes": true,
-	"byked": true,
-	"bykes": true,
-	"bylaw": true,
-	"byres": true,
-	"byrls": true,
-	"byssi": true,
-	"bytes": true,
-	"byway": true,
-	"caaed": true,
-	"cabal": true,
-	"cabas": true,
-	"cabby": true,
-	"caber": true,
-	"cabin": true,
-	"cable": true,
-	"cabob": true,
-	"caboc": true,
-	"cabre": true,
-	"cacao": true,
-	"cacas": true,
-	"cache": true,
-	"cacks": true,
-	"cacky": true,
-	"cacti": true,
-	"caddy": true,
-	"cadee": true,
-	"cades": true,
-	"cadet": true,
-	"cadge": true,
-	"cadgy": true,
-	"cadie": true,
-	"cadis": true,
-	"cadre": true,
-	"caeca": true,
-	"caese": true,
-	"cafes": true,
-	"caffs": true,
-	"caged": true,
-	"cager": true,
-	"cages": true,
-	"cagey": true,
-	"cagot": true,
-	"cahow": true,
-	"caids": true,
-	"cains": true,
-	"caird": true,
-	"cairn": true,
-	"cajon": true,
-	"cajun": true,
-	"caked": true,
-	"cakes": true,
-	"cakey": true,
-	"calfs": true,
-	"calid": true,
-	"calif": true,
-	"calix": true,
-	"calks": true,
-	"calla": true,
-	"calls": true,
-	"calms": true,
-	"calmy": true,
-	"calos": true,
-	"calpa": true,
-	"calps": true,
-	"calve": true,
-	"calyx": true,
-	"caman": true,
-	"camas": true,
-	"camel": true,
-	"cameo": true,
-	"cames": true,
-	"camis": true,
-	"camos": true,
-	"campi": true,
-	"campo": true,
-	"camps": true,
-	"campy": true,
-	"camus": true,
-	"canal": true,
-	"candy": true,
-	"caned": true,
-	"caneh": true,
-	"caner": true,
-	"canes": true,
-	"cangs": true,
-	"canid": true,
-	"canna": true,
-	"canns": true,
-	"canny": true,
-	"canoe": true,
-	"canon": true,
-	"canso": true,
-	"canst": true,
-	"canto": true,
-	"cants": true,
-	"canty": true,
-	"capas": true,
-	"caped": true,
-	"caper": true,
-	"capes": true,
-	"capex": true,
-	"caphs": true,
-	"capiz": true,
-	"caple": true,
-	"capon": true,
-	"capos": true,
-	"capot": true,
-	"capri": true,
-	"capul": true,
-	"caput": true,
-	"carap": true,
-	"carat": true,
-	"carbo": true,
-	"carbs": true,
-	"carby": true,
-	"cardi": true,
-	"cards": true,
-	"cardy": true,
-	"cared": true,
-	"carer": true,
-	"cares": true,
-	"caret": true,
-	"carex": true,
-	"cargo": true,
-	"carks": true,
-	"carle": true,
-	"carls": true,
-	"carns": true,
-	"carny": true,
-	"carob": true,
-	"carol": true,
-	"carom": true,
-	"caron": true,
-	"carpi": true,
-	"carps": true,
-	"carrs": true,
-	"carry": true,
-	"carse": true,
-	"carta": true,
-	"carte": true,
-	"carts": true,
-	"carve": true,
-	"carvy": true,
-	"casas": true,
-	"casco": true,
-	"cased": true,
-	"cases": true,
-	"casks": true,
-	"casky": true,
-	"caste": true,
-	"casts": true,
-	"casus": true,
-	"catch": true,
-	"cater": true,
-	"cates": true,
-	"catty": true,
-	"cauda": true,
-	"cauks": true,
-	"cauld": true,
-	"caulk": true,
-	"cauls": true,
-	"caums": true,
-	"caups": true,
-	"cauri": true,
-	"causa": true,
-	"cause": true,
-	"cavas": true,
-	"caved": true,
-	"cavel": true,
-	"caver": true,
-	"caves": true,
-	"cavie": true,
-	"cavil": true,
-	"cawed": true,
-	"cawks": true,
-	"caxon": true,
-	"cease": true,
-	"ceaze": true,
-	"cebid": true,
-	"cecal": true,
-	"cecum": true,
-	"cedar": true,
-	"ceded": true,
-	"ceder": true,
-	"cedes": true,
-	"cedis": true,
-	"ceiba": true,
-	"ceili": true,
-	"ceils": true,
-	"celeb": true,
-	"cella": true,
-	"celli": true,
-	"cello": true,
-	"cells": true,
-	"celom": true,
-	"celts": true,
-	"cense": true,
-	"cento": true,
-	"cents": true,
-	"centu": true,
-	"ceorl": true,
-	"cepes": true,
-	"cerci": true,
-	"cered": true,
-	"ceres": true,
-	"cerge": true,
-	"ceria": true,
-	"ceric": true,
-	"cerne": true,
-	"ceroc": true,
-	"ceros": true,
-	"certs": true,
-	"certy": true,
-	"cesse": true,
-	"cesta": true,
-	"cesti": true,
-	"cetes": true,
-	"cetyl": true,
-	"cezve": true,
-	"chace": true,
-	"chack": true,
-	"chaco": true,
-	"chado": true,
-	"chads": true,
-	"chafe": true,
-	"chaff": true,
-	"chaft": true,
-	"chain": true,
-	"chair": true,
-	"chais": true,
-	"chalk": true,
-	"chals": true,
-	"champ": true,
-	"chams": true,
-	"chana": true,
-	"chang": true,
-	"chank": true,
-	"chant": true,
-	"chaos": true,
-	"chape": true,
-	"chaps": true,
-	"chapt": true,
-	"chara": true,
-	"chard": true,
-	"chare": true,
-	"chark": true,
-	"charm": true,
-	"charr": true,
-	"chars": true,
-	"chart": true,
-	"chary": true,
-	"chase": true,
-	"chasm": true,
-	"chats": true,
-	"chave": true,
-	"chavs": true,
-	"chawk": true,
-	"chaws": true,
-	"chaya": true,
-	"chays": true,
-	"cheap": true,
-	"cheat": true,
-	"check": true,
-	"cheek": true,
-	"cheep": true,
-	"cheer": true,
-	"chefs": true,
-	"cheka": true,
-	"chela": true,
-	"chelp": true,
-	"chemo": true,
-	"chems": true,
-	"chere": true,
-	"chert": true,
-	"chess": true,
-	"chest": true,
-	"cheth": true,
-	"chevy": true,
-	"chews": true,
-	"chewy": true,
-	"chiao": true,
-	"chias": true,
-	"chibs": true,
-	"chica": true,
-	"chich": true,
-	"chick": true,
-	"chico": true,
-	"chics": true,
-	"chide": true,
-	"chief": true,
-	"chiel": true,
-	"chiks": true,
-	"child": true,
-	"chile": true,
-	"chili": true,
-	"chill": true,
-	"chimb": true,
-	"chime": true,
-	"chimo": true,
-	"chimp": true,
-	"china": true,
-	"chine": true,
-	"ching": true,
-	"chink": true,
-	"chino": true,
-	"chins": true,
-	"chips": true,
-	"chirk": true,
-	"chirl": true,
-	"chirm": true,
-	"chiro": true,
-	"chirp": true,
-	"chirr": true,
-	"chirt": true,
-	"chiru": true,
-	"chits": true,
-	"chive": true,
-	"chivs": true,
-	"chivy": true,
-	"chizz": true,
-	"chock": true,
-	"choco": true,
-	"chocs": true,
-	"chode": true,
-	"chogs": true,
-	"choil": true,
-	"choir": true,
-	"choke": true,
-	"choko": true,
-	"choky": true,
-	"chola": true,
-	"choli": true,
-	"cholo": true,
-	"chomp": true,
-	"chons": true,
-	"choof": true,
-	"chook": true,
-	"choom": true,
-	"choon": true,
-	"chops": true,
-	"chord": true,
-	"chore": true,
-	"chose": true,
-	"chota": true,
-	"chott": true,
-	"chout": true,
-	"choux": true,
-	"chowk": true,
-	"chows": true,
-	"chubs": true,
-	"chuck": true,
-	"chufa": true,
-	"chuff": true,
-	"chugs": true,
-	"chump": true,
-	"chums": true,
-	"chunk": true,
-	"churl": true,
-	"churn": true,
-	"churr": true,
-	"chuse": true,
-	"chute": true,
-	"chuts": true,
-	"chyle": true,
-	"chyme": true,
-	"chynd": true,
-	"cibol": true,
-	"cided": true,
-	"cider": true,
-	"cides": true,
-	"ciels": true,
-	"cigar": true,
-	"ciggy": true,
-	"cilia": true,
-	"cills": true,
-	"cimar": true,
-	"cimex": true,
-	"cinch": true,
-	"cinct": true,
-	"cines": true,
-	"cinqs": true,
-	"cions": true,
-	"cippi": true,
-	"circa": true,
-	"circs": true,
-	"cires": true,
-	"cirls": true,
-	"cirri": true,
-	"cisco": true,
-	"cissy": true,
-	"cists": true,
-	"cital": true,
-	"cited": true,
-	"citer": true,
-	"cites": true,
-	"cives": true,
-	"civet": true,
-	"civic": true,
-	"civie": true,
-	"civil": true,
-	"civvy": true,
-	"clach": true,
-	"clack": true,
-	"clade": true,
-	"clads": true,
-	"claes": true,
-	"clags": true,
-	"claim": true,
-	"clame": true,
-	"clamp": true,
-	"clams": true,
-	"clang": true,
-	"clank": true,
-	"clans": true,
-	"claps": true,
-	"clapt": true,
-	"claro": true,
-	"clart": true,
-	"clary": true,
-	"clash": true,
-	"clasp": true,
-	"class": true,
-	"clast": true,
-	"clats": true,
-	"claut": true,
-	"clave": true,
-	"clavi": true,
-	"claws": true,
-	"clays": true,
-	"clean": true,
-	"clear": true,
-	"cleat": true,
-	"cleck": true,
-	"cleek": true,
-	"cleep": true,
-	"clefs": true,
-	"cleft": true,
-	"clegs": true,
-	"cleik": true,
-	"clems": true,
-	"clepe": true,
-	"clept": true,
-	"clerk": true,
-	"cleve": true,
-	"clews": true,
-	"click": true,
-	"clied": true,
-	"clies": true,
-	"cliff": true,
-	"clift": true,
-	"climb": true,
-	"clime": true,
-	"cline": true,
-	"cling": true,
-	"clink": true,
-	"clint": true,
-	"clipe": true,
-	"clips": true,
-	"clipt": true,
-	"clits": true,
-	"cloak": true,
-	"cloam": true,
-	"clock": true,
-	"clods": true,
-	"cloff": true,
-	"clogs": true,
-	"cloke": true,
-	"clomb": true,
-	"clomp": true,
-	"clone": true,
-	"clonk": true,
-	"clons": true,
-	"cloop": true,
-	"cloot": true,
-	"clops": true,
-	"close": true,
-	"clote": true,
-	"cloth": true,
-	"clots": true,
-	"cloud": true,
-	"clour": true,
-	"clous": true,
-	"clout": true,
-	"clove": true,
-	"clown": true,
-	"clows": true,
-	"cloye": true,
-	"cloys": true,
-	"cloze": true,
-	"clubs": true,
-	"cluck": true,
-	"clued": true,
-	"clues": true,
-	"cluey": true,
-	"clump": true,
-	"clung": true,
-	"clunk": true,
-	"clype": true,
-	"cnida": true,
-	"coach": true,
-	"coact": true,
-	"coady": true,
-	"coala": true,
-	"coals": true,
-	"coaly": true,
-	"coapt": true,
-	"coarb": true,
-	"coast": true,
-	"coate": true,
-	"coati": true,
-	"coats": true,
-	"cobbs": true,
-	"cobby": true,
-	"cobia": true,
-	"coble": true,
-	"cobra": true,
-	"cobza": true,
-	"cocas": true,
-	"cocci": true,
-	"cocco": true,
-	"cocks": true,
-	"cocky": true,
-	"cocoa": true,
-	"cocos": true,
-	"codas": true,
-	"codec": true,
-	"coded": true,
-	"coden": true,
-	"coder": true,
-	"codes": true,
-	"codex": true,
-	"codon": true,
-	"coeds": true,
-	"coffs": true,
-	"cogie": true,
-	"cogon": true,
-	"cogue": true,
-	"cohab": true,
-	"cohen": true,
-	"cohoe": true,
-	"cohog": true,
-	"cohos": true,
-	"coifs": true,
-	"coign": true,
-	"coils": true,
-	"coins": true,
-	"coirs": true,
-	"coits": true,
-	"coked": true,
-	"cokes": true,
-	"colas": true,
-	"colby": true,
-	"colds": true,
-	"coled": true,
-	"coles": true,
-	"coley": true,
-	"colic": true,
-	"colin": true,
-	"colls": true,
-	"colly": true,
-	"colog": true,
-	"colon": true,
-	"color": true,
-	"colts": true,
-	"colza": true,
-	"comae": true,
-	"comal": true,
-	"comas": true,
-	"combe": true,
-	"combi": true,
-	"combo": true,
-	"combs": true,
-	"comby": true,
-	"comer": true,
-	"comes": true,
-	"comet": true,
-	"comfy": true,
-	"comic": true,
-	"comix": true,
-	"comma": true,
-	"commo": true,
-	"comms": true,
-	"commy": true,
-	"compo": true,
-	"comps": true,
-	"compt": true,
-	"comte": true,
-	"comus": true,
-	"conch": true,
-	"condo": true,
-	"coned": true,
-	"cones": true,
-	"coney": true,
-	"confs": true,
-	"conga": true,
-	"conge": true,
-	"congo": true,
-	"conia": true,
-	"conic": true,
-	"conin": true,
-	"conks": true,
-	"conky": true,
-	"conne": true,
-	"conns": true,
-	"conte": true,
-	"conto": true,
-	"conus": true,
-	"convo": true,
-	"cooch": true,
-	"cooed": true,
-	"cooee": true,
-	"cooer": true,
-	"cooey": true,
-	"coofs": true,
-	"cooks": true,
-	"cooky": true,
-	"cools": true,
-	"cooly": true,
-	"coomb": true,
-	"cooms": true,
-	"coomy": true,
-	"coons": true,
-	"coops": true,
-	"coopt": true,
-	"coost": true,
-	"coots": true,
-	"cooze": true,
-	"copal": true,
-	"copay": true,
-	"coped": true,
-	"copen": true,
-	"coper": true,
-	"copes": true,
-	"coppy": true,
-	"copra": true,
-	"copse": true,
-	"copsy": true,
-	"coqui": true,
-	"coral": true,
-	"coram": true,
-	"corbe": true,
-	"corby": true,
-	"cords": true,
-	"cored": true,
-	"corer": true,
-	"cores": true,
-	"corey": true,
-	"corgi": true,
-	"coria": true,
-	"corks": true,
-	"corky": true,
-	"corms": true,
-	"corni": true,
-	"corno": true,
-	"corns": true,
-	"cornu": true,
-	"corny": true,
-	"corps": true,
-	"corse": true,
-	"corso": true,
-	"cosec": true,
-	"cosed": true,
-	"coses": true,
-	"coset": true,
-	"cosey": true,
-	"cosie": true,
-	"costa": true,
-	"coste": true,
-	"costs": true,
-	"cotan": true,
-	"coted": true,
-	"cotes": true,
-	"coths": true,
-	"cotta": true,
-	"cotts": true,
-	"couch": true,
-	"coude": true,
-	"cough": true,
-	"could": true,
-	"count": true,
-	"coupe": true,
-	"coups": true,
-	"courb": true,
-	"courd": true,
-	"coure": true,
-	"cours": true,
-	"court": true,
-	"couta": true,
-	"couth": true,
-	"coved": true,
-	"coven": true,
-	"cover": true,
-	"coves": true,
-	"covet": true,
-	"covey": true,
-	"covin": true,
-	"cowal": true,
-	"cowan": true,
-	"cowed": true,
-	"cower": true,
-	"cowks": true,
-	"cowls": true,
-	"cowps": true,
-	"cowry": true,
-	"coxae": true,
-	"coxal": true,
-	"coxed": true,
-	"coxes": true,
-	"coxib": true,
-	"coyau": true,
-	"coyed": true,
-	"coyer": true,
-	"coyly": true,
-	"coypu": true,
-	"cozed": true,
-	"cozen": true,
-	"cozes": true,
-	"cozey": true,
-	"cozie": true,
-	"craal": true,
-	"crabs": true,
-	"crack": true,
-	"craft": true,
-	"crags": true,
-	"craic": true,
-	"craig": true,
-	"crake": true,
-	"crame": true,
-	"cramp": true,
-	"crams": true,
-	"crane": true,
-	"crank": true,
-	"crans": true,
-	"crape": true,
-	"craps": true,
-	"crapy": true,
-	"crare": true,
-	"crash": true,
-	"crass": true,
-	"crate": true,
-	"crave": true,
-	"crawl": true,
-	"craws": true,
-	"crays": true,
-	"craze": true,
-	"crazy": true,
-	"creak": true,
-	"cream": true,
-	"credo": true,
-	"creds": true,
-	"creed": true,
-	"creek": true,
-	"creel": true,
-	"creep": true,
-	"crees": true,
-	"creme": true,
-	"crems": true,
-	"crena": true,
-	"crepe": true,
-	"creps": true,
-	"crept": true,
-	"crepy": true,
-	"cress": true,
-	"crest": true,
-	"crewe": true,
-	"crews": true,
-	"crias": true,
-	"cribs": true,
-	"crick": true,
-	"cried": true,
-	"crier": true,
-	"cries": true,
-	"crime": true,
-	"crimp": true,
-	"crims": true,
-	"crine": true,
-	"crios": true,
-	"cripe": true,
-	"crips": true,
-	"crise": true,
-	"crisp": true,
-	"crith": true,
-	"crits": true,
-	"croak": true,
-	"croci": true,
-	"crock": true,
-	"crocs": true,
-	"croft": true,
-	"crogs": true,
-	"cromb": true,
-	"crome": true,
-	"crone": true,
-	"cronk": true,
-	"crons": true,
-	"crony": true,
-	"crook": true,
-	"crool": true,
-	"croon": true,
-	"crops": true,
-	"crore": true,
-	"cross": true,
-	"crost": true,
-	"croup": true,
-	"crout": true,
-	"crowd": true,
-	"crown": true,
-	"crows": true,
-	"croze": true,
-	"cruck": true,
-	"crude": true,
-	"crudo": true,
-	"cruds": true,
-	"crudy": true,
-	"cruel": true,
-	"crues": true,
-	"cruet": true,
-	"cruft": true,
-	"crumb": true,
-	"crump": true,
-	"crunk": true,
-	"cruor": true,
-	"crura": true,
-	"cruse": true,
-	"crush": true,
-	"crust": true,
-	"crusy": true,
-	"cruve": true,
-	"crwth": true,
-	"cryer": true,
-	"crypt": true,
-	"ctene": true,
-	"cubby": true,
-	"cubeb": true,
-	"cubed": true,
-	"cuber": true,
-	"cubes": true,
-	"cubic": true,
-	"cubit": true,
-	"cuddy": true,
-	"cuffo": true,
-	"cuffs": true,
-	"cuifs": true,
-	"cuing": true,
-	"cuish": true,
-	"cuits": true,
-	"cukes": true,
-	"culch": true,
-	"culet": true,
-	"culex": true,
-	"culls": true,
-	"cully": true,
-	"culms": true,
-	"culpa": true,
-	"culti": true,
-	"cults": true,
-	"culty": true,
-	"cumec": true,
-	"cumin": true,
-	"cundy": true,
-	"cunei": true,
-	"cunit": true,
-	"cunts": true,
-	"cupel": true,
-	"cupid": true,
-	"cuppa": true,
-	"cuppy": true,
-	"curat": true,
-	"curbs": true,
-	"curch": true,
-	"curds": true,
-	"curdy": true,
-	"cured": true,
-	"curer": true,
-	"cures": true,
-	"curet": true,
-	"curfs": true,
-	"curia": true,
-	"curie": true,
-	"curio": true,
-	"curli": true,
-	"curls": true,
-	"curly": true,
-	"curns": true,
-	"curny": true,
-	"currs": true,
-	"curry": true,
-	"curse": true,
-	"cursi": true,
-	"curst": true,
-	"curve": true,
-	"curvy": true,
-	"cusec": true,
-	"cushy": true,
-	"cusks": true,
-	"cusps": true,
-	"cuspy": true,
-	"cusso": true,
-	"cusum": true,
-	"cutch": true,
-	"cuter": true,
-	"cutes": true,
-	"cutey": true,
-	"cutie": true,
-	"cutin": true,
-	"cutis": true,
-	"cutto": true,
-	"cutty": true,
-	"cutup": true,
-	"cuvee": true,
-	"cuzes": true,
-	"cwtch": true,
-	"cyano": true,
-	"cyans": true,
-	"cyber": true,
-	"cycad": true,
-	"cycas": true,
-	"cycle": true,
-	"cyclo": true,
-	"cyder": true,
-	"cylix": true,
-	"cymae": true,
-	"cymar": true,
-	"cymas": true,
-	"cymes": true,
-	"cymol": true,
-	"cynic": true,
-	"cysts": true,
-	"cytes": true,
-	"cyton": true,
-	"czars": true,
-	"daals": true,
-	"dabba": true,
-	"daces": true,
-	"dacha": true,
-	"dacks": true,
-	"dadah": true,
-	"dadas": true,
-	"daddy": true,
-	"dados": true,
-	"daffs": true,
-	"daffy": true,
-	"dagga": true,
-	"daggy": true,
-	"dagos": true,
-	"dahls": true,
-	"daiko": true,
-	"daily": true,
-	"daine": true,
-	"daint": true,
-	"dairy": true,
-	"daisy": true,
-	"daker": true,
-	"daled": true,
-	"dales": true,
-	"dalis": true,
-	"dalle": true,
-	"dally": true,
-	"dalts": true,
-	"daman": true,
-	"damar": true,
-	"dames": true,
-	"damme": true,
-	"damns": true,
-	"damps": true,
-	"dampy": true,
-	"dance": true,
-	"dancy": true,
-	"dandy": true,
-	"dangs": true,
-	"danio": true,
-	"danks": true,
-	"danny": true,
-	"dants": true,
-	"daraf": true,
-	"darbs": true,
-	"darcy": true,
-	"dared": true,
-	"darer": true,
-	"dares": true,
-	"darga": true,
-	"dargs": true,
-	"daric": true,
-	"daris": true,
-	"darks": true,
-	"darky": true,
-	"darns": true,
-	"darre": true,
-	"darts": true,
-	"darzi": true,
-	"dashi": true,
-	"dashy": true,
-	"datal": true,
-	"dated": true,
-	"dater": true,
-	"dates": true,
-	"datos": true,
-	"datto": true,
-	"datum": true,
-	"daube": true,
-	"daubs": true,
-	"dauby": true,
-	"dauds": true,
-	"dault": true,
-	"daunt": true,
-	"daurs": true,
-	"dauts": true,
-	"daven": true,
-	"davit": true,
-	"dawah": true,
-	"dawds": true,
-	"dawed": true,
-	"dawen": true,
-	"dawks": true,
-	"dawns": true,
-	"dawts": true,
-	"dayan": true,
-	"daych": true,
-	"daynt": true,
-	"dazed": true,
-	"dazer": true,
-	"dazes": true,
-	"deads": true,
-	"deair": true,
-	"deals": true,
-	"dealt": true,
-	"deans": true,
-	"deare": true,
-	"dearn": true,
-	"dears": true,
-	"deary": true,
-	"deash": true,
-	"death": true,
-	"deave": true,
-	"deaws": true,
-	"deawy": true,
-	"debag": true,
-	"debar": true,
-	"debby": true,
-	"debel": true,
-	"debes": true,
-	"debit": true,
-	"debts": true,
-	"debud": true,
-	"debug": true,
-	"debur": true,
-	"debus": true,
-	"debut": true,
-	"debye": true,
-	"decad": true,
-	"decaf": true,
-	"decal": true,
-	"decan": true,
-	"decay": true,
-	"decko": true,
-	"decks": true,
-	"decor": true,
-	"decos": true,
-	"decoy": true,
-	"decry": true,
-	"dedal": true,
-	"deeds": true,
-	"deedy": true,
-	"deely": true,
-	"deems": true,
-	"deens": true,
-	"deeps": true,
-	"deere": true,
-	"deers": true,
-	"deets": true,
-	"deeve": true,
-	"deevs": true,
-	"defat": true,
-	"defer": true,
-	"deffo": true,
-	"defis": true,
-	"defog": true,
-	"degas": true,
-	"degum": true,
-	"degus": true,
-	"deice": true,
-	"deids": true,
-	"deify": true,
-	"deign": true,
-	"deils": true,
-	"deism": true,
-	"deist": true,
-	"deity": true,
-	"deked": true,
-	"dekes": true,
-	"dekko": true,
-	"delay": true,
-	"deled": true,
-	"deles": true,
-	"delfs": true,
-	"delft": true,
-	"delis": true,
-	"dells": true,
-	"delly": true,
-	"delos": true,
-	"delph": true,
-	"delta": true,
-	"delts": true,
-	"delve": true,
-	"deman": true,
-	"demes": true,
-	"demic": true,
-	"demit": true,
-	"demob": true,
-	"demoi": true,
-	"demon": true,
-	"demos": true,
-	"dempt": true,
-	"demur": true,
-	"denar": true,
-	"denay": true,
-	"dench": true,
-	"denes": true,
-	"denet": true,
-	"denim": true,
-	"denis": true,
-	"dense": true,
-	"dents": true,
-	"deoxy": true,
-	"depot": true,
-	"depth": true,
-	"derat": true,
-	"deray": true,
-	"derby": true,
-	"dered": true,
-	"deres": true,
-	"derig": true,
-	"derma": true,
-	"derms": true,
-	"derns": true,
-	"derny": true,
-	"deros": true,
-	"derro": true,
-	"derry": true,
-	"derth": true,
-	"dervs": true,
-	"desex": true,
-	"deshi": true,
-	"desis": true,
-	"desks": true,
-	"desse": true,
-	"deter": true,
-	"detox": true,
-	"deuce": true,
-	"devas": true,
-	"devel": true,
-	"devil": true,
-	"devis": true,
-	"devon": true,
-	"devos": true,
-	"devot": true,
-	"dewan": true,
-	"dewar": true,
-	"dewax": true,
-	"dewed": true,
-	"dexes": true,
-	"dexie": true,
-	"dhaba": true,
-	"dhaks": true,
-	"dhals": true,
-	"dhikr": true,
-	"dhobi": true,
-	"dhole": true,
-	"dholl": true,
-	"dhols": true,
-	"dhoti": true,
-	"dhows": true,
-	"dhuti": true,
-	"diact": true,
-	"dials": true,
-	"diane": true,
-	"diary": true,
-	"diazo": true,
-	"dibbs": true,
-	"diced": true,
-	"dicer": true,
-	"dices": true,
-	"dicey": true,
-	"dicht": true,
-	"dicks": true,
-	"dicky": true,
-	"dicot": true,
-	"dicta": true,
-	"dicts": true,
-	"dicty": true,
-	"diddy": true,
-	"didie": true,
-	"didos": true,
-	"didst": true,
-	"diebs": true,
-	"diels": true,
-	"diene": true,
-	"diets": true,
-	"diffs": true,
-	"dight": true,
-	"digit": true,
-	"dikas": true,
-	"diked": true,
-	"diker": true,
-	"dikes": true,
-	"dikey": true,
-	"dildo": true,
-	"dilli": true,
-	"dills": true,
-	"dilly": true,
-	"dimbo": true,
-	"dimer": true,
-	"dimes": true,
-	"dimly": true,
-	"dimps": true,
-	"dinar": true,
-	"dined": true,
-	"diner": true,
-	"dines": true,
-	"dinge": true,
-	"dingo": true,
-	"dings": true,
-	"dingy": true,
-	"dinic": true,
-	"dinks": true,
-	"dinky": true,
-	"dinna": true,
-	"dinos": true,
-	"dints": true,
-	"diode": true,
-	"diols": true,
-	"diota": true,
-	"dippy": true,
-	"dipso": true,
-	"diram": true,
-	"direr": true,
-	"dirge": true,
-	"dirke": true,
-	"dirks": true,
-	"dirls": true,
-	"dirts": true,
-	"dirty": true,
-	"disas": true,
-	"disci": true,
-	"disco": true,
-	"discs": true,
-	"dishy": true,
-	"disks": true,
-	"disme": true,
-	"dital": true,
-	"ditas": true,
-	"ditch": true,
-	"dited": true,
-	"dites": true,
-	"ditsy": true,
-	"ditto": true,
-	"ditts": true,
-	"ditty": true,
-	"ditzy": true,
-	"divan": true,
-	"divas": true,
-	"dived": true,
-	"diver": true,
-	"dives": true,
-	"divis": true,
-	"divna": true,
-	"divos": true,
-	"divot": true,
-	"divvy": true,
-	"diwan": true,
-	"dixie": true,
-	"dixit": true,
-	"diyas": true,
-	"dizen": true,
-	"dizzy": true,
-	"djinn": true,
-	"djins": true,
-	"doabs": true,
-	"doats": true,
-	"dobby": true,
-	"dobes": true,
-	"dobie": true,
-	"dobla": true,
-	"dobra": true,
-	"dobro": true,
-	"docht": true,
-	"docks": true,
-	"docos": true,
-	"docus": true,
-	"doddy": true,
-	"dodge": true,
-	"dodgy": true,
-	"dodos": true,
-	"doeks": true,
-	"doers": true,
-	"doest": true,
-	"doeth": true,
-	"doffs": true,
-	"dogan": true,
-	"doges": true,
-	"dogey": true,
-	"doggo": true,
-	"doggy": true,
-	"dogie": true,
-	"dogma": true,
-	"dohyo": true,
-	"doilt": true,
-	"doily": true,
-	"doing": true,
-	"doits": true,
-	"dojos": true,
-	"dolce": true,
-	"dolci": true,
-	"doled": true,
-	"doles": true,
-	"dolia": true,
-	"dolls": true,
-	"dolly": true,
-	"dolma": true,
-	"dolor": true,
-	"dolos": true,
-	"dolts": true,
-	"domal": true,
-	"domed": true,
-	"domes": true,
-	"domic": true,
-	"donah": true,
-	"donas": true,
-	"donee": true,
-	"doner": true,
-	"donga": true,
-	"dongs": true,
-	"donko": true,
-	"donna": true,
-	"donne": true,
-	"donny": true,
-	"donor": true,
-	"donsy": true,
-	"donut": true,
-	"doobs": true,
-	"dooce": true,
-	"doody": true,
-	"dooks": true,
-	"doole": true,
-	"dools": true,
-	"dooly": true,
-	"dooms": true,
-	"doomy": true,
-	"doona": true,
-	"doorn": true,
-	"doors": true,
-	"doozy": true,
-	"dopas": true,
-	"doped": true,
-	"doper": true,
-	"dopes": true,
-	"dopey": true,
-	"dorad": true,
-	"dorba": true,
-	"dorbs": true,
-	"doree": true,
-	"dores": true,
-	"doric": true,
-	"doris": true,
-	"dorks": true,
-	"dorky": true,
-	"dorms": true,
-	"dormy": true,
-	"dorps": true,
-	"dorrs": true,
-	"dorsa": true,
-	"dorse": true,
-	"dorts": true,
-	"dorty": true,
-	"dosai": true,
-	"dosas": true,
-	"dosed": true,
-	"doseh": true,
-	"doser": true,
-	"doses": true,
-	"dosha": true,
-	"dotal": true,
-	"doted": true,
-	"doter": true,
-	"dotes": true,
-	"dotty": true,
-	"douar": true,
-	"doubt": true,
-	"douce": true,
-	"doucs": true,
-	"dough": true,
-	"douks": true,
-	"doula": true,
-	"douma": true,
-	"doums": true,
-	"doups": true,
-	"doura": true,
-	"douse": true,
-	"douts": true,
-	"doved": true,
-	"doven": true,
-	"dover": true,
-	"doves": true,
-	"dovie": true,
-	"dowar": true,
-	"dowds": true,
-	"dowdy": true,
-	"dowed": true,
-	"dowel": true,
-	"dower": true,
-	"dowie": true,
-	"dowle": true,
-	"dowls": true,
-	"dowly": true,
-	"downa": true,
-	"downs": true,
-	"downy": true,
-	"dowps": true,
-	"dowry": true,
-	"dowse": true,
-	"dowts": true,
-	"doxed": true,
-	"doxes": true,
-	"doxie": true,
-	"doyen": true,
-	"doyly": true,
-	"dozed": true,
-	"dozen": true,
-	"dozer": true,
-	"dozes": true,
-	"drabs": true,
-	"drack": true,
-	"draco": true,
-	"draff": true,
-	"draft": true,
-	"drags": true,
-	"drail": true,
-	"drain": true,
-	"drake": true,
-	"drama": true,
-	"drams": true,
-	"drank": true,
-	"drant": true,
-	"drape": true,
-	"draps": true,
-	"drats": true,
-	"drave": true,
-	"drawl": true,
-	"drawn": true,
-	"draws": true,
-	"drays": true,
-	"dread": true,
-	"dream": true,
-	"drear": true,
-	"dreck": true,
-	"dreed": true,
-	"dreer": true,
-	"drees": true,
-	"dregs": true,
-	"dreks": true,
-	"drent": true,
-	"drere": true,
-	"dress": true,
-	"drest": true,
-	"dreys": true,
-	"dribs": true,
-	"drice": true,
-	"dried": true,
-	"drier": true,
-	"dries": true,
-	"drift": true,
-	"drill": true,
-	"drily": true,
-	"drink": true,
-	"drips": true,
-	"dript": true,
-	"drive": true,
-	"droid": true,
-	"droil": true,
-	"droit": true,
-	"droke": true,
-	"drole": true,
-	"droll": true,
-	"drome": true,
-	"drone": true,
-	"drony": true,
-	"droob": true,
-	"droog": true,
-	"drook": true,
-	"drool": true,
-	"droop": true,
-	"drops": true,
-	"dropt": true,
-	"dross": true,
-	"drouk": true,
-	"drove": true,
-	"drown": true,
-	"drows": true,
-	"drubs": true,
-	"drugs": true,
-	"druid": true,
-	"drums": true,
-	"drunk": true,
-	"drupe": true,
-	"druse": true,
-	"drusy": true,
-	"druxy": true,
-	"dryad": true,
-	"dryas": true,
-	"dryer": true,
-	"dryly": true,
-	"dsobo": true,
-	"dsomo": true,
-	"duads": true,
-	"duals": true,
-	"duans": true,
-	"duars": true,
-	"dubbo": true,
-	"ducal": true,
-	"ducat": true,
-	"duces": true,
-	"duchy": true,
-	"ducks": true,
-	"ducky": true,
-	"ducts": true,
-	"duddy": true,
-	"duded": true,
-	"dudes": true,
-	"duels": true,
-	"duets": true,
-	"duett": true,
-	"duffs": true,
-	"dufus": true,
-	"duing": true,
-	"duits": true,
-	"dukas": true,
-	"duked": true,
-	"dukes": true,
-	"dukka": true,
-	"dulce": true,
-	"dules": true,
-	"dulia": true,
-	"dulls": true,
-	"dully": true,
-	"dulse": true,
-	"dumas": true,
-	"dumbo": true,
-	"dumbs": true,
-	"dumka": true,
-	"dumky": true,
-	"dummy": true,
-	"dumps": true,
-	"dumpy": true,
-	"dunam": true,
-	"dunce": true,
-	"dunch": true,
-	"dunes": true,
-	"dungs": true,
-	"dungy": true,
-	"dunks": true,
-	"dunno": true,
-	"dunny": true,
-	"dunsh": true,
-	"dunts": true,
-	"duomi": true,
-	"duomo": true,
-	"duped": true,
-	"duper": true,
-	"dupes": true,
-	"duple": true,
-	"duply": true,
-	"duppy": true,
-	"dural": true,
-	"duras": true,
-	"dured": true,
-	"dures": true,
-	"durgy": true,
-	"durns": true,
-	"duroc": true,
-	"duros": true,
-	"duroy": true,
-	"durra": true,
-	"durrs": true,
-	"durry": true,
-	"durst": true,
-	"durum": true,
-	"durzi": true,
-	"dusks": true,
-	"dusky": true,
-	"dusts": true,
-	"dusty": true,
-	"dutch": true,
-	"duvet": true,
-	"duxes": true,
-	"dwaal": true,
-	"dwale": true,
-	"dwalm": true,
-	"dwams": true,
-	"dwang": true,
-	"dwarf": true,
-	"dwaum": true,
-	"dweeb": true,
-	"dwell": true,
-	"dwelt": true,
-	"dwile": true,
-	"dwine": true,
-	"dyads": true,
-	"dyers": true,
-	"dying": true,
-	"dyked": true,
-	"dykes": true,
-	"dykey": true,
-	"dykon": true,
-	"dynel": true,
-	"dynes": true,
-	"dzhos": true,
-	"eager": true,
-	"eagle": true,
-	"eagre": true,
-	"ealed": true,
-	"eales": true,
-	"eaned": true,
-	"eards": true,
-	"eared": true,
-	"earls": true,
-	"early": true,
-	"earns": true,
-	"earnt": true,
-	"earst": true,
-	"earth": true,
-	"eased": true,
-	"easel": true,
-	"easer": true,
-	"eases": true,
-	"easle": true,
-	"easts": true,
-	"eaten": true,
-	"eater": true,
-	"eathe": true,
-	"eaved": true,
-	"eaves": true,
-	"ebbed": true,
-	"ebbet": true,
-	"ebons": true,
-	"ebony": true,
-	"ebook": true,
-	"ecads": true,
-	"eched": true,
-	"eches": true,
-	"echos": true,
-	"eclat": true,
-	"ecrus": true,
-	"edema": true,
-	"edged": true,
-	"edger": true,
-	"edges": true,
-	"edict": true,
-	"edify": true,
-	"edile": true,
-	"edits": true,
-	"educe": true,
-	"educt": true,
-	"eejit": true,
-	"eensy": true,
-	"eerie": true,
-	"eeven": true,
-	"eevns": true,
-	"effed": true,
-	"egads": true,
-	"egers": true,
-	"egest": true,
-	"eggar": true,
-	"egged": true,
-	"egger": true,
-	"egmas": true,
-	"egret": true,
-	"ehing": true,
-	"eider": true,
-	"eidos": true,
-	"eight": true,
-	"eigne": true,
-	"eiked": true,
-	"eikon": true,
-	"eilds": true,
-	"eisel": true,
-	"eject": true,
-	"ejido": true,
-	"eking": true,
-	"ekkas": true,
-	"elain": true,
-	"eland": true,
-	"elans": true,
-	"elate": true,
-	"elbow": true,
-	"elchi": true,
-	"elder": true,
-	"eldin": true,
-	"elect": true,
-	"elegy": true,
-	"elemi": true,
-	"elfed": true,
-	"elfin": true,
-	"eliad": true,
-	"elide": true,
-	"elint": true,
-	"elite": true,
-	"elmen": true,
-	"eloge": true,
-	"elogy": true,
-	"eloin": true,
-	"elope": true,
-	"elops": true,
-	"elpee": true,
-	"elsin": true,
-	"elude": true,
-	"elute": true,
-	"elvan": true,
-	"elven": true,
-	"elver": true,
-	"elves": true,
-	"emacs": true,
-	"email": true,
-	"embar": true,
-	"embay": true,
-	"embed": true,
-	"ember": true,
-	"embog": true,
-	"embow": true,
-	"embox": true,
-	"embus": true,
-	"emcee": true,
-	"emeer": true,
-	"emend": true,
-	"emerg": true,
-	"emery": true,
-	"emeus": true,
-	"emics": true,
-	"emirs": true,
-	"emits": true,
-	"emmas": true,
-	"emmer": true,
-	"emmet": true,
-	"emmew": true,
-	"emmys": true,
-	"emoji": true,
-	"emong": true,
-	"emote": true,
-	"emove": true,
-	"empts": true,
-	"empty": true,
-	"emule": true,
-	"emure": true,
-	"emyde": true,
-	"emyds": true,
-	"enact": true,
-	"enarm": true,
-	"enate": true,
-	"ended": true,
-	"ender": true,
-	"endew": true,
-	"endow": true,
-	"endue": true,
-	"enema": true,
-	"enemy": true,
-	"enews": true,
-	"enfix": true,
-	"eniac": true,
-	"enjoy": true,
-	"enlit": true,
-	"enmew": true,
-	"ennog": true,
-	"ennui": true,
-	"enoki": true,
-	"enols": true,
-	"enorm": true,
-	"enows": true,
-	"enrol": true,
-	"ensew": true,
-	"ensky": true,
-	"ensue": true,
-	"enter": true,
-	"entia": true,
-	"entry": true,
-	"enure": true,
-	"enurn": true,
-	"envoi": true,
-	"envoy": true,
-	"enzym": true,
-	"eorls": true,
-	"eosin": true,
-	"epact": true,
-	"epees": true,
-	"ephah": true,
-	"ephas": true,
-	"ephod": true,
-	"ephor": true,
-	"epics": true,
-	"epoch": true,
-	"epode": true,
-	"epopt": true,
-	"epoxy": true,
-	"epris": true,
-	"equal": true,
-	"eques": true,
-	"equid": true,
-	"equip": true,
-	"erase": true,
-	"erbia": true,
-	"erect": true,
-	"erevs": true,
-	"ergon": true,
-	"ergos": true,
-	"ergot": true,
-	"erhus": true,
-	"erica": true,
-	"erick": true,
-	"erics": true,
-	"ering": true,
-	"erned": true,
-	"ernes": true,
-	"erode": true,
-	"erose": true,
-	"erred": true,
-	"error": true,
-	"erses": true,
-	"eruct": true,
-	"erugo": true,
-	"erupt": true,
-	"eruvs": true,
-	"erven": true,
-	"ervil": true,
-	"escar": true,
-	"escot": true,
-	"esile": true,
-	"eskar": true,
-	"esker": true,
-	"esnes": true,
-	"essay": true,
-	"esses": true,
-	"ester": true,
-	"estoc": true,
-	"estop": true,
-	"estro": true,
-	"etage": true,
-	"etape": true,
-	"etats": true,
-	"etens": true,
-	"ethal": true,
-	"ether": true,
-	"ethic": true,
-	"ethne": true,
-	"ethos": true,
-	"ethyl": true,
-	"etics": true,
-	"etnas": true,
-	"ettin": true,
-	"ettle": true,
-	"etude": true,
-	"etuis": true,
-	"etwee": true,
-	"etyma": true,
-	"eughs": true,
-	"euked": true,
-	"eupad": true,
-	"euros": true,
-	"eusol": true,
-	"evade": true,
-	"evens": true,
-	"event": true,
-	"evert": true,
-	"every": true,
-	"evets": true,
-	"evhoe": true,
-	"evict": true,
-	"evils": true,
-	"evite": true,
-	"evohe": true,
-	"evoke": true,
-	"ewers": true,
-	"ewest": true,
-	"ewhow": true,
-	"ewked": true,
-	"exact": true,
-	"exalt": true,
-	"exams": true,
-	"excel": true,
-	"exeat": true,
-	"execs": true,
-	"exeem": true,
-	"exeme": true,
-	"exert": true,
-	"exfil": true,
-	"exies": true,
-	"exile": true,
-	"exine": true,
-	"exing": true,
-	"exist": true,
-	"exits": true,
-	"exode": true,
-	"exome": true,
-	"exons": true,
-	"expat": true,
-	"expel": true,
-	"expos": true,
-	"extol": true,
-	"extra": true,
-	"exude": true,
-	"exuls": true,
-	"exult": true,
-	"exurb": true,
-	"eyass": true,
-	"eyers": true,
-	"eying": true,
-	"eyots": true,
-	"eyras": true,
-	"eyres": true,
-	"eyrie": true,
-	"eyrir": true,
-	"ezine": true,
-	"fabby": true,
-	"fable": true,
-	"faced": true,
-	"facer": true,
-	"faces": true,
-	"facet": true,
-	"facia": true,
-	"facta": true,
-	"facts": true,
-	"faddy": true,
-	"faded": true,
-	"fader": true,
-	"fades": true,
-	"fadge": true,
-	"fados": true,
-	"faena": true,
-	"faery": true,
-	"faffs": true,
-	"faffy": true,
-	"faggy": true,
-	"fagin": true,
-	"fagot": true,
-	"faiks": true,
-	"fails": true,
-	"faine": true,
-	"fains": true,
-	"faint": true,
-	"fairs": true,
-	"fairy": true,
-	"faith": true,
-	"faked": true,
-	"faker": true,
-	"fakes": true,
-	"fakey": true,
-	"fakie": true,
-	"fakir": true,
-	"falaj": true,
-	"falls": true,
-	"false": true,
-	"famed": true,
-	"fames": true,
-	"fanal": true,
-	"fancy": true,
-	"fands": true,
-	"fanes": true,
-	"fanga": true,
-	"fango": true,
-	"fangs": true,
-	"fanks": true,
-	"fanny": true,
-	"fanon": true,
-	"fanos": true,
-	"fanum": true,
-	"faqir": true,
-	"farad": true,
-	"farce": true,
-	"farci": true,
-	"farcy": true,
-	"fards": true,
-	"fared": true,
-	"farer": true,
-	"fares": true,
-	"farle": true,
-	"farls": true,
-	"farms": true,
-	"faros": true,
-	"farro": true,
-	"farse": true,
-	"farts": true,
-	"fasci": true,
-	"fasti": true,
-	"fasts": true,
-	"fatal": true,
-	"fated": true,
-	"fates": true,
-	"fatly": true,
-	"fatso": true,
-	"fatty": true,
-	"fatwa": true,
-	"faugh": true,
-	"fauld": true,
-	"fault": true,
-	"fauna": true,
-	"fauns": true,
-	"faurd": true,
-	"fauts": true,
-	"fauve": true,
-	"favas": true,
-	"favel": true,
-	"faver": true,
-	"faves": true,
-	"favor": true,
-	"favus": true,
-	"fawns": true,
-	"fawny": true,
-	"faxed": true,
-	"faxes": true,
-	"fayed": true,
-	"fayer": true,
-	"fayne": true,
-	"fayre": true,
-	"fazed": true,
-	"fazes": true,
-	"feals": true,
-	"feare": true,
-	"fears": true,
-	"feart": true,
-	"fease": true,
-	"feast": true,
-	"feats": true,
-	"feaze": true,
-	"fecal": true,
-	"feces": true,
-	"fecht": true,
-	"fecit": true,
-	"fecks": true,
-	"fedex": true,
-	"feebs": true,
-	"feeds": true,
-	"feels": true,
-	"feens": true,
-	"feers": true,
-	"feese": true,
-	"feeze": true,
-	"fehme": true,
-	"feign": true,
-	"feint": true,
-	"feist": true,
-	"felch": true,
-	"felid": true,
-	"fella": true,
-	"fells": true,
-	"felly": true,
-	"felon": true,
-	"felts": true,
-	"felty": true,
-	"femal": true,
-	"femes": true,
-	"femme": true,
-	"femmy": true,
-	"femur": true,
-	"fence": true,
-	"fends": true,
-	"fendy": true,
-	"fenis": true,
-	"fenks": true,
-	"fenny": true,
-	"fents": true,
-	"feods": true,
-	"feoff": true,
-	"feral": true,
-	"ferer": true,
-	"feres": true,
-	"feria": true,
-	"ferly": true,
-	"fermi": true,
-	"ferms": true,
-	"ferns": true,
-	"ferny": true,
-	"ferry": true,
-	"fesse": true,
-	"festa": true,
-	"fests": true,
-	"festy": true,
-	"fetal": true,
-	"fetas": true,
-	"fetch": true,
-	"feted": true,
-	"fetes": true,
-	"fetid": true,
-	"fetor": true,
-	"fetta": true,
-	"fetts": true,
-	"fetus": true,
-	"fetwa": true,
-	"feuar": true,
-	"feuds": true,
-	"feued": true,
-	"fever": true,
-	"fewer": true,
-	"feyed": true,
-	"feyer": true,
-	"feyly": true,
-	"fezes": true,
-	"fezzy": true,
-	"fiars": true,
-	"fiats": true,
-	"fiber": true,
-	"fibre": true,
-	"fibro": true,
-	"fices": true,
-	"fiche": true,
-	"fichu": true,
-	"ficin": true,
-	"ficos": true,
-	"ficus": true,
-	"fides": true,
-	"fidge": true,
-	"fidos": true,
-	"fiefs": true,
-	"field": true,
-	"fiend": true,
-	"fient": true,
-	"fiere": true,
-	"fiers": true,
-	"fiery": true,
-	"fiest": true,
-	"fifed": true,
-	"fifer": true,
-	"fifes": true,
-	"fifis": true,
-	"fifth": true,
-	"fifty": true,
-	"figgy": true,
-	"fight": true,
-	"figos": true,
-	"fiked": true,
-	"fikes": true,
-	"filar": true,
-	"filch": true,
-	"filed": true,
-	"filer": true,
-	"files": true,
-	"filet": true,
-	"filii": true,
-	"filks": true,
-	"fille": true,
-	"fillo": true,
-	"fills": true,
-	"filly": true,
-	"filmi": true,
-	"films": true,
-	"filmy": true,
-	"filos": true,
-	"filth": true,
-	"filum": true,
-	"final": true,
-	"finca": true,
-	"finch": true,
-	"finds": true,
-	"fined": true,
-	"finer": true,
-	"fines": true,
-	"finis": true,
-	"finks": true,
-	"finny": true,
-	"finos": true,
-	"fiord": true,
-	"fiqhs": true,
-	"fique": true,
-	"fired": true,
-	"firer": true,
-	"fires": true,
-	"firie": true,
-	"firks": true,
-	"firms": true,
-	"firns": true,
-	"firry": true,
-	"first": true,
-	"firth": true,
-	"fiscs": true,
-	"fishy": true,
-	"fisks": true,
-	"fists": true,
-	"fisty": true,
-	"fitch": true,
-	"fitly": true,
-	"fitna": true,
-	"fitte": true,
-	"fitts": true,
-	"fiver": true,
-	"fives": true,
-	"fixed": true,
-	"fixer": true,
-	"fixes": true,
-	"fixit": true,
-	"fizzy": true,
-	"fjeld": true,
-	"fjord": true,
-	"flabs": true,
-	"flack": true,
-	"flaff": true,
-	"flags": true,
-	"flail": true,
-	"flair": true,
-	"flake": true,
-	"flaks": true,
-	"flaky": true,
-	"flame": true,
-	"flamm": true,
-	"flams": true,
-	"flamy": true,
-	"flane": true,
-	"flank": true,
-	"flans": true,
-	"flaps": true,
-	"flare": true,
-	"flary": true,
-	"flash": true,
-	"flask": true,
-	"flats": true,
-	"flava": true,
-	"flawn": true,
-	"flaws": true,
-	"flawy": true,
-	"flaxy": true,
-	"flays": true,
-	"fleam": true,
-	"fleas": true,
-	"fleck": true,
-	"fleek": true,
-	"fleer": true,
-	"flees": true,
-	"fleet": true,
-	"flegs": true,
-	"fleme": true,
-	"flesh": true,
-	"fleur": true,
-	"flews": true,
-	"flexi": true,
-	"flexo": true,
-	"fleys": true,
-	"flick": true,
-	"flics": true,
-	"flied": true,
-	"flier": true,
-	"flies": true,
-	"flimp": true,
-	"flims": true,
-	"fling": true,
-	"flint": true,
-	"flips": true,
-	"flirs": true,
-	"flirt": true,
-	"flisk": true,
-	"flite": true,
-	"flits": true,
-	"flitt": true,
-	"float": true,
-	"flobs": true,
-	"flock": true,
-	"flocs": true,
-	"floes": true,
-	"flogs": true,
-	"flong": true,
-	"flood": true,
-	"floor": true,
-	"flops": true,
-	"flora": true,
-	"flors": true,
-	"flory": true,
-	"flosh": true,
-	"floss": true,
-	"flota": true,
-	"flote": true,
-	"flour": true,
-	"flout": true,
-	"flown": true,
-	"flows": true,
-	"flubs": true,
-	"flued": true,
-	"flues": true,
-	"fluey": true,
-	"fluff": true,
-	"fluid": true,
-	"fluke": true,
-	"fluky": true,
-	"flume": true,
-	"flump": true,
-	"flung": true,
-	"flunk": true,
-	"fluor": true,
-	"flurr": true,
-	"flush": true,
-	"flute": true,
-	"fluty": true,
-	"fluyt": true,
-	"flyby": true,
-	"flyer": true,
-	"flype": true,
-	"flyte": true,
-	"foals": true,
-	"foams": true,
-	"foamy": true,
-	"focal": true,
-	"focus": true,
-	"foehn": true,
-	"fogey": true,
-	"foggy": true,
-	"fogie": true,
-	"fogle": true,
-	"fogou": true,
-	"fohns": true,
-	"foids": true,
-	"foils": true,
-	"foins": true,
-	"foist": true,
-	"folds": true,
-	"foley": true,
-	"folia": true,
-	"folic": true,
-	"folie": true,
-	"folio": true,
-	"folks": true,
-	"folky": true,
-	"folly": true,
-	"fomes": true,
-	"fonda": true,
-	"fonds": true,
-	"fondu": true,
-	"fones": true,
-	"fonly": true,
-	"fonts": true,
-	"foods": true,
-	"foody": true,
-	"fools": true,
-	"foots": true,
-	"footy": true,
-	"foram": true,
-	"foray": true,
-	"forbs": true,
-	"forby": true,
-	"force": true,
-	"fordo": true,
-	"fords": true,
-	"forel": true,
-	"fores": true,
-	"forex": true,
-	"forge": true,
-	"forgo": true,
-	"forks": true,
-	"forky": true,
-	"forme": true,
-	"forms": true,
-	"forte": true,
-	"forth": true,
-	"forts": true,
-	"forty": true,
-	"forum": true,
-	"forza": true,
-	"forze": true,
-	"fossa": true,
-	"fosse": true,
-	"fouat": true,
-	"fouds": true,
-	"fouer": true,
-	"fouet": true,
-	"foule": true,
-	"fouls": true,
-	"found": true,
-	"fount": true,
-	"fours": true,
-	"fouth": true,
-	"fovea": true,
-	"fowls": true,
-	"fowth": true,
-	"foxed": true,
-	"foxes": true,
-	"foxie": true,
-	"foyer": true,
-	"foyle": true,
-	"foyne": true,
-	"frabs": true,
-	"frack": true,
-	"fract": true,
-	"frags": true,
-	"frail": true,
-	"fraim": true,
-	"frame": true,
-	"franc": true,
-	"frank": true,
-	"frape": true,
-	"fraps": true,
-	"frass": true,
-	"frate": true,
-	"frati": true,
-	"frats": true,
-	"fraud": true,
-	"fraus": true,
-	"frays": true,
-	"freak": true,
-	"freed": true,
-	"freer": true,
-	"frees": true,
-	"freet": true,
-	"freit": true,
-	"fremd": true,
-	"frena": true,
-	"freon": true,
-	"frere": true,
-	"fresh": true,
-	"frets": true,
-	"friar": true,
-	"fribs": true,
-	"fried": true,
-	"frier": true,
-	"fries": true,
-	"frigs": true,
-	"frill": true,
-	"frise": true,
-	"frisk": true,
-	"frist": true,
-	"frith": true,
-	"frits": true,
-	"fritt": true,
-	"fritz": true,
-	"frize": true,
-	"frizz": true,
-	"frock": true,
-	"froes": true,
-	"frogs": true,
-	"frond": true,
-	"frons": true,
-	"front": true,
-	"frore": true,
-	"frorn": true,
-	"frory": true,
-	"frosh": true,
-	"frost": true,
-	"froth": true,
-	"frown": true,
-	"frows": true,
-	"frowy": true,
-	"froze": true,
-	"frugs": true,
-	"fruit": true,
-	"frump": true,
-	"frush": true,
-	"frust": true,
-	"fryer": true,
-	"fubar": true,
-	"fubby": true,
-	"fubsy": true,
-	"fucks": true,
-	"fucus": true,
-	"fuddy": true,
-	"fudge": true,
-	"fudgy": true,
-	"fuels": true,
-	"fuero": true,
-	"fuffs": true,
-	"fuffy": true,
-	"fugal": true,
-	"fuggy": true,
-	"fugie": true,
-	"fugio": true,
-	"fugle": true,
-	"fugly": true,
-	"fugue": true,
-	"fugus": true,
-	"fujis": true,
-	"fulls": true,
-	"fully": true,
-	"fumed": true,
-	"fumer": true,
-	"fumes": true,
-	"fumet": true,
-	"fundi": true,
-	"funds": true,
-	"fundy": true,
-	"fungi": true,
-	"fungo": true,
-	"fungs": true,
-	"funks": true,
-	"funky": true,
-	"funny": true,
-	"fural": true,
-	"furan": true,
-	"furca": true,
-	"furls": true,
-	"furol": true,
-	"furor": true,
-	"furrs": true,
-	"furry": true,
-	"furth": true,
-	"furze": true,
-	"furzy": true,
-	"fused": true,
-	"fusee": true,
-	"fusel": true,
-	"fuses": true,
-	"fusil": true,
-	"fusks": true,
-	"fussy": true,
-	"fusts": true,
-	"fusty": true,
-	"futon": true,
-	"fuzed": true,
-	"fuzee": true,
-	"fuzes": true,
-	"fuzil": true,
-	"fuzzy": true,
-	"fyces": true,
-	"fyked": true,
-	"fykes": true,
-	"fyles": true,
-	"fyrds": true,
-	"fytte": true,
-	"gabba": true,
-	"gabby": true,
-	"gable": true,
-	"gaddi": true,
-	"gades": true,
-	"gadge": true,
-	"gadid": true,
-	"gadis": true,
-	"gadje": true,
-	"gadjo": true,
-	"gadso": true,
-	"gaffe": true,
-	"gaffs": true,
-	"gaged": true,
-	"gager": true,
-	"gages": true,
-	"gaids": true,
-	"gaily": true,
-	"gains": true,
-	"gairs": true,
-	"gaita": true,
-	"gaits": true,
-	"gaitt": true,
-	"gajos": true,
-	"galah": true,
-	"galas": true,
-	"galax": true,
-	"galea": true,
-	"galed": true,
-	"gales": true,
-	"galls": true,
-	"gally": true,
-	"galop": true,
-	"galut": true,
-	"galvo": true,
-	"gamas": true,
-	"gamay": true,
-	"gamba": true,
-	"gambe": true,
-	"gambo": true,
-	"gambs": true,
-	"gamed": true,
-	"gamer": true,
-	"games": true,
-	"gamey": true,
-	"gamic": true,
-	"gamin": true,
-	"gamma": true,
-	"gamme": true,
-	"gammy": true,
-	"gamps": true,
-	"gamut": true,
-	"ganch": true,
-	"gandy": true,
-	"ganef": true,
-	"ganev": true,
-	"gangs": true,
-	"ganja": true,
-	"ganof": true,
-	"gants": true,
-	"gaols": true,
-	"gaped": true,
-	"gaper": true,
-	"gapes": true,
-	"gapos": true,
-	"gappy": true,
-	"garbe": true,
-	"garbo": true,
-	"garbs": true,
-	"garda": true,
-	"gares": true,
-	"garis": true,
-	"garms": true,
-	"garni": true,
-	"garre": true,
-	"garth": true,
-	"garum": true,
-	"gases": true,
-	"gasps": true,
-	"gaspy": true,
-	"gassy": true,
-	"gasts": true,
-	"gatch": true,
-	"gated": true,
-	"gater": true,
-	"gates": true,
-	"gaths": true,
-	"gator": true,
-	"gauch": true,
-	"gaucy": true,
-	"gauds": true,
-	"gaudy": true,
-	"gauge": true,
-	"gauje": true,
-	"gault": true,
-	"gaums": true,
-	"gaumy": true,
-	"gaunt": true,
-	"gaups": true,
-	"gaurs": true,
-	"gauss": true,
-	"gauze": true,
-	"gauzy": true,
-	"gavel": true,
-	"gavot": true,
-	"gawcy": true,
-	"gawds": true,
-	"gawks": true,
-	"gawky": true,
-	"gawps": true,
-	"gawsy": true,
-	"gayal": true,
-	"gayer": true,
-	"gayly": true,
-	"gazal": true,
-	"gazar": true,
-	"gazed": true,
-	"gazer": true,
-	"gazes": true,
-	"gazon": true,
-	"gazoo": true,
-	"geals": true,
-	"geans": true,
-	"geare": true,
-	"gears": true,
-	"geats": true,
-	"gebur": true,
-	"gecko": true,
-	"gecks": true,
-	"geeks": true,
-	"geeky": true,
-	"geeps": true,
-	"geese": true,
-	"geest": true,
-	"geist": true,
-	"geits": true,
-	"gelds": true,
-	"gelee": true,
-	"gelid": true,
-	"gelly": true,
-	"gelts": true,
-	"gemel": true,
-	"gemma": true,
-	"gemmy": true,
-	"gemot": true,
-	"genal": true,
-	"genas": true,
-	"genes": true,
-	"genet": true,
-	"genic": true,
-	"genie": true,
-	"genii": true,
-	"genip": true,
-	"genny": true,
-	"genoa": true,
-	"genom": true,
-	"genre": true,
-	"genro": true,
-	"gents": true,
-	"genty": true,
-	"genua": true,
-	"genus": true,
-	"geode": true,
-	"geoid": true,
-	"gerah": true,
-	"gerbe": true,
-	"geres": true,
-	"gerle": true,
-	"germs": true,
-	"germy": true,
-	"gerne": true,
-	"gesse": true,
-	"gesso": true,
-	"geste": true,
-	"gests": true,
-	"getas": true,
-	"getup": true,
-	"geums": true,
-	"geyan": true,
-	"geyer": true,
-	"ghast": true,
-	"ghats": true,
-	"ghaut": true,
-	"ghazi": true,
-	"ghees": true,
-	"ghest": true,
-	"ghost": true,
-	"ghoul": true,
-	"ghyll": true,
-	"giant": true,
-	"gibed": true,
-	"gibel": true,
-	"giber": true,
-	"gibes": true,
-	"gibli": true,
-	"gibus": true,
-	"giddy": true,
-	"gifts": true,
-	"gigas": true,
-	"gighe": true,
-	"gigot": true,
-	"gigue": true,
-	"gilas": true,
-	"gilds": true,
-	"gilet": true,
-	"gills": true,
-	"gilly": true,
-	"gilpy": true,
-	"gilts": true,
-	"gimel": true,
-	"gimme": true,
-	"gimps": true,
-	"gimpy": true,
-	"ginch": true,
-	"ginge": true,
-	"gings": true,
-	"ginks": true,
-	"ginny": true,
-	"ginzo": true,
-	"gipon": true,
-	"gippo": true,
-	"gippy": true,
-	"gipsy": true,
-	"girds": true,
-	"girls": true,
-	"girly": true,
-	"girns": true,
-	"giron": true,
-	"giros": true,
-	"girrs": true,
-	"girsh": true,
-	"girth": true,
-	"girts": true,
-	"gismo": true,
-	"gisms": true,
-	"gists": true,
-	"gitch": true,
-	"gites": true,
-	"giust": true,
-	"gived": true,
-	"given": true,
-	"giver": true,
-	"gives": true,
-	"gizmo": true,
-	"glace": true,
-	"glade": true,
-	"glads": true,
-	"glady": true,
-	"glaik": true,
-	"glair": true,
-	"glams": true,
-	"gland": true,
-	"glans": true,
-	"glare": true,
-	"glary": true,
-	"glass": true,
-	"glaum": true,
-	"glaur": true,
-	"glaze": true,
-	"glazy": true,
-	"gleam": true,
-	"glean": true,
-	"gleba": true,
-	"glebe": true,
-	"gleby": true,
-	"glede": true,
-	"gleds": true,
-	"gleed": true,
-	"gleek": true,
-	"glees": true,
-	"gleet": true,
-	"gleis": true,
-	"glens": true,
-	"glent": true,
-	"gleys": true,
-	"glial": true,
-	"glias": true,
-	"glibs": true,
-	"glide": true,
-	"gliff": true,
-	"glift": true,
-	"glike": true,
-	"glime": true,
-	"glims": true,
-	"glint": true,
-	"glisk": true,
-	"glits": true,
-	"glitz": true,
-	"gloam": true,
-	"gloat": true,
-	"globe": true,
-	"globi": true,
-	"globs": true,
-	"globy": true,
-	"glode": true,
-	"glogg": true,
-	"gloms": true,
-	"gloom": true,
-	"gloop": true,
-	"glops": true,
-	"glory": true,
-	"gloss": true,
-	"glost": true,
-	"glout": true,
-	"glove": true,
-	"glows": true,
-	"gloze": true,
-	"glued": true,
-	"gluer": true,
-	"glues": true,
-	"gluey": true,
-	"glugs": true,
-	"glume": true,
-	"glums": true,
-	"gluon": true,
-	"glute": true,
-	"gluts": true,
-	"glyph": true,
-	"gnarl": true,
-	"gnarr": true,
-	"gnars": true,
-	"gnash": true,
-	"gnats": true,
-	"gnawn": true,
-	"gnaws": true,
-	"gnome": true,
-	"gnows": true,
-	"goads": true,
-	"goafs": true,
-	"goals": true,
-	"goary": true,
-	"goats": true,
-	"goaty": true,
-	"goban": true,
-	"gobar": true,
-	"gobbi": true,
-	"gobbo": true,
-	"gobby": true,
-	"gobis": true,
-	"gobos": true,
-	"godet": true,
-	"godly": true,
-	"godso": true,
-	"goels": true,
-	"goers": true,
-	"goest": true,
-	"goeth": true,
-	"goety": true,
-	"gofer": true,
-	"goffs": true,
-	"gogga": true,
-	"gogos": true,
-	"goier": true,
-	"going": true,
-	"gojis": true,
-	"golds": true,
-	"goldy": true,
-	"golem": true,
-	"goles": true,
-	"golfs": true,
-	"golly": true,
-	"golpe": true,
-	"golps": true,
-	"gombo": true,
-	"gomer": true,
-	"gompa": true,
-	"gonad": true,
-	"gonch": true,
-	"gonef": true,
-	"goner": true,
-	"gongs": true,
-	"gonia": true,
-	"gonif": true,
-	"gonks": true,
-	"gonna": true,
-	"gonof": true,
-	"gonys": true,
-	"gonzo": true,
-	"gooby": true,
-	"goods": true,
-	"goody": true,
-	"gooey": true,
-	"goofs": true,
-	"goofy": true,
-	"googs": true,
-	"gooks": true,
-	"gooky": true,
-	"goold": true,
-	"gools": true,
-	"gooly": true,
-	"goons": true,
-	"goony": true,
-	"goops": true,
-	"goopy": true,
-	"goors": true,
-	"goory": true,
-	"goose": true,
-	"goosy": true,
-	"gopak": true,
-	"gopik": true,
-	"goral": true,
-	"goras": true,
-	"gored": true,
-	"gores": true,
-	"gorge": true,
-	"goris": true,
-	"gorms": true,
-	"gormy": true,
-	"gorps": true,
-	"gorse": true,
-	"gorsy": true,
-	"gosht": true,
-	"gosse": true,
-	"gotch": true,
-	"goths": true,
-	"gothy": true,
-	"gotta": true,
-	"gouch": true,
-	"gouge": true,
-	"gouks": true,
-	"goura": true,
-	"gourd": true,
-	"gouts": true,
-	"gouty": true,
-	"gowan": true,
-	"gowds": true,
-	"gowfs": true,
-	"gowks": true,
-	"gowls": true,
-	"gowns": true,
-	"goxes": true,
-	"goyim": true,
-	"goyle": true,
-	"graal": true,
-	"grabs": true,
-	"grace": true,
-	"grade": true,
-	"grads": true,
-	"graff": true,
-	"graft": true,
-	"grail": true,
-	"grain": true,
-	"graip": true,
-	"grama": true,
-	"grame": true,
-	"gramp": true,
-	"grams": true,
-	"grana": true,
-	"grand": true,
-	"grans": true,
-	"grant": true,
-	"grape": true,
-	"graph": true,
-	"grapy": true,
-	"grasp": true,
-	"grass": true,
-	"grate": true,
-	"grave": true,
-	"gravs": true,
-	"gravy": true,
-	"grays": true,
-	"graze": true,
-	"great": true,
-	"grebe": true,
-	"grebo": true,
-	"grece": true,
-	"greed": true,
-	"greek": true,
-	"green": true,
-	"grees": true,
-	"greet": true,
-	"grege": true,
-	"grego": true,
-	"grein": true,
-	"grens": true,
-	"grese": true,
-	"greve": true,
-	"grews": true,
-	"greys": true,
-	"grice": true,
-	"gride": true,
-	"grids": true,
-	"grief": true,
-	"griff": true,
-	"grift": true,
-	"grigs": true,
-	"grike": true,
-	"grill": true,
-	"grime": true,
-	"grimy": true,
-	"grind": true,
-	"grins": true,
-	"griot": true,
-	"gripe": true,
-	"grips": true,
-	"gript": true,
-	"gripy": true,
-	"grise": true,
-	"grist": true,
-	"grisy": true,
-	"grith": true,
-	"grits": true,
-	"grize": true,
-	"groan": true,
-	"groat": true,
-	"grody": true,
-	"grogs": true,
-	"groin": true,
-	"groks": true,
-	"groma": true,
-	"grone": true,
-	"groof": true,
-	"groom": true,
-	"grope": true,
-	"gross": true,
-	"grosz": true,
-	"grots": true,
-	"grouf": true,
-	"group": true,
-	"grout": true,
-	"grove": true,
-	"grovy": true,
-	"growl": true,
-	"grown": true,
-	"grows": true,
-	"grrls": true,
-	"grrrl": true,
-	"grubs": true,
-	"grued": true,
-	"gruel": true,
-	"grues": true,
-	"grufe": true,
-	"gruff": true,
-	"grume": true,
-	"grump": true,
-	"grund": true,
-	"grunt": true,
-	"gryce": true,
-	"gryde": true,
-	"gryke": true,
-	"grype": true,
-	"grypt": true,
-	"guaco": true,
-	"guana": true,
-	"guano": true,
-	"guans": true,
-	"guard": true,
-	"guars": true,
-	"guava": true,
-	"gucks": true,
-	"gucky": true,
-	"gudes": true,
-	"guess": true,
-	"guest": true,
-	"guffs": true,
-	"gugas": true,
-	"guide": true,
-	"guids": true,
-	"guild": true,
-	"guile": true,
-	"guilt": true,
-	"guimp": true,
-	"guiro": true,
-	"guise": true,
-	"gulag": true,
-	"gular": true,
-	"gulas": true,
-	"gulch": true,
-	"gules": true,
-	"gulet": true,
-	"gulfs": true,
-	"gulfy": true,
-	"gulls": true,
-	"gully": true,
-	"gulph": true,
-	"gulps": true,
-	"gulpy": true,
-	"gumbo": true,
-	"gumma": true,
-	"gummi": true,
-	"gummy": true,
-	"gumps": true,
-	"gundy": true,
-	"gunge": true,
-	"gungy": true,
-	"gunks": true,
-	"gunky": true,
-	"gunny": true,
-	"guppy": true,
-	"guqin": true,
-	"gurdy": true,
-	"gurge": true,
-	"gurls": true,
-	"gurly": true,
-	"gurns": true,
-	"gurry": true,
-	"gursh": true,
-	"gurus": true,
-	"gushy": true,
-	"gusla": true,
-	"gusle": true,
-	"gusli": true,
-	"gussy": true,
-	"gusto": true,
-	"gusts": true,
-	"gusty": true,
-	"gutsy": true,
-	"gutta": true,
-	"gutty": true,
-	"guyed": true,
-	"guyle": true,
-	"guyot": true,
-	"guyse": true,
-	"gwine": true,
-	"gyals": true,
-	"gyans": true,
-	"gybed": true,
-	"gybes": true,
-	"gyeld": true,
-	"gymps": true,
-	"gynae": true,
-	"gynie": true,
-	"gynny": true,
-	"gynos": true,
-	"gyoza": true,
-	"gypos": true,
-	"gyppo": true,
-	"gyppy": true,
-	"gypsy": true,
-	"gyral": true,
-	"gyred": true,
-	"gyres": true,
-	"gyron": true,
-	"gyros": true,
-	"gyrus": true,
-	"gytes": true,
-	"gyved": true,
-	"gyves": true,
-	"haafs": true,
-	"haars": true,
-	"habit": true,
-	"hable": true,
-	"habus": true,
-	"hacek": true,
-	"hacks": true,
-	"hadal": true,
-	"haded": true,
-	"hades": true,
-	"hadji": true,
-	"hadst": true,
-	"haems": true,
-	"haets": true,
-	"haffs": true,
-	"hafiz": true,
-	"hafts": true,
-	"haggs": true,
-	"hahas": true,
-	"haick": true,
-	"haika": true,
-	"haiks": true,
-	"haiku": true,
-	"hails": true,
-	"haily": true,
-	"hains": true,
-	"haint": true,
-	"hairs": true,
-	"hairy": true,
-	"haith": true,
-	"hajes": true,
-	"hajis": true,
-	"hajji": true,
-	"hakam": true,
-	"hakas": true,
-	"hakea": true,
-	"hakes": true,
-	"hakim": true,
-	"hakus": true,
-	"halal": true,
-	"haled": true,
-	"haler": true,
-	"hales": true,
-	"halfa": true,
-	"halfs": true,
-	"halid": true,
-	"hallo": true,
-	"halls": true,
-	"halma": true,
-	"halms": true,
-	"halon": true,
-	"halos": true,
-	"halse": true,
-	"halts": true,
-	"halva": true,
-	"halve": true,
-	"halwa": true,
-	"hamal": true,
-	"hamba": true,
-	"hamed": true,
-	"hames": true,
-	"hammy": true,
-	"hamza": true,
-	"hanap": true,
-	"hance": true,
-	"hanch": true,
-	"hands": true,
-	"handy": true,
-	"hangi": true,
-	"hangs": true,
-	"hanks": true,
-	"hanky": true,
-	"hansa": true,
-	"hanse": true,
-	"hants": true,
-	"haole": true,
-	"haoma": true,
-	"hapax": true,
-	"haply": true,
-	"happi": true,
-	"happy": true,
-	"hapus": true,
-	"haram": true,
-	"hards": true,
-	"hardy": true,
-	"hared": true,
-	"harem": true,
-	"hares": true,
-	"harim": true,
-	"harks": true,
-	"harls": true,
-	"harms": true,
-	"harns": true,
-	"haros": true,
-	"harps": true,
-	"harpy": true,
-	"harry": true,
-	"harsh": true,
-	"harts": true,
-	"hashy": true,
-	"hasks": true,
-	"hasps": true,
-	"hasta": true,
-	"haste": true,
-	"hasty": true,
-	"hatch": true,
-	"hated": true,
-	"hater": true,
-	"hates": true,
-	"hatha": true,
-	"hauds": true,
-	"haufs": true,
-	"haugh": true,
-	"hauld": true,
-	"haulm": true,
-	"hauls": true,
-	"hault": true,
-	"hauns": true,
-	"haunt": true,
-	"hause": true,
-	"haute": true,
-	"haven": true,
-	"haver": true,
-	"haves": true,
-	"havoc": true,
-	"hawed": true,
-	"hawks": true,
-	"hawms": true,
-	"hawse": true,
-	"hayed": true,
-	"hayer": true,
-	"hayey": true,
-	"hayle": true,
-	"hazan": true,
-	"hazed": true,
-	"hazel": true,
-	"hazer": true,
-	"hazes": true,
-	"heads": true,
-	"heady": true,
-	"heald": true,
-	"heals": true,
-	"heame": true,
-	"heaps": true,
-	"heapy": true,
-	"heard": true,
-	"heare": true,
-	"hears": true,
-	"heart": true,
-	"heast": true,
-	"heath": true,
-	"heats": true,
-	"heave": true,
-	"heavy": true,
-	"heben": true,
-	"hebes": true,
-	"hecht": true,
-	"hecks": true,
-	"heder": true,
-	"hedge": true,
-	"hedgy": true,
-	"heeds": true,
-	"heedy": true,
-	"heels": true,
-	"heeze": true,
-	"hefte": true,
-	"hefts": true,
-	"hefty": true,
-	"heids": true,
-	"heigh": true,
-	"heils": true,
-	"heirs": true,
-	"heist": true,
-	"hejab": true,
-	"hejra": true,
-	"heled": true,
-	"heles": true,
-	"helio": true,
-	"helix": true,
-	"hello": true,
-	"hells": true,
-	"helms": true,
-	"helos": true,
-	"helot": true,
-	"helps": true,
-	"helve": true,
-	"hemal": true,
-	"hemes": true,
-	"hemic": true,
-	"hemin": true,
-	"hemps": true,
-	"hempy": true,
-	"hence": true,
-	"hench": true,
-	"hends": true,
-	"henge": true,
-	"henna": true,
-	"henny": true,
-	"henry": true,
-	"hents": true,
-	"hepar": true,
-	"herbs": true,
-	"herby": true,
-	"herds": true,
-	"heres": true,
-	"herls": true,
-	"herma": true,
-	"herms": true,
-	"herns": true,
-	"heron": true,
-	"heros": true,
-	"herry": true,
-	"herse": true,
-	"hertz": true,
-	"herye": true,
-	"hesps": true,
-	"hests": true,
-	"hetes": true,
-	"heths": true,
-	"heuch": true,
-	"heugh": true,
-	"hevea": true,
-	"hewed": true,
-	"hewer": true,
-	"hewgh": true,
-	"hexad": true,
-	"hexed": true,
-	"hexer": true,
-	"hexes": true,
-	"hexyl": true,
-	"heyed": true,
-	"hiant": true,
-	"hicks": true,
-	"hided": true,
-	"hider": true,
-	"hides": true,
-	"hiems": true,
-	"highs": true,
-	"hight": true,
-	"hijab": true,
-	"hijra": true,
-	"hiked": true,
-	"hiker": true,
-	"hikes": true,
-	"hikoi": true,
-	"hilar": true,
-	"hilch": true,
-	"hillo": true,
-	"hills": true,
-	"hilly": true,
-	"hilts": true,
-	"hilum": true,
-	"hilus": true,
-	"himbo": true,
-	"hinau": true,
-	"hinds": true,
-	"hinge": true,
-	"hings": true,
-	"hinky": true,
-	"hinny": true,
-	"hints": true,
-	"hiois": true,
-	"hiply": true,
-	"hippo": true,
-	"hippy": true,
-	"hired": true,
-	"hiree": true,
-	"hirer": true,
-	"hires": true,
-	"hissy": true,
-	"hists": true,
-	"hitch": true,
-	"hithe": true,
-	"hived": true,
-	"hiver": true,
-	"hives": true,
-	"hizen": true,
-	"hoaed": true,
-	"hoagy": true,
-	"hoard": true,
-	"hoars": true,
-	"hoary": true,
-	"hoast": true,
-	"hobby": true,
-	"hobos": true,
-	"hocks": true,
-	"hocus": true,
-	"hodad": true,
-	"hodja": true,
-	"hoers": true,
-	"hogan": true,
-	"hogen": true,
-	"hoggs": true,
-	"hoghs": true,
-	"hohed": true,
-	"hoick": true,
-	"hoied": true,
-	"hoiks": true,
-	"hoing": true,
-	"hoise": true,
-	"hoist": true,
-	"hokas": true,
-	"hoked": true,
-	"hokes": true,
-	"hokey": true,
-	"hokis": true,
-	"hokku": true,
-	"hokum": true,
-	"holds": true,
-	"holed": true,
-	"holes": true,
-	"holey": true,
-	"holks": true,
-	"holla": true,
-	"hollo": true,
-	"holly": true,
-	"holme": true,
-	"holms": true,
-	"holon": true,
-	"holos": true,
-	"holts": true,
-	"homas": true,
-	"homed": true,
-	"homer": true,
-	"homes": true,
-	"homey": true,
-	"homie": true,
-	"homme": true,
-	"homos": true,
-	"honan": true,
-	"honda": true,
-	"honds": true,
-	"honed": true,
-	"honer": true,
-	"hones": true,
-	"honey": true,
-	"hongi": true,
-	"hongs": true,
-	"honks": true,
-	"honky": true,
-	"honor": true,
-	"hooch": true,
-	"hoods": true,
-	"hoody": true,
-	"hooey": true,
-	"hoofs": true,
-	"hooka": true,
-	"hooks": true,
-	"hooky": true,
-	"hooly": true,
-	"hoons": true,
-	"hoops": true,
-	"hoord": true,
-	"hoors": true,
-	"hoosh": true,
-	"hoots": true,
-	"hooty": true,
-	"hoove": true,
-	"hopak": true,
-	"hoped": true,
-	"hoper": true,
-	"hopes": true,
-	"hoppy": true,
-	"horah": true,
-	"horal": true,
-	"horas": true,
-	"horde": true,
-	"horis": true,
-	"horks": true,
-	"horme": true,
-	"horns": true,
-	"horny": true,
-	"horse": true,
-	"horst": true,
-	"horsy": true,
-	"hosed": true,
-	"hosel": true,
-	"hosen": true,
-	"hoser": true,
-	"hoses": true,
-	"hosey": true,
-	"hosta": true,
-	"hosts": true,
-	"hotch": true,
-	"hotel": true,
-	"hoten": true,
-	"hotly": true,
-	"hotty": true,
-	"houff": true,
-	"houfs": true,
-	"hough": true,
-	"hound": true,
-	"houri": true,
-	"hours": true,
-	"house": true,
-	"houts": true,
-	"hovea": true,
-	"hoved": true,
-	"hovel": true,
-	"hoven": true,
-	"hover": true,
-	"hoves": true,
-	"howbe": true,
-	"howdy": true,
-	"howes": true,
-	"howff": true,
-	"howfs": true,
-	"howks": true,
-	"howls": true,
-	"howre": true,
-	"howso": true,
-	"hoxed": true,
-	"hoxes": true,
-	"hoyas": true,
-	"hoyed": true,
-	"hoyle": true,
-	"hubby": true,
-	"hucks": true,
-	"hudna": true,
-	"hudud": true,
-	"huers": true,
-	"huffs": true,
-	"huffy": true,
-	"huger": true,
-	"huggy": true,
-	"huhus": true,
-	"huias": true,
-	"hulas": true,
-	"hules": true,
-	"hulks": true,
-	"hulky": true,
-	"hullo": true,
-	"hulls": true,
-	"hully": true,
-	"human": true,
-	"humas": true,
-	"humfs": true,
-	"humic": true,
-	"humid": true,
-	"humor": true,
-	"humph": true,
-	"humps": true,
-	"humpy": true,
-	"humus": true,
-	"hunch": true,
-	"hunks": true,
-	"hunky": true,
-	"hunts": true,
-	"hurds": true,
-	"hurls": true,
-	"hurly": true,
-	"hurra": true,
-	"hurry": true,
-	"hurst": true,
-	"hurts": true,
-	"hushy": true,
-	"husks": true,
-	"husky": true,
-	"husos": true,
-	"hussy": true,
-	"hutch": true,
-	"hutia": true,
-	"huzza": true,
-	"huzzy": true,
-	"hwyls": true,
-	"hydra": true,
-	"hydro": true,
-	"hyena": true,
-	"hyens": true,
-	"hygge": true,
-	"hying": true,
-	"hykes": true,
-	"hylas": true,
-	"hyleg": true,
-	"hyles": true,
-	"hylic": true,
-	"hymen": true,
-	"hymns": true,
-	"hynde": true,
-	"hyoid": true,
-	"hyped": true,
-	"hyper": true,
-	"hypes": true,
-	"hypha": true,
-	"hyphy": true,
-	"hypos": true,
-	"hyrax": true,
-	"hyson": true,
-	"hythe": true,
-	"iambi": true,
-	"iambs": true,
-	"ibrik": true,
-	"icers": true,
-	"iched": true,
-	"iches": true,
-	"ichor": true,
-	"icier": true,
-	"icily": true,
-	"icing": true,
-	"icker": true,
-	"ickle": true,
-	"icons": true,
-	"ictal": true,
-	"ictic": true,
-	"ictus": true,
-	"idant": true,
-	"ideal": true,
-	"ideas": true,
-	"idees": true,
-	"ident": true,
-	"idiom": true,
-	"idiot": true,
-	"idled": true,
-	"idler": true,
-	"idles": true,
-	"idola": true,
-	"idols": true,
-	"idyll": true,
-	"idyls": true,
-	"iftar": true,
-	"igapo": true,
-	"igged": true,
-	"igloo": true,
-	"iglus": true,
-	"ihram": true,
-	"ikans": true,
-	"ikats": true,
-	"ikons": true,
-	"ileac": true,
-	"ileal": true,
-	"ileum": true,
-	"ileus": true,
-	"iliac": true,
-	"iliad": true,
-	"ilial": true,
-	"ilium": true,
-	"iller": true,
-	"illth": true,
-	"image": true,
-	"imago": true,
-	"imams": true,
-	"imari": true,
-	"imaum": true,
-	"imbar": true,
-	"imbed": true,
-	"imbue": true,
-	"imide": true,
-	"imido": true,
-	"imids": true,
-	"imine": true,
-	"imino": true,
-	"immew": true,
-	"immit": true,
-	"immix": true,
-	"imped": true,
-	"impel": true,
-	"impis": true,
-	"imply": true,
-	"impot": true,
-	"impro": true,
-	"imshi": true,
-	"imshy": true,
-	"inane": true,
-	"inapt": true,
-	"inarm": true,
-	"inbox": true,
-	"inbye": true,
-	"incel": true,
-	"incle": true,
-	"incog": true,
-	"incur": true,
-	"incus": true,
-	"incut": true,
-	"indew": true,
-	"index": true,
-	"india": true,
-	"indie": true,
-	"indol": true,
-	"indow": true,
-	"indri": true,
-	"indue": true,
-	"inept": true,
-	"inerm": true,
-	"inert": true,
-	"infer": true,
-	"infix": true,
-	"infos": true,
-	"infra": true,
-	"ingan": true,
-	"ingle": true,
-	"ingot": true,
-	"inion": true,
-	"inked": true,
-	"inker": true,
-	"inkle": true,
-	"inlay": true,
-	"inlet": true,
-	"inned": true,
-	"inner": true,
-	"innit": true,
-	"inorb": true,
-	"input": true,
-	"inrun": true,
-	"inset": true,
-	"inspo": true,
-	"intel": true,
-	"inter": true,
-	"intil": true,
-	"intis": true,
-	"intra": true,
-	"intro": true,
-	"inula": true,
-	"inure": true,
-	"inurn": true,
-	"inust": true,
-	"invar": true,
-	"inwit": true,
-	"iodic": true,
-	"iodid": true,
-	"iodin": true,
-	"ionic": true,
-	"iotas": true,
-	"ippon": true,
-	"irade": true,
-	"irate": true,
-	"irids": true,
-	"iring": true,
-	"irked": true,
-	"iroko": true,
-	"irone": true,
-	"irons": true,
-	"irony": true,
-	"isbas": true,
-	"ishes": true,
-	"isled": true,
-	"isles": true,
-	"islet": true,
-	"isnae": true,
-	"issei": true,
-	"issue": true,
-	"istle": true,
-	"itchy": true,
-	"items": true,
-	"ither": true,
-	"ivied": true,
-	"ivies": true,
-	"ivory": true,
-	"ixias": true,
-	"ixnay": true,
-	"ixora": true,
-	"ixtle": true,
-	"izard": true,
-	"izars": true,
-	"izzat": true,
-	"jaaps": true,
-	"jabot": true,
-	"jacal": true,
-	"jacks": true,
-	"jacky": true,
-	"jaded": true,
-	"jades": true,
-	"jafas": true,
-	"jaffa": true,
-	"jagas": true,
-	"jager": true,
-	"jaggs": true,
-	"jaggy": true,
-	"jagir": true,
-	"jagra": true,
-	"jails": true,
-	"jaker": true,
-	"jakes": true,
-	"jakey": true,
-	"jalap": true,
-	"jalop": true,
-	"jambe": true,
-	"jambo": true,
-	"jambs": true,
-	"jambu": true,
-	"james": true,
-	"jammy": true,
-	"jamon": true,
-	"janes": true,
-	"janns": true,
-	"janny": true,
-	"janty": true,
-	"japan": true,
-	"japed": true,
-	"japer": true,
-	"japes": true,
-	"jarks": true,
-	"jarls": true,
-	"jarps": true,
-	"jarta": true,
-	"jarul": true,
-	"jasey": true,
-	"jaspe": true,
-	"jasps": true,
-	"jatos": true,
-	"jauks": true,
-	"jaunt": true,
-	"jaups": true,
-	"javas": true,
-	"javel": true,
-	"jawan": true,
-	"jawed": true,
-	"jaxie": true,
-	"jazzy": true,
-	"jeans": true,
-	"jeats": true,
-	"jebel": true,
-	"jedis": true,
-	"jeels": true,
-	"jeely": true,
-	"jeeps": true,
-	"jeers": true,
-	"jeeze": true,
-	"jefes": true,
-	"jeffs": true,
-	"jehad": true,
-	"jehus": true,
-	"jelab": true,
-	"jello": true,
-	"jells": true,
-	"jelly": true,
-	"jembe": true,
-	"jemmy": true,
-	"jenny": true,
-	"jeons": true,
-	"jerid": true,
-	"jerks": true,
-	"jerky": true,
-	"jerry": true,
-	"jesse": true,
-	"jests": true,
-	"jesus": true,
-	"jetes": true,
-	"jeton": true,
-	"jetty": true,
-	"jeune": true,
-	"jewed": true,
-	"jewel": true,
-	"jewie": true,
-	"jhala": true,
-	"jiaos": true,
-	"jibba": true,
-	"jibbs": true,
-	"jibed": true,
-	"jiber": true,
-	"jibes": true,
-	"jiffs": true,
-	"jiffy": true,
-	"jiggy": true,
-	"jigot": true,
-	"jihad": true,
-	"jills": true,
-	"jilts": true,
-	"jimmy": true,
-	"jimpy": true,
-	"jingo": true,
-	"jinks": true,
-	"jinne": true,
-	"jinni": true,
-	"jinns": true,
-	"jirds": true,
-	"jirga": true,
-	"jirre": true,
-	"jisms": true,
-	"jived": true,
-	"jiver": true,
-	"jives": true,
-	"jivey": true,
-	"jnana": true,
-	"jobed": true,
-	"jobes": true,
-	"jocko": true,
-	"jocks": true,
-	"jocky": true,
-	"jocos": true,
-	"jodel": true,
-	"joeys": true,
-	"johns": true,
-	"joins": true,
-	"joint": true,
-	"joist": true,
-	"joked": true,
-	"joker": true,
-	"jokes": true,
-	"jokey": true,
-	"jokol": true,
-	"joled": true,
-	"joles": true,
-	"jolls": true,
-	"jolly": true,
-	"jolts": true,
-	"jolty": true,
-	"jomon": true,
-	"jomos": true,
-	"jones": true,
-	"jongs": true,
-	"jonty": true,
-	"jooks": true,
-	"joram": true,
-	"jorum": true,
-	"jotas": true,
-	"jotty": true,
-	"jotun": true,
-	"joual": true,
-	"jougs": true,
-	"jouks": true,
-	"joule": true,
-	"jours": true,
-	"joust": true,
-	"jowar": true,
-	"jowed": true,
-	"jowls": true,
-	"jowly": true,
-	"joyed": true,
-	"jubas": true,
-	"jubes": true,
-	"jucos": true,
-	"judas": true,
-	"judge": true,
-	"judgy": true,
-	"judos": true,
-	"jugal": true,
-	"jugum": true,
-	"juice": true,
-	"juicy": true,
-	"jujus": true,
-	"juked": true,
-	"jukes": true,
-	"jukus": true,
-	"julep": true,
-	"jumar": true,
-	"jumbo": true,
-	"jumby": true,
-	"jumps": true,
-	"jumpy": true,
-	"junco": true,
-	"junks": true,
-	"junky": true,
-	"junta": true,
-	"junto": true,
-	"jupes": true,
-	"jupon": true,
-	"jural": true,
-	"jurat": true,
-	"jurel": true,
-	"jures": true,
-	"juror": true,
-	"justs": true,
-	"jutes": true,
-	"jutty": true,
-	"juves": true,
-	"juvie": true,
-	"kaama": true,
-	"kabab": true,
-	"kabar": true,
-	"kabob": true,
-	"kacha": true,
-	"kacks": true,
-	"kadai": true,
-	"kades": true,
-	"kadis": true,
-	"kafir": true,
-	"kagos": true,
-	"kagus": true,
-	"kahal": true,
-	"kaiak": true,
-	"kaids": true,
-	"kaies": true,
-	"kaifs": true,
-	"kaika": true,
-	"kaiks": true,
-	"kails": true,
-	"kaims": true,
-	"kaing": true,
-	"kains": true,
-	"kakas": true,
-	"kakis": true,
-	"kalam": true,
-	"kales": true,
-	"kalif": true,
-	"kalis": true,
-	"kalpa": true,
-	"kamas": true,
-	"kames": true,
-	"kamik": true,
-	"kamis": true,
-	"kamme": true,
-	"kanae": true,
-	"kanas": true,
-	"kandy": true,
-	"kaneh": true,
-	"kanes": true,
-	"kanga": true,
-	"kangs": true,
-	"kanji": true,
-	"kants": true,
-	"kanzu": true,
-	"kaons": true,
-	"kapas": true,
-	"kaphs": true,
-	"kapok": true,
-	"kapow": true,
-	"kappa": true,
-	"kapus": true,
-	"kaput": true,
-	"karas": true,
-	"karat": true,
-	"karks": true,
-	"karma": true,
-	"karns": true,
-	"karoo": true,
-	"karos": true,
-	"karri": true,
-	"karst": true,
-	"karsy": true,
-	"karts": true,
-	"karzy": true,
-	"kasha": true,
-	"kasme": true,
-	"katal": true,
-	"katas": true,
-	"katis": true,
-	"katti": true,
-	"kaugh": true,
-	"kauri": true,
-	"kauru": true,
-	"kaury": true,
-	"kaval": true,
-	"kavas": true,
-	"kawas": true,
-	"kawau": true,
-	"kawed": true,
-	"kayak": true,
-	"kayle": true,
-	"kayos": true,
-	"kazis": true,
-	"kazoo": true,
-	"kbars": true,
-	"kebab": true,
-	"kebar": true,
-	"kebob": true,
-	"kecks": true,
-	"kedge": true,
-	"kedgy": true,
-	"keech": true,
-	"keefs": true,
-	"keeks": true,
-	"keels": true,
-	"keema": true,
-	"keeno": true,
-	"keens": true,
-	"keeps": true,
-	"keets": true,
-	"keeve": true,
-	"kefir": true,
-	"kehua": true,
-	"keirs": true,
-	"kelep": true,
-	"kelim": true,
-	"kells": true,
-	"kelly": true,
-	"kelps": true,
-	"kelpy": true,
-	"kelts": true,
-	"kelty": true,
-	"kembo": true,
-	"kembs": true,
-	"kemps": true,
-	"kempt": true,
-	"kempy": true,
-	"kenaf": true,
-	"kench": true,
-	"kendo": true,
-	"kenos": true,
-	"kente": true,
-	"kents": true,
-	"kepis": true,
-	"kerbs": true,
-	"kerel": true,
-	"kerfs": true,
-	"kerky": true,
-	"kerma": true,
-	"kerne": true,
-	"kerns": true,
-	"keros": true,
-	"kerry": true,
-	"kerve": true,
-	"kesar": true,
-	"kests": true,
-	"ketas": true,
-	"ketch": true,
-	"ketes": true,
-	"ketol": true,
-	"kevel": true,
-	"kevil": true,
-	"kexes": true,
-	"keyed": true,
-	"keyer": true,
-	"khadi": true,
-	"khafs": true,
-	"khaki": true,
-	"khans": true,
-	"khaph": true,
-	"khats": true,
-	"khaya": true,
-	"khazi": true,
-	"kheda": true,
-	"kheth": true,
-	"khets": true,
-	"khoja": true,
-	"khors": true,
-	"khoum": true,
-	"khuds": true,
-	"kiaat": true,
-	"kiack": true,
-	"kiang": true,
-	"kibbe": true,
-	"kibbi": true,
-	"kibei": true,
-	"kibes": true,
-	"kibla": true,
-	"kicks": true,
-	"kicky": true,
-	"kiddo": true,
-	"kiddy": true,
-	"kidel": true,
-	"kidge": true,
-	"kiefs": true,
-	"kiers": true,
-	"kieve": true,
-	"kievs": true,
-	"kight": true,
-	"kikes": true,
-	"kikoi": true,
-	"kiley": true,
-	"kilim": true,
-	"kills": true,
-	"kilns": true,
-	"kilos": true,
-	"kilps": true,
-	"kilts": true,
-	"kilty": true,
-	"kimbo": true,
-	"kinas": true,
-	"kinda": true,
-	"kinds": true,
-	"kindy": true,
-	"kines": true,
-	"kings": true,
-	"kinin": true,
-	"kinks": true,
-	"kinky": true,
-	"kinos": true,
-	"kiore": true,
-	"kiosk": true,
-	"kipes": true,
-	"kippa": true,
-	"kipps": true,
-	"kirby": true,
-	"kirks": true,
-	"kirns": true,
-	"kirri": true,
-	"kisan": true,
-	"kissy": true,
-	"kists": true,
-	"kited": true,
-	"kiter": true,
-	"kites": true,
-	"kithe": true,
-	"kiths": true,
-	"kitty": true,
-	"kitul": true,
-	"kivas": true,
-	"kiwis": true,
-	"klang": true,
-	"klaps": true,
-	"klett": true,
-	"klick": true,
-	"klieg": true,
-	"kliks": true,
-	"klong": true,
-	"kloof": true,
-	"kluge": true,
-	"klutz": true,
-	"knack": true,
-	"knags": true,
-	"knaps": true,
-	"knarl": true,
-	"knars": true,
-	"knaur": true,
-	"knave": true,
-	"knawe": true,
-	"knead": true,
-	"kneed": true,
-	"kneel": true,
-	"knees": true,
-	"knell": true,
-	"knelt": true,
-	"knife": true,
-	"knish": true,
-	"knits": true,
-	"knive": true,
-	"knobs": true,
-	"knock": true,
-	"knoll": true,
-	"knops": true,
-	"knosp": true,
-	"knots": true,
-	"knout": true,
-	"knowe": true,
-	"known": true,
-	"knows": true,
-	"knubs": true,
-	"knurl": true,
-	"knurr": true,
-	"knurs": true,
-	"knuts": true,
-	"koala": true,
-	"koans": true,
-	"koaps": true,
-	"koban": true,
-	"kobos": true,
-	"koels": true,
-	"koffs": true,
-	"kofta": true,
-	"kogal": true,
-	"kohas": true,
-	"kohen": true,
-	"kohls": true,
-	"koine": true,
-	"kojis": true,
-	"kokam": true,
-	"kokas": true,
-	"koker": true,
-	"kokra": true,
-	"kokum": true,
-	"kolas": true,
-	"kolos": true,
-	"kombu": true,
-	"konbu": true,
-	"kondo": true,
-	"konks": true,
-	"kooks": true,
-	"kooky": true,
-	"koori": true,
-	"kopek": true,
-	"kophs": true,
-	"kopje": true,
-	"koppa": true,
-	"korai": true,
-	"koras": true,
-	"korat": true,
-	"kores": true,
-	"korma": true,
-	"koros": true,
-	"korun": true,
-	"korus": true,
-	"koses": true,
-	"kotch": true,
-	"kotos": true,
-	"kotow": true,
-	"koura": true,
-	"kraal": true,
-	"krabs": true,
-	"kraft": true,
-	"krais": true,
-	"krait": true,
-	"krang": true,
-	"krans": true,
-	"kranz": true,
-	"kraut": true,
-	"krays": true,
-	"kreep": true,
-	"kreng": true,
-	"krewe": true,
-	"krill": true,
-	"krona": true,
-	"krone": true,
-	"kroon": true,
-	"krubi": true,
-	"krunk": true,
-	"ksars": true,
-	"kubie": true,
-	"kudos": true,
-	"kudus": true,
-	"kudzu": true,
-	"kufis": true,
-	"kugel": true,
-	"kuias": true,
-	"kukri": true,
-	"kukus": true,
-	"kulak": true,
-	"kulan": true,
-	"kulas": true,
-	"kulfi": true,
-	"kumis": true,
-	"kumys": true,
-	"kuris": true,
-	"kurre": true,
-	"kurta": true,
-	"kurus": true,
-	"kusso": true,
-	"kutas": true,
-	"kutch": true,
-	"kutis": true,
-	"kutus": true,
-	"kuzus": true,
-	"kvass": true,
-	"kvell": true,
-	"kwela": true,
-	"kyack": true,
-	"kyaks": true,
-	"kyang": true,
-	"kyars": true,
-	"kyats": true,
-	"kybos": true,
-	"kydst": true,
-	"kyles": true,
-	"kylie": true,
-	"kylin": true,
-	"kylix": true,
-	"kyloe": true,
-	"kynde": true,
-	"kynds": true,
-	"kypes": true,
-	"kyrie": true,
-	"kytes": true,
-	"kythe": true,
-	"laari": true,
-	"labda": true,
-	"label": true,
-	"labia": true,
-	"labis": true,
-	"labor": true,
-	"labra": true,
-	"laced": true,
-	"lacer": true,
-	"laces": true,
-	"lacet": true,
-	"lacey": true,
-	"lacks": true,
-	"laddy": true,
-	"laded": true,
-	"laden": true,
-	"lader": true,
-	"lades": true,
-	"ladle": true,
-	"laers": true,
-	"laevo": true,
-	"lagan": true,
-	"lager": true,
-	"lahal": true,
-	"lahar": true,
-	"laich": true,
-	"laics": true,
-	"laids": true,
-	"laigh": true,
-	"laika": true,
-	"laiks": true,
-	"laird": true,
-	"lairs": true,
-	"lairy": true,
-	"laith": true,
-	"laity": true,
-	"laked": true,
-	"laker": true,
-	"lakes": true,
-	"lakhs": true,
-	"lakin": true,
-	"laksa": true,
-	"laldy": true,
-	"lalls": true,
-	"lamas": true,
-	"lambs": true,
-	"lamby": true,
-	"lamed": true,
-	"lamer": true,
-	"lames": true,
-	"lamia": true,
-	"lammy": true,
-	"lamps": true,
-	"lanai": true,
-	"lanas": true,
-	"lance": true,
-	"lanch": true,
-	"lande": true,
-	"lands": true,
-	"lanes": true,
-	"lanks": true,
-	"lanky": true,
-	"lants": true,
-	"lapel": true,
-	"lapin": true,
-	"lapis": true,
-	"lapje": true,
-	"lapse": true,
-	"larch": true,
-	"lards": true,
-	"lardy": true,
-	"laree": true,
-	"lares": true,
-	"large": true,
-	"largo": true,
-	"laris": true,
-	"larks": true,
-	"larky": true,
-	"larns": true,
-	"larnt": true,
-	"larum": true,
-	"larva": true,
-	"lased": true,
-	"laser": true,
-	"lases": true,
-	"lassi": true,
-	"lasso": true,
-	"lassu": true,
-	"lassy": true,
-	"lasts": true,
-	"latah": true,
-	"latch": true,
-	"lated": true,
-	"laten": true,
-	"later": true,
-	"latex": true,
-	"lathe": true,
-	"lathi": true,
-	"laths": true,
-	"lathy": true,
-	"latke": true,
-	"latte": true,
-	"latus": true,
-	"lauan": true,
-	"lauch": true,
-	"lauds": true,
-	"laufs": true,
-	"laugh": true,
-	"laund": true,
-	"laura": true,
-	"laval": true,
-	"lavas": true,
-	"laved": true,
-	"laver": true,
-	"laves": true,
-	"lavra": true,
-	"lavvy": true,
-	"lawed": true,
-	"lawer": true,
-	"lawin": true,
-	"lawks": true,
-	"lawns": true,
-	"lawny": true,
-	"laxed": true,
-	"laxer": true,
-	"laxes": true,
-	"laxly": true,
-	"layed": true,
-	"layer": true,
-	"layin": true,
-	"layup": true,
-	"lazar": true,
-	"lazed": true,
-	"lazes": true,
-	"lazos": true,
-	"lazzi": true,
-	"lazzo": true,
-	"leach": true,
-	"leads": true,
-	"leady": true,
-	"leafs": true,
-	"leafy": true,
-	"leaks": true,
-	"leaky": true,
-	"leams": true,
-	"leans": true,
-	"leant": true,
-	"leany": true,
-	"leaps": true,
-	"leapt": true,
-	"leare": true,
-	"learn": true,
-	"lears": true,
-	"leary": true,
-	"lease": true,
-	"leash": true,
-	"least": true,
-	"leats": true,
-	"leave": true,
-	"leavy": true,
-	"leaze": true,
-	"leben": true,
-	"leccy": true,
-	"ledes": true,
-	"ledge": true,
-	"ledgy": true,
-	"ledum": true,
-	"leear": true,
-	"leech": true,
-	"leeks": true,
-	"leeps": true,
-	"leers": true,
-	"leery": true,
-	"leese": true,
-	"leets": true,
-	"leeze": true,
-	"lefte": true,
-	"lefts": true,
-	"lefty": true,
-	"legal": true,
-	"leger": true,
-	"leges": true,
-	"legge": true,
-	"leggo": true,
-	"leggy": true,
-	"legit": true,
-	"lehrs": true,
-	"lehua": true,
-	"leirs": true,
-	"leish": true,
-	"leman": true,
-	"lemed": true,
-	"lemel": true,
-	"lemes": true,
-	"lemma": true,
-	"lemme": true,
-	"lemon": true,
-	"lemur": true,
-	"lends": true,
-	"lenes": true,
-	"lengs": true,
-	"lenis": true,
-	"lenos": true,
-	"lense": true,
-	"lenti": true,
-	"lento": true,
-	"leone": true,
-	"leper": true,
-	"lepid": true,
-	"lepra": true,
-	"lepta": true,
-	"lered": true,
-	"leres": true,
-	"lerps": true,
-	"lesbo": true,
-	"leses": true,
-	"lests": true,
-	"letch": true,
-	"lethe": true,
-	"letup": true,
-	"leuch": true,
-	"leuco": true,
-	"leuds": true,
-	"leugh": true,
-	"levas": true,
-	"levee": true,
-	"level": true,
-	"lever": true,
-	"leves": true,
-	"levin": true,
-	"levis": true,
-	"lewis": true,
-	"lexes": true,
-	"lexis": true,
-	"lezes": true,
-	"lezza": true,
-	"lezzy": true,
-	"liana": true,
-	"liane": true,
-	"liang": true,
-	"liard": true,
-	"liars": true,
-	"liart": true,
-	"libel": true,
-	"liber": true,
-	"libra": true,
-	"libri": true,
-	"lichi": true,
-	"licht": true,
-	"licit": true,
-	"licks": true,
-	"lidar": true,
-	"lidos": true,
-	"liefs": true,
-	"liege": true,
-	"liens": true,
-	"liers": true,
-	"lieus": true,
-	"lieve": true,
-	"lifer": true,
-	"lifes": true,
-	"lifts": true,
-	"ligan": true,
-	"liger": true,
-	"ligge": true,
-	"light": true,
-	"ligne": true,
-	"liked": true,
-	"liken": true,
-	"liker": true,
-	"likes": true,
-	"likin": true,
-	"lilac": true,
-	"lills": true,
-	"lilos": true,
-	"lilts": true,
-	"liman": true,
-	"limas": true,
-	"limax": true,
-	"limba": true,
-	"limbi": true,
-	"limbo": true,
-	"limbs": true,
-	"limby": true,
-	"limed": true,
-	"limen": true,
-	"limes": true,
-	"limey": true,
-	"limit": true,
-	"limma": true,
-	"limns": true,
-	"limos": true,
-	"limpa": true,
-	"limps": true,
-	"linac": true,
-	"linch": true,
-	"linds": true,
-	"lindy": true,
-	"lined": true,
-	"linen": true,
-	"liner": true,
-	"lines": true,
-	"liney": true,
-	"linga": true,
-	"lingo": true,
-	"lings": true,
-	"lingy": true,
-	"linin": true,
-	"links": true,
-	"linky": true,
-	"linns": true,
-	"linny": true,
-	"linos": true,
-	"lints": true,
-	"linty": true,
-	"linum": true,
-	"linux": true,
-	"lions": true,
-	"lipas": true,
-	"lipes": true,
-	"lipid": true,
-	"lipin": true,
-	"lipos": true,
-	"lippy": true,
-	"liras": true,
-	"lirks": true,
-	"lirot": true,
-	"lisks": true,
-	"lisle": true,
-	"lisps": true,
-	"lists": true,
-	"litai": true,
-	"litas": true,
-	"lited": true,
-	"liter": true,
-	"lites": true,
-	"lithe": true,
-	"litho": true,
-	"liths": true,
-	"litre": true,
-	"lived": true,
-	"liven": true,
-	"liver": true,
-	"lives": true,
-	"livid": true,
-	"livor": true,
-	"livre": true,
-	"llama": true,
-	"llano": true,
-	"loach": true,
-	"loads": true,
-	"loafs": true,
-	"loams": true,
-	"loamy": true,
-	"loans": true,
-	"loast": true,
-	"loath": true,
-	"loave": true,
-	"lobar": true,
-	"lobby": true,
-	"lobed": true,
-	"lobes": true,
-	"lobos": true,
-	"lobus": true,
-	"local": true,
-	"loche": true,
-	"lochs": true,
-	"locie": true,
-	"locis": true,
-	"locks": true,
-	"locos": true,
-	"locum": true,
-	"locus": true,
-	"loden": true,
-	"lodes": true,
-	"lodge": true,
-	"loess": true,
-	"lofts": true,
-	"lofty": true,
-	"logan": true,
-	"loges": true,
-	"loggy": true,
-	"logia": true,
-	"logic": true,
-	"logie": true,
-	"login": true,
-	"logoi": true,
-	"logon": true,
-	"logos": true,
-	"lohan": true,
-	"loids": true,
-	"loins": true,
-	"loipe": true,
-	"loirs": true,
-	"lokes": true,
-	"lolls": true,
-	"lolly": true,
-	"lolog": true,
-	"lomas": true,
-	"lomed": true,
-	"lomes": true,
-	"loner": true,
-	"longa": true,
-	"longe": true,
-	"longs": true,
-	"looby": true,
-	"looed": true,
-	"looey": true,
-	"loofa": true,
-	"loofs": true,
-	"looie": true,
-	"looks": true,
-	"looky": true,
-	"looms": true,
-	"loons": true,
-	"loony": true,
-	"loops": true,
-	"loopy": true,
-	"loord": true,
-	"loose": true,
-	"loots": true,
-	"loped": true,
-	"loper": true,
-	"lopes": true,
-	"loppy": true,
-	"loral": true,
-	"loran": true,
-	"lords": true,
-	"lordy": true,
-	"lorel": true,
-	"lores": true,
-	"loric": true,
-	"loris": true,
-	"lorry": true,
-	"losed": true,
-	"losel": true,
-	"losen": true,
-	"loser": true,
-	"loses": true,
-	"lossy": true,
-	"lotah": true,
-	"lotas": true,
-	"lotes": true,
-	"lotic": true,
-	"lotos": true,
-	"lotsa": true,
-	"lotta": true,
-	"lotte": true,
-	"lotto": true,
-	"lotus": true,
-	"loued": true,
-	"lough": true,
-	"louie": true,
-	"louis": true,
-	"louma": true,
-	"lound": true,
-	"louns": true,
-	"loupe": true,
-	"loups": true,
-	"loure": true,
-	"lours": true,
-	"loury": true,
-	"louse": true,
-	"lousy": true,
-	"louts": true,
-	"lovat": true,
-	"loved": true,
-	"lover": true,
-	"loves": true,
-	"lovey": true,
-	"lovie": true,
-	"lowan": true,
-	"lowed": true,
-	"lower": true,
-	"lowes": true,
-	"lowly": true,
-	"lownd": true,
-	"lowne": true,
-	"lowns": true,
-	"lowps": true,
-	"lowry": true,
-	"lowse": true,
-	"lowts": true,
-	"loxed": true,
-	"loxes": true,
-	"loyal": true,
-	"lozen": true,
-	"luach": true,
-	"luaus": true,
-	"lubed": true,
-	"lubes": true,
-	"lubra": true,
-	"luces": true,
-	"lucid": true,
-	"lucks": true,
-	"lucky": true,
-	"lucre": true,
-	"ludes": true,
-	"ludic": true,
-	"ludos": true,
-	"luffa": true,
-	"luffs": true,
-	"luged": true,
-	"luger": true,
-	"luges": true,
-	"lulls": true,
-	"lulus": true,
-	"lumas": true,
-	"lumbi": true,
-	"lumen": true,
-	"lumme": true,
-	"lummy": true,
-	"lumps": true,
-	"lumpy": true,
-	"lunar": true,
-	"lunas": true,
-	"lunch": true,
-	"lunes": true,
-	"lunet": true,
-	"lunge": true,
-	"lungi": true,
-	"lungs": true,
-	"lunks": true,
-	"lunts": true,
-	"lupin": true,
-	"lupus": true,
-	"lurch": true,
-	"lured": true,
-	"lurer": true,
-	"lures": true,
-	"lurex": true,
-	"lurgi": true,
-	"lurgy": true,
-	"lurid": true,
-	"lurks": true,
-	"lurry": true,
-	"lurve": true,
-	"luser": true,
-	"lushy": true,
-	"lusks": true,
-	"lusts": true,
-	"lusty": true,
-	"lusus": true,
-	"lutea": true,
-	"luted": true,
-	"luter": true,
-	"lutes": true,
-	"luvvy": true,
-	"luxed": true,
-	"luxer": true,
-	"luxes": true,
-	"lweis": true,
-	"lyams": true,
-	"lyard": true,
-	"lyart": true,
-	"lyase": true,
-	"lycea": true,
-	"lycee": true,
-	"lycra": true,
-	"lying": true,
-	"lymes": true,
-	"lymph": true,
-	"lynch": true,
-	"lynes": true,
-	"lyres": true,
-	"lyric": true,
-	"lysed": true,
-	"lyses": true,
-	"lysin": true,
-	"lysis": true,
-	"lysol": true,
-	"lyssa": true,
-	"lyted": true,
-	"lytes": true,
-	"lythe": true,
-	"lytic": true,
-	"lytta": true,
-	"maaed": true,
-	"maare": true,
-	"maars": true,
-	"mabes": true,
-	"macas": true,
-	"macaw": true,
-	"maced": true,
-	"macer": true,
-	"maces": true,
-	"mache": true,
-	"machi": true,
-	"macho": true,
-	"machs": true,
-	"macks": true,
-	"macle": true,
-	"macon": true,
-	"macro": true,
-	"madam": true,
-	"madge": true,
-	"madid": true,
-	"madly": true,
-	"madre": true,
-	"maerl": true,
-	"mafia": true,
-	"mafic": true,
-	"mages": true,
-	"maggs": true,
-	"magic": true,
-	"magma": true,
-	"magot": true,
-	"magus": true,
-	"mahoe": true,
-	"mahua": true,
-	"mahwa": true,
-	"maids": true,
-	"maiko": true,
-	"maiks": true,
-	"maile": true,
-	"maill": true,
-	"mails": true,
-	"maims": true,
-	"mains": true,
-	"maire": true,
-	"mairs": true,
-	"maise": true,
-	"maist": true,
-	"maize": true,
-	"major": true,
-	"makar": true,
-	"maker": true,
-	"makes": true,
-	"makis": true,
-	"makos": true,
-	"malam": true,
-	"malar": true,
-	"malas": true,
-	"malax": true,
-	"males": true,
-	"malic": true,
-	"malik": true,
-	"malis": true,
-	"malls": true,
-	"malms": true,
-	"malmy": true,
-	"malts": true,
-	"malty": true,
-	"malus": true,
-	"malva": true,
-	"malwa": true,
-	"mamas": true,
-	"mamba": true,
-	"mambo": true,
-	"mamee": true,
-	"mamey": true,
-	"mamie": true,
-	"mamma": true,
-	"mammy": true,
-	"manas": true,
-	"manat": true,
-	"mandi": true,
-	"maneb": true,
-	"maned": true,
-	"maneh": true,
-	"manes": true,
-	"manet": true,
-	"manga": true,
-	"mange": true,
-	"mango": true,
-	"mangs": true,
-	"mangy": true,
-	"mania": true,
-	"manic": true,
-	"manis": true,
-	"manky": true,
-	"manly": true,
-	"manna": true,
-	"manor": true,
-	"manos": true,
-	"manse": true,
-	"manta": true,
-	"manto": true,
-	"manty": true,
-	"manul": true,
-	"manus": true,
-	"mapau": true,
-	"maple": true,
-	"maqui": true,
-	"marae": true,
-	"marah": true,
-	"maras": true,
-	"march": true,
-	"marcs": true,
-	"mardy": true,
-	"mares": true,
-	"marge": true,
-	"margs": true,
-	"maria": true,
-	"marid": true,
-	"marka": true,
-	"marks": true,
-	"marle": true,
-	"marls": true,
-	"marly": true,
-	"marms": true,
-	"maron": true,
-	"maror": true,
-	"marra": true,
-	"marri": true,
-	"marry": true,
-	"marse": true,
-	"marsh": true,
-	"marts": true,
-	"marvy": true,
-	"masas": true,
-	"mased": true,
-	"maser": true,
-	"mases": true,
-	"mashy": true,
-	"masks": true,
-	"mason": true,
-	"massa": true,
-	"masse": true,
-	"massy": true,
-	"masts": true,
-	"masty": true,
-	"masus": true,
-	"matai": true,
-	"match": true,
-	"mated": true,
-	"mater": true,
-	"mates": true,
-	"matey": true,
-	"maths": true,
-	"matin": true,
-	"matlo": true,
-	"matte": true,
-	"matts": true,
-	"matza": true,
-	"matzo": true,
-	"mauby": true,
-	"mauds": true,
-	"mauls": true,
-	"maund": true,
-	"mauri": true,
-	"mausy": true,
-	"mauts": true,
-	"mauve": true,
-	"mauzy": true,
-	"maven": true,
-	"mavie": true,
-	"mavin": true,
-	"mavis": true,
-	"mawed": true,
-	"mawks": true,
-	"mawky": true,
-	"mawns": true,
-	"mawrs": true,
-	"maxed": true,
-	"maxes": true,
-	"maxim": true,
-	"maxis": true,
-	"mayan": true,
-	"mayas": true,
-	"maybe": true,
-	"mayed": true,
-	"mayor": true,
-	"mayos": true,
-	"mayst": true,
-	"mazed": true,
-	"mazer": true,
-	"mazes": true,
-	"mazey": true,
-	"mazut": true,
-	"mbira": true,
-	"meads": true,
-	"meals": true,
-	"mealy": true,
-	"meane": true,
-	"means": true,
-	"meant": true,
-	"meany": true,
-	"meare": true,
-	"mease": true,
-	"meath": true,
-	"meats": true,
-	"meaty": true,
-	"mebos": true,
-	"mecca": true,
-	"mechs": true,
-	"mecks": true,
-	"medal": true,
-	"media": true,
-	"medic": true,
-	"medii": true,
-	"medle": true,
-	"meeds": true,
-	"meers": true,
-	"meets": true,
-	"meffs": true,
-	"meins": true,
-	"meint": true,
-	"meiny": true,
-	"meith": true,
-	"mekka": true,
-	"melas": true,
-	"melba": true,
-	"melds": true,
-	"melee": true,
-	"melic": true,
-	"melik": true,
-	"mells": true,
-	"melon": true,
-	"melts": true,
-	"melty": true,
-	"memes": true,
-	"memos": true,
-	"menad": true,
-	"mends": true,
-	"mened": true,
-	"menes": true,
-	"menge": true,
-	"mengs": true,
-	"mensa": true,
-	"mense": true,
-	"mensh": true,
-	"menta": true,
-	"mento": true,
-	"menus": true,
-	"meous": true,
-	"meows": true,
-	"merch": true,
-	"mercs": true,
-	"mercy": true,
-	"merde": true,
-	"mered": true,
-	"merel": true,
-	"merer": true,
-	"meres": true,
-	"merge": true,
-	"meril": true,
-	"meris": true,
-	"merit": true,
-	"merks": true,
-	"merle": true,
-	"merls": true,
-	"merry": true,
-	"merse": true,
-	"mesal": true,
-	"mesas": true,
-	"mesel": true,
-	"meses": true,
-	"meshy": true,
-	"mesic": true,
-	"mesne": true,
-	"meson": true,
-	"messy": true,
-	"mesto": true,
-	"metal": true,
-	"meted": true,
-	"meter": true,
-	"metes": true,
-	"metho": true,
-	"meths": true,
-	"metic": true,
-	"metif": true,
-	"metis": true,
-	"metol": true,
-	"metre": true,
-	"metro": true,
-	"meuse": true,
-	"meved": true,
-	"meves": true,
-	"mewed": true,
-	"mewls": true,
-	"meynt": true,
-	"mezes": true,
-	"mezze": true,
-	"mezzo": true,
-	"mhorr": true,
-	"miaou": true,
-	"miaow": true,
-	"miasm": true,
-	"miaul": true,
-	"micas": true,
-	"miche": true,
-	"micht": true,
-	"micks": true,
-	"micky": true,
-	"micos": true,
-	"micra": true,
-	"micro": true,
-	"middy": true,
-	"midge": true,
-	"midgy": true,
-	"midis": true,
-	"midst": true,
-	"miens": true,
-	"mieve": true,
-	"miffs": true,
-	"miffy": true,
-	"mifty": true,
-	"miggs": true,
-	"might": true,
-	"mihas": true,
-	"mihis": true,
-	"miked": true,
-	"mikes": true,
-	"mikra": true,
-	"mikva": true,
-	"milch": true,
-	"milds": true,
-	"miler": true,
-	"miles": true,
-	"milfs": true,
-	"milia": true,
-	"milko": true,
-	"milks": true,
-	"milky": true,
-	"mille": true,
-	"mills": true,
-	"milor": true,
-	"milos": true,
-	"milpa": true,
-	"milts": true,
-	"milty": true,
-	"miltz": true,
-	"mimed": true,
-	"mimeo": true,
-	"mimer": true,
-	"mimes": true,
-	"mimic": true,
-	"mimsy": true,
-	"minae": true,
-	"minar": true,
-	"minas": true,
-	"mince": true,
-	"mincy": true,
-	"minds": true,
-	"mined": true,
-	"miner": true,
-	"mines": true,
-	"minge": true,
-	"mings": true,
-	"mingy": true,
-	"minim": true,
-	"minis": true,
-	"minke": true,
-	"minks": true,
-	"minny": true,
-	"minor": true,
-	"minos": true,
-	"mints": true,
-	"minty": true,
-	"minus": true,
-	"mired": true,
-	"mires": true,
-	"mirex": true,
-	"mirid": true,
-	"mirin": true,
-	"mirks": true,
-	"mirky": true,
-	"mirly": true,
-	"miros": true,
-	"mirth": true,
-	"mirvs": true,
-	"mirza": true,
-	"misch": true,
-	"misdo": true,
-	"miser": true,
-	"mises": true,
-	"misgo": true,
-	"misos": true,
-	"missa": true,
-	"missy": true,
-	"mists": true,
-	"misty": true,
-	"mitch": true,
-	"miter": true,
-	"mites": true,
-	"mitis": true,
-	"mitre": true,
-	"mitts": true,
-	"mixed": true,
-	"mixen": true,
-	"mixer": true,
-	"mixes": true,
-	"mixte": true,
-	"mixup": true,
-	"mizen": true,
-	"mizzy": true,
-	"mneme": true,
-	"moans": true,
-	"moats": true,
-	"mobby": true,
-	"mobes": true,
-	"mobey": true,
-	"mobie": true,
-	"moble": true,
-	"mocha": true,
-	"mochi": true,
-	"mochs": true,
-	"mochy": true,
-	"mocks": true,
-	"modal": true,
-	"model": true,
-	"modem": true,
-	"moder": true,
-	"modes": true,
-	"modge": true,
-	"modii": true,
-	"modus": true,
-	"moers": true,
-	"mofos": true,
-	"moggy": true,
-	"mogul": true,
-	"mohel": true,
-	"mohos": true,
-	"mohrs": true,
-	"mohua": true,
-	"mohur": true,
-	"moile": true,
-	"moils": true,
-	"moira": true,
-	"moire": true,
-	"moist": true,
-	"moits": true,
-	"mojos": true,
-	"mokes": true,
-	"mokis": true,
-	"mokos": true,
-	"molal": true,
-	"molar": true,
-	"molas": true,
-	"molds": true,
-	"moldy": true,
-	"moled": true,
-	"moles": true,
-	"molla": true,
-	"molls": true,
-	"molly": true,
-	"molto": true,
-	"molts": true,
-	"molys": true,
-	"momes": true,
-	"momma": true,
-	"mommy": true,
-	"momus": true,
-	"monad": true,
-	"monal": true,
-	"monas": true,
-	"monde": true,
-	"mondo": true,
-	"moner": true,
-	"money": true,
-	"mongo": true,
-	"mongs": true,
-	"monic": true,
-	"monie": true,
-	"monks": true,
-	"monos": true,
-	"monte": true,
-	"month": true,
-	"monty": true,
-	"moobs": true,
-	"mooch": true,
-	"moods": true,
-	"moody": true,
-	"mooed": true,
-	"mooks": true,
-	"moola": true,
-	"mooli": true,
-	"mools": true,
-	"mooly": true,
-	"moong": true,
-	"moons": true,
-	"moony": true,
-	"moops": true,
-	"moors": true,
-	"moory": true,
-	"moose": true,
-	"moots": true,
-	"moove": true,
-	"moped": true,
-	"moper": true,
-	"mopes": true,
-	"mopey": true,
-	"moppy": true,
-	"mopsy": true,
-	"mopus": true,
-	"morae": true,
-	"moral": true,
-	"moras": true,
-	"morat": true,
-	"moray": true,
-	"morel": true,
-	"mores": true,
-	"moria": true,
-	"morne": true,
-	"morns": true,
-	"moron": true,
-	"morph": true,
-	"morra": true,
-	"morro": true,
-	"morse": true,
-	"morts": true,
-	"mosed": true,
-	"moses": true,
-	"mosey": true,
-	"mosks": true,
-	"mosso": true,
-	"mossy": true,
-	"moste": true,
-	"mosts": true,
-	"moted": true,
-	"motel": true,
-	"moten": true,
-	"motes": true,
-	"motet": true,
-	"motey": true,
-	"moths": true,
-	"mothy": true,
-	"motif": true,
-	"motis": true,
-	"motor": true,
-	"motte": true,
-	"motto": true,
-	"motts": true,
-	"motty": true,
-	"motus": true,
-	"motza": true,
-	"mouch": true,
-	"moues": true,
-	"mould": true,
-	"mouls": true,
-	"moult": true,
-	"mound": true,
-	"mount": true,
-	"moups": true,
-	"mourn": true,
-	"mouse": true,
-	"moust": true,
-	"mousy": true,
-	"mouth": true,
-	"moved": true,
-	"mover": true,
-	"moves": true,
-	"movie": true,
-	"mowas": true,
-	"mowed": true,
-	"mower": true,
-	"mowra": true,
-	"moxas": true,
-	"moxie": true,
-	"moyas": true,
-	"moyle": true,
-	"moyls": true,
-	"mozed": true,
-	"mozes": true,
-	"mozos": true,
-	"mpret": true,
-	"mucho": true,
-	"mucic": true,
-	"mucid": true,
-	"mucin": true,
-	"mucks": true,
-	"mucky": true,
-	"mucor": true,
-	"mucro": true,
-	"mucus": true,
-	"muddy": true,
-	"mudge": true,
-	"mudir": true,
-	"mudra": true,
-	"muffs": true,
-	"mufti": true,
-	"mugga": true,
-	"muggs": true,
-	"muggy": true,
-	"muhly": true,
-	"muids": true,
-	"muils": true,
-	"muirs": true,
-	"muist": true,
-	"mujik": true,
-	"mulch": true,
-	"mulct": true,
-	"muled": true,
-	"mules": true,
-	"muley": true,
-	"mulga": true,
-	"mulie": true,
-	"mulla": true,
-	"mulls": true,
-	"mulse": true,
-	"mulsh": true,
-	"mumms": true,
-	"mummy": true,
-	"mumps": true,
-	"mumsy": true,
-	"mumus": true,
-	"munch": true,
-	"munga": true,
-	"munge": true,
-	"mungo": true,
-	"mungs": true,
-	"munis": true,
-	"munts": true,
-	"muntu": true,
-	"muons": true,
-	"mural": true,
-	"muras": true,
-	"mured": true,
-	"mures": true,
-	"murex": true,
-	"murid": true,
-	"murks": true,
-	"murky": true,
-	"murls": true,
-	"murly": true,
-	"murra": true,
-	"murre": true,
-	"murri": true,
-	"murrs": true,
-	"murry": true,
-	"murti": true,
-	"murva": true,
-	"musar": true,
-	"musca": true,
-	"mused": true,
-	"muser": true,
-	"muses": true,
-	"muset": true,
-	"musha": true,
-	"mushy": true,
-	"music": true,
-	"musit": true,
-	"musks": true,
-	"musky": true,
-	"musos": true,
-	"musse": true,
-	"mussy": true,
-	"musth": true,
-	"musts": true,
-	"musty": true,
-	"mutch": true,
-	"muted": true,
-	"muter": true,
-	"mutes": true,
-	"mutha": true,
-	"mutis": true,
-	"muton": true,
-	"mutts": true,
-	"muxed": true,
-	"muxes": true,
-	"muzak": true,
-	"muzzy": true,
-	"mvule": true,
-	"myall": true,
-	"mylar": true,
-	"mynah": true,
-	"mynas": true,
-	"myoid": true,
-	"myoma": true,
-	"myope": true,
-	"myops": true,
-	"myopy": true,
-	"myrrh": true,
-	"mysid": true,
-	"mythi": true,
-	"myths": true,
-	"mythy": true,
-	"myxos": true,
-	"mzees": true,
-	"naams": true,
-	"naans": true,
-	"nabes": true,
-	"nabis": true,
-	"nabks": true,
-	"nabla": true,
-	"nabob": true,
-	"nache": true,
-	"nacho": true,
-	"nacre": true,
-	"nadas": true,
-	"nadir": true,
-	"naeve": true,
-	"naevi": true,
-	"naffs": true,
-	"nagas": true,
-	"naggy": true,
-	"nagor": true,
-	"nahal": true,
-	"naiad": true,
-	"naifs": true,
-	"naiks": true,
-	"nails": true,
-	"naira": true,
-	"nairu": true,
-	"naive": true,
-	"naked": true,
-	"naker": true,
-	"nakfa": true,
-	"nalas": true,
-	"naled": true,
-	"nalla": true,
-	"named": true,
-	"namer": true,
-	"names": true,
-	"namma": true,
-	"namus": true,
-	"nanas": true,
-	"nance": true,
-	"nancy": true,
-	"nandu": true,
-	"nanna": true,
-	"nanny": true,
-	"nanos": true,
-	"nanua": true,
-	"napas": true,
-	"naped": true,
-	"napes": true,
-	"napoo": true,
-	"nappa": true,
-	"nappe": true,
-	"nappy": true,
-	"naras": true,
-	"narco": true,
-	"narcs": true,
-	"nards": true,
-	"nares": true,
-	"naric": true,
-	"naris": true,
-	"narks": true,
-	"narky": true,
-	"narre": true,
-	"nasal": true,
-	"nashi": true,
-	"nasty": true,
-	"natal": true,
-	"natch": true,
-	"nates": true,
-	"natis": true,
-	"natty": true,
-	"nauch": true,
-	"naunt": true,
-	"naval": true,
-	"navar": true,
-	"navel": true,
-	"naves": true,
-	"navew": true,
-	"navvy": true,
-	"nawab": true,
-	"nazes": true,
-	"nazir": true,
-	"nazis": true,
-	"nduja": true,
-	"neafe": true,
-	"neals": true,
-	"neaps": true,
-	"nears": true,
-	"neath": true,
-	"neats": true,
-	"nebek": true,
-	"nebel": true,
-	"necks": true,
-	"neddy": true,
-	"needs": true,
-	"needy": true,
-	"neeld": true,
-	"neele": true,
-	"neemb": true,
-	"neems": true,
-	"neeps": true,
-	"neese": true,
-	"neeze": true,
-	"negro": true,
-	"negus": true,
-	"neifs": true,
-	"neigh": true,
-	"neist": true,
-	"neive": true,
-	"nelis": true,
-	"nelly": true,
-	"nemas": true,
-	"nemns": true,
-	"nempt": true,
-	"nenes": true,
-	"neons": true,
-	"neper": true,
-	"nepit": true,
-	"neral": true,
-	"nerds": true,
-	"nerdy": true,
-	"nerka": true,
-	"nerks": true,
-	"nerol": true,
-	"nerts": true,
-	"nertz": true,
-	"nerve": true,
-	"nervy": true,
-	"nests": true,
-	"netes": true,
-	"netop": true,
-	"netts": true,
-	"netty": true,
-	"neuks": true,
-	"neume": true,
-	"neums": true,
-	"nevel": true,
-	"never": true,
-	"neves": true,
-	"nevus": true,
-	"newbs": true,
-	"newed": true,
-	"newel": true,
-	"newer": true,
-	"newie": true,
-	"newly": true,
-	"newsy": true,
-	"newts": true,
-	"nexts": true,
-	"nexus": true,
-	"ngaio": true,
-	"ngana": true,
-	"ngati": true,
-	"ngoma": true,
-	"ngwee": true,
-	"nicad": true,
-	"nicer": true,
-	"niche": true,
-	"nicht": true,
-	"nicks": true,
-	"nicol": true,
-	"nidal": true,
-	"nided": true,
-	"nides": true,
-	"nidor": true,
-	"nidus": true,
-	"niece": true,
-	"niefs": true,
-	"nieve": true,
-	"nifes": true,
-	"niffs": true,
-	"niffy": true,
-	"nifty": true,
-	"niger": true,
-	"nighs": true,
-	"night": true,
-	"nihil": true,
-	"nikab": true,
-	"nikah": true,
-	"nikau": true,
-	"nills": true,
-	"nimbi": true,
-	"nimbs": true,
-	"nimps": true,
-	"niner": true,
-	"nines": true,
-	"ninja": true,
-	"ninny": true,
-	"ninon": true,
-	"ninth": true,
-	"nipas": true,
-	"nippy": true,
-	"niqab": true,
-	"nirls": true,
-	"nirly": true,
-	"nisei": true,
-	"nisse": true,
-	"nisus": true,
-	"niter": true,
-	"nites": true,
-	"nitid": true,
-	"niton": true,
-	"nitre": true,
-	"nitro": true,
-	"nitry": true,
-	"nitty": true,
-	"nival": true,
-	"nixed": true,
-	"nixer": true,
-	"nixes": true,
-	"nixie": true,
-	"nizam": true,
-	"nkosi": true,
-	"noahs": true,
-	"nobby": true,
-	"noble": true,
-	"nobly": true,
-	"nocks": true,
-	"nodal": true,
-	"noddy": true,
-	"nodes": true,
-	"nodus": true,
-	"noels": true,
-	"noggs": true,
-	"nohow": true,
-	"noils": true,
-	"noily": true,
-	"noint": true,
-	"noirs": true,
-	"noise": true,
-	"noisy": true,
-	"noles": true,
-	"nolls": true,
-	"nolos": true,
-	"nomad": true,
-	"nomas": true,
-	"nomen": true,
-	"nomes": true,
-	"nomic": true,
-	"nomoi": true,
-	"nomos": true,
-	"nonas": true,
-	"nonce": true,
-	"nones": true,
-	"nonet": true,
-	"nongs": true,
-	"nonis": true,
-	"nonny": true,
-	"nonyl": true,
-	"noobs": true,
-	"nooit": true,
-	"nooks": true,
-	"nooky": true,
-	"noons": true,
-	"noops": true,
-	"noose": true,
-	"nopal": true,
-	"noria": true,
-	"noris": true,
-	"norks": true,
-	"norma": true,
-	"norms": true,
-	"north": true,
-	"nosed": true,
-	"noser": true,
-	"noses": true,
-	"nosey": true,
-	"notal": true,
-	"notch": true,
-	"noted": true,
-	"noter": true,
-	"notes": true,
-	"notum": true,
-	"nould": true,
-	"noule": true,
-	"nouls": true,
-	"nouns": true,
-	"nouny": true,
-	"noups": true,
-	"novae": true,
-	"novas": true,
-	"novel": true,
-	"novum": true,
-	"noway": true,
-	"nowed": true,
-	"nowls": true,
-	"nowts": true,
-	"nowty": true,
-	"noxal": true,
-	"noxes": true,
-	"noyau": true,
-	"noyed": true,
-	"noyes": true,
-	"nubby": true,
-	"nubia": true,
-	"nucha": true,
-	"nuddy": true,
-	"nuder": true,
-	"nudes": true,
-	"nudge": true,
-	"nudie": true,
-	"nudzh": true,
-	"nuffs": true,
-	"nugae": true,
-	"nuked": true,
-	"nukes": true,
-	"nulla": true,
-	"nulls": true,
-	"numbs": true,
-	"numen": true,
-	"nummy": true,
-	"nunny": true,
-	"nurds": true,
-	"nurdy": true,
-	"nurls": true,
-	"nurrs": true,
-	"nurse": true,
-	"nutso": true,
-	"nutsy": true,
-	"nutty": true,
-	"nyaff": true,
-	"nyala": true,
-	"nying": true,
-	"nylon": true,
-	"nymph": true,
-	"nyssa": true,
-	"oaked": true,
-	"oaken": true,
-	"oaker": true,
-	"oakum": true,
-	"oared": true,
-	"oases": true,
-	"oasis": true,
-	"oasts": true,
-	"oaten": true,
-	"oater": true,
-	"oaths": true,
-	"oaves": true,
-	"obang": true,
-	"obeah": true,
-	"obeli": true,
-	"obese": true,
-	"obeys": true,
-	"obias": true,
-	"obied": true,
-	"obiit": true,
-	"obits": true,
-	"objet": true,
-	"oboes": true,
-	"obole": true,
-	"oboli": true,
-	"obols": true,
-	"occam": true,
-	"occur": true,
-	"ocean": true,
-	"ocher": true,
-	"oches": true,
-	"ochre": true,
-	"ochry": true,
-	"ocker": true,
-	"ocrea": true,
-	"octad": true,
-	"octal": true,
-	"octan": true,
-	"octas": true,
-	"octet": true,
-	"octyl": true,
-	"oculi": true,
-	"odahs": true,
-	"odals": true,
-	"odder": true,
-	"oddly": true,
-	"odeon": true,
-	"odeum": true,
-	"odism": true,
-	"odist": true,
-	"odium": true,
-	"odors": true,
-	"odour": true,
-	"odyle": true,
-	"odyls": true,
-	"ofays": true,
-	"offal": true,
-	"offed": true,
-	"offer": true,
-	"offie": true,
-	"oflag": true,
-	"often": true,
-	"ofter": true,
-	"ogams": true,
-	"ogeed": true,
-	"ogees": true,
-	"oggin": true,
-	"ogham": true,
-	"ogive": true,
-	"ogled": true,
-	"ogler": true,
-	"ogles": true,
-	"ogmic": true,
-	"ogres": true,
-	"ohias": true,
-	"ohing": true,
-	"ohmic": true,
-	"ohone": true,
-	"oidia": true,
-	"oiled": true,
-	"oiler": true,
-	"oinks": true,
-	"oints": true,
-	"ojime": true,
-	"okapi": true,
-	"okays": true,
-	"okehs": true,
-	"okras": true,
-	"oktas": true,
-	"olden": true,
-	"older": true,
-	"oldie": true,
-	"oleic": true,
-	"olein": true,
-	"olent": true,
-	"oleos": true,
-	"oleum": true,
-	"olios": true,
-	"olive": true,
-	"ollas": true,
-	"ollav": true,
-	"oller": true,
-	"ollie": true,
-	"ology": true,
-	"olpae": true,
-	"olpes": true,
-	"omasa": true,
-	"omber": true,
-	"ombre": true,
-	"ombus": true,
-	"omega": true,
-	"omens": true,
-	"omers": true,
-	"omits": true,
-	"omlah": true,
-	"omovs": true,
-	"omrah": true,
-	"oncer": true,
-	"onces": true,
-	"oncet": true,
-	"oncus": true,
-	"onely": true,
-	"oners": true,
-	"onery": true,
-	"onion": true,
-	"onium": true,
-	"onkus": true,
-	"onlay": true,
-	"onned": true,
-	"onset": true,
-	"ontic": true,
-	"oobit": true,
-	"oohed": true,
-	"oomph": true,
-	"oonts": true,
-	"ooped": true,
-	"oorie": true,
-	"ooses": true,
-	"ootid": true,
-	"oozed": true,
-	"oozes": true,
-	"opahs": true,
-	"opals": true,
-	"opens": true,
-	"opepe": true,
-	"opera": true,
-	"opine": true,
-	"oping": true,
-	"opium": true,
-	"oppos": true,
-	"opsin": true,
-	"opted": true,
-	"opter": true,
-	"optic": true,
-	"orach": true,
-	"oracy": true,
-	"orals": true,
-	"orang": true,
-	"orant": true,
-	"orate": true,
-	"orbed": true,
-	"orbit": true,
-	"orcas": true,
-	"orcin": true,
-	"order": true,
-	"ordos": true,
-	"oread": true,
-	"orfes": true,
-	"organ": true,
-	"orgia": true,
-	"orgic": true,
-	"orgue": true,
-	"oribi": true,
-	"oriel": true,
-	"orixa": true,
-	"orles": true,
-	"orlon": true,
-	"orlop": true,
-	"ormer": true,
-	"ornis": true,
-	"orpin": true,
-	"orris": true,
-	"ortho": true,
-	"orval": true,
-	"orzos": true,
-	"oscar": true,
-	"oshac": true,
-	"osier": true,
-	"osmic": true,
-	"osmol": true,
-	"ossia": true,
-	"ostia": true,
-	"otaku": true,
-	"otary": true,
-	"other": true,
-	"ottar": true,
-	"otter": true,
-	"ottos": true,
-	"oubit": true,
-	"oucht": true,
-	"ouens": true,
-	"ought": true,
-	"ouija": true,
-	"oulks": true,
-	"oumas": true,
-	"ounce": true,
-	"oundy": true,
-	"oupas": true,
-	"ouped": true,
-	"ouphe": true,
-	"ouphs": true,
-	"ourie": true,
-	"ousel": true,
-	"ousts": true,
-	"outby": true,
-	"outdo": true,
-	"outed": true,
-	"outer": true,
-	"outgo": true,
-	"outre": true,
-	"outro": true,
-	"outta": true,
-	"ouzel": true,
-	"ouzos": true,
-	"ovals": true,
-	"ovary": true,
-	"ovate": true,
-	"ovels": true,
-	"ovens": true,
-	"overs": true,
-	"overt": true,
-	"ovine": true,
-	"ovist": true,
-	"ovoid": true,
-	"ovoli": true,
-	"ovolo": true,
-	"ovule": true,
-	"owche": true,
-	"owies": true,
-	"owing": true,
-	"owled": true,
-	"owler": true,
-	"owlet": true,
-	"owned": true,
-	"owner": true,
-	"owres": true,
-	"owrie": true,
-	"owsen": true,
-	"oxbow": true,
-	"oxers": true,
-	"oxeye": true,
-	"oxide": true,
-	"oxids": true,
-	"oxies": true,
-	"oxime": true,
-	"oxims": true,
-	"oxlip": true,
-	"oxter": true,
-	"oyers": true,
-	"ozeki": true,
-	"ozone": true,
-	"ozzie": true,
-	"paals": true,
-	"paans": true,
-	"pacas": true,
-	"paced": true,
-	"pacer": true,
-	"paces": true,
-	"pacey": true,
-	"pacha": true,
-	"packs": true,
-	"pacos": true,
-	"pacta": true,
-	"pacts": true,
-	"paddy": true,
-	"padis": true,
-	"padle": true,
-	"padma": true,
-	"padre": true,
-	"padri": true,
-	"paean": true,
-	"paedo": true,
-	"paeon": true,
-	"pagan": true,
-	"paged": true,
-	"pager": true,
-	"pages": true,
-	"pagle": true,
-	"pagod": true,
-	"pagri": true,
-	"paiks": true,
-	"pails": true,
-	"pains": true,
-	"paint": true,
-	"paire": true,
-	"pairs": true,
-	"paisa": true,
-	"paise": true,
-	"pakka": true,
-	"palas": true,
-	"palay": true,
-	"palea": true,
-	"paled": true,
-	"paler": true,
-	"pales": true,
-	"palet": true,
-	"palis": true,
-	"palki": true,
-	"palla": true,
-	"palls": true,
-	"pally": true,
-	"palms": true,
-	"palmy": true,
-	"palpi": true,
-	"palps": true,
-	"palsa": true,
-	"palsy": true,
-	"pampa": true,
-	"panax": true,
-	"pance": true,
-	"panda": true,
-	"pands": true,
-	"pandy": true,
-	"paned": true,
-	"panel": true,
-	"panes": true,
-	"panga": true,
-	"pangs": true,
-	"panic": true,
-	"panim": true,
-	"panko": true,
-	"panne": true,
-	"panni": true,
-	"pansy": true,
-	"panto": true,
-	"pants": true,
-	"panty": true,
-	"paoli": true,
-	"paolo": true,
-	"papal": true,
-	"papas": true,
-	"papaw": true,
-	"paper": true,
-	"papes": true,
-	"pappi": true,
-	"pappy": true,
-	"parae": true,
-	"paras": true,
-	"parch": true,
-	"pardi": true,
-	"pards": true,
-	"pardy": true,
-	"pared": true,
-	"paren": true,
-	"pareo": true,
-	"parer": true,
-	"pares": true,
-	"pareu": true,
-	"parev": true,
-	"parge": true,
-	"pargo": true,
-	"paris": true,
-	"parka": true,
-	"parki": true,
-	"parks": true,
-	"parky": true,
-	"parle": true,
-	"parly": true,
-	"parma": true,
-	"parol": true,
-	"parps": true,
-	"parra": true,
-	"parrs": true,
-	"parry": true,
-	"parse": true,
-	"parti": true,
-	"parts": true,
-	"party": true,
-	"parve": true,
-	"parvo": true,
-	"paseo": true,
-	"pases": true,
-	"pasha": true,
-	"pashm": true,
-	"paska": true,
-	"paspy": true,
-	"passe": true,
-	"pasta": true,
-	"paste": true,
-	"pasts": true,
-	"pasty": true,
-	"patch": true,
-	"pated": true,
-	"paten": true,
-	"pater": true,
-	"pates": true,
-	"paths": true,
-	"patin": true,
-	"patio": true,
-	"patka": true,
-	"patly": true,
-	"patsy": true,
-	"patte": true,
-	"patty": true,
-	"patus": true,
-	"pauas": true,
-	"pauls": true,
-	"pause": true,
-	"pavan": true,
-	"paved": true,
-	"paven": true,
-	"paver": true,
-	"paves": true,
-	"pavid": true,
-	"pavin": true,
-	"pavis": true,
-	"pawas": true,
-	"pawaw": true,
-	"pawed": true,
-	"pawer": true,
-	"pawks": true,
-	"pawky": true,
-	"pawls": true,
-	"pawns": true,
-	"paxes": true,
-	"payed": true,
-	"payee": true,
-	"payer": true,
-	"payor": true,
-	"paysd": true,
-	"peace": true,
-	"peach": true,
-	"peage": true,
-	"peags": true,
-	"peaks": true,
-	"peaky": true,
-	"peals": true,
-	"peans": true,
-	"peare": true,
-	"pearl": true,
-	"pears": true,
-	"peart": true,
-	"pease": true,
-	"peats": true,
-	"peaty": true,
-	"peavy": true,
-	"peaze": true,
-	"pebas": true,
-	"pecan": true,
-	"pechs": true,
-	"pecke": true,
-	"pecks": true,
-	"pecky": true,
-	"pedal": true,
-	"pedes": true,
-	"pedis": true,
-	"pedro": true,
-	"peece": true,
-	"peeks": true,
-	"peels": true,
-	"peens": true,
-	"peeoy": true,
-	"peepe": true,
-	"peeps": true,
-	"peers": true,
-	"peery": true,
-	"peeve": true,
-	"peggy": true,
-	"peghs": true,
-	"peins": true,
-	"peise": true,
-	"peize": true,
-	"pekan": true,
-	"pekes": true,
-	"pekin": true,
-	"pekoe": true,
-	"pelas": true,
-	"pelau": true,
-	"peles": true,
-	"pelfs": true,
-	"pells": true,
-	"pelma": true,
-	"pelon": true,
-	"pelta": true,
-	"pelts": true,
-	"penal": true,
-	"pence": true,
-	"pends": true,
-	"pendu": true,
-	"pened": true,
-	"penes": true,
-	"pengo": true,
-	"penie": true,
-	"penis": true,
-	"penks": true,
-	"penna": true,
-	"penne": true,
-	"penni": true,
-	"penny": true,
-	"pents": true,
-	"peons": true,
-	"peony": true,
-	"pepla": true,
-	"pepos": true,
-	"peppy": true,
-	"pepsi": true,
-	"perai": true,
-	"perce": true,
-	"perch": true,
-	"percs": true,
-	"perdu": true,
-	"perdy": true,
-	"perea": true,
-	"peres": true,
-	"peril": true,
-	"peris": true,
-	"perks": true,
-	"perky": true,
-	"perms": true,
-	"perns": true,
-	"perog": true,
-	"perps": true,
-	"perry": true,
-	"perse": true,
-	"perst": true,
-	"perts": true,
-	"perve": true,
-	"pervo": true,
-	"pervs": true,
-	"pervy": true,
-	"pesky": true,
-	"pesos": true,
-	"pesto": true,
-	"pests": true,
-	"pesty": true,
-	"petal": true,
-	"petar": true,
-	"peter": true,
-	"petit": true,
-	"petre": true,
-	"petri": true,
-	"petti": true,
-	"petto": true,
-	"petty": true,
-	"pewee": true,
-	"pewit": true,
-	"peyse": true,
-	"phage": true,
-	"phang": true,
-	"phare": true,
-	"pharm": true,
-	"phase": true,
-	"pheer": true,
-	"phene": true,
-	"pheon": true,
-	"phese": true,
-	"phial": true,
-	"phish": true,
-	"phizz": true,
-	"phlox": true,
-	"phoca": true,
-	"phone": true,
-	"phono": true,
-	"phons": true,
-	"phony": true,
-	"photo": true,
-	"phots": true,
-	"phpht": true,
-	"phuts": true,
-	"phyla": true,
-	"phyle": true,
-	"piani": true,
-	"piano": true,
-	"pians": true,
-	"pibal": true,
-	"pical": true,
-	"picas": true,
-	"piccy": true,
-	"picks": true,
-	"picky": true,
-	"picot": true,
-	"picra": true,
-	"picul": true,
-	"piece": true,
-	"piend": true,
-	"piers": true,
-	"piert": true,
-	"pieta": true,
-	"piets": true,
-	"piety": true,
-	"piezo": true,
-	"piggy": true,
-	"pight": true,
-	"pigmy": true,
-	"piing": true,
-	"pikas": true,
-	"pikau": true,
-	"piked": true,
-	"piker": true,
-	"pikes": true,
-	"pikey": true,
-	"pikis": true,
-	"pikul": true,
-	"pilae": true,
-	"pilaf": true,
-	"pilao": true,
-	"pilar": true,
-	"pilau": true,
-	"pilaw": true,
-	"pilch": true,
-	"pilea": true,
-	"piled": true,
-	"pilei": true,
-	"piler": true,
-	"piles": true,
-	"pilis": true,
-	"pills": true,
-	"pilot": true,
-	"pilow": true,
-	"pilum": true,
-	"pilus": true,
-	"pimas": true,
-	"pimps": true,
-	"pinas": true,
-	"pinch": true,
-	"pined": true,
-	"pines": true,
-	"piney": true,
-	"pingo": true,
-	"pings": true,
-	"pinko": true,
-	"pinks": true,
-	"pinky": true,
-	"pinna": true,
-	"pinny": true,
-	"pinon": true,
-	"pinot": true,
-	"pinta": true,
-	"pinto": true,
-	"pints": true,
-	"pinup": true,
-	"pions": true,
-	"piony": true,
-	"pious": true,
-	"pioye": true,
-	"pioys": true,
-	"pipal": true,
-	"pipas": true,
-	"piped": true,
-	"piper": true,
-	"pipes": true,
-	"pipet": true,
-	"pipis": true,
-	"pipit": true,
-	"pippy": true,
-	"pipul": true,
-	"pique": true,
-	"pirai": true,
-	"pirls": true,
-	"pirns": true,
-	"pirog": true,
-	"pisco": true,
-	"pises": true,
-	"pisky": true,
-	"pisos": true,
-	"pissy": true,
-	"piste": true,
-	"pitas": true,
-	"pitch": true,
-	"piths": true,
-	"pithy": true,
-	"piton": true,
-	"pitot": true,
-	"pitta": true,
-	"piums": true,
-	"pivot": true,
-	"pixel": true,
-	"pixes": true,
-	"pixie": true,
-	"pized": true,
-	"pizes": true,
-	"pizza": true,
-	"plaas": true,
-	"place": true,
-	"plack": true,
-	"plage": true,
-	"plaid": true,
-	"plain": true,
-	"plait": true,
-	"plane": true,
-	"plank": true,
-	"plans": true,
-	"plant": true,
-	"plaps": true,
-	"plash": true,
-	"plasm": true,
-	"plast": true,
-	"plate": true,
-	"plats": true,
-	"platt": true,
-	"platy": true,
-	"playa": true,
-	"plays": true,
-	"plaza": true,
-	"plead": true,
-	"pleas": true,
-	"pleat": true,
-	"plebe": true,
-	"plebs": true,
-	"plena": true,
-	"pleon": true,
-	"plesh": true,
-	"plews": true,
-	"plica": true,
-	"plied": true,
-	"plier": true,
-	"plies": true,
-	"plims": true,
-	"pling": true,
-	"plink": true,
-	"ploat": true,
-	"plods": true,
-	"plong": true,
-	"plonk": true,
-	"plook": true,
-	"plops": true,
-	"plots": true,
-	"plotz": true,
-	"plouk": true,
-	"plows": true,
-	"ploye": true,
-	"ploys": true,
-	"pluck": true,
-	"plues": true,
-	"pluff": true,
-	"plugs": true,
-	"plumb": true,
-	"plume": true,
-	"plump": true,
-	"plums": true,
-	"plumy": true,
-	"plunk": true,
-	"pluot": true,
-	"plush": true,
-	"pluto": true,
-	"plyer": true,
-	"poach": true,
-	"poaka": true,
-	"poake": true,
-	"poboy": true,
-	"pocks": true,
-	"pocky": true,
-	"podal": true,
-	"poddy": true,
-	"podex": true,
-	"podge": true,
-	"podgy": true,
-	"podia": true,
-	"poems": true,
-	"poeps": true,
-	"poesy": true,
-	"poets": true,
-	"pogey": true,
-	"pogge": true,
-	"pogos": true,
-	"pohed": true,
-	"poilu": true,
-	"poind": true,
-	"point": true,
-	"poise": true,
-	"pokal": true,
-	"poked": true,
-	"poker": true,
-	"pokes": true,
-	"pokey": true,
-	"pokie": true,
-	"polar": true,
-	"poled": true,
-	"poler": true,
-	"poles": true,
-	"poley": true,
-	"polio": true,
-	"polis": true,
-	"polje": true,
-	"polka": true,
-	"polks": true,
-	"polls": true,
-	"polly": true,
-	"polos": true,
-	"polts": true,
-	"polyp": true,
-	"polys": true,
-	"pombe": true,
-	"pomes": true,
-	"pommy": true,
-	"pomos": true,
-	"pomps": true,
-	"ponce": true,
-	"poncy": true,
-	"ponds": true,
-	"pones": true,
-	"poney": true,
-	"ponga": true,
-	"pongo": true,
-	"pongs": true,
-	"pongy": true,
-	"ponks": true,
-	"ponts": true,
-	"ponty": true,
-	"ponzu": true,
-	"pooch": true,
-	"poods": true,
-	"pooed": true,
-	"poofs": true,
-	"poofy": true,
-	"poohs": true,
-	"pooja": true,
-	"pooka": true,
-	"pooks": true,
-	"pools": true,
-	"poons": true,
-	"poops": true,
-	"poopy": true,
-	"poori": true,
-	"poort": true,
-	"poots": true,
-	"poove": true,
-	"poovy": true,
-	"popes": true,
-	"poppa": true,
-	"poppy": true,
-	"popsy": true,
-	"porae": true,
-	"poral": true,
-	"porch": true,
-	"pored": true,
-	"porer": true,
-	"pores": true,
-	"porge": true,
-	"porgy": true,
-	"porin": true,
-	"porks": true,
-	"porky": true,
-	"porno": true,
-	"porns": true,
-	"porny": true,
-	"porta": true,
-	"ports": true,
-	"porty": true,
-	"posed": true,
-	"poser": true,
-	"poses": true,
-	"posey": true,
-	"posho": true,
-	"posit": true,
-	"posse": true,
-	"posts": true,
-	"potae": true,
-	"potch": true,
-	"poted": true,
-	"potes": true,
-	"potin": true,
-	"potoo": true,
-	"potsy": true,
-	"potto": true,
-	"potts": true,
-	"potty": true,
-	"pouch": true,
-	"pouff": true,
-	"poufs": true,
-	"pouke": true,
-	"pouks": true,
-	"poule": true,
-	"poulp": true,
-	"poult": true,
-	"pound": true,
-	"poupe": true,
-	"poupt": true,
-	"pours": true,
-	"pouts": true,
-	"pouty": true,
-	"powan": true,
-	"power": true,
-	"powin": true,
-	"pownd": true,
-	"powns": true,
-	"powny": true,
-	"powre": true,
-	"poxed": true,
-	"poxes": true,
-	"poynt": true,
-	"poyou": true,
-	"poyse": true,
-	"pozzy": true,
-	"praam": true,
-	"prads": true,
-	"prahu": true,
-	"prams": true,
-	"prana": true,
-	"prang": true,
-	"prank": true,
-	"praos": true,
-	"prase": true,
-	"prate": true,
-	"prats": true,
-	"pratt": true,
-	"praty": true,
-	"praus": true,
-	"prawn": true,
-	"prays": true,
-	"predy": true,
-	"preed": true,
-	"preen": true,
-	"prees": true,
-	"preif": true,
-	"prems": true,
-	"premy": true,
-	"prent": true,
-	"preon": true,
-	"preop": true,
-	"preps": true,
-	"presa": true,
-	"prese": true,
-	"press": true,
-	"prest": true,
-	"preve": true,
-	"prexy": true,
-	"preys": true,
-	"prial": true,
-	"price": true,
-	"prick": true,
-	"pricy": true,
-	"pride": true,
-	"pried": true,
-	"prief": true,
-	"prier": true,
-	"pries": true,
-	"prigs": true,
-	"prill": true,
-	"prima": true,
-	"prime": true,
-	"primi": true,
-	"primo": true,
-	"primp": true,
-	"prims": true,
-	"primy": true,
-	"prink": true,
-	"print": true,
-	"prion": true,
-	"prior": true,
-	"prise": true,
-	"prism": true,
-	"priss": true,
-	"privy": true,
-	"prize": true,
-	"proas": true,
-	"probe": true,
-	"probs": true,
-	"prods": true,
-	"proem": true,
-	"profs": true,
-	"progs": true,
-	"proin": true,
-	"proke": true,
-	"prole": true,
-	"proll": true,
-	"promo": true,
-	"proms": true,
-	"prone": true,
-	"prong": true,
-	"pronk": true,
-	"proof": true,
-	"props": true,
-	"prore": true,
-	"prose": true,
-	"proso": true,
-	"pross": true,
-	"prost": true,
-	"prosy": true,
-	"proto": true,
-	"proud": true,
-	"proul": true,
-	"prove": true,
-	"prowl": true,
-	"prows": true,
-	"proxy": true,
-	"proyn": true,
-	"prude": true,
-	"prune": true,
-	"prunt": true,
-	"pruta": true,
-	"pryer": true,
-	"pryse": true,
-	"psalm": true,
-	"pseud": true,
-	"pshaw": true,
-	"psion": true,
-	"psoae": true,
-	"psoai": true,
-	"psoas": true,
-	"psora": true,
-	"psych": true,
-	"psyop": true,
-	"pubco": true,
-	"pubes": true,
-	"pubic": true,
-	"pubis": true,
-	"pucan": true,
-	"pucer": true,
-	"puces": true,
-	"pucka": true,
-	"pucks": true,
-	"puddy": true,
-	"pudge": true,
-	"pudgy": true,
-	"pudic": true,
-	"pudor": true,
-	"pudsy": true,
-	"pudus": true,
-	"puers": true,
-	"puffa": true,
-	"puffs": true,
-	"puffy": true,
-	"puggy": true,
-	"pugil": true,
-	"puhas": true,
-	"pujah": true,
-	"pujas": true,
-	"pukas": true,
-	"puked": true,
-	"puker": true,
-	"pukes": true,
-	"pukey": true,
-	"pukka": true,
-	"pukus": true,
-	"pulao": true,
-	"pulas": true,
-	"puled": true,
-	"puler": true,
-	"pules": true,
-	"pulik": true,
-	"pulis": true,
-	"pulka": true,
-	"pulks": true,
-	"pulli": true,
-	"pulls": true,
-	"pully": true,
-	"pulmo": true,
-	"pulps": true,
-	"pulpy": true,
-	"pulse": true,
-	"pulus": true,
-	"pumas": true,
-	"pumie": true,
-	"pumps": true,
-	"punas": true,
-	"punce": true,
-	"punch": true,
-	"punga": true,
-	"pungs": true,
-	"punji": true,
-	"punka": true,
-	"punks": true,
-	"punky": true,
-	"punny": true,
-	"punto": true,
-	"punts": true,
-	"punty": true,
-	"pupae": true,
-	"pupal": true,
-	"pupas": true,
-	"pupil": true,
-	"puppy": true,
-	"pupus": true,
-	"purda": true,
-	"pured": true,
-	"puree": true,
-	"purer": true,
-	"pures": true,
-	"purge": true,
-	"purin": true,
-	"puris": true,
-	"purls": true,
-	"purpy": true,
-	"purrs": true,
-	"purse": true,
-	"pursy": true,
-	"purty": true,
-	"puses": true,
-	"pushy": true,
-	"pusle": true,
-	"pussy": true,
-	"putid": true,
-	"puton": true,
-	"putti": true,
-	"putto": true,
-	"putts": true,
-	"putty": true,
-	"puzel": true,
-	"pwned": true,
-	"pyats": true,
-	"pyets": true,
-	"pygal": true,
-	"pygmy": true,
-	"pyins": true,
-	"pylon": true,
-	"pyned": true,
-	"pynes": true,
-	"pyoid": true,
-	"pyots": true,
-	"pyral": true,
-	"pyran": true,
-	"pyres": true,
-	"pyrex": true,
-	"pyric": true,
-	"pyros": true,
-	"pyxed": true,
-	"pyxes": true,
-	"pyxie": true,
-	"pyxis": true,
-	"pzazz": true,
-	"qadis": true,
-	"qaids": true,
-	"qajaq": true,
-	"qanat": true,
-	"qapik": true,
-	"qibla": true,
-	"qophs": true,
-	"qorma": true,
-	"quack": true,
-	"quads": true,
-	"quaff": true,
-	"quags": true,
-	"quail": true,
-	"quair": true,
-	"quais": true,
-	"quake": true,
-	"quaky": true,
-	"quale": true,
-	"qualm": true,
-	"quant": true,
-	"quare": true,
-	"quark": true,
-	"quart": true,
-	"quash": true,
-	"quasi": true,
-	"quass": true,
-	"quate": true,
-	"quats": true,
-	"quayd": true,
-	"quays": true,
-	"qubit": true,
-	"quean": true,
-	"queen": true,
-	"queer": true,
-	"quell": true,
-	"queme": true,
-	"quena": true,
-	"quern": true,
-	"query": true,
-	"quest": true,
-	"queue": true,
-	"queyn": true,
-	"queys": true,
-	"quich": true,
-	"quick": true,
-	"quids": true,
-	"quiet": true,
-	"quiff": true,
-	"quill": true,
-	"quilt": true,
-	"quims": true,
-	"quina": true,
-	"quine": true,
-	"quino": true,
-	"quins": true,
-	"quint": true,
-	"quipo": true,
-	"quips": true,
-	"quipu": true,
-	"quire": true,
-	"quirk": true,
-	"quirt": true,
-	"quist": true,
-	"quite": true,
-	"quits": true,
-	"quoad": true,
-	"quods": true,
-	"quoif": true,
-	"quoin": true,
-	"quoit": true,
-	"quoll": true,
-	"quonk": true,
-	"quops": true,
-	"quota": true,
-	"quote": true,
-	"quoth": true,
-	"qursh": true,
-	"quyte": true,
-	"rabat": true,
-	"rabbi": true,
-	"rabic": true,
-	"rabid": true,
-	"rabis": true,
-	"raced": true,
-	"racer": true,
-	"races": true,
-	"rache": true,
-	"racks": true,
-	"racon": true,
-	"radar": true,
-	"radge": true,
-	"radii": true,
-	"radio": true,
-	"radix": true,
-	"radon": true,
-	"raffs": true,
-	"rafts": true,
-	"ragas": true,
-	"ragde": true,
-	"raged": true,
-	"ragee": true,
-	"rager": true,
-	"rages": true,
-	"ragga": true,
-	"raggs": true,
-	"raggy": true,
-	"ragis": true,
-	"ragus": true,
-	"rahed": true,
-	"rahui": true,
-	"raias": true,
-	"raids": true,
-	"raiks": true,
-	"raile": true,
-	"rails": true,
-	"raine": true,
-	"rains": true,
-	"rainy": true,
-	"raird": true,
-	"raise": true,
-	"raita": true,
-	"raits": true,
-	"rajah": true,
-	"rajas": true,
-	"rajes": true,
-	"raked": true,
-	"rakee": true,
-	"raker": true,
-	"rakes": true,
-	"rakia": true,
-	"rakis": true,
-	"rakus": true,
-	"rales": true,
-	"rally": true,
-	"ralph": true,
-	"ramal": true,
-	"ramee": true,
-	"ramen": true,
-	"ramet": true,
-	"ramie": true,
-	"ramin": true,
-	"ramis": true,
-	"rammy": true,
-	"ramps": true,
-	"ramus": true,
-	"ranas": true,
-	"rance": true,
-	"ranch": true,
-	"rands": true,
-	"randy": true,
-	"ranee": true,
-	"ranga": true,
-	"range": true,
-	"rangi": true,
-	"rangs": true,
-	"rangy": true,
-	"ranid": true,
-	"ranis": true,
-	"ranke": true,
-	"ranks": true,
-	"rants": true,
-	"raped": true,
-	"raper": true,
-	"rapes": true,
-	"raphe": true,
-	"rapid": true,
-	"rappe": true,
-	"rared": true,
-	"raree": true,
-	"rarer": true,
-	"rares": true,
-	"rarks": true,
-	"rased": true,
-	"raser": true,
-	"rases": true,
-	"rasps": true,
-	"raspy": true,
-	"rasse": true,
-	"rasta": true,
-	"ratal": true,
-	"ratan": true,
-	"ratas": true,
-	"ratch": true,
-	"rated": true,
-	"ratel": true,
-	"rater": true,
-	"rates": true,
-	"ratha": true,
-	"rathe": true,
-	"raths": true,
-	"ratio": true,
-	"ratoo": true,
-	"ratos": true,
-	"ratty": true,
-	"ratus": true,
-	"rauns": true,
-	"raupo": true,
-	"raved": true,
-	"ravel": true,
-	"raven": true,
-	"raver": true,
-	"raves": true,
-	"ravey": true,
-	"ravin": true,
-	"rawer": true,
-	"rawin": true,
-	"rawly": true,
-	"rawns": true,
-	"raxed": true,
-	"raxes": true,
-	"rayah": true,
-	"rayas": true,
-	"rayed": true,
-	"rayle": true,
-	"rayne": true,
-	"rayon": true,
-	"razed": true,
-	"razee": true,
-	"razer": true,
-	"razes": true,
-	"razoo": true,
-	"razor": true,
-	"reach": true,
-	"react": true,
-	"readd": true,
-	"reads": true,
-	"ready": true,
-	"reais": true,
-	"reaks": true,
-	"realm": true,
-	"realo": true,
-	"reals": true,
-	"reame": true,
-	"reams": true,
-	"reamy": true,
-	"reans": true,
-	"reaps": true,
-	"rearm": true,
-	"rears": true,
-	"reast": true,
-	"reata": true,
-	"reate": true,
-	"reave": true,
-	"rebar": true,
-	"rebbe": true,
-	"rebec": true,
-	"rebel": true,
-	"rebid": true,
-	"rebit": true,
-	"rebop": true,
-	"rebus": true,
-	"rebut": true,
-	"rebuy": true,
-	"recal": true,
-	"recap": true,
-	"recce": true,
-	"recco": true,
-	"reccy": true,
-	"recit": true,
-	"recks": true,
-	"recon": true,
-	"recta": true,
-	"recti": true,
-	"recto": true,
-	"recur": true,
-	"recut": true,
-	"redan": true,
-	"redds": true,
-	"reddy": true,
-	"reded": true,
-	"redes": true,
-	"redia": true,
-	"redid": true,
-	"redip": true,
-	"redly": true,
-	"redon": true,
-	"redos": true,
-	"redox": true,
-	"redry": true,
-	"redub": true,
-	"redux": true,
-	"redye": true,
-	"reech": true,
-	"reede": true,
-	"reeds": true,
-	"reedy": true,
-	"reefs": true,
-	"reefy": true,
-	"reeks": true,
-	"reeky": true,
-	"reels": true,
-	"reens": true,
-	"reest": true,
-	"reeve": true,
-	"refed": true,
-	"refel": true,
-	"refer": true,
-	"reffo": true,
-	"refis": true,
-	"refit": true,
-	"refix": true,
-	"refly": true,
-	"refry": true,
-	"regal": true,
-	"regar": true,
-	"reges": true,
-	"reggo": true,
-	"regie": true,
-	"regma": true,
-	"regna": true,
-	"regos": true,
-	"regur": true,
-	"rehab": true,
-	"rehem": true,
-	"reifs": true,
-	"reify": true,
-	"reign": true,
-	"reiki": true,
-	"reiks": true,
-	"reink": true,
-	"reins": true,
-	"reird": true,
-	"reist": true,
-	"reive": true,
-	"rejig": true,
-	"rejon": true,
-	"reked": true,
-	"rekes": true,
-	"rekey": true,
-	"relax": true,
-	"relay": true,
-	"relet": true,
-	"relic": true,
-	"relie": true,
-	"relit": true,
-	"rello": true,
-	"reman": true,
-	"remap": true,
-	"remen": true,
-	"remet": true,
-	"remex": true,
-	"remit": true,
-	"remix": true,
-	"renal": true,
-	"renay": true,
-	"rends": true,
-	"renew": true,
-	"reney": true,
-	"renga": true,
-	"renig": true,
-	"renin": true,
-	"renne": true,
-	"renos": true,
-	"rente": true,
-	"rents": true,
-	"reoil": true,
-	"reorg": true,
-	"repay": true,
-	"repeg": true,
-	"repel": true,
-	"repin": true,
-	"repla": true,
-	"reply": true,
-	"repos": true,
-	"repot": true,
-	"repps": true,
-	"repro": true,
-	"reran": true,
-	"rerig": true,
-	"rerun": true,
-	"resat": true,
-	"resaw": true,
-	"resay": true,
-	"resee": true,
-	"reses": true,
-	"reset": true,
-	"resew": true,
-	"resid": true,
-	"resin": true,
-	"resit": true,
-	"resod": true,
-	"resow": true,
-	"resto": true,
-	"rests": true,
-	"resty": true,
-	"resus": true,
-	"retag": true,
-	"retax": true,
-	"retch": true,
-	"retem": true,
-	"retia": true,
-	"retie": true,
-	"retox": true,
-	"retro": true,
-	"retry": true,
-	"reuse": true,
-	"revel": true,
-	"revet": true,
-	"revie": true,
-	"revue": true,
-	"rewan": true,
-	"rewax": true,
-	"rewed": true,
-	"rewet": true,
-	"rewin": true,
-	"rewon": true,
-	"rewth": true,
-	"rexes": true,
-	"rezes": true,
-	"rheas": true,
-	"rheme": true,
-	"rheum": true,
-	"rhies": true,
-	"rhime": true,
-	"rhine": true,
-	"rhino": true,
-	"rhody": true,
-	"rhomb": true,
-	"rhone": true,
-	"rhumb": true,
-	"rhyme": true,
-	"rhyne": true,
-	"rhyta": true,
-	"riads": true,
-	"rials": true,
-	"riant": true,
-	"riata": true,
-	"ribas": true,
-	"ribby": true,
-	"ribes": true,
-	"riced": true,
-	"ricer": true,
-	"rices": true,
-	"ricey": true,
-	"richt": true,
-	"ricin": true,
-	"ricks": true,
-	"rider": true,
-	"rides": true,
-	"ridge": true,
-	"ridgy": true,
-	"ridic": true,
-	"riels": true,
-	"riems": true,
-	"rieve": true,
-	"rifer": true,
-	"riffs": true,
-	"rifle": true,
-	"rifte": true,
-	"rifts": true,
-	"rifty": true,
-	"riggs": true,
-	"right": true,
-	"rigid": true,
-	"rigol": true,
-	"rigor": true,
-	"riled": true,
-	"riles": true,
-	"riley": true,
-	"rille": true,
-	"rills": true,
-	"rimae": true,
-	"rimed": true,
-	"rimer": true,
-	"rimes": true,
-	"rimus": true,
-	"rinds": true,
-	"rindy": true,
-	"rines": true,
-	"rings": true,
-	"rinks": true,
-	"rinse": true,
-	"rioja": true,
-	"riots": true,
-	"riped": true,
-	"ripen": true,
-	"riper": true,
-	"ripes": true,
-	"ripps": true,
-	"risen": true,
-	"riser": true,
-	"rises": true,
-	"rishi": true,
-	"risks": true,
-	"risky": true,
-	"risps": true,
-	"risus": true,
-	"rites": true,
-	"ritts": true,
-	"ritzy": true,
-	"rival": true,
-	"rivas": true,
-	"rived": true,
-	"rivel": true,
-	"riven": true,
-	"river": true,
-	"rives": true,
-	"rivet": true,
-	"riyal": true,
-	"rizas": true,
-	"roach": true,
-	"roads": true,
-	"roams": true,
-	"roans": true,
-	"roars": true,
-	"roary": true,
-	"roast": true,
-	"roate": true,
-	"robed": true,
-	"robes": true,
-	"robin": true,
-	"roble": true,
-	"robot": true,
-	"rocks": true,
-	"rocky": true,
-	"roded": true,
-	"rodeo": true,
-	"rodes": true,
-	"roger": true,
-	"rogue": true,
-	"roguy": true,
-	"rohes": true,
-	"roids": true,
-	"roils": true,
-	"roily": true,
-	"roins": true,
-	"roist": true,
-	"rojak": true,
-	"rojis": true,
-	"roked": true,
-	"roker": true,
-	"rokes": true,
-	"rolag": true,
-	"roles": true,
-	"rolfs": true,
-	"rolls": true,
-	"romal": true,
-	"roman": true,
-	"romeo": true,
-	"romps": true,
-	"ronde": true,
-	"rondo": true,
-	"roneo": true,
-	"rones": true,
-	"ronin": true,
-	"ronne": true,
-	"ronte": true,
-	"ronts": true,
-	"roods": true,
-	"roofs": true,
-	"roofy": true,
-	"rooks": true,
-	"rooky": true,
-	"rooms": true,
-	"roomy": true,
-	"roons": true,
-	"roops": true,
-	"roopy": true,
-	"roosa": true,
-	"roose": true,
-	"roost": true,
-	"roots": true,
-	"rooty": true,
-	"roped": true,
-	"roper": true,
-	"ropes": true,
-	"ropey": true,
-	"roque": true,
-	"roral": true,
-	"rores": true,
-	"roric": true,
-	"rorid": true,
-	"rorie": true,
-	"rorts": true,
-	"rorty": true,
-	"rosed": true,
-	"roses": true,
-	"roset": true,
-	"roshi": true,
-	"rosin": true,
-	"rosit": true,
-	"rosti": true,
-	"rosts": true,
-	"rotal": true,
-	"rotan": true,
-	"rotas": true,
-	"rotch": true,
-	"roted": true,
-	"rotes": true,
-	"rotis": true,
-	"rotls": true,
-	"roton": true,
-	"rotor": true,
-	"rotos": true,
-	"rotte": true,
-	"rouen": true,
-	"roues": true,
-	"rouge": true,
-	"rough": true,
-	"roule": true,
-	"rouls": true,
-	"roums": true,
-	"round": true,
-	"roups": true,
-	"roupy": true,
-	"rouse": true,
-	"roust": true,
-	"route": true,
-	"routh": true,
-	"routs": true,
-	"roved": true,
-	"roven": true,
-	"rover": true,
-	"roves": true,
-	"rowan": true,
-	"rowdy": true,
-	"rowed": true,
-	"rowel": true,
-	"rowen": true,
-	"rower": true,
-	"rowie": true,
-	"rowme": true,
-	"rownd": true,
-	"rowth": true,
-	"rowts": true,
-	"royal": true,
-	"royne": true,
-	"royst": true,
-	"rozet": true,
-	"rozit": true,
-	"ruana": true,
-	"rubai": true,
-	"rubby": true,
-	"rubel": true,
-	"rubes": true,
-	"rubin": true,
-	"ruble": true,
-	"rubli": true,
-	"rubus": true,
-	"ruche": true,
-	"rucks": true,
-	"rudas": true,
-	"rudds": true,
-	"ruddy": true,
-	"ruder": true,
-	"rudes": true,
-	"rudie": true,
-	"rudis": true,
-	"rueda": true,
-	"ruers": true,
-	"ruffe": true,
-	"ruffs": true,
-	"rugae": true,
-	"rugal": true,
-	"rugby": true,
-	"ruggy": true,
-	"ruing": true,
-	"ruins": true,
-	"rukhs": true,
-	"ruled": true,
-	"ruler": true,
-	"rules": true,
-	"rumal": true,
-	"rumba": true,
-	"rumbo": true,
-	"rumen": true,
-	"rumes": true,
-	"rumly": true,
-	"rummy": true,
-	"rumor": true,
-	"rumpo": true,
-	"rumps": true,
-	"rumpy": true,
-	"runch": true,
-	"runds": true,
-	"runed": true,
-	"runes": true,
-	"rungs": true,
-	"runic": true,
-	"runny": true,
-	"runts": true,
-	"runty": true,
-	"rupee": true,
-	"rupia": true,
-	"rural": true,
-	"rurps": true,
-	"rurus": true,
-	"rusas": true,
-	"ruses": true,
-	"rushy": true,
-	"rusks": true,
-	"rusma": true,
-	"russe": true,
-	"rusts": true,
-	"rusty": true,
-	"ruths": true,
-	"rutin": true,
-	"rutty": true,
-	"ryals": true,
-	"rybat": true,
-	"ryked": true,
-	"rykes": true,
-	"rymme": true,
-	"rynds": true,
-	"ryots": true,
-	"ryper": true,
-	"saags": true,
-	"sabal": true,
-	"sabed": true,
-	"saber": true,
-	"sabes": true,
-	"sabha": true,
-	"sabin": true,
-	"sabir": true,
-	"sable": true,
-	"sabot": true,
-	"sabra": true,
-	"sabre": true,
-	"sacks": true,
-	"sacra": true,
-	"saddo": true,
-	"sades": true,
-	"sadhe": true,
-	"sadhu": true,
-	"sadis": true,
-	"sadly": true,
-	"sados": true,
-	"sadza": true,
-	"safed": true,
-	"safer": true,
-	"safes": true,
-	"sagas": true,
-	"sager": true,
-	"sages": true,
-	"saggy": true,
-	"sagos": true,
-	"sagum": true,
-	"saheb": true,
-	"sahib": true,
-	"saice": true,
-	"saick": true,
-	"saics": true,
-	"saids": true,
-	"saiga": true,
-	"sails": true,
-	"saims": true,
-	"saine": true,
-	"sains": true,
-	"saint": true,
-	"sairs": true,
-	"saist": true,
-	"saith": true,
-	"sajou": true,
-	"sakai": true,
-	"saker": true,
-	"sakes": true,
-	"sakia": true,
-	"sakis": true,
-	"sakti": true,
-	"salad": true,
-	"salal": true,
-	"salat": true,
-	"salep": true,
-	"sales": true,
-	"salet": true,
-	"salic": true,
-	"salix": true,
-	"salle": true,
-	"sally": true,
-	"salmi": true,
-	"salol": true,
-	"salon": true,
-	"salop": true,
-	"salpa": true,
-	"salps": true,
-	"salsa": true,
-	"salse": true,
-	"salto": true,
-	"salts": true,
-	"salty": true,
-	"salue": true,
-	"salut": true,
-	"salve": true,
-	"salvo": true,
-	"saman": true,
-	"samas": true,
-	"samba": true,
-	"sambo": true,
-	"samek": true,
-	"samel": true,
-	"samen": true,
-	"sames": true,
-	"samey": true,
-	"samfu": true,
-	"sammy": true,
-	"sampi": true,
-	"samps": true,
-	"sands": true,
-	"sandy": true,
-	"saned": true,
-	"saner": true,
-	"sanes": true,
-	"sanga": true,
-	"sangh": true,
-	"sango": true,
-	"sangs": true,
-	"sanko": true,
-	"sansa": true,
-	"santo": true,
-	"sants": true,
-	"saola": true,
-	"sapan": true,
-	"sapid": true,
-	"sapor": true,
-	"sappy": true,
-	"saran": true,
-	"sards": true,
-	"sared": true,
-	"saree": true,
-	"sarge": true,
-	"sargo": true,
-	"sarin": true,
-	"saris": true,
-	"sarks": true,
-	"sarky": true,
-	"sarod": true,
-	"saros": true,
-	"sarus": true,
-	"saser": true,
-	"sasin": true,
-	"sasse": true,
-	"sassy": true,
-	"satai": true,
-	"satay": true,
-	"sated": true,
-	"satem": true,
-	"sates": true,
-	"satin": true,
-	"satis": true,
-	"satyr": true,
-	"sauba": true,
-	"sauce": true,
-	"sauch": true,
-	"saucy": true,
-	"saugh": true,
-	"sauls": true,
-	"sault": true,
-	"sauna": true,
-	"saunt": true,
-	"saury": true,
-	"saute": true,
-	"sauts": true,
-	"saved": true,
-	"saver": true,
-	"saves": true,
-	"savey": true,
-	"savin": true,
-	"savor": true,
-	"savoy": true,
-	"savvy": true,
-	"sawah": true,
-	"sawed": true,
-	"sawer": true,
-	"saxes": true,
-	"sayed": true,
-	"sayer": true,
-	"sayid": true,
-	"sayne": true,
-	"sayon": true,
-	"sayst": true,
-	"sazes": true,
-	"scabs": true,
-	"scads": true,
-	"scaff": true,
-	"scags": true,
-	"scail": true,
-	"scala": true,
-	"scald": true,
-	"scale": true,
-	"scall": true,
-	"scalp": true,
-	"scaly": true,
-	"scamp": true,
-	"scams": true,
-	"scand": true,
-	"scans": true,
-	"scant": true,
-	"scapa": true,
-	"scape": true,
-	"scapi": true,
-	"scare": true,
-	"scarf": true,
-	"scarp": true,
-	"scars": true,
-	"scart": true,
-	"scary": true,
-	"scath": true,
-	"scats": true,
-	"scatt": true,
-	"scaud": true,
-	"scaup": true,
-	"scaur": true,
-	"scaws": true,
-	"sceat": true,
-	"scena": true,
-	"scend": true,
-	"scene": true,
-	"scent": true,
-	"schav": true,
-	"schmo": true,
-	"schul": true,
-	"schwa": true,
-	"scion": true,
-	"sclim": true,
-	"scody": true,
-	"scoff": true,
-	"scogs": true,
-	"scold": true,
-	"scone": true,
-	"scoog": true,
-	"scoop": true,
-	"scoot": true,
-	"scopa": true,
-	"scope": true,
-	"scops": true,
-	"score": true,
-	"scorn": true,
-	"scots": true,
-	"scoug": true,
-	"scoup": true,
-	"scour": true,
-	"scout": true,
-	"scowl": true,
-	"scowp": true,
-	"scows": true,
-	"scrab": true,
-	"scrae": true,
-	"scrag": true,
-	"scram": true,
-	"scran": true,
-	"scrap": true,
-	"scrat": true,
-	"scraw": true,
-	"scray": true,
-	"scree": true,
-	"screw": true,
-	"scrim": true,
-	"scrip": true,
-	"scrob": true,
-	"scrod": true,
-	"scrog": true,
-	"scrow": true,
-	"scrub": true,
-	"scrum": true,
-	"scuba": true,
-	"scudi": true,
-	"scudo": true,
-	"scuds": true,
-	"scuff": true,
-	"scuft": true,
-	"scugs": true,
-	"sculk": true,
-	"scull": true,
-	"sculp": true,
-	"sculs": true,
-	"scums": true,
-	"scups": true,
-	"scurf": true,
-	"scurs": true,
-	"scuse": true,
-	"scuta": true,
-	"scute": true,
-	"scuts": true,
-	"scuzz": true,
-	"scyes": true,
-	"sdayn": true,
-	"sdein": true,
-	"seals": true,
-	"seame": true,
-	"seams": true,
-	"seamy": true,
-	"seans": true,
-	"seare": true,
-	"sears": true,
-	"sease": true,
-	"seats": true,
-	"seaze": true,
-	"sebum": true,
-	"secco": true,
-	"sechs": true,
-	"sects": true,
-	"sedan": true,
-	"seder": true,
-	"sedes": true,
-	"sedge": true,
-	"sedgy": true,
-	"sedum": true,
-	"seeds": true,
-	"seedy": true,
-	"seeks": true,
-	"seeld": true,
-	"seels": true,
-	"seely": true,
-	"seems": true,
-	"seeps": true,
-	"seepy": true,
-	"seers": true,
-	"sefer": true,
-	"segar": true,
-	"segni": true,
-	"segno": true,
-	"segol": true,
-	"segos": true,
-	"segue": true,
-	"sehri": true,
-	"seifs": true,
-	"seils": true,
-	"seine": true,
-	"seirs": true,
-	"seise": true,
-	"seism": true,
-	"seity": true,
-	"seiza": true,
-	"seize": true,
-	"sekos": true,
-	"sekts": true,
-	"selah": true,
-	"seles": true,
-	"selfs": true,
-	"sella": true,
-	"selle": true,
-	"sells": true,
-	"selva": true,
-	"semee": true,
-	"semen": true,
-	"semes": true,
-	"semie": true,
-	"semis": true,
-	"senas": true,
-	"sends": true,
-	"senes": true,
-	"sengi": true,
-	"senna": true,
-	"senor": true,
-	"sensa": true,
-	"sense": true,
-	"sensi": true,
-	"sente": true,
-	"senti": true,
-	"sents": true,
-	"senvy": true,
-	"senza": true,
-	"sepad": true,
-	"sepal": true,
-	"sepia": true,
-	"sepic": true,
-	"sepoy": true,
-	"septa": true,
-	"septs": true,
-	"serac": true,
-	"serai": true,
-	"seral": true,
-	"sered": true,
-	"serer": true,
-	"seres": true,
-	"serfs": true,
-	"serge": true,
-	"seric": true,
-	"serif": true,
-	"serin": true,
-	"serks": true,
-	"seron": true,
-	"serow": true,
-	"serra": true,
-	"serre": true,
-	"serrs": true,
-	"serry": true,
-	"serum": true,
-	"serve": true,
-	"servo": true,
-	"sesey": true,
-	"sessa": true,
-	"setae": true,
-	"setal": true,
-	"seton": true,
-	"setts": true,
-	"setup": true,
-	"seven": true,
-	"sever": true,
-	"sewan": true,
-	"sewar": true,
-	"sewed": true,
-	"sewel": true,
-	"sewen": true,
-	"sewer": true,
-	"sewin": true,
-	"sexed": true,
-	"sexer": true,
-	"sexes": true,
-	"sexto": true,
-	"sexts": true,
-	"seyen": true,
-	"shack": true,
-	"shade": true,
-	"shads": true,
-	"shady": true,
-	"shaft": true,
-	"shags": true,
-	"shahs": true,
-	"shake": true,
-	"shako": true,
-	"shakt": true,
-	"shaky": true,
-	"shale": true,
-	"shall": true,
-	"shalm": true,
-	"shalt": true,
-	"shaly": true,
-	"shama": true,
-	"shame": true,
-	"shams": true,
-	"shand": true,
-	"shank": true,
-	"shans": true,
-	"shape": true,
-	"shaps": true,
-	"shard": true,
-	"share": true,
-	"shark": true,
-	"sharn": true,
-	"sharp": true,
-	"shash": true,
-	"shaul": true,
-	"shave": true,
-	"shawl": true,
-	"shawm": true,
-	"shawn": true,
-	"shaws": true,
-	"shaya": true,
-	"shays": true,
-	"shchi": true,
-	"sheaf": true,
-	"sheal": true,
-	"shear": true,
-	"sheas": true,
-	"sheds": true,
-	"sheel": true,
-	"sheen": true,
-	"sheep": true,
-	"sheer": true,
-	"sheet": true,
-	"sheik": true,
-	"shelf": true,
-	"shell": true,
-	"shend": true,
-	"shent": true,
-	"sheol": true,
-	"sherd": true,
-	"shere": true,
-	"shero": true,
-	"shets": true,
-	"sheva": true,
-	"shewn": true,
-	"shews": true,
-	"shiai": true,
-	"shied": true,
-	"shiel": true,
-	"shier": true,
-	"shies": true,
-	"shift": true,
-	"shill": true,
-	"shily": true,
-	"shims": true,
-	"shine": true,
-	"shins": true,
-	"shiny": true,
-	"ships": true,
-	"shire": true,
-	"shirk": true,
-	"shirr": true,
-	"shirs": true,
-	"shirt": true,
-	"shish": true,
-	"shiso": true,
-	"shist": true,
-	"shite": true,
-	"shits": true,
-	"shiur": true,
-	"shiva": true,
-	"shive": true,
-	"shivs": true,
-	"shlep": true,
-	"shlub": true,
-	"shmek": true,
-	"shmoe": true,
-	"shoal": true,
-	"shoat": true,
-	"shock": true,
-	"shoed": true,
-	"shoer": true,
-	"shoes": true,
-	"shogi": true,
-	"shogs": true,
-	"shoji": true,
-	"shojo": true,
-	"shola": true,
-	"shone": true,
-	"shook": true,
-	"shool": true,
-	"shoon": true,
-	"shoos": true,
-	"shoot": true,
-	"shope": true,
-	"shops": true,
-	"shore": true,
-	"shorl": true,
-	"shorn": true,
-	"short": true,
-	"shote": true,
-	"shots": true,
-	"shott": true,
-	"shout": true,
-	"shove": true,
-	"showd": true,
-	"shown": true,
-	"shows": true,
-	"showy": true,
-	"shoyu": true,
-	"shred": true,
-	"shrew": true,
-	"shris": true,
-	"shrow": true,
-	"shrub": true,
-	"shrug": true,
-	"shtik": true,
-	"shtum": true,
-	"shtup": true,
-	"shuck": true,
-	"shule": true,
-	"shuln": true,
-	"shuls": true,
-	"shuns": true,
-	"shunt": true,
-	"shura": true,
-	"shush": true,
-	"shute": true,
-	"shuts": true,
-	"shwas": true,
-	"shyer": true,
-	"shyly": true,
-	"sials": true,
-	"sibbs": true,
-	"sibyl": true,
-	"sices": true,
-	"sicht": true,
-	"sicko": true,
-	"sicks": true,
-	"sicky": true,
-	"sidas": true,
-	"sided": true,
-	"sider": true,
-	"sides": true,
-	"sidha": true,
-	"sidhe": true,
-	"sidle": true,
-	"siege": true,
-	"sield": true,
-	"siens": true,
-	"sient": true,
-	"sieth": true,
-	"sieur": true,
-	"sieve": true,
-	"sifts": true,
-	"sighs": true,
-	"sight": true,
-	"sigil": true,
-	"sigla": true,
-	"sigma": true,
-	"signa": true,
-	"signs": true,
-	"sijos": true,
-	"sikas": true,
-	"siker": true,
-	"sikes": true,
-	"silds": true,
-	"siled": true,
-	"silen": true,
-	"siler": true,
-	"siles": true,
-	"silex": true,
-	"silks": true,
-	"silky": true,
-	"sills": true,
-	"silly": true,
-	"silos": true,
-	"silts": true,
-	"silty": true,
-	"silva": true,
-	"simar": true,
-	"simas": true,
-	"simba": true,
-	"simis": true,
-	"simps": true,
-	"simul": true,
-	"since": true,
-	"sinds": true,
-	"sined": true,
-	"sines": true,
-	"sinew": true,
-	"singe": true,
-	"sings": true,
-	"sinhs": true,
-	"sinks": true,
-	"sinky": true,
-	"sinus": true,
-	"siped": true,
-	"sipes": true,
-	"sippy": true,
-	"sired": true,
-	"siree": true,
-	"siren": true,
-	"sires": true,
-	"sirih": true,
-	"siris": true,
-	"siroc": true,
-	"sirra": true,
-	"sirup": true,
-	"sisal": true,
-	"sises": true,
-	"sissy": true,
-	"sista": true,
-	"sists": true,
-	"sitar": true,
-	"sited": true,
-	"sites": true,
-	"sithe": true,
-	"sitka": true,
-	"situp": true,
-	"situs": true,
-	"siver": true,
-	"sixer": true,
-	"sixes": true,
-	"sixmo": true,
-	"sixte": true,
-	"sixth": true,
-	"sixty": true,
-	"sizar": true,
-	"sized": true,
-	"sizel": true,
-	"sizer": true,
-	"sizes": true,
-	"skags": true,
-	"skail": true,
-	"skald": true,
-	"skank": true,
-	"skart": true,
-	"skate": true,
-	"skats": true,
-	"skatt": true,
-	"skaws": true,
-	"skean": true,
-	"skear": true,
-	"skeds": true,
-	"skeed": true,
-	"skeef": true,
-	"skeen": true,
-	"skeer": true,
-	"skees": true,
-	"skeet": true,
-	"skegg": true,
-	"skegs": true,
-	"skein": true,
-	"skelf": true,
-	"skell": true,
-	"skelm": true,
-	"skelp": true,
-	"skene": true,
-	"skens": true,
-	"skeos": true,
-	"skeps": true,
-	"skers": true,
-	"skets": true,
-	"skews": true,
-	"skids": true,
-	"skied": true,
-	"skier": true,
-	"skies": true,
-	"skiey": true,
-	"skiff": true,
-	"skill": true,
-	"skimo": true,
-	"skimp": true,
-	"skims": true,
-	"skink": true,
-	"skins": true,
-	"skint": true,
-	"skios": true,
-	"skips": true,
-	"skirl": true,
-	"skirr": true,
-	"skirt": true,
-	"skite": true,
-	"skits": true,
-	"skive": true,
-	"skivy": true,
-	"sklim": true,
-	"skoal": true,
-	"skody": true,
-	"skoff": true,
-	"skogs": true,
-	"skols": true,
-	"skool": true,
-	"skort": true,
-	"skosh": true,
-	"skran": true,
-	"skrik": true,
-	"skuas": true,
-	"skugs": true,
-	"skulk": true,
-	"skull": true,
-	"skunk": true,
-	"skyed": true,
-	"skyer": true,
-	"skyey": true,
-	"skyfs": true,
-	"skyre": true,
-	"skyrs": true,
-	"skyte": true,
-	"slabs": true,
-	"slack": true,
-	"slade": true,
-	"slaes": true,
-	"slags": true,
-	"slaid": true,
-	"slain": true,
-	"slake": true,
-	"slams": true,
-	"slane": true,
-	"slang": true,
-	"slank": true,
-	"slant": true,
-	"slaps": true,
-	"slart": true,
-	"slash": true,
-	"slate": true,
-	"slats": true,
-	"slaty": true,
-	"slave": true,
-	"slaws": true,
-	"slays": true,
-	"slebs": true,
-	"sleds": true,
-	"sleek": true,
-	"sleep": true,
-	"sleer": true,
-	"sleet": true,
-	"slept": true,
-	"slews": true,
-	"sleys": true,
-	"slice": true,
-	"slick": true,
-	"slide": true,
-	"slier": true,
-	"slily": true,
-	"slime": true,
-	"slims": true,
-	"slimy": true,
-	"sling": true,
-	"slink": true,
-	"slipe": true,
-	"slips": true,
-	"slipt": true,
-	"slish": true,
-	"slits": true,
-	"slive": true,
-	"sloan": true,
-	"slobs": true,
-	"sloes": true,
-	"slogs": true,
-	"sloid": true,
-	"slojd": true,
-	"slomo": true,
-	"sloom": true,
-	"sloop": true,
-	"sloot": true,
-	"slope": true,
-	"slops": true,
-	"slopy": true,
-	"slorm": true,
-	"slosh": true,
-	"sloth": true,
-	"slots": true,
-	"slove": true,
-	"slows": true,
-	"sloyd": true,
-	"slubb": true,
-	"slubs": true,
-	"slued": true,
-	"slues": true,
-	"sluff": true,
-	"slugs": true,
-	"sluit": true,
-	"slump": true,
-	"slums": true,
-	"slung": true,
-	"slunk": true,
-	"slurb": true,
-	"slurp": true,
-	"slurs": true,
-	"sluse": true,
-	"slush": true,
-	"sluts": true,
-	"slyer": true,
-	"slyly": true,
-	"slype": true,
-	"smaak": true,
-	"smack": true,
-	"smaik": true,
-	"small": true,
-	"smalm": true,
-	"smalt": true,
-	"smarm": true,
-	"smart": true,
-	"smash": true,
-	"smaze": true,
-	"smear": true,
-	"smeek": true,
-	"smees": true,
-	"smeik": true,
-	"smeke": true,
-	"smell": true,
-	"smelt": true,
-	"smerk": true,
-	"smews": true,
-	"smile": true,
-	"smirk": true,
-	"smirr": true,
-	"smirs": true,
-	"smite": true,
-	"smith": true,
-	"smits": true,
-	"smock": true,
-	"smogs": true,
-	"smoke": true,
-	"smoko": true,
-	"smoky": true,
-	"smolt": true,
-	"smoor": true,
-	"smoot": true,
-	"smore": true,
-	"smorg": true,
-	"smote": true,
-	"smout": true,
-	"smowt": true,
-	"smugs": true,
-	"smurs": true,
-	"smush": true,
-	"smuts": true,
-	"snabs": true,
-	"snack": true,
-	"snafu": true,
-	"snags": true,
-	"snail": true,
-	"snake": true,
-	"snaky": true,
-	"snaps": true,
-	"snare": true,
-	"snarf": true,
-	"snark": true,
-	"snarl": true,
-	"snars": true,
-	"snary": true,
-	"snash": true,
-	"snath": true,
-	"snaws": true,
-	"snead": true,
-	"sneak": true,
-	"sneap": true,
-	"snebs": true,
-	"sneck": true,
-	"sneds": true,
-	"sneed": true,
-	"sneer": true,
-	"snees": true,
-	"snell": true,
-	"snibs": true,
-	"snick": true,
-	"snide": true,
-	"snies": true,
-	"sniff": true,
-	"snift": true,
-	"snigs": true,
-	"snipe": true,
-	"snips": true,
-	"snipy": true,
-	"snirt": true,
-	"snits": true,
-	"snobs": true,
-	"snods": true,
-	"snoek": true,
-	"snoep": true,
-	"snogs": true,
-	"snoke": true,
-	"snood": true,
-	"snook": true,
-	"snool": true,
-	"snoop": true,
-	"snoot": true,
-	"snore": true,
-	"snort": true,
-	"snots": true,
-	"snout": true,
-	"snowk": true,
-	"snows": true,
-	"snowy": true,
-	"snubs": true,
-	"snuck": true,
-	"snuff": true,
-	"snugs": true,
-	"snush": true,
-	"snyes": true,
-	"soaks": true,
-	"soaps": true,
-	"soapy": true,
-	"soare": true,
-	"soars": true,
-	"soave": true,
-	"sobas": true,
-	"sober": true,
-	"socas": true,
-	"soces": true,
-	"socko": true,
-	"socks": true,
-	"socle": true,
-	"sodas": true,
-	"soddy": true,
-	"sodic": true,
-	"sodom": true,
-	"sofar": true,
-	"sofas": true,
-	"softa": true,
-	"softs": true,
-	"softy": true,
-	"soger": true,
-	"soggy": true,
-	"sohur": true,
-	"soils": true,
-	"soily": true,
-	"sojas": true,
-	"sojus": true,
-	"sokah": true,
-	"soken": true,
-	"sokes": true,
-	"sokol": true,
-	"solah": true,
-	"solan": true,
-	"solar": true,
-	"solas": true,
-	"solde": true,
-	"soldi": true,
-	"soldo": true,
-	"solds": true,
-	"soled": true,
-	"solei": true,
-	"soler": true,
-	"soles": true,
-	"solid": true,
-	"solon": true,
-	"solos": true,
-	"solum": true,
-	"solus": true,
-	"solve": true,
-	"soman": true,
-	"somas": true,
-	"sonar": true,
-	"sonce": true,
-	"sonde": true,
-	"sones": true,
-	"songs": true,
-	"sonic": true,
-	"sonly": true,
-	"sonne": true,
-	"sonny": true,
-	"sonse": true,
-	"sonsy": true,
-	"sooey": true,
-	"sooks": true,
-	"sooky": true,
-	"soole": true,
-	"sools": true,
-	"sooms": true,
-	"soops": true,
-	"soote": true,
-	"sooth": true,
-	"soots": true,
-	"sooty": true,
-	"sophs": true,
-	"sophy": true,
-	"sopor": true,
-	"soppy": true,
-	"sopra": true,
-	"soral": true,
-	"soras": true,
-	"sorbo": true,
-	"sorbs": true,
-	"sorda": true,
-	"sordo": true,
-	"sords": true,
-	"sored": true,
-	"soree": true,
-	"sorel": true,
-	"sorer": true,
-	"sores": true,
-	"sorex": true,
-	"sorgo": true,
-	"sorns": true,
-	"sorra": true,
-	"sorry": true,
-	"sorta": true,
-	"sorts": true,
-	"sorus": true,
-	"soths": true,
-	"sotol": true,
-	"souce": true,
-	"souct": true,
-	"sough": true,
-	"souks": true,
-	"souls": true,
-	"soums": true,
-	"sound": true,
-	"soups": true,
-	"soupy": true,
-	"sours": true,
-	"souse": true,
-	"south": true,
-	"souts": true,
-	"sowar": true,
-	"sowce": true,
-	"sowed": true,
-	"sower": true,
-	"sowff": true,
-	"sowfs": true,
-	"sowle": true,
-	"sowls": true,
-	"sowms": true,
-	"sownd": true,
-	"sowne": true,
-	"sowps": true,
-	"sowse": true,
-	"sowth": true,
-	"soyas": true,
-	"soyle": true,
-	"soyuz": true,
-	"sozin": true,
-	"space": true,
-	"spacy": true,
-	"spade": true,
-	"spado": true,
-	"spaed": true,
-	"spaer": true,
-	"spaes": true,
-	"spags": true,
-	"spahi": true,
-	"spail": true,
-	"spain": true,
-	"spait": true,
-	"spake": true,
-	"spald": true,
-	"spale": true,
-	"spall": true,
-	"spalt": true,
-	"spams": true,
-	"spane": true,
-	"spang": true,
-	"spank": true,
-	"spans": true,
-	"spard": true,
-	"spare": true,
-	"spark": true,
-	"spars": true,
-	"spart": true,
-	"spasm": true,
-	"spate": true,
-	"spats": true,
-	"spaul": true,
-	"spawl": true,
-	"spawn": true,
-	"spaws": true,
-	"spayd": true,
-	"spays": true,
-	"spaza": true,
-	"spazz": true,
-	"speak": true,
-	"speal": true,
-	"spean": true,
-	"spear": true,
-	"speat": true,
-	"speck": true,
-	"specs": true,
-	"spect": true,
-	"speed": true,
-	"speel": true,
-	"speer": true,
-	"speil": true,
-	"speir": true,
-	"speks": true,
-	"speld": true,
-	"spelk": true,
-	"spell": true,
-	"spelt": true,
-	"spend": true,
-	"spent": true,
-	"speos": true,
-	"sperm": true,
-	"spets": true,
-	"speug": true,
-	"spews": true,
-	"spewy": true,
-	"spial": true,
-	"spica": true,
-	"spice": true,
-	"spick": true,
-	"spics": true,
-	"spicy": true,
-	"spide": true,
-	"spied": true,
-	"spiel": true,
-	"spier": true,
-	"spies": true,
-	"spiff": true,
-	"spifs": true,
-	"spike": true,
-	"spiks": true,
-	"spiky": true,
-	"spile": true,
-	"spill": true,
-	"spilt": true,
-	"spims": true,
-	"spina": true,
-	"spine": true,
-	"spink": true,
-	"spins": true,
-	"spiny": true,
-	"spire": true,
-	"spirt": true,
-	"spiry": true,
-	"spite": true,
-	"spits": true,
-	"spitz": true,
-	"spivs": true,
-	"splat": true,
-	"splay": true,
-	"split": true,
-	"splog": true,
-	"spode": true,
-	"spods": true,
-	"spoil": true,
-	"spoke": true,
-	"spoof": true,
-	"spook": true,
-	"spool": true,
-	"spoom": true,
-	"spoon": true,
-	"spoor": true,
-	"spoot": true,
-	"spore": true,
-	"spork": true,
-	"sport": true,
-	"sposh": true,
-	"spots": true,
-	"spout": true,
-	"sprad": true,
-	"sprag": true,
-	"sprat": true,
-	"spray": true,
-	"spred": true,
-	"spree": true,
-	"sprew": true,
-	"sprig": true,
-	"sprit": true,
-	"sprod": true,
-	"sprog": true,
-	"sprue": true,
-	"sprug": true,
-	"spuds": true,
-	"spued": true,
-	"spuer": true,
-	"spues": true,
-	"spugs": true,
-	"spule": true,
-	"spume": true,
-	"spumy": true,
-	"spunk": true,
-	"spurn": true,
-	"spurs": true,
-	"spurt": true,
-	"sputa": true,
-	"spyal": true,
-	"spyre": true,
-	"squab": true,
-	"squad": true,
-	"squat": true,
-	"squaw": true,
-	"squeg": true,
-	"squib": true,
-	"squid": true,
-	"squit": true,
-	"squiz": true,
-	"stabs": true,
-	"stack": true,
-	"stade": true,
-	"staff": true,
-	"stage": true,
-	"stags": true,
-	"stagy": true,
-	"staid": true,
-	"staig": true,
-	"stain": true,
-	"stair": true,
-	"stake": true,
-	"stale": true,
-	"stalk": true,
-	"stall": true,
-	"stamp": true,
-	"stand": true,
-	"stane": true,
-	"stang": true,
-	"stank": true,
-	"staph": true,
-	"staps": true,
-	"stare": true,
-	"stark": true,
-	"starn": true,
-	"starr": true,
-	"stars": true,
-	"start": true,
-	"stash": true,
-	"state": true,
-	"stats": true,
-	"staun": true,
-	"stave": true,
-	"staws": true,
-	"stays": true,
-	"stead": true,
-	"steak": true,
-	"steal": true,
-	"steam": true,
-	"stean": true,
-	"stear": true,
-	"stedd": true,
-	"stede": true,
-	"steds": true,
-	"steed": true,
-	"steek": true,
-	"steel": true,
-	"steem": true,
-	"steen": true,
-	"steep": true,
-	"steer": true,
-	"steil": true,
-	"stein": true,
-	"stela": true,
-	"stele": true,
-	"stell": true,
-	"steme": true,
-	"stems": true,
-	"stend": true,
-	"steno": true,
-	"stens": true,
-	"stent": true,
-	"steps": true,
-	"stept": true,
-	"stere": true,
-	"stern": true,
-	"stets": true,
-	"stews": true,
-	"stewy": true,
-	"steys": true,
-	"stich": true,
-	"stick": true,
-	"stied": true,
-	"sties": true,
-	"stiff": true,
-	"stilb": true,
-	"stile": true,
-	"still": true,
-	"stilt": true,
-	"stime": true,
-	"stims": true,
-	"stimy": true,
-	"sting": true,
-	"stink": true,
-	"stint": true,
-	"stipa": true,
-	"stipe": true,
-	"stire": true,
-	"stirk": true,
-	"stirp": true,
-	"stirs": true,
-	"stive": true,
-	"stivy": true,
-	"stoae": true,
-	"stoai": true,
-	"stoas": true,
-	"stoat": true,
-	"stobs": true,
-	"stock": true,
-	"stoep": true,
-	"stogy": true,
-	"stoic": true,
-	"stoit": true,
-	"stoke": true,
-	"stole": true,
-	"stoln": true,
-	"stoma": true,
-	"stomp": true,
-	"stond": true,
-	"stone": true,
-	"stong": true,
-	"stonk": true,
-	"stonn": true,
-	"stony": true,
-	"stood": true,
-	"stook": true,
-	"stool": true,
-	"stoop": true,
-	"stoor": true,
-	"stope": true,
-	"stops": true,
-	"stopt": true,
-	"store": true,
-	"stork": true,
-	"storm": true,
-	"story": true,
-	"stoss": true,
-	"stots": true,
-	"stott": true,
-	"stoun": true,
-	"stoup": true,
-	"stour": true,
-	"stout": true,
-	"stove": true,
-	"stown": true,
-	"stowp": true,
-	"stows": true,
-	"strad": true,
-	"strae": true,
-	"strag": true,
-	"strak": true,
-	"strap": true,
-	"straw": true,
-	"stray": true,
-	"strep": true,
-	"strew": true,
-	"stria": true,
-	"strig": true,
-	"strim": true,
-	"strip": true,
-	"strop": true,
-	"strow": true,
-	"stroy": true,
-	"strum": true,
-	"strut": true,
-	"stubs": true,
-	"stuck": true,
-	"stude": true,
-	"studs": true,
-	"study": true,
-	"stuff": true,
-	"stull": true,
-	"stulm": true,
-	"stumm": true,
-	"stump": true,
-	"stums": true,
-	"stung": true,
-	"stunk": true,
-	"stuns": true,
-	"stunt": true,
-	"stupa": true,
-	"stupe": true,
-	"sture": true,
-	"sturt": true,
-	"styed": true,
-	"styes": true,
-	"style": true,
-	"styli": true,
-	"stylo": true,
-	"styme": true,
-	"stymy": true,
-	"styre": true,
-	"styte": true,
-	"suave": true,
-	"subah": true,
-	"subas": true,
-	"subby": true,
-	"suber": true,
-	"subha": true,
-	"succi": true,
-	"sucks": true,
-	"sucky": true,
-	"sucre": true,
-	"sudds": true,
-	"sudor": true,
-	"sudsy": true,
-	"suede": true,
-	"suent": true,
-	"suers": true,
-	"suete": true,
-	"suets": true,
-	"suety": true,
-	"sugan": true,
-	"sugar": true,
-	"sughs": true,
-	"sugos": true,
-	"suhur": true,
-	"suids": true,
-	"suing": true,
-	"suint": true,
-	"suite": true,
-	"suits": true,
-	"sujee": true,
-	"sukhs": true,
-	"sukuk": true,
-	"sulci": true,
-	"sulfa": true,
-	"sulfo": true,
-	"sulks": true,
-	"sulky": true,
-	"sully": true,
-	"sulph": true,
-	"sulus": true,
-	"sumac": true,
-	"sumis": true,
-	"summa": true,
-	"sumos": true,
-	"sumph": true,
-	"sumps": true,
-	"sunis": true,
-	"sunks": true,
-	"sunna": true,
-	"sunns": true,
-	"sunny": true,
-	"sunup": true,
-	"super": true,
-	"supes": true,
-	"supra": true,
-	"surah": true,
-	"sural": true,
-	"suras": true,
-	"surat": true,
-	"surds": true,
-	"sured": true,
-	"surer": true,
-	"sures": true,
-	"surfs": true,
-	"surfy": true,
-	"surge": true,
-	"surgy": true,
-	"surly": true,
-	"surra": true,
-	"sused": true,
-	"suses": true,
-	"sushi": true,
-	"susus": true,
-	"sutor": true,
-	"sutra": true,
-	"sutta": true,
-	"swabs": true,
-	"swack": true,
-	"swads": true,
-	"swage": true,
-	"swags": true,
-	"swail": true,
-	"swain": true,
-	"swale": true,
-	"swaly": true,
-	"swami": true,
-	"swamp": true,
-	"swamy": true,
-	"swang": true,
-	"swank": true,
-	"swans": true,
-	"swaps": true,
-	"swapt": true,
-	"sward": true,
-	"sware": true,
-	"swarf": true,
-	"swarm": true,
-	"swart": true,
-	"swash": true,
-	"swath": true,
-	"swats": true,
-	"swayl": true,
-	"sways": true,
-	"sweal": true,
-	"swear": true,
-	"sweat": true,
-	"swede": true,
-	"sweed": true,
-	"sweel": true,
-	"sweep": true,
-	"sweer": true,
-	"swees": true,
-	"sweet": true,
-	"sweir": true,
-	"swell": true,
-	"swelt": true,
-	"swept": true,
-	"swerf": true,
-	"sweys": true,
-	"swies": true,
-	"swift": true,
-	"swigs": true,
-	"swile": true,
-	"swill": true,
-	"swims": true,
-	"swine": true,
-	"swing": true,
-	"swink": true,
-	"swipe": true,
-	"swire": true,
-	"swirl": true,
-	"swish": true,
-	"swiss": true,
-	"swith": true,
-	"swits": true,
-	"swive": true,
-	"swizz": true,
-	"swobs": true,
-	"swole": true,
-	"swoln": true,
-	"swoon": true,
-	"swoop": true,
-	"swops": true,
-	"swopt": true,
-	"sword": true,
-	"swore": true,
-	"sworn": true,
-	"swots": true,
-	"swoun": true,
-	"swung": true,
-	"sybbe": true,
-	"sybil": true,
-	"syboe": true,
-	"sybow": true,
-	"sycee": true,
-	"syces": true,
-	"sycon": true,
-	"syens": true,
-	"syker": true,
-	"sykes": true,
-	"sylis": true,
-	"sylph": true,
-	"sylva": true,
-	"symar": true,
-	"synch": true,
-	"syncs": true,
-	"synds": true,
-	"syned": true,
-	"synes": true,
-	"synod": true,
-	"synth": true,
-	"syped": true,
-	"sypes": true,
-	"syphs": true,
-	"syrah": true,
-	"syren": true,
-	"syrup": true,
-	"sysop": true,
-	"sythe": true,
-	"syver": true,
-	"taals": true,
-	"taata": true,
-	"tabby": true,
-	"taber": true,
-	"tabes": true,
-	"tabid": true,
-	"tabis": true,
-	"tabla": true,
-	"table": true,
-	"taboo": true,
-	"tabor": true,
-	"tabun": true,
-	"tabus": true,
-	"tacan": true,
-	"taces": true,
-	"tacet": true,
-	"tache": true,
-	"tacho": true,
-	"tachs": true,
-	"tacit": true,
-	"tacks": true,
-	"tacky": true,
-	"tacos": true,
-	"tacts": true,
-	"taels": true,
-	"taffy": true,
-	"tafia": true,
-	"taggy": true,
-	"tagma": true,
-	"tahas": true,
-	"tahrs": true,
-	"taiga": true,
-	"taigs": true,
-	"taiko": true,
-	"tails": true,
-	"tains": true,
-	"taint": true,
-	"taira": true,
-	"taish": true,
-	"taits": true,
-	"tajes": true,
-	"takas": true,
-	"taken": true,
-	"taker": true,
-	"takes": true,
-	"takhi": true,
-	"takin": true,
-	"takis": true,
-	"takky": true,
-	"talak": true,
-	"talaq": true,
-	"talar": true,
-	"talas": true,
-	"talcs": true,
-	"talcy": true,
-	"talea": true,
-	"taler": true,
-	"tales": true,
-	"talks": true,
-	"talky": true,
-	"talls": true,
-	"tally": true,
-	"talma": true,
-	"talon": true,
-	"talpa": true,
-	"taluk": true,
-	"talus": true,
-	"tamal": true,
-	"tamed": true,
-	"tamer": true,
-	"tames": true,
-	"tamin": true,
-	"tamis": true,
-	"tammy": true,
-	"tamps": true,
-	"tanas": true,
-	"tanga": true,
-	"tangi": true,
-	"tango": true,
-	"tangs": true,
-	"tangy": true,
-	"tanhs": true,
-	"tanka": true,
-	"tanks": true,
-	"tanky": true,
-	"tanna": true,
-	"tansy": true,
-	"tanti": true,
-	"tanto": true,
-	"tanty": true,
-	"tapas": true,
-	"taped": true,
-	"tapen": true,
-	"taper": true,
-	"tapes": true,
-	"tapet": true,
-	"tapir": true,
-	"tapis": true,
-	"tappa": true,
-	"tapus": true,
-	"taras": true,
-	"tardo": true,
-	"tardy": true,
-	"tared": true,
-	"tares": true,
-	"targa": true,
-	"targe": true,
-	"tarns": true,
-	"taroc": true,
-	"tarok": true,
-	"taros": true,
-	"tarot": true,
-	"tarps": true,
-	"tarre": true,
-	"tarry": true,
-	"tarsi": true,
-	"tarts": true,
-	"tarty": true,
-	"tasar": true,
-	"tased": true,
-	"taser": true,
-	"tases": true,
-	"tasks": true,
-	"tassa": true,
-	"tasse": true,
-	"tasso": true,
-	"taste": true,
-	"tasty": true,
-	"tatar": true,
-	"tater": true,
-	"tates": true,
-	"taths": true,
-	"tatie": true,
-	"tatou": true,
-	"tatts": true,
-	"tatty": true,
-	"tatus": true,
-	"taube": true,
-	"tauld": true,
-	"taunt": true,
-	"tauon": true,
-	"taupe": true,
-	"tauts": true,
-	"tavah": true,
-	"tavas": true,
-	"taver": true,
-	"tawai": true,
-	"tawas": true,
-	"tawed": true,
-	"tawer": true,
-	"tawie": true,
-	"tawny": true,
-	"tawse": true,
-	"tawts": true,
-	"taxed": true,
-	"taxer": true,
-	"taxes": true,
-	"taxis": true,
-	"taxol": true,
-	"taxon": true,
-	"taxor": true,
-	"taxus": true,
-	"tayra": true,
-	"tazza": true,
-	"tazze": true,
-	"teach": true,
-	"teade": true,
-	"teads": true,
-	"teaed": true,
-	"teaks": true,
-	"teals": true,
-	"teams": true,
-	"tears": true,
-	"teary": true,
-	"tease": true,
-	"teats": true,
-	"teaze": true,
-	"techs": true,
-	"techy": true,
-	"tecta": true,
-	"teddy": true,
-	"teels": true,
-	"teems": true,
-	"teend": true,
-	"teene": true,
-	"teens": true,
-	"teeny": true,
-	"teers": true,
-	"teeth": true,
-	"teffs": true,
-	"teggs": true,
-	"tegua": true,
-	"tegus": true,
-	"tehrs": true,
-	"teiid": true,
-	"teils": true,
-	"teind": true,
-	"teins": true,
-	"telae": true,
-	"telco": true,
-	"teles": true,
-	"telex": true,
-	"telia": true,
-	"telic": true,
-	"tells": true,
-	"telly": true,
-	"teloi": true,
-	"telos": true,
-	"temed": true,
-	"temes": true,
-	"tempi": true,
-	"tempo": true,
-	"temps": true,
-	"tempt": true,
-	"temse": true,
-	"tench": true,
-	"tends": true,
-	"tendu": true,
-	"tenes": true,
-	"tenet": true,
-	"tenge": true,
-	"tenia": true,
-	"tenne": true,
-	"tenno": true,
-	"tenny": true,
-	"tenon": true,
-	"tenor": true,
-	"tense": true,
-	"tenth": true,
-	"tents": true,
-	"tenty": true,
-	"tenue": true,
-	"tepal": true,
-	"tepas": true,
-	"tepee": true,
-	"tepid": true,
-	"tepoy": true,
-	"terai": true,
-	"teras": true,
-	"terce": true,
-	"terek": true,
-	"teres": true,
-	"terfe": true,
-	"terfs": true,
-	"terga": true,
-	"terms": true,
-	"terne": true,
-	"terns": true,
-	"terra": true,
-	"terry": true,
-	"terse": true,
-	"terts": true,
-	"tesla": true,
-	"testa": true,
-	"teste": true,
-	"tests": true,
-	"testy": true,
-	"tetes": true,
-	"teths": true,
-	"tetra": true,
-	"tetri": true,
-	"teuch": true,
-	"teugh": true,
-	"tewed": true,
-	"tewel": true,
-	"tewit": true,
-	"texas": true,
-	"texes": true,
-	"texts": true,
-	"thack": true,
-	"thagi": true,
-	"thaim": true,
-	"thale": true,
-	"thali": true,
-	"thana": true,
-	"thane": true,
-	"thang": true,
-	"thank": true,
-	"thans": true,
-	"thanx": true,
-	"tharm": true,
-	"thars": true,
-	"thaws": true,
-	"thawy": true,
-	"thebe": true,
-	"theca": true,
-	"theed": true,
-	"theek": true,
-	"thees": true,
-	"theft": true,
-	"thegn": true,
-	"theic": true,
-	"thein": true,
-	"their": true,
-	"thelf": true,
-	"thema": true,
-	"theme": true,
-	"thens": true,
-	"theow": true,
-	"there": true,
-	"therm": true,
-	"these": true,
-	"thesp": true,
-	"theta": true,
-	"thete": true,
-	"thews": true,
-	"thewy": true,
-	"thick": true,
-	"thief": true,
-	"thigh": true,
-	"thigs": true,
-	"thilk": true,
-	"thill": true,
-	"thine": true,
-	"thing": true,
-	"think": true,
-	"thins": true,
-	"thiol": true,
-	"third": true,
-	"thirl": true,
-	"thoft": true,
-	"thole": true,
-	"tholi": true,
-	"thong": true,
-	"thorn": true,
-	"thoro": true,
-	"thorp": true,
-	"those": true,
-	"thous": true,
-	"thowl": true,
-	"thrae": true,
-	"thraw": true,
-	"three": true,
-	"threw": true,
-	"thrid": true,
-	"thrip": true,
-	"throb": true,
-	"throe": true,
-	"throw": true,
-	"thrum": true,
-	"thuds": true,
-	"thugs": true,
-	"thuja": true,
-	"thumb": true,
-	"thump": true,
-	"thunk": true,
-	"thurl": true,
-	"thuya": true,
-	"thyme": true,
-	"thymi": true,
-	"thymy": true,
-	"tians": true,
-	"tiara": true,
-	"tiars": true,
-	"tibia": true,
-	"tical": true,
-	"ticca": true,
-	"ticed": true,
-	"tices": true,
-	"tichy": true,
-	"ticks": true,
-	"ticky": true,
-	"tidal": true,
-	"tiddy": true,
-	"tided": true,
-	"tides": true,
-	"tiers": true,
-	"tiffs": true,
-	"tifos": true,
-	"tifts": true,
-	"tiger": true,
-	"tiges": true,
-	"tight": true,
-	"tigon": true,
-	"tikas": true,
-	"tikes": true,
-	"tikis": true,
-	"tikka": true,
-	"tilak": true,
-	"tilde": true,
-	"tiled": true,
-	"tiler": true,
-	"tiles": true,
-	"tills": true,
-	"tilly": true,
-	"tilth": true,
-	"tilts": true,
-	"timbo": true,
-	"timed": true,
-	"timer": true,
-	"times": true,
-	"timid": true,
-	"timon": true,
-	"timps": true,
-	"tinas": true,
-	"tinct": true,
-	"tinds": true,
-	"tinea": true,
-	"tined": true,
-	"tines": true,
-	"tinge": true,
-	"tings": true,
-	"tinks": true,
-	"tinny": true,
-	"tints": true,
-	"tinty": true,
-	"tipis": true,
-	"tippy": true,
-	"tipsy": true,
-	"tired": true,
-	"tires": true,
-	"tirls": true,
-	"tiros": true,
-	"tirrs": true,
-	"titan": true,
-	"titch": true,
-	"titer": true,
-	"tithe": true,
-	"titis": true,
-	"title": true,
-	"titre": true,
-	"titty": true,
-	"titup": true,
-	"tiyin": true,
-	"tiyns": true,
-	"tizes": true,
-	"tizzy": true,
-	"toads": true,
-	"toady": true,
-	"toast": true,
-	"toaze": true,
-	"tocks": true,
-	"tocky": true,
-	"tocos": true,
-	"today": true,
-	"todde": true,
-	"toddy": true,
-	"toeas": true,
-	"toffs": true,
-	"toffy": true,
-	"tofts": true,
-	"tofus": true,
-	"togae": true,
-	"togas": true,
-	"toged": true,
-	"toges": true,
-	"togue": true,
-	"tohos": true,
-	"toile": true,
-	"toils": true,
-	"toing": true,
-	"toise": true,
-	"toits": true,
-	"tokay": true,
-	"toked": true,
-	"token": true,
-	"toker": true,
-	"tokes": true,
-	"tokos": true,
-	"tolan": true,
-	"tolar": true,
-	"tolas": true,
-	"toled": true,
-	"toles": true,
-	"tolls": true,
-	"tolly": true,
-	"tolts": true,
-	"tolus": true,
-	"tolyl": true,
-	"toman": true,
-	"tombs": true,
-	"tomes": true,
-	"tomia": true,
-	"tommy": true,
-	"tomos": true,
-	"tonal": true,
-	"tondi": true,
-	"tondo": true,
-	"toned": true,
-	"toner": true,
-	"tones": true,
-	"toney": true,
-	"tonga": true,
-	"tongs": true,
-	"tonic": true,
-	"tonka": true,
-	"tonks": true,
-	"tonne": true,
-	"tonus": true,
-	"tools": true,
-	"tooms": true,
-	"toons": true,
-	"tooth": true,
-	"toots": true,
-	"topaz": true,
-	"toped": true,
-	"topee": true,
-	"topek": true,
-	"toper": true,
-	"topes": true,
-	"tophe": true,
-	"tophi": true,
-	"tophs": true,
-	"topic": true,
-	"topis": true,
-	"topoi": true,
-	"topos": true,
-	"toppy": true,
-	"toque": true,
-	"torah": true,
-	"toran": true,
-	"toras": true,
-	"torch": true,
-	"torcs": true,
-	"tores": true,
-	"toric": true,
-	"torii": true,
-	"toros": true,
-	"torot": true,
-	"torrs": true,
-	"torse": true,
-	"torsi": true,
-	"torsk": true,
-	"torso": true,
-	"torta": true,
-	"torte": true,
-	"torts": true,
-	"torus": true,
-	"tosas": true,
-	"tosed": true,
-	"toses": true,
-	"toshy": true,
-	"tossy": true,
-	"total": true,
-	"toted": true,
-	"totem": true,
-	"toter": true,
-	"totes": true,
-	"totty": true,
-	"touch": true,
-	"tough": true,
-	"touks": true,
-	"touns": true,
-	"tours": true,
-	"touse": true,
-	"tousy": true,
-	"touts": true,
-	"touze": true,
-	"touzy": true,
-	"towed": true,
-	"towel": true,
-	"tower": true,
-	"towie": true,
-	"towns": true,
-	"towny": true,
-	"towse": true,
-	"towsy": true,
-	"towts": true,
-	"towze": true,
-	"towzy": true,
-	"toxic": true,
-	"toxin": true,
-	"toyed": true,
-	"toyer": true,
-	"toyon": true,
-	"toyos": true,
-	"tozed": true,
-	"tozes": true,
-	"tozie": true,
-	"trabs": true,
-	"trace": true,
-	"track": true,
-	"tract": true,
-	"trade": true,
-	"trads": true,
-	"tragi": true,
-	"traik": true,
-	"trail": true,
-	"train": true,
-	"trait": true,
-	"tramp": true,
-	"trams": true,
-	"trank": true,
-	"tranq": true,
-	"trans": true,
-	"trant": true,
-	"trape": true,
-	"traps": true,
-	"trapt": true,
-	"trash": true,
-	"trass": true,
-	"trats": true,
-	"tratt": true,
-	"trave": true,
-	"trawl": true,
-	"trayf": true,
-	"trays": true,
-	"tread": true,
-	"treat": true,
-	"treck": true,
-	"treed": true,
-	"treen": true,
-	"trees": true,
-	"trefa": true,
-	"treif": true,
-	"treks": true,
-	"trema": true,
-	"trems": true,
-	"trend": true,
-	"tress": true,
-	"trest": true,
-	"trets": true,
-	"trews": true,
-	"treyf": true,
-	"treys": true,
-	"triac": true,
-	"triad": true,
-	"trial": true,
-	"tribe": true,
-	"trice": true,
-	"trick": true,
-	"tride": true,
-	"tried": true,
-	"trier": true,
-	"tries": true,
-	"triff": true,
-	"trigo": true,
-	"trigs": true,
-	"trike": true,
-	"trild": true,
-	"trill": true,
-	"trims": true,
-	"trine": true,
-	"trins": true,
-	"triol": true,
-	"trior": true,
-	"trios": true,
-	"tripe": true,
-	"trips": true,
-	"tripy": true,
-	"trist": true,
-	"trite": true,
-	"troad": true,
-	"troak": true,
-	"troat": true,
-	"trock": true,
-	"trode": true,
-	"trods": true,
-	"trogs": true,
-	"trois": true,
-	"troke": true,
-	"troll": true,
-	"tromp": true,
-	"trona": true,
-	"tronc": true,
-	"trone": true,
-	"tronk": true,
-	"trons": true,
-	"troop": true,
-	"trooz": true,
-	"trope": true,
-	"troth": true,
-	"trots": true,
-	"trout": true,
-	"trove": true,
-	"trows": true,
-	"troys": true,
-	"truce": true,
-	"truck": true,
-	"trued": true,
-	"truer": true,
-	"trues": true,
-	"trugo": true,
-	"trugs": true,
-	"trull": true,
-	"truly": true,
-	"trump": true,
-	"trunk": true,
-	"truss": true,
-	"trust": true,
-	"truth": true,
-	"tryer": true,
-	"tryke": true,
-	"tryma": true,
-	"tryps": true,
-	"tryst": true,
-	"tsade": true,
-	"tsadi": true,
-	"tsars": true,
-	"tsked": true,
-	"tsuba": true,
-	"tsubo": true,
-	"tuans": true,
-	"tuart": true,
-	"tuath": true,
-	"tubae": true,
-	"tubal": true,
-	"tubar": true,
-	"tubas": true,
-	"tubby": true,
-	"tubed": true,
-	"tuber": true,
-	"tubes": true,
-	"tucks": true,
-	"tufas": true,
-	"tuffe": true,
-	"tuffs": true,
-	"tufts": true,
-	"tufty": true,
-	"tugra": true,
-	"tuile": true,
-	"tuina": true,
-	"tuism": true,
-	"tuktu": true,
-	"tules": true,
-	"tulip": true,
-	"tulle": true,
-	"tulpa": true,
-	"tulsi": true,
-	"tumid": true,
-	"tummy": true,
-	"tumor": true,
-	"tumps": true,
-	"tumpy": true,
-	"tunas": true,
-	"tunds": true,
-	"tuned": true,
-	"tuner": true,
-	"tunes": true,
-	"tungs": true,
-	"tunic": true,
-	"tunny": true,
-	"tupek": true,
-	"tupik": true,
-	"tuple": true,
-	"tuque": true,
-	"turbo": true,
-	"turds": true,
-	"turfs": true,
-	"turfy": true,
-	"turks": true,
-	"turme": true,
-	"turms": true,
-	"turns": true,
-	"turnt": true,
-	"turps": true,
-	"turrs": true,
-	"tushy": true,
-	"tusks": true,
-	"tusky": true,
-	"tutee": true,
-	"tutor": true,
-	"tutti": true,
-	"tutty": true,
-	"tutus": true,
-	"tuxes": true,
-	"tuyer": true,
-	"twaes": true,
-	"twain": true,
-	"twals": true,
-	"twang": true,
-	"twank": true,
-	"twats": true,
-	"tways": true,
-	"tweak": true,
-	"tweed": true,
-	"tweel": true,
-	"tween": true,
-	"tweep": true,
-	"tweer": true,
-	"tweet": true,
-	"twerk": true,
-	"twerp": true,
-	"twice": true,
-	"twier": true,
-	"twigs": true,
-	"twill": true,
-	"twilt": true,
-	"twine": true,
-	"twink": true,
-	"twins": true,
-	"twiny": true,
-	"twire": true,
-	"twirl": true,
-	"twirp": true,
-	"twist": true,
-	"twite": true,
-	"twits": true,
-	"twixt": true,
-	"twoer": true,
-	"twyer": true,
-	"tyees": true,
-	"tyers": true,
-	"tying": true,
-	"tyiyn": true,
-	"tykes": true,
-	"tyler": true,
-	"tymps": true,
-	"tynde": true,
-	"tyned": true,
-	"tynes": true,
-	"typal": true,
-	"typed": true,
-	"types": true,
-	"typey": true,
-	"typic": true,
-	"typos": true,
-	"typps": true,
-	"typto": true,
-	"tyran": true,
-	"tyred": true,
-	"tyres": true,
-	"tyros": true,
-	"tythe": true,
-	"tzars": true,
-	"udals": true,
-	"udder": true,
-	"udons": true,
-	"ugali": true,
-	"ugged": true,
-	"uhlan": true,
-	"uhuru": true,
-	"ukase": true,
-	"ulama": true,
-	"ulans": true,
-	"ulcer": true,
-	"ulema": true,
-	"ulmin": true,
-	"ulnad": true,
-	"ulnae": true,
-	"ulnar": true,
-	"ulnas": true,
-	"ulpan": true,
-	"ultra": true,
-	"ulvas": true,
-	"ulyie": true,
-	"ulzie": true,
-	"umami": true,
-	"umbel": true,
-	"umber": true,
-	"umble": true,
-	"umbos": true,
-	"umbra": true,
-	"umbre": true,
-	"umiac": true,
-	"umiak": true,
-	"umiaq": true,
-	"ummah": true,
-	"ummas": true,
-	"ummed": true,
-	"umped": true,
-	"umphs": true,
-	"umpie": true,
-	"umpty": true,
-	"umrah": true,
-	"umras": true,
-	"unais": true,
-	"unapt": true,
-	"unarm": true,
-	"unary": true,
-	"unaus": true,
-	"unbag": true,
-	"unban": true,
-	"unbar": true,
-	"unbed": true,
-	"unbid": true,
-	"unbox": true,
-	"uncap": true,
-	"unces": true,
-	"uncia": true,
-	"uncle": true,
-	"uncos": true,
-	"uncoy": true,
-	"uncus": true,
-	"uncut": true,
-	"undam": true,
-	"undee": true,
-	"under": true,
-	"undid": true,
-	"undos": true,
-	"undue": true,
-	"undug": true,
-	"uneth": true,
-	"unfed": true,
-	"unfit": true,
-	"unfix": true,
-	"ungag": true,
-	"unget": true,
-	"ungod": true,
-	"ungot": true,
-	"ungum": true,
-	"unhat": true,
-	"unhip": true,
-	"unica": true,
-	"unify": true,
-	"union": true,
-	"unite": true,
-	"units": true,
-	"unity": true,
-	"unjam": true,
-	"unked": true,
-	"unket": true,
-	"unkid": true,
-	"unlaw": true,
-	"unlay": true,
-	"unled": true,
-	"unlet": true,
-	"unlid": true,
-	"unlit": true,
-	"unman": true,
-	"unmet": true,
-	"unmew": true,
-	"unmix": true,
-	"unpay": true,
-	"unpeg": true,
-	"unpen": true,
-	"unpin": true,
-	"unred": true,
-	"unrid": true,
-	"unrig": true,
-	"unrip": true,
-	"unsaw": true,
-	"unsay": true,
-	"unsee": true,
-	"unset": true,
-	"unsew": true,
-	"unsex": true,
-	"unsod": true,
-	"untax": true,
-	"untie": true,
-	"until": true,
-	"untin": true,
-	"unwed": true,
-	"unwet": true,
-	"unwit": true,
-	"unwon": true,
-	"unzip": true,
-	"upbow": true,
-	"upbye": true,
-	"updos": true,
-	"updry": true,
-	"upend": true,
-	"upjet": true,
-	"uplay": true,
-	"upled": true,
-	"uplit": true,
-	"upped": true,
-	"upper": true,
-	"upran": true,
-	"uprun": true,
-	"upsee": true,
-	"upset": true,
-	"upsey": true,
-	"uptak": true,
-	"upter": true,
-	"uptie": true,
-	"uraei": true,
-	"urali": true,
-	"uraos": true,
-	"urare": true,
-	"urari": true,
-	"urase": true,
-	"urate": true,
-	"urban": true,
-	"urbex": true,
-	"urbia": true,
-	"urdee": true,
-	"ureal": true,
-	"ureas": true,
-	"uredo": true,
-	"ureic": true,
-	"urena": true,
-	"urent": true,
-	"urged": true,
-	"urger": true,
-	"urges": true,
-	"urial": true,
-	"urine": true,
-	"urite": true,
-	"urman": true,
-	"urnal": true,
-	"urned": true,
-	"urped": true,
-	"ursae": true,
-	"ursid": true,
-	"urson": true,
-	"urubu": true,
-	"urvas": true,
-	"usage": true,
-	"users": true,
-	"usher": true,
-	"using": true,
-	"usnea": true,
-	"usque": true,
-	"usual": true,
-	"usure": true,
-	"usurp": true,
-	"usury": true,
-	"uteri": true,
-	"utile": true,
-	"utter": true,
-	"uveal": true,
-	"uveas": true,
-	"uvula": true,
-	"vacua": true,
-	"vaded": true,
-	"vades": true,
-	"vagal": true,
-	"vague": true,
-	"vagus": true,
-	"vails": true,
-	"vaire": true,
-	"vairs": true,
-	"vairy": true,
-	"vakas": true,
-	"vakil": true,
-	"vales": true,
-	"valet": true,
-	"valid": true,
-	"valis": true,
-	"valor": true,
-	"valse": true,
-	"value": true,
-	"valve": true,
-	"vamps": true,
-	"vampy": true,
-	"vanda": true,
-	"vaned": true,
-	"vanes": true,
-	"vangs": true,
-	"vants": true,
-	"vaped": true,
-	"vaper": true,
-	"vapes": true,
-	"vapid": true,
-	"vapor": true,
-	"varan": true,
-	"varas": true,
-	"vardy": true,
-	"varec": true,
-	"vares": true,
-	"varia": true,
-	"varix": true,
-	"varna": true,
-	"varus": true,
-	"varve": true,
-	"vasal": true,
-	"vases": true,
-	"vasts": true,
-	"vasty": true,
-	"vatic": true,
-	"vatus": true,
-	"vauch": true,
-	"vault": true,
-	"vaunt": true,
-	"vaute": true,
-	"vauts": true,
-	"vawte": true,
-	"vaxes": true,
-	"veale": true,
-	"veals": true,
-	"vealy": true,
-	"veena": true,
-	"veeps": true,
-	"veers": true,
-	"veery": true,
-	"vegan": true,
-	"vegas": true,
-	"veges": true,
-	"vegie": true,
-	"vegos": true,
-	"vehme": true,
-	"veils": true,
-	"veily": true,
-	"veins": true,
-	"veiny": true,
-	"velar": true,
-	"velds": true,
-	"veldt": true,
-	"veles": true,
-	"vells": true,
-	"velum": true,
-	"venae": true,
-	"venal": true,
-	"vends": true,
-	"vendu": true,
-	"veney": true,
-	"venge": true,
-	"venin": true,
-	"venom": true,
-	"vents": true,
-	"venue": true,
-	"venus": true,
-	"verbs": true,
-	"verge": true,
-	"verra": true,
-	"verry": true,
-	"verse": true,
-	"verso": true,
-	"verst": true,
-	"verts": true,
-	"vertu": true,
-	"verve": true,
-	"vespa": true,
-	"vesta": true,
-	"vests": true,
-	"vetch": true,
-	"vexed": true,
-	"vexer": true,
-	"vexes": true,
-	"vexil": true,
-	"vezir": true,
-	"vials": true,
-	"viand": true,
-	"vibes": true,
-	"vibex": true,
-	"vibey": true,
-	"vicar": true,
-	"viced": true,
-	"vices": true,
-	"vichy": true,
-	"video": true,
-	"viers": true,
-	"views": true,
-	"viewy": true,
-	"vifda": true,
-	"viffs": true,
-	"vigas": true,
-	"vigia": true,
-	"vigil": true,
-	"vigor": true,
-	"vilde": true,
-	"viler": true,
-	"villa": true,
-	"villi": true,
-	"vills": true,
-	"vimen": true,
-	"vinal": true,
-	"vinas": true,
-	"vinca": true,
-	"vined": true,
-	"viner": true,
-	"vines": true,
-	"vinew": true,
-	"vinic": true,
-	"vinos": true,
-	"vints": true,
-	"vinyl": true,
-	"viola": true,
-	"viold": true,
-	"viols": true,
-	"viper": true,
-	"viral": true,
-	"vired": true,
-	"vireo": true,
-	"vires": true,
-	"virga": true,
-	"virge": true,
-	"virid": true,
-	"virls": true,
-	"virtu": true,
-	"virus": true,
-	"visas": true,
-	"vised": true,
-	"vises": true,
-	"visie": true,
-	"visit": true,
-	"visne": true,
-	"vison": true,
-	"visor": true,
-	"vista": true,
-	"visto": true,
-	"vitae": true,
-	"vital": true,
-	"vitas": true,
-	"vitex": true,
-	"vitro": true,
-	"vitta": true,
-	"vivas": true,
-	"vivat": true,
-	"vivda": true,
-	"viver": true,
-	"vives": true,
-	"vivid": true,
-	"vixen": true,
-	"vizir": true,
-	"vizor": true,
-	"vleis": true,
-	"vlies": true,
-	"vlogs": true,
-	"voars": true,
-	"vocab": true,
-	"vocal": true,
-	"voces": true,
-	"voddy": true,
-	"vodka": true,
-	"vodou": true,
-	"vodun": true,
-	"voema": true,
-	"vogie": true,
-	"vogue": true,
-	"voice": true,
-	"voids": true,
-	"voila": true,
-	"voile": true,
-	"voips": true,
-	"volae": true,
-	"volar": true,
-	"voled": true,
-	"voles": true,
-	"volet": true,
-	"volks": true,
-	"volta": true,
-	"volte": true,
-	"volti": true,
-	"volts": true,
-	"volva": true,
-	"volve": true,
-	"vomer": true,
-	"vomit": true,
-	"voted": true,
-	"voter": true,
-	"votes": true,
-	"vouch": true,
-	"vouge": true,
-	"voulu": true,
-	"vowed": true,
-	"vowel": true,
-	"vower": true,
-	"voxel": true,
-	"vozhd": true,
-	"vraic": true,
-	"vrils": true,
-	"vroom": true,
-	"vrous": true,
-	"vrouw": true,
-	"vrows": true,
-	"vuggs": true,
-	"vuggy": true,
-	"vughs": true,
-	"vughy": true,
-	"vulgo": true,
-	"vulns": true,
-	"vulva": true,
-	"vutty": true,
-	"vying": true,
-	"waacs": true,
-	"wacke": true,
-	"wacko": true,
-	"wacks": true,
-	"wacky": true,
-	"wadds": true,
-	"waddy": true,
-	"waded": true,
-	"wader": true,
-	"wades": true,
-	"wadge": true,
-	"wadis": true,
-	"wadts": true,
-	"wafer": true,
-	"waffs": true,
-	"wafts": true,
-	"waged": true,
-	"wager": true,
-	"wages": true,
-	"wagga": true,
-	"wagon": true,
-	"wagyu": true,
-	"wahoo": true,
-	"waide": true,
-	"waifs": true,
-	"waift": true,
-	"wails": true,
-	"wains": true,
-	"wairs": true,
-	"waist": true,
-	"waite": true,
-	"waits": true,
-	"waive": true,
-	"wakas": true,
-	"waked": true,
-	"waken": true,
-	"waker": true,
-	"wakes": true,
-	"wakfs": true,
-	"waldo": true,
-	"walds": true,
-	"waled": true,
-	"waler": true,
-	"wales": true,
-	"walie": true,
-	"walis": true,
-	"walks": true,
-	"walla": true,
-	"walls": true,
-	"wally": true,
-	"walty": true,
-	"waltz": true,
-	"wamed": true,
-	"wames": true,
-	"wamus": true,
-	"wands": true,
-	"waned": true,
-	"wanes": true,
-	"waney": true,
-	"wangs": true,
-	"wanks": true,
-	"wanky": true,
-	"wanle": true,
-	"wanly": true,
-	"wanna": true,
-	"wants": true,
-	"wanty": true,
-	"wanze": true,
-	"waqfs": true,
-	"warbs": true,
-	"warby": true,
-	"wards": true,
-	"wared": true,
-	"wares": true,
-	"warez": true,
-	"warks": true,
-	"warms": true,
-	"warns": true,
-	"warps": true,
-	"warre": true,
-	"warst": true,
-	"warts": true,
-	"warty": true,
-	"wases": true,
-	"washy": true,
-	"wasms": true,
-	"wasps": true,
-	"waspy": true,
-	"waste": true,
-	"wasts": true,
-	"watap": true,
-	"watch": true,
-	"water": true,
-	"watts": true,
-	"wauff": true,
-	"waugh": true,
-	"wauks": true,
-	"waulk": true,
-	"wauls": true,
-	"waurs": true,
-	"waved": true,
-	"waver": true,
-	"waves": true,
-	"wavey": true,
-	"wawas": true,
-	"wawes": true,
-	"wawls": true,
-	"waxed": true,
-	"waxen": true,
-	"waxer": true,
-	"waxes": true,
-	"wayed": true,
-	"wazir": true,
-	"wazoo": true,
-	"weald": true,
-	"weals": true,
-	"weamb": true,
-	"weans": true,
-	"wears": true,
-	"weary": true,
-	"weave": true,
-	"webby": true,
-	"weber": true,
-	"wecht": true,
-	"wedel": true,
-	"wedge": true,
-	"wedgy": true,
-	"weeds": true,
-	"weedy": true,
-	"weeke": true,
-	"weeks": true,
-	"weels": true,
-	"weems": true,
-	"weens": true,
-	"weeny": true,
-	"weeps": true,
-	"weepy": true,
-	"weest": true,
-	"weete": true,
-	"weets": true,
-	"wefte": true,
-	"wefts": true,
-	"weids": true,
-	"weigh": true,
-	"weils": true,
-	"weird": true,
-	"weirs": true,
-	"weise": true,
-	"weize": true,
-	"wekas": true,
-	"welch": true,
-	"welds": true,
-	"welke": true,
-	"welks": true,
-	"welkt": true,
-	"wells": true,
-	"welly": true,
-	"welsh": true,
-	"welts": true,
-	"wembs": true,
-	"wench": true,
-	"wends": true,
-	"wenge": true,
-	"wenny": true,
-	"wents": true,
-	"weros": true,
-	"wersh": true,
-	"wests": true,
-	"wetas": true,
-	"wetly": true,
-	"wexed": true,
-	"wexes": true,
-	"whack": true,
-	"whale": true,
-	"whamo": true,
-	"whams": true,
-	"whang": true,
-	"whaps": true,
-	"whare": true,
-	"wharf": true,
-	"whata": true,
-	"whats": true,
-	"whaup": true,
-	"whaur": true,
-	"wheal": true,
-	"whear": true,
-	"wheat": true,
-	"wheel": true,
-	"wheen": true,
-	"wheep": true,
-	"wheft": true,
-	"whelk": true,
-	"whelm": true,
-	"whelp": true,
-	"whens": true,
-	"where": true,
-	"whets": true,
-	"whews": true,
-	"wheys": true,
-	"which": true,
-	"whids": true,
-	"whiff": true,
-	"whift": true,
-	"whigs": true,
-	"while": true,
-	"whilk": true,
-	"whims": true,
-	"whine": true,
-	"whins": true,
-	"whiny": true,
-	"whios": true,
-	"whips": true,
-	"whipt": true,
-	"whirl": true,
-	"whirr": true,
-	"whirs": true,
-	"whish": true,
-	"whisk": true,
-	"whiss": true,
-	"whist": true,
-	"white": true,
-	"whits": true,
-	"whity": true,
-	"whizz": true,
-	"whole": true,
-	"whomp": true,
-	"whoof": true,
-	"whoop": true,
-	"whoot": true,
-	"whops": true,
-	"whore": true,
-	"whorl": true,
-	"whort": true,
-	"whose": true,
-	"whoso": true,
-	"whows": true,
-	"whump": true,
-	"whups": true,
-	"whyda": true,
-	"wicca": true,
-	"wicks": true,
-	"wicky": true,
-	"widdy": true,
-	"widen": true,
-	"wider": true,
-	"wides": true,
-	"widow": true,
-	"width": true,
-	"wield": true,
-	"wiels": true,
-	"wifed": true,
-	"wifes": true,
-	"wifey": true,
-	"wifie": true,
-	"wifty": true,
-	"wigan": true,
-	"wigga": true,
-	"wiggy": true,
-	"wight": true,
-	"wikis": true,
-	"wilco": true,
-	"wilds": true,
-	"wiled": true,
-	"wiles": true,
-	"wilga": true,
-	"wilis": true,
-	"wilja": true,
-	"wills": true,
-	"willy": true,
-	"wilts": true,
-	"wimps": true,
-	"wimpy": true,
-	"wince": true,
-	"winch": true,
-	"winds": true,
-	"windy": true,
-	"wined": true,
-	"wines": true,
-	"winey": true,
-	"winge": true,
-	"wings": true,
-	"wingy": true,
-	"winks": true,
-	"winna": true,
-	"winns": true,
-	"winos": true,
-	"winze": true,
-	"wiped": true,
-	"wiper": true,
-	"wipes": true,
-	"wired": true,
-	"wirer": true,
-	"wires": true,
-	"wirra": true,
-	"wised": true,
-	"wiser": true,
-	"wises": true,
-	"wisha": true,
-	"wisht": true,
-	"wisps": true,
-	"wispy": true,
-	"wists": true,
-	"witan": true,
-	"witch": true,
-	"wited": true,
-	"wites": true,
-	"withe": true,
-	"withs": true,
-	"withy": true,
-	"witty": true,
-	"wived": true,
-	"wiver": true,
-	"wives": true,
-	"wizen": true,
-	"wizes": true,
-	"woads": true,
-	"woald": true,
-	"wocks": true,
-	"wodge": true,
-	"woful": true,
-	"wojus": true,
-	"woken": true,
-	"woker": true,
-	"wokka": true,
-	"wolds": true,
-	"wolfs": true,
-	"wolly": true,
-	"wolve": true,
-	"woman": true,
-	"wombs": true,
-	"womby": true,
-	"women": true,
-	"womyn": true,
-	"wonga": true,
-	"wongi": true,
-	"wonks": true,
-	"wonky": true,
-	"wonts": true,
-	"woods": true,
-	"woody": true,
-	"wooed": true,
-	"wooer": true,
-	"woofs": true,
-	"woofy": true,
-	"woold": true,
-	"wools": true,
-	"wooly": true,
-	"woons": true,
-	"woops": true,
-	"woopy": true,
-	"woose": true,
-	"woosh": true,
-	"wootz": true,
-	"woozy": true,
-	"words": true,
-	"wordy": true,
-	"works": true,
-	"world": true,
-	"worms": true,
-	"wormy": true,
-	"worry": true,
-	"worse": true,
-	"worst": true,
-	"worth": true,
-	"worts": true,
-	"would": true,
-	"wound": true,
-	"woven": true,
-	"wowed": true,
-	"wowee": true,
-	"woxen": true,
-	"wrack": true,
-	"wrang": true,
-	"wraps": true,
-	"wrapt": true,
-	"wrast": true,
-	"wrate": true,
-	"wrath": true,
-	"wrawl": true,
-	"wreak": true,
-	"wreck": true,
-	"wrens": true,
-	"wrest": true,
-	"wrick": true,
-	"wried": true,
-	"wrier": true,
-	"wries": true,
-	"wring": true,
-	"wrist": true,
-	"write": true,
-	"writs": true,
-	"wroke": true,
-	"wrong": true,
-	"wroot": true,
-	"wrote": true,
-	"wroth": true,
-	"wrung": true,
-	"wryer": true,
-	"wryly": true,
-	"wuddy": true,
-	"wudus": true,
-	"wulls": true,
-	"wurst": true,
-	"wuses": true,
-	"wushu": true,
-	"wussy": true,
-	"wuxia": true,
-	"wyled": true,
-	"wyles": true,
-	"wynds": true,
-	"wynns": true,
-	"wyted": true,
-	"wytes": true,
-	"xebec": true,
-	"xenia": true,
-	"xenic": true,
-	"xenon": true,
-	"xeric": true,
-	"xerox": true,
-	"xerus": true,
-	"xoana": true,
-	"xrays": true,
-	"xylan": true,
-	"xylem": true,
-	"xylic": true,
-	"xylol": true,
-	"xylyl": true,
-	"xysti": true,
-	"xysts": true,
-	"yaars": true,
-	"yabas": true,
-	"yabba": true,
-	"yabby": true,
-	"yacca": true,
-	"yacht": true,
-	"yacka": true,
-	"yacks": true,
-	"yaffs": true,
-	"yager": true,
-	"yages": true,
-	"yagis": true,
-	"yahoo": true,
-	"yaird": true,
-	"yakka": true,
-	"yakow": true,
-	"yales": true,
-	"yamen": true,
-	"yampy": true,
-	"yamun": true,
-	"yangs": true,
-	"yanks": true,
-	"yapok": true,
-	"yapon": true,
-	"yapps": true,
-	"yappy": true,
-	"yarak": true,
-	"yarco": true,
-	"yards": true,
-	"yarer": true,
-	"yarfa": true,
-	"yarks": true,
-	"yarns": true,
-	"yarrs": true,
-	"yarta": true,
-	"yarto": true,
-	"yates": true,
-	"yauds": true,
-	"yauld": true,
-	"yaups": true,
-	"yawed": true,
-	"yawey": true,
-	"yawls": true,
-	"yawns": true,
-	"yawny": true,
-	"yawps": true,
-	"ybore": true,
-	"yclad": true,
-	"ycled": true,
-	"ycond": true,
-	"ydrad": true,
-	"ydred": true,
-	"yeads": true,
-	"yeahs": true,
-	"yealm": true,
-	"yeans": true,
-	"yeard": true,
-	"yearn": true,
-	"years": true,
-	"yeast": true,
-	"yecch": true,
-	"yechs": true,
-	"yechy": true,
-	"yedes": true,
-	"yeeds": true,
-	"yeesh": true,
-	"yeggs": true,
-	"yelks": true,
-	"yells": true,
-	"yelms": true,
-	"yelps": true,
-	"yelts": true,
-	"yenta": true,
-	"yente": true,
-	"yerba": true,
-	"yerds": true,
-	"yerks": true,
-	"yeses": true,
-	"yesks": true,
-	"yests": true,
-	"yesty": true,
-	"yetis": true,
-	"yetts": true,
-	"yeuks": true,
-	"yeuky": true,
-	"yeven": true,
-	"yeves": true,
-	"yewen": true,
-	"yexed": true,
-	"yexes": true,
-	"yfere": true,
-	"yield": true,
-	"yiked": true,
-	"yikes": true,
-	"yills": true,
-	"yince": true,
-	"yipes": true,
-	"yippy": true,
-	"yirds": true,
-	"yirks": true,
-	"yirrs": true,
-	"yirth": true,
-	"yites": true,
-	"yitie": true,
-	"ylems": true,
-	"ylike": true,
-	"ylkes": true,
-	"ymolt": true,
-	"ympes": true,
-	"yobbo": true,
-	"yobby": true,
-	"yocks": true,
-	"yodel": true,
-	"yodhs": true,
-	"yodle": true,
-	"yogas": true,
-	"yogee": true,
-	"yoghs": true,
-	"yogic": true,
-	"yogin": true,
-	"yogis": true,
-	"yoick": true,
-	"yojan": true,
-	"yoked": true,
-	"yokel": true,
-	"yoker": true,
-	"yokes": true,
-	"yokul": true,
-	"yolks": true,
-	"yolky": true,
-	"yomim": true,
-	"yomps": true,
-	"yonic": true,
-	"yonis": true,
-	"yonks": true,
-	"yoofs": true,
-	"yoops": true,
-	"yores": true,
-	"yorks": true,
-	"yorps": true,
-	"youks": true,
-	"young": true,
-	"yourn": true,
-	"yours": true,
-	"yourt": true,
-	"youse": true,
-	"youth": true,
-	"yowed": true,
-	"yowes": true,
-	"yowie": true,
-	"yowls": true,
-	"yowza": true,
-	"yrapt": true,
-	"yrent": true,
-	"yrivd": true,
-	"yrneh": true,
-	"ysame": true,
-	"ytost": true,
-	"yuans": true,
-	"yucas": true,
-	"yucca": true,
-	"yucch": true,
-	"yucko": true,
-	"yucks": true,
-	"yucky": true,
-	"yufts": true,
-	"yugas": true,
-	"yuked": true,
-	"yukes": true,
-	"yukky": true,
-	"yukos": true,
-	"yulan": true,
-	"yules": true,
-	"yummo": true,
-	"yummy": true,
-	"yumps": true,
-	"yupon": true,
-	"yuppy": true,
-	"yurta": true,
-	"yurts": true,
-	"yuzus": true,
-	"zabra": true,
-	"zacks": true,
-	"zaida": true,
-	"zaidy": true,
-	"zaire": true,
-	"zakat": true,
-	"zaman": true,
-	"zambo": true,
-	"zamia": true,
-	"zanja": true,
-	"zante": true,
-	"zanza": true,
-	"zanze": true,
-	"zappy": true,
-	"zarfs": true,
-	"zaris": true,
-	"zatis": true,
-	"zaxes": true,
-	"zayin": true,
-	"zazen": true,
-	"zeals": true,
-	"zebec": true,
-	"zebra": true,
-	"zebub": true,
-	"zebus": true,
-	"zedas": true,
-	"zeins": true,
-	"zendo": true,
-	"zerda": true,
-	"zerks": true,
-	"zeros": true,
-	"zests": true,
-	"zesty": true,
-	"zetas": true,
-	"zexes": true,
-	"zezes": true,
-	"zhomo": true,
-	"zibet": true,
-	"ziffs": true,
-	"zigan": true,
-	"zilas": true,
-	"zilch": true,
-	"zilla": true,
-	"zills": true,
-	"zimbi": true,
-	"zimbs": true,
-	"zinco": true,
-	"zincs": true,
-	"zincy": true,
-	"zineb": true,
-	"zines": true,
-	"zings": true,
-	"zingy": true,
-	"zinke": true,
-	"zinky": true,
-	"zippo": true,
-	"zippy": true,
-	"ziram": true,
-	"zitis": true,
-	"zizel": true,
-	"zizit": true,
-	"zlote": true,
-	"zloty": true,
-	"zoaea": true,
-	"zobos": true,
-	"zobus": true,
-	"zocco": true,
-	"zoeae": true,
-	"zoeal": true,
-	"zoeas": true,
-	"zoism": true,
-	"zoist": true,
-	"zombi": true,
-	"zonae": true,
-	"zonal": true,
-	"zonda": true,
-	"zoned": true,
-	"zoner": true,
-	"zones": true,
-	"zonks": true,
-	"zooea": true,
-	"zooey": true,
-	"zooid": true,
-	"zooks": true,
-	"zooms": true,
-	"zoons": true,
-	"zooty": true,
-	"zoppa": true,
-	"zoppo": true,
-	"zoril": true,
-	"zoris": true,
-	"zorro": true,
-	"zouks": true,
-	"zowee": true,
-	"zowie": true,
-	"zulus": true,
-	"zupan": true,
-	"zupas": true,
-	"zuppa": true,
-	"zurfs": true,
-	"zuzim": true,
-	"zygal": true,
-	"zygon": true,
-	"zymes": true,
-	"zymic": true,
+// IsValid returns true if the word may be guessed.
+func (d *Dictionary) IsValid(word string) bool {
+	return d.lists[utf8.RuneCountInString(word)].allowed[word]
 }
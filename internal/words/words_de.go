@@ -0,0 +1,4207 @@
+package words
+
+// German answers of four to eight letters: common words in their usual form.
+var germanAnswers = [...]string{
+	"abend",
+	"acker",
+	"adler",
+	"affe",
+	"alarm",
+	"alter",
+	"ampel",
+	"angel",
+	"angst",
+	"apfel",
+	"arbeit",
+	"armee",
+	"asche",
+	"atlas",
+	"atmen",
+	"auto",
+	"backen",
+	"baden",
+	"bahn",
+	"bald",
+	"ball",
+	"band",
+	"bank",
+	"bart",
+	"bauch",
+	"bauen",
+	"bauer",
+	"baum",
+	"beere",
+	"bein",
+	"berg",
+	"besen",
+	"beten",
+	"bett",
+	"biegen",
+	"biene",
+	"bier",
+	"bieten",
+	"bild",
+	"binden",
+	"birne",
+	"bitte",
+	"bitten",
+	"bitter",
+	"blasen",
+	"blatt",
+	"blau",
+	"bleiben",
+	"blick",
+	"blind",
+	"blitz",
+	"blume",
+	"bluse",
+	"boden",
+	"bogen",
+	"bohne",
+	"boot",
+	"brand",
+	"braten",
+	"braun",
+	"brechen",
+	"breit",
+	"brennen",
+	"brief",
+	"brille",
+	"bringen",
+	"brot",
+	"bruder",
+	"brust",
+	"buch",
+	"bund",
+	"bunt",
+	"butter",
+	"böse",
+	"bühne",
+	"bürger",
+	"creme",
+	"dach",
+	"dame",
+	"dampf",
+	"dank",
+	"danken",
+	"decke",
+	"decken",
+	"degen",
+	"denken",
+	"dick",
+	"dienen",
+	"dienst",
+	"ding",
+	"donner",
+	"dorf",
+	"dose",
+	"drache",
+	"draht",
+	"dreck",
+	"druck",
+	"duft",
+	"dumm",
+	"dunkel",
+	"dunst",
+	"durst",
+	"dünn",
+	"dürfen",
+	"ebene",
+	"echt",
+	"ecke",
+	"edel",
+	"eiche",
+	"eilen",
+	"eimer",
+	"eisen",
+	"elend",
+	"ende",
+	"engel",
+	"erde",
+	"ernst",
+	"ernte",
+	"esel",
+	"essen",
+	"essig",
+	"eule",
+	"euro",
+	"fabel",
+	"fach",
+	"faden",
+	"fahne",
+	"fahren",
+	"fair",
+	"fall",
+	"falle",
+	"fallen",
+	"falsch",
+	"fangen",
+	"farbe",
+	"fass",
+	"fassen",
+	"faul",
+	"feder",
+	"fegen",
+	"fehlen",
+	"fehler",
+	"feier",
+	"feiern",
+	"fein",
+	"feind",
+	"feld",
+	"fenster",
+	"ferien",
+	"fern",
+	"fest",
+	"fett",
+	"feucht",
+	"feuer",
+	"fieber",
+	"figur",
+	"film",
+	"finden",
+	"finger",
+	"flach",
+	"fleck",
+	"fleiss",
+	"fliegen",
+	"fliehen",
+	"fliessen",
+	"flink",
+	"flucht",
+	"flug",
+	"flur",
+	"fluss",
+	"flöte",
+	"folge",
+	"folgen",
+	"form",
+	"frage",
+	"fragen",
+	"frau",
+	"frei",
+	"fremd",
+	"fressen",
+	"freude",
+	"friede",
+	"frieren",
+	"frisch",
+	"froh",
+	"frost",
+	"frucht",
+	"früh",
+	"fuchs",
+	"futter",
+	"fühlen",
+	"führen",
+	"füllen",
+	"gabel",
+	"gans",
+	"ganz",
+	"garten",
+	"gasse",
+	"gast",
+	"geben",
+	"gehen",
+	"geist",
+	"gelb",
+	"geld",
+	"gelten",
+	"genau",
+	"gerade",
+	"gern",
+	"gewalt",
+	"giessen",
+	"gift",
+	"glanz",
+	"glas",
+	"glatt",
+	"glauben",
+	"gleich",
+	"glück",
+	"gnade",
+	"gold",
+	"gott",
+	"grab",
+	"graben",
+	"gras",
+	"grau",
+	"greifen",
+	"grenze",
+	"griff",
+	"grob",
+	"gross",
+	"grube",
+	"gruppe",
+	"gruss",
+	"grösse",
+	"grün",
+	"grüssen",
+	"gunst",
+	"gurt",
+	"gürtel",
+	"haben",
+	"hafen",
+	"hafer",
+	"hagel",
+	"hahn",
+	"halb",
+	"halle",
+	"hals",
+	"halten",
+	"hammer",
+	"hand",
+	"handy",
+	"harfe",
+	"hart",
+	"hase",
+	"hassen",
+	"haufen",
+	"haus",
+	"haut",
+	"hebel",
+	"heben",
+	"hecke",
+	"heft",
+	"heide",
+	"heilen",
+	"heimat",
+	"heiss",
+	"heissen",
+	"held",
+	"helfen",
+	"hell",
+	"hemd",
+	"herz",
+	"heute",
+	"hilfe",
+	"himmel",
+	"hitze",
+	"hobel",
+	"hoch",
+	"hoffen",
+	"hohl",
+	"holen",
+	"honig",
+	"horn",
+	"hose",
+	"hotel",
+	"hund",
+	"hunger",
+	"hängen",
+	"hölle",
+	"hören",
+	"hübsch",
+	"hügel",
+	"hüpfen",
+	"idee",
+	"igel",
+	"insel",
+	"jacke",
+	"jagd",
+	"jagen",
+	"jahr",
+	"jubel",
+	"jugend",
+	"jung",
+	"jäger",
+	"kabel",
+	"kaffee",
+	"kahl",
+	"kaiser",
+	"kalt",
+	"kamel",
+	"kamm",
+	"kampf",
+	"kanal",
+	"kante",
+	"kappe",
+	"karte",
+	"kasse",
+	"katze",
+	"kaufen",
+	"kaum",
+	"kehle",
+	"keller",
+	"kennen",
+	"kerze",
+	"kessel",
+	"kette",
+	"kiefer",
+	"kind",
+	"kinn",
+	"kino",
+	"kirche",
+	"kissen",
+	"kiste",
+	"klage",
+	"klagen",
+	"klang",
+	"klar",
+	"klasse",
+	"kleben",
+	"kleid",
+	"klein",
+	"klettern",
+	"klima",
+	"klingen",
+	"klopfen",
+	"klug",
+	"knabe",
+	"knapp",
+	"knecht",
+	"knie",
+	"knopf",
+	"koch",
+	"kochen",
+	"koffer",
+	"kohl",
+	"kohle",
+	"kommen",
+	"kopf",
+	"korb",
+	"kosten",
+	"kraft",
+	"kragen",
+	"krank",
+	"kranz",
+	"kreis",
+	"kreuz",
+	"kriechen",
+	"krieg",
+	"krone",
+	"krug",
+	"krumm",
+	"kuchen",
+	"kugel",
+	"kunde",
+	"kunst",
+	"kurve",
+	"kurz",
+	"kuss",
+	"käfer",
+	"kälte",
+	"kämpfen",
+	"können",
+	"küche",
+	"kühl",
+	"küste",
+	"lache",
+	"lachen",
+	"laden",
+	"lager",
+	"lahm",
+	"lamm",
+	"lampe",
+	"land",
+	"lang",
+	"lanze",
+	"lassen",
+	"last",
+	"laub",
+	"lauf",
+	"laufen",
+	"laut",
+	"leben",
+	"lecker",
+	"leder",
+	"leer",
+	"legen",
+	"lehm",
+	"lehre",
+	"lehren",
+	"leiche",
+	"leicht",
+	"leiden",
+	"leihen",
+	"leim",
+	"leine",
+	"leise",
+	"lerche",
+	"lernen",
+	"lesen",
+	"leute",
+	"licht",
+	"liebe",
+	"lieben",
+	"lied",
+	"liegen",
+	"linie",
+	"link",
+	"linse",
+	"lippe",
+	"liste",
+	"loben",
+	"loch",
+	"locker",
+	"lohn",
+	"luchs",
+	"luft",
+	"lust",
+	"lärm",
+	"löffel",
+	"lösen",
+	"löwe",
+	"lücke",
+	"lügen",
+	"machen",
+	"macht",
+	"magen",
+	"mager",
+	"mahl",
+	"mais",
+	"malen",
+	"mann",
+	"mantel",
+	"mark",
+	"markt",
+	"marmor",
+	"matt",
+	"mauer",
+	"maul",
+	"maus",
+	"meer",
+	"mehl",
+	"meile",
+	"meinen",
+	"meise",
+	"melden",
+	"menge",
+	"messe",
+	"messen",
+	"metall",
+	"miene",
+	"milch",
+	"mild",
+	"minute",
+	"mitte",
+	"mittel",
+	"monat",
+	"mond",
+	"moos",
+	"mord",
+	"morgen",
+	"motor",
+	"mulde",
+	"mund",
+	"munter",
+	"muse",
+	"musik",
+	"mutter",
+	"möbel",
+	"mögen",
+	"möwe",
+	"müde",
+	"mühle",
+	"münze",
+	"müssen",
+	"nabel",
+	"nacht",
+	"nackt",
+	"nadel",
+	"nagel",
+	"name",
+	"narbe",
+	"narr",
+	"nase",
+	"nass",
+	"natur",
+	"nebel",
+	"neffe",
+	"nehmen",
+	"nennen",
+	"nest",
+	"nett",
+	"netz",
+	"nichte",
+	"nicken",
+	"noch",
+	"nord",
+	"norden",
+	"notiz",
+	"nudel",
+	"nummer",
+	"nutzen",
+	"nähe",
+	"nähen",
+	"oben",
+	"obst",
+	"ochse",
+	"ofen",
+	"offen",
+	"ohne",
+	"onkel",
+	"opfer",
+	"orden",
+	"ordnung",
+	"osten",
+	"packen",
+	"paket",
+	"palme",
+	"papa",
+	"papier",
+	"park",
+	"party",
+	"pass",
+	"pause",
+	"pech",
+	"perle",
+	"pfad",
+	"pfahl",
+	"pfanne",
+	"pfeife",
+	"pfeil",
+	"pferd",
+	"pflanze",
+	"pflegen",
+	"pflicht",
+	"pfund",
+	"pilz",
+	"pinsel",
+	"pirat",
+	"plan",
+	"planen",
+	"platz",
+	"pracht",
+	"preis",
+	"probe",
+	"puls",
+	"puppe",
+	"putzen",
+	"quelle",
+	"rabe",
+	"rache",
+	"radio",
+	"rahmen",
+	"rand",
+	"rang",
+	"rasch",
+	"rasen",
+	"rast",
+	"raten",
+	"ratte",
+	"rauben",
+	"rauchen",
+	"raum",
+	"raupe",
+	"rebe",
+	"rechnen",
+	"recht",
+	"reden",
+	"regal",
+	"regel",
+	"regen",
+	"regnen",
+	"reiben",
+	"reich",
+	"reif",
+	"reihe",
+	"rein",
+	"reise",
+	"reisen",
+	"reiten",
+	"rennen",
+	"rest",
+	"retten",
+	"riechen",
+	"rinde",
+	"ring",
+	"ringen",
+	"ritter",
+	"rock",
+	"rohr",
+	"rollen",
+	"rose",
+	"rudel",
+	"ruder",
+	"rudern",
+	"rufen",
+	"ruhe",
+	"ruhm",
+	"rund",
+	"rätsel",
+	"rücken",
+	"rühren",
+	"saal",
+	"sache",
+	"sack",
+	"saft",
+	"sagen",
+	"sahne",
+	"saite",
+	"salat",
+	"salz",
+	"samen",
+	"sand",
+	"sanft",
+	"satt",
+	"sattel",
+	"satz",
+	"sauber",
+	"sauer",
+	"saugen",
+	"schaf",
+	"schaffen",
+	"schale",
+	"scharf",
+	"schatz",
+	"schauen",
+	"scheinen",
+	"schere",
+	"schieben",
+	"schief",
+	"schiff",
+	"schild",
+	"schilf",
+	"schlaf",
+	"schlafen",
+	"schlagen",
+	"schlank",
+	"schlau",
+	"schlecht",
+	"schloss",
+	"schmal",
+	"schmerz",
+	"schnee",
+	"schnell",
+	"schrank",
+	"schreien",
+	"schräg",
+	"schuh",
+	"schuld",
+	"schule",
+	"schwach",
+	"schwan",
+	"schwarz",
+	"schwer",
+	"schön",
+	"segel",
+	"segeln",
+	"sehen",
+	"seife",
+	"seil",
+	"seite",
+	"sekunde",
+	"senden",
+	"senf",
+	"sessel",
+	"setzen",
+	"sicher",
+	"sicht",
+	"sieb",
+	"silber",
+	"singen",
+	"sinken",
+	"sitte",
+	"sitzen",
+	"socke",
+	"sohn",
+	"sollen",
+	"sommer",
+	"sonne",
+	"sorge",
+	"sorgen",
+	"spange",
+	"sparen",
+	"spass",
+	"spiegel",
+	"spiel",
+	"spielen",
+	"spinne",
+	"spitze",
+	"sport",
+	"sprache",
+	"sprechen",
+	"springen",
+	"spur",
+	"spät",
+	"staat",
+	"stab",
+	"stadt",
+	"stall",
+	"stamm",
+	"stark",
+	"staub",
+	"stechen",
+	"stecken",
+	"stehen",
+	"stehlen",
+	"steigen",
+	"steil",
+	"stein",
+	"stelle",
+	"stellen",
+	"sterben",
+	"stern",
+	"still",
+	"stimme",
+	"stirn",
+	"stock",
+	"stoff",
+	"stolz",
+	"stossen",
+	"strand",
+	"strasse",
+	"strauch",
+	"streit",
+	"streiten",
+	"stroh",
+	"strom",
+	"stufe",
+	"stuhl",
+	"stumm",
+	"stunde",
+	"sturm",
+	"stück",
+	"suchen",
+	"suppe",
+	"säge",
+	"süden",
+	"süss",
+	"tafel",
+	"tante",
+	"tanz",
+	"tanzen",
+	"tapfer",
+	"tasche",
+	"tasse",
+	"taube",
+	"tauchen",
+	"teig",
+	"teil",
+	"teilen",
+	"teuer",
+	"tief",
+	"tier",
+	"tinte",
+	"tisch",
+	"tochter",
+	"toll",
+	"topf",
+	"tragen",
+	"traum",
+	"treffen",
+	"treiben",
+	"treten",
+	"treu",
+	"treue",
+	"trinken",
+	"trocken",
+	"trommel",
+	"tropfen",
+	"träumen",
+	"trüb",
+	"tuch",
+	"tugend",
+	"tulpe",
+	"turm",
+	"töten",
+	"ufer",
+	"unfall",
+	"urlaub",
+	"vase",
+	"vater",
+	"verein",
+	"vers",
+	"vetter",
+	"vieh",
+	"villa",
+	"vogel",
+	"voll",
+	"vorhang",
+	"waage",
+	"wach",
+	"wachsen",
+	"waffe",
+	"wagen",
+	"wahl",
+	"wahr",
+	"wald",
+	"wand",
+	"wange",
+	"wann",
+	"ware",
+	"warm",
+	"warten",
+	"waschen",
+	"wasser",
+	"watte",
+	"weben",
+	"wecken",
+	"weich",
+	"weide",
+	"weile",
+	"wein",
+	"weinen",
+	"weise",
+	"weiss",
+	"weit",
+	"welle",
+	"welt",
+	"werden",
+	"werfen",
+	"wert",
+	"wespe",
+	"wetter",
+	"wiegen",
+	"wiese",
+	"wild",
+	"wille",
+	"wind",
+	"winken",
+	"winter",
+	"wissen",
+	"wohl",
+	"wohnen",
+	"wolf",
+	"wolke",
+	"wolle",
+	"wollen",
+	"wort",
+	"wunde",
+	"wunsch",
+	"wurm",
+	"wurst",
+	"wählen",
+	"wärme",
+	"wünschen",
+	"würde",
+	"wüste",
+	"zahl",
+	"zahlen",
+	"zahm",
+	"zahn",
+	"zange",
+	"zart",
+	"zaun",
+	"zeigen",
+	"zeile",
+	"zeit",
+	"zeitung",
+	"zelt",
+	"zettel",
+	"ziege",
+	"ziegel",
+	"ziehen",
+	"ziel",
+	"zielen",
+	"zimmer",
+	"zirkus",
+	"zoll",
+	"zopf",
+	"zorn",
+	"zucker",
+	"zukunft",
+	"zunge",
+	"zweck",
+	"zweig",
+	"zwerg",
+	"zählen",
+	"ärger",
+	"öffnen",
+	"übel",
+	"üben",
+	"übung",
+}
+
+// Other German words that may be guessed: plurals, verb forms and the
+// endings of adjectives, and less common words.
+var germanAllowed = [...]string{
+	"aalen",
+	"abbau",
+	"abende",
+	"aber",
+	"abgas",
+	"abwehr",
+	"achse",
+	"acht",
+	"achte",
+	"achten",
+	"achtend",
+	"achtest",
+	"achtet",
+	"achtete",
+	"achteten",
+	"achtetet",
+	"adern",
+	"affen",
+	"agent",
+	"akten",
+	"aktie",
+	"album",
+	"alle",
+	"allem",
+	"allen",
+	"aller",
+	"alles",
+	"alpen",
+	"also",
+	"altar",
+	"alte",
+	"altem",
+	"alten",
+	"altes",
+	"ampeln",
+	"amsel",
+	"andere",
+	"anderen",
+	"anders",
+	"angeln",
+	"anker",
+	"anzug",
+	"april",
+	"arbeiten",
+	"arena",
+	"arme",
+	"armeen",
+	"armem",
+	"armen",
+	"armer",
+	"armes",
+	"armut",
+	"asien",
+	"assen",
+	"asyl",
+	"atme",
+	"atmend",
+	"atmest",
+	"atmet",
+	"atmete",
+	"atmeten",
+	"atmetest",
+	"atmetet",
+	"atome",
+	"auch",
+	"augen",
+	"august",
+	"autor",
+	"autos",
+	"backend",
+	"backt",
+	"bade",
+	"badend",
+	"badest",
+	"badet",
+	"badete",
+	"badeten",
+	"badetest",
+	"badetet",
+	"bagger",
+	"bahnen",
+	"bahre",
+	"balken",
+	"banane",
+	"bande",
+	"banken",
+	"barde",
+	"basis",
+	"baten",
+	"baue",
+	"bauend",
+	"bauern",
+	"baust",
+	"baut",
+	"baute",
+	"bauten",
+	"bautest",
+	"bautet",
+	"beeren",
+	"befahl",
+	"befehle",
+	"begann",
+	"beginne",
+	"beginnt",
+	"begonnen",
+	"beide",
+	"beiden",
+	"beine",
+	"beleg",
+	"bereits",
+	"berge",
+	"beruf",
+	"besser",
+	"bessere",
+	"besseren",
+	"besseres",
+	"beste",
+	"besten",
+	"beton",
+	"betten",
+	"beule",
+	"beute",
+	"bibel",
+	"biber",
+	"biege",
+	"biegend",
+	"biegt",
+	"bienen",
+	"biere",
+	"biest",
+	"biete",
+	"bietend",
+	"bietet",
+	"bilder",
+	"binde",
+	"bindend",
+	"birke",
+	"birnen",
+	"bisher",
+	"bison",
+	"bisse",
+	"bist",
+	"bittend",
+	"bittest",
+	"bittet",
+	"blase",
+	"blasend",
+	"blast",
+	"blech",
+	"bleibe",
+	"bleibend",
+	"bleibst",
+	"bleibt",
+	"blicke",
+	"blieb",
+	"blieben",
+	"blies",
+	"blinde",
+	"blindem",
+	"blinden",
+	"blinder",
+	"blindere",
+	"blindes",
+	"blitze",
+	"blumen",
+	"blusen",
+	"bläst",
+	"blätter",
+	"blüte",
+	"bohle",
+	"bohnen",
+	"bolzen",
+	"bombe",
+	"bonus",
+	"boote",
+	"borke",
+	"borte",
+	"boten",
+	"boxen",
+	"brach",
+	"brachte",
+	"brachten",
+	"brate",
+	"bratend",
+	"braune",
+	"braunem",
+	"braunen",
+	"brauner",
+	"braunere",
+	"braunes",
+	"braunste",
+	"breche",
+	"brechend",
+	"brecht",
+	"breite",
+	"breitem",
+	"breiten",
+	"breiter",
+	"breitere",
+	"breites",
+	"brennend",
+	"brennt",
+	"brett",
+	"brichst",
+	"bricht",
+	"briefe",
+	"briet",
+	"brillen",
+	"bringe",
+	"bringst",
+	"bringt",
+	"brise",
+	"brote",
+	"brände",
+	"brät",
+	"brüder",
+	"brühe",
+	"brüste",
+	"buche",
+	"bucht",
+	"buden",
+	"bulle",
+	"bunte",
+	"buntem",
+	"bunten",
+	"bunter",
+	"buntere",
+	"bunteren",
+	"bunteres",
+	"buntes",
+	"bunteste",
+	"busch",
+	"busen",
+	"bäche",
+	"bälle",
+	"bänder",
+	"bänke",
+	"bärte",
+	"bäuche",
+	"bäume",
+	"böden",
+	"bögen",
+	"bösee",
+	"böseem",
+	"böseen",
+	"böseer",
+	"bösees",
+	"bösem",
+	"bösen",
+	"böser",
+	"böses",
+	"bücher",
+	"bühnen",
+	"bünde",
+	"büste",
+	"chaos",
+	"chefs",
+	"chips",
+	"chöre",
+	"couch",
+	"dachte",
+	"dachten",
+	"dafür",
+	"daher",
+	"damen",
+	"damit",
+	"danach",
+	"danke",
+	"dankend",
+	"dankst",
+	"dankt",
+	"dankte",
+	"dankten",
+	"danktest",
+	"danktet",
+	"dann",
+	"darauf",
+	"darf",
+	"darfst",
+	"darum",
+	"daten",
+	"datum",
+	"daune",
+	"davon",
+	"dazu",
+	"deckend",
+	"deckst",
+	"deckt",
+	"deckte",
+	"deckten",
+	"decktest",
+	"decktet",
+	"deich",
+	"delle",
+	"denke",
+	"denkst",
+	"denkt",
+	"denn",
+	"depot",
+	"deren",
+	"dessen",
+	"dezember",
+	"dicht",
+	"dicke",
+	"dickem",
+	"dicken",
+	"dicker",
+	"dickere",
+	"dickeren",
+	"dickeres",
+	"dickes",
+	"dickste",
+	"dicksten",
+	"diebe",
+	"diene",
+	"dienend",
+	"dienstag",
+	"dienste",
+	"dient",
+	"diente",
+	"dienten",
+	"dientest",
+	"dientet",
+	"diese",
+	"diesem",
+	"diesen",
+	"dieser",
+	"dieses",
+	"dinge",
+	"doch",
+	"dolch",
+	"dort",
+	"dosen",
+	"dosis",
+	"drachen",
+	"drama",
+	"drang",
+	"draussen",
+	"drehe",
+	"drei",
+	"dreissig",
+	"drinnen",
+	"dritt",
+	"dritte",
+	"drucke",
+	"drähte",
+	"drüben",
+	"duell",
+	"dumme",
+	"dummem",
+	"dummen",
+	"dummer",
+	"dummes",
+	"durfte",
+	"durften",
+	"dächer",
+	"dörfer",
+	"düfte",
+	"dümmer",
+	"dümmere",
+	"dümmeren",
+	"dümmeres",
+	"dümmste",
+	"dümmsten",
+	"dünne",
+	"dünnem",
+	"dünnen",
+	"dünner",
+	"dünnere",
+	"dünneren",
+	"dünneres",
+	"dünnes",
+	"dünnste",
+	"dünnsten",
+	"dürfte",
+	"düse",
+	"ebbe",
+	"eben",
+	"ebenen",
+	"ebenso",
+	"echse",
+	"echte",
+	"echtem",
+	"echten",
+	"echter",
+	"echtere",
+	"echteren",
+	"echteres",
+	"echtes",
+	"echteste",
+	"ecken",
+	"egge",
+	"eichen",
+	"eifer",
+	"eigen",
+	"eile",
+	"eilend",
+	"eilig",
+	"eilst",
+	"eilt",
+	"eilte",
+	"eilten",
+	"eiltest",
+	"eiltet",
+	"eine",
+	"einem",
+	"einen",
+	"einer",
+	"eines",
+	"einige",
+	"einmal",
+	"einst",
+	"eisig",
+	"elfen",
+	"enkel",
+	"enten",
+	"erben",
+	"erbse",
+	"ernste",
+	"ernstem",
+	"ernsten",
+	"ernster",
+	"ernstere",
+	"ernstes",
+	"ernten",
+	"erste",
+	"ersten",
+	"esse",
+	"essend",
+	"esst",
+	"etage",
+	"etwa",
+	"etwas",
+	"euch",
+	"euer",
+	"eulen",
+	"eure",
+	"fabeln",
+	"fahnen",
+	"fahre",
+	"fahrend",
+	"fahrt",
+	"falke",
+	"fallend",
+	"fallt",
+	"falsche",
+	"falschem",
+	"falschen",
+	"falscher",
+	"falsches",
+	"falte",
+	"fand",
+	"fanden",
+	"fange",
+	"fangend",
+	"fangt",
+	"farben",
+	"farne",
+	"fasan",
+	"fasse",
+	"fassend",
+	"fasst",
+	"fasste",
+	"fassten",
+	"fasstest",
+	"fasstet",
+	"fast",
+	"fasten",
+	"faule",
+	"faulem",
+	"faulen",
+	"fauler",
+	"faulere",
+	"fauleren",
+	"fauleres",
+	"faules",
+	"faulste",
+	"faulsten",
+	"fauna",
+	"faust",
+	"februar",
+	"federn",
+	"fege",
+	"fegend",
+	"fegst",
+	"fegt",
+	"fegte",
+	"fegten",
+	"fegtest",
+	"fegtet",
+	"fehle",
+	"fehlend",
+	"fehlst",
+	"fehlt",
+	"fehlte",
+	"fehlten",
+	"fehltest",
+	"fehltet",
+	"feile",
+	"feinde",
+	"feine",
+	"feinem",
+	"feinen",
+	"feiner",
+	"feinere",
+	"feineren",
+	"feineres",
+	"feines",
+	"feinste",
+	"feinsten",
+	"felder",
+	"felge",
+	"ferne",
+	"fernem",
+	"fernen",
+	"ferner",
+	"fernere",
+	"ferneren",
+	"ferneres",
+	"fernes",
+	"fernste",
+	"fernsten",
+	"fesch",
+	"feste",
+	"festem",
+	"festen",
+	"fester",
+	"festere",
+	"festeren",
+	"festeres",
+	"festes",
+	"festeste",
+	"fette",
+	"fettem",
+	"fetten",
+	"fetter",
+	"fettere",
+	"fetteren",
+	"fetteres",
+	"fettes",
+	"fetteste",
+	"feuchte",
+	"feuchtem",
+	"feuchten",
+	"feuchter",
+	"feuchtes",
+	"fichte",
+	"fiel",
+	"fielen",
+	"figuren",
+	"filme",
+	"filter",
+	"finde",
+	"findend",
+	"findest",
+	"findet",
+	"fing",
+	"fingen",
+	"firma",
+	"fisch",
+	"flache",
+	"flachem",
+	"flachen",
+	"flacher",
+	"flachere",
+	"flaches",
+	"flachste",
+	"flaum",
+	"flecken",
+	"fliege",
+	"fliegend",
+	"fliegst",
+	"fliegt",
+	"fliehend",
+	"flieht",
+	"fliesse",
+	"fliesst",
+	"flinke",
+	"flinkem",
+	"flinken",
+	"flinker",
+	"flinkere",
+	"flinkes",
+	"flinkste",
+	"flirt",
+	"flog",
+	"flogen",
+	"floss",
+	"flott",
+	"flure",
+	"flöhe",
+	"flöten",
+	"flüge",
+	"flüsse",
+	"folgend",
+	"folgst",
+	"folgt",
+	"folgte",
+	"folgten",
+	"folgtest",
+	"folgtet",
+	"foren",
+	"formen",
+	"forst",
+	"fotos",
+	"frack",
+	"fragend",
+	"fragst",
+	"fragt",
+	"fragte",
+	"fragten",
+	"fragtest",
+	"fragtet",
+	"frass",
+	"frauen",
+	"freie",
+	"freiem",
+	"freien",
+	"freier",
+	"freiere",
+	"freieren",
+	"freieres",
+	"freies",
+	"freitag",
+	"fremde",
+	"fremdem",
+	"fremden",
+	"fremder",
+	"fremdere",
+	"fremdes",
+	"fresse",
+	"fressend",
+	"fresst",
+	"freuden",
+	"friere",
+	"frierend",
+	"friert",
+	"frische",
+	"frischem",
+	"frischen",
+	"frischer",
+	"frisches",
+	"frisst",
+	"frist",
+	"frohe",
+	"frohem",
+	"frohen",
+	"froher",
+	"frohere",
+	"froheren",
+	"froheres",
+	"frohes",
+	"fromm",
+	"front",
+	"fror",
+	"früchte",
+	"frühe",
+	"frühem",
+	"frühen",
+	"früher",
+	"frühere",
+	"früheren",
+	"früheres",
+	"frühes",
+	"frühling",
+	"frühste",
+	"frühsten",
+	"fuhr",
+	"fuhre",
+	"fuhren",
+	"funke",
+	"furche",
+	"furie",
+	"fusses",
+	"fäden",
+	"fähre",
+	"fährst",
+	"fährt",
+	"fällst",
+	"fällt",
+	"fängt",
+	"füchse",
+	"fühle",
+	"fühlend",
+	"fühlst",
+	"fühlt",
+	"fühlte",
+	"fühlten",
+	"fühltest",
+	"fühltet",
+	"führe",
+	"führend",
+	"führst",
+	"führt",
+	"führte",
+	"führten",
+	"führtest",
+	"führtet",
+	"fülle",
+	"füllend",
+	"füllst",
+	"füllt",
+	"füllte",
+	"füllten",
+	"fülltest",
+	"fülltet",
+	"fünf",
+	"fürchte",
+	"fürchten",
+	"fürchtet",
+	"gabeln",
+	"gaben",
+	"gagen",
+	"galle",
+	"ganze",
+	"ganzem",
+	"ganzen",
+	"ganzer",
+	"ganzere",
+	"ganzeren",
+	"ganzeres",
+	"ganzes",
+	"ganzeste",
+	"garbe",
+	"garde",
+	"gassen",
+	"gatte",
+	"geachtet",
+	"geatmet",
+	"gebadet",
+	"gebaut",
+	"gebe",
+	"gebend",
+	"gebet",
+	"gebeten",
+	"geblasen",
+	"gebogen",
+	"geboten",
+	"gebracht",
+	"gebraten",
+	"gebt",
+	"gebunden",
+	"gedacht",
+	"gedankt",
+	"gedeckt",
+	"gedient",
+	"geeilt",
+	"gefahren",
+	"gefallen",
+	"gefangen",
+	"gefasst",
+	"gefegt",
+	"gefehlt",
+	"geflogen",
+	"gefolgt",
+	"gefragt",
+	"gefroren",
+	"gefunden",
+	"gefühlt",
+	"geführt",
+	"gefüllt",
+	"gegangen",
+	"gegeben",
+	"gegen",
+	"gegessen",
+	"geglaubt",
+	"gegossen",
+	"gegraben",
+	"gegrüsst",
+	"gehabt",
+	"gehalten",
+	"gehasst",
+	"gehe",
+	"geheilt",
+	"gehend",
+	"gehofft",
+	"geholfen",
+	"geholt",
+	"gehst",
+	"geht",
+	"gehör",
+	"gehört",
+	"gehüpft",
+	"geier",
+	"geige",
+	"geister",
+	"geizig",
+	"gejagt",
+	"gekannt",
+	"gekauft",
+	"geklagt",
+	"geklebt",
+	"geklopft",
+	"gekocht",
+	"gekommen",
+	"gekonnt",
+	"gekostet",
+	"gekämpft",
+	"gelacht",
+	"geladen",
+	"gelandet",
+	"gelassen",
+	"gelaufen",
+	"gelbe",
+	"gelbem",
+	"gelben",
+	"gelber",
+	"gelbere",
+	"gelberen",
+	"gelberes",
+	"gelbes",
+	"gelbste",
+	"gelbsten",
+	"gelder",
+	"gelebt",
+	"gelegen",
+	"gelegt",
+	"gelehrt",
+	"geleitet",
+	"gelernt",
+	"gelesen",
+	"geliebt",
+	"gelitten",
+	"gelobt",
+	"gelogen",
+	"geltend",
+	"gelöst",
+	"gemacht",
+	"gemalt",
+	"gemeint",
+	"gemeldet",
+	"gemessen",
+	"gemietet",
+	"gemusst",
+	"genannt",
+	"genaue",
+	"genauem",
+	"genauen",
+	"genauer",
+	"genauere",
+	"genaues",
+	"genauste",
+	"genickt",
+	"geniesse",
+	"geniesst",
+	"genommen",
+	"genoss",
+	"genossen",
+	"genug",
+	"genutzt",
+	"genäht",
+	"gepackt",
+	"gepflegt",
+	"geplant",
+	"geputzt",
+	"geradee",
+	"geradeem",
+	"geradeen",
+	"geradeer",
+	"geradees",
+	"gerannt",
+	"gerast",
+	"geraten",
+	"geraubt",
+	"geraucht",
+	"gerechnt",
+	"geredet",
+	"geregnet",
+	"gereist",
+	"gerettet",
+	"geritten",
+	"gerollt",
+	"gerte",
+	"gerufen",
+	"gerungen",
+	"gerührt",
+	"gesagt",
+	"gesaugt",
+	"geschaut",
+	"gesehen",
+	"gesessen",
+	"gesetzt",
+	"gesorgt",
+	"gespart",
+	"gespielt",
+	"gesteckt",
+	"gestellt",
+	"gesten",
+	"gestern",
+	"gesucht",
+	"gesungen",
+	"gesunken",
+	"getan",
+	"getanzt",
+	"getaucht",
+	"geteilt",
+	"getestet",
+	"getragen",
+	"getreten",
+	"geträumt",
+	"getötet",
+	"gewagt",
+	"gewann",
+	"gewartet",
+	"geweckt",
+	"geweint",
+	"gewesen",
+	"gewinkt",
+	"gewinne",
+	"gewinnt",
+	"gewogen",
+	"gewohnt",
+	"gewollt",
+	"gewonnen",
+	"geworden",
+	"geworfen",
+	"gewusst",
+	"gewählt",
+	"gezahlt",
+	"gezeigt",
+	"gezielt",
+	"gezogen",
+	"gezählt",
+	"geöffnet",
+	"geübt",
+	"gibst",
+	"gibt",
+	"gicht",
+	"giesse",
+	"giessend",
+	"giesst",
+	"gifte",
+	"gilde",
+	"ging",
+	"gingen",
+	"gipfel",
+	"glatte",
+	"glattem",
+	"glatten",
+	"glatter",
+	"glattere",
+	"glattes",
+	"glaube",
+	"glaubend",
+	"glaubst",
+	"glaubt",
+	"glaubte",
+	"glaubten",
+	"glaubtet",
+	"glied",
+	"gläser",
+	"goss",
+	"grabe",
+	"grabend",
+	"grabt",
+	"greifend",
+	"greift",
+	"greis",
+	"grenzen",
+	"griess",
+	"griffe",
+	"grill",
+	"grips",
+	"grobe",
+	"grobem",
+	"groben",
+	"grober",
+	"grobes",
+	"groll",
+	"grosse",
+	"grossem",
+	"grossen",
+	"grosser",
+	"grosses",
+	"grub",
+	"gruben",
+	"gruft",
+	"gruppen",
+	"gräber",
+	"gräbt",
+	"gräser",
+	"gröber",
+	"gröbere",
+	"gröberen",
+	"gröberes",
+	"gröbste",
+	"gröbsten",
+	"grösser",
+	"grössere",
+	"grösste",
+	"grössten",
+	"grüne",
+	"grünem",
+	"grünen",
+	"grüner",
+	"grünere",
+	"grüneren",
+	"grüneres",
+	"grünes",
+	"grünste",
+	"grünsten",
+	"grüsse",
+	"grüssend",
+	"grüsst",
+	"grüsste",
+	"grüssten",
+	"grüsstet",
+	"gully",
+	"gummi",
+	"gurke",
+	"gurte",
+	"gusto",
+	"gute",
+	"gutem",
+	"guten",
+	"guter",
+	"gutes",
+	"gänse",
+	"gärten",
+	"gäste",
+	"götter",
+	"habe",
+	"habt",
+	"haken",
+	"halbe",
+	"halbem",
+	"halben",
+	"halber",
+	"halbere",
+	"halberen",
+	"halberes",
+	"halbes",
+	"halbste",
+	"halbsten",
+	"halde",
+	"half",
+	"halfen",
+	"hallen",
+	"hallo",
+	"halme",
+	"halte",
+	"haltend",
+	"hange",
+	"harke",
+	"harsch",
+	"harte",
+	"hartem",
+	"harten",
+	"harter",
+	"hartes",
+	"hasen",
+	"hasse",
+	"hassend",
+	"hasst",
+	"hasste",
+	"hassten",
+	"hasstest",
+	"hasstet",
+	"hast",
+	"hatte",
+	"hatten",
+	"hattest",
+	"hattet",
+	"hauch",
+	"haupt",
+	"hebend",
+	"heber",
+	"hebt",
+	"hecken",
+	"heere",
+	"hefte",
+	"heiden",
+	"heile",
+	"heilend",
+	"heilst",
+	"heilt",
+	"heilte",
+	"heilten",
+	"heiltest",
+	"heiltet",
+	"heirate",
+	"heiraten",
+	"heiratet",
+	"heisse",
+	"heissem",
+	"heissend",
+	"heisser",
+	"heissere",
+	"heisses",
+	"heisst",
+	"helden",
+	"helfe",
+	"helfend",
+	"helft",
+	"helle",
+	"hellem",
+	"hellen",
+	"heller",
+	"hellere",
+	"helleren",
+	"helleres",
+	"helles",
+	"hellste",
+	"hellsten",
+	"helme",
+	"hemden",
+	"henne",
+	"herbst",
+	"herde",
+	"hering",
+	"herzen",
+	"heuer",
+	"hexen",
+	"hiebe",
+	"hielt",
+	"hielten",
+	"hier",
+	"hiess",
+	"hiessen",
+	"hilfen",
+	"hilfst",
+	"hilft",
+	"hinten",
+	"hinter",
+	"hirse",
+	"hirte",
+	"hobby",
+	"hocke",
+	"hoden",
+	"hofes",
+	"hoffe",
+	"hoffend",
+	"hoffst",
+	"hofft",
+	"hoffte",
+	"hofften",
+	"hofftest",
+	"hofftet",
+	"hohle",
+	"hohlem",
+	"hohlen",
+	"hohler",
+	"hohlere",
+	"hohleren",
+	"hohleres",
+	"hohles",
+	"hohlste",
+	"hohlsten",
+	"holde",
+	"hole",
+	"holend",
+	"holst",
+	"holt",
+	"holte",
+	"holten",
+	"holtest",
+	"holtet",
+	"hosen",
+	"hotels",
+	"humor",
+	"hunde",
+	"hundert",
+	"hupen",
+	"häfen",
+	"hähne",
+	"hälse",
+	"hält",
+	"hältst",
+	"hämmer",
+	"hände",
+	"hängend",
+	"hängt",
+	"härter",
+	"härtere",
+	"härteren",
+	"härteres",
+	"härteste",
+	"hätte",
+	"hätten",
+	"häuser",
+	"häute",
+	"höhle",
+	"höre",
+	"hörend",
+	"hörst",
+	"hört",
+	"hörte",
+	"hörten",
+	"hörtest",
+	"hörtet",
+	"hübsche",
+	"hübschem",
+	"hübschen",
+	"hübscher",
+	"hübsches",
+	"hülle",
+	"hüpfe",
+	"hüpfend",
+	"hüpfst",
+	"hüpft",
+	"hüpfte",
+	"hüpften",
+	"hüpftest",
+	"hüpftet",
+	"hürde",
+	"hütte",
+	"ideal",
+	"ideen",
+	"idiot",
+	"imker",
+	"immer",
+	"index",
+	"indiz",
+	"innen",
+	"inseln",
+	"ironie",
+	"isst",
+	"jacht",
+	"jacken",
+	"jage",
+	"jagend",
+	"jagst",
+	"jagt",
+	"jagte",
+	"jagten",
+	"jagtest",
+	"jagtet",
+	"jahre",
+	"januar",
+	"jede",
+	"jedem",
+	"jeden",
+	"jeder",
+	"jedes",
+	"jemand",
+	"jene",
+	"jener",
+	"jetzt",
+	"juli",
+	"junge",
+	"jungem",
+	"jungen",
+	"junger",
+	"junges",
+	"juni",
+	"juwel",
+	"jünger",
+	"jüngere",
+	"jüngeren",
+	"jüngeres",
+	"jüngste",
+	"jüngsten",
+	"kader",
+	"kahle",
+	"kahlem",
+	"kahlen",
+	"kahler",
+	"kahlere",
+	"kahleren",
+	"kahleres",
+	"kahles",
+	"kahlste",
+	"kahlsten",
+	"kakao",
+	"kalte",
+	"kaltem",
+	"kalten",
+	"kalter",
+	"kaltes",
+	"kamele",
+	"kamen",
+	"kann",
+	"kannst",
+	"kannte",
+	"kannten",
+	"kanten",
+	"kanäle",
+	"kapok",
+	"kappen",
+	"karre",
+	"karten",
+	"kassen",
+	"kasus",
+	"kater",
+	"katzen",
+	"kaufe",
+	"kaufend",
+	"kaufst",
+	"kauft",
+	"kaufte",
+	"kauften",
+	"kauftest",
+	"kauftet",
+	"kehlen",
+	"keile",
+	"keime",
+	"keine",
+	"keinem",
+	"keinen",
+	"keiner",
+	"kelch",
+	"kelle",
+	"kenne",
+	"kennend",
+	"kennst",
+	"kennt",
+	"kerbe",
+	"kerle",
+	"kerne",
+	"kerzen",
+	"ketten",
+	"keule",
+	"kiesel",
+	"kinder",
+	"kinne",
+	"kinos",
+	"kippe",
+	"kirchen",
+	"kisten",
+	"kitsch",
+	"kittel",
+	"klagend",
+	"klagst",
+	"klagt",
+	"klagte",
+	"klagten",
+	"klagtest",
+	"klagtet",
+	"klare",
+	"klarem",
+	"klaren",
+	"klarer",
+	"klarere",
+	"klareren",
+	"klareres",
+	"klares",
+	"klarste",
+	"klarsten",
+	"klassen",
+	"klaue",
+	"klebe",
+	"klebend",
+	"klebst",
+	"klebt",
+	"klebte",
+	"klebten",
+	"klebtest",
+	"klebtet",
+	"kleider",
+	"kleie",
+	"kleine",
+	"kleinem",
+	"kleinen",
+	"kleiner",
+	"kleinere",
+	"kleines",
+	"kleinste",
+	"klinge",
+	"klingend",
+	"klingt",
+	"klopfe",
+	"klopfend",
+	"klopfst",
+	"klopft",
+	"klopfte",
+	"klopften",
+	"klopftet",
+	"klotz",
+	"kluft",
+	"kluge",
+	"klugem",
+	"klugen",
+	"kluger",
+	"kluges",
+	"klänge",
+	"klüger",
+	"klügere",
+	"klügeren",
+	"klügeres",
+	"klügste",
+	"klügsten",
+	"knaben",
+	"knall",
+	"knappe",
+	"knappem",
+	"knappen",
+	"knapper",
+	"knappere",
+	"knappes",
+	"knappste",
+	"knast",
+	"knauf",
+	"knechte",
+	"kniff",
+	"knoten",
+	"knöpfe",
+	"kobra",
+	"koche",
+	"kochend",
+	"kochst",
+	"kocht",
+	"kochte",
+	"kochten",
+	"kochtest",
+	"kochtet",
+	"kohlen",
+	"kojen",
+	"komma",
+	"komme",
+	"kommend",
+	"kommst",
+	"kommt",
+	"konnte",
+	"konnten",
+	"kopie",
+	"kopiere",
+	"kopieren",
+	"kopierst",
+	"kopiert",
+	"kopierte",
+	"kosak",
+	"koste",
+	"kostend",
+	"kostest",
+	"kostet",
+	"kostete",
+	"kosteten",
+	"kostetet",
+	"koten",
+	"krabbe",
+	"krach",
+	"kralle",
+	"kranke",
+	"krankem",
+	"kranken",
+	"kranker",
+	"krankes",
+	"krebs",
+	"kreide",
+	"kreise",
+	"kreuze",
+	"kriecht",
+	"kriege",
+	"krimi",
+	"kripo",
+	"kronen",
+	"krume",
+	"krumme",
+	"krummem",
+	"krummen",
+	"krummer",
+	"krummere",
+	"krummes",
+	"krummste",
+	"kräfte",
+	"krähe",
+	"kränker",
+	"kränkere",
+	"kränkste",
+	"kränze",
+	"kröte",
+	"krüge",
+	"krümel",
+	"kugeln",
+	"kunden",
+	"kuppe",
+	"kurie",
+	"kurven",
+	"kurze",
+	"kurzem",
+	"kurzen",
+	"kurzer",
+	"kurzes",
+	"kutte",
+	"kälter",
+	"kältere",
+	"kälteren",
+	"kälteres",
+	"kälteste",
+	"käme",
+	"kämme",
+	"kämpfe",
+	"kämpfend",
+	"kämpfst",
+	"kämpft",
+	"kämpfte",
+	"kämpften",
+	"kämpftet",
+	"köche",
+	"könnte",
+	"köpfe",
+	"körbe",
+	"küchen",
+	"kühle",
+	"kühlem",
+	"kühlen",
+	"kühler",
+	"kühlere",
+	"kühleren",
+	"kühleres",
+	"kühles",
+	"kühlste",
+	"kühlsten",
+	"künste",
+	"kürzer",
+	"kürzere",
+	"kürzeren",
+	"kürzeres",
+	"kürzeste",
+	"küsse",
+	"küsten",
+	"lachend",
+	"lachs",
+	"lachst",
+	"lacht",
+	"lachte",
+	"lachten",
+	"lachtest",
+	"lachtet",
+	"lade",
+	"ladend",
+	"lagen",
+	"lahme",
+	"lahmem",
+	"lahmen",
+	"lahmer",
+	"lahmere",
+	"lahmeren",
+	"lahmeres",
+	"lahmes",
+	"lahmste",
+	"lahmsten",
+	"laien",
+	"laken",
+	"lamas",
+	"lampen",
+	"lande",
+	"landen",
+	"landend",
+	"landest",
+	"landet",
+	"landete",
+	"landeten",
+	"landetet",
+	"lange",
+	"langem",
+	"langen",
+	"langer",
+	"langes",
+	"lasen",
+	"lasse",
+	"lassend",
+	"lasso",
+	"lasst",
+	"lasten",
+	"laube",
+	"lauch",
+	"lauer",
+	"laufe",
+	"laufend",
+	"lauft",
+	"laute",
+	"lautem",
+	"lauten",
+	"lauter",
+	"lautere",
+	"lauteren",
+	"lauteres",
+	"lautes",
+	"lauteste",
+	"lebe",
+	"lebend",
+	"leber",
+	"lebst",
+	"lebt",
+	"lebte",
+	"lebten",
+	"lebtest",
+	"lebtet",
+	"leckere",
+	"leckerem",
+	"leckeren",
+	"leckerer",
+	"leckeres",
+	"leere",
+	"leerem",
+	"leeren",
+	"leerer",
+	"leerere",
+	"leereren",
+	"leereres",
+	"leeres",
+	"leerste",
+	"leersten",
+	"lege",
+	"legend",
+	"legst",
+	"legt",
+	"legte",
+	"legten",
+	"legtest",
+	"legtet",
+	"lehne",
+	"lehrend",
+	"lehrst",
+	"lehrt",
+	"lehrte",
+	"lehrten",
+	"lehrtest",
+	"lehrtet",
+	"leichen",
+	"leichte",
+	"leichtem",
+	"leichten",
+	"leichter",
+	"leichtes",
+	"leide",
+	"leidend",
+	"leier",
+	"leihend",
+	"leiht",
+	"leinen",
+	"leisem",
+	"leisen",
+	"leiser",
+	"leises",
+	"leite",
+	"leiten",
+	"leitend",
+	"leitest",
+	"leitet",
+	"leitete",
+	"leiteten",
+	"leitetet",
+	"lende",
+	"lenze",
+	"lerne",
+	"lernend",
+	"lernst",
+	"lernt",
+	"lernte",
+	"lernten",
+	"lerntest",
+	"lerntet",
+	"lese",
+	"lesend",
+	"leser",
+	"lest",
+	"lichter",
+	"lider",
+	"liebend",
+	"liebst",
+	"liebt",
+	"liebte",
+	"liebten",
+	"liebtest",
+	"liebtet",
+	"lieder",
+	"lief",
+	"liefen",
+	"liege",
+	"liegend",
+	"liegst",
+	"liegt",
+	"liess",
+	"liessen",
+	"liest",
+	"limit",
+	"linde",
+	"linien",
+	"linke",
+	"linkem",
+	"linken",
+	"linker",
+	"linkere",
+	"linkeren",
+	"linkeres",
+	"linkes",
+	"linkste",
+	"linksten",
+	"linsen",
+	"lippen",
+	"listen",
+	"litt",
+	"lobby",
+	"lobe",
+	"lobend",
+	"lobst",
+	"lobt",
+	"lobte",
+	"lobten",
+	"lobtest",
+	"lobtet",
+	"lockere",
+	"lockerem",
+	"lockeren",
+	"lockerer",
+	"lockeres",
+	"loden",
+	"logik",
+	"lotse",
+	"lotto",
+	"luder",
+	"lunge",
+	"lunte",
+	"lurch",
+	"lädt",
+	"lämmer",
+	"länder",
+	"länger",
+	"längere",
+	"längeren",
+	"längeres",
+	"längste",
+	"längsten",
+	"lässt",
+	"läufe",
+	"läufst",
+	"läuft",
+	"löcher",
+	"löhne",
+	"löse",
+	"lösend",
+	"löst",
+	"löste",
+	"lösten",
+	"löstest",
+	"löstet",
+	"löwen",
+	"lücken",
+	"lüfte",
+	"lüge",
+	"lügend",
+	"lügt",
+	"mache",
+	"machend",
+	"machst",
+	"machte",
+	"machten",
+	"machtest",
+	"machtet",
+	"macke",
+	"magie",
+	"magst",
+	"makel",
+	"male",
+	"malend",
+	"malst",
+	"malt",
+	"malte",
+	"malten",
+	"maltest",
+	"maltet",
+	"manche",
+	"manchmal",
+	"mango",
+	"manie",
+	"marke",
+	"markiere",
+	"markiert",
+	"mass",
+	"masse",
+	"massen",
+	"matte",
+	"mattem",
+	"matten",
+	"matter",
+	"mattere",
+	"matteren",
+	"matteres",
+	"mattes",
+	"matteste",
+	"mauern",
+	"meere",
+	"mehr",
+	"meilen",
+	"meine",
+	"meinem",
+	"meinend",
+	"meiner",
+	"meinst",
+	"meint",
+	"meinte",
+	"meinten",
+	"meintest",
+	"meintet",
+	"meisen",
+	"melde",
+	"meldend",
+	"meldest",
+	"meldet",
+	"meldete",
+	"meldeten",
+	"meldetet",
+	"mengen",
+	"messend",
+	"messt",
+	"metalle",
+	"meter",
+	"mich",
+	"mienen",
+	"miete",
+	"mieten",
+	"mietend",
+	"mietest",
+	"mietet",
+	"mietete",
+	"mieteten",
+	"mietetet",
+	"milbe",
+	"milde",
+	"mildem",
+	"milden",
+	"milder",
+	"mildere",
+	"milderen",
+	"milderes",
+	"mildes",
+	"mildeste",
+	"mimik",
+	"minuten",
+	"minze",
+	"misst",
+	"mitten",
+	"mittwoch",
+	"mixer",
+	"mochte",
+	"mochten",
+	"monate",
+	"monde",
+	"montag",
+	"moped",
+	"moral",
+	"morde",
+	"motiv",
+	"motoren",
+	"motte",
+	"mumie",
+	"muntere",
+	"munterem",
+	"munteren",
+	"munterer",
+	"munteres",
+	"muscheln",
+	"muss",
+	"musst",
+	"musste",
+	"mussten",
+	"mächte",
+	"mädel",
+	"mägen",
+	"männer",
+	"mäntel",
+	"märkte",
+	"mäuse",
+	"möchte",
+	"möchten",
+	"mönch",
+	"möwen",
+	"mücke",
+	"müdee",
+	"müdeem",
+	"müdeen",
+	"müdeer",
+	"müdeere",
+	"müdeeren",
+	"müdeeres",
+	"müdees",
+	"müdem",
+	"müden",
+	"müder",
+	"müdes",
+	"müdeste",
+	"müdesten",
+	"mühlen",
+	"münder",
+	"münzen",
+	"müsste",
+	"mütter",
+	"mütze",
+	"nach",
+	"nachts",
+	"nacken",
+	"nackte",
+	"nacktem",
+	"nackten",
+	"nackter",
+	"nacktere",
+	"nacktes",
+	"nadeln",
+	"nager",
+	"nahen",
+	"nahm",
+	"nahmen",
+	"namen",
+	"nannte",
+	"narben",
+	"narren",
+	"nasen",
+	"nasse",
+	"nassem",
+	"nassen",
+	"nasser",
+	"nassere",
+	"nasseren",
+	"nasseres",
+	"nasses",
+	"nasseste",
+	"neben",
+	"neffen",
+	"nehme",
+	"nehmend",
+	"nehmt",
+	"neige",
+	"nein",
+	"nenne",
+	"nennend",
+	"nennt",
+	"nerven",
+	"nester",
+	"nette",
+	"nettem",
+	"netten",
+	"netter",
+	"nettere",
+	"netteren",
+	"netteres",
+	"nettes",
+	"netteste",
+	"netze",
+	"neue",
+	"neuem",
+	"neuen",
+	"neuer",
+	"neuere",
+	"neueren",
+	"neueres",
+	"neues",
+	"neun",
+	"neuste",
+	"neusten",
+	"nicht",
+	"nichten",
+	"nichts",
+	"nicke",
+	"nickend",
+	"nickst",
+	"nickt",
+	"nickte",
+	"nickten",
+	"nicktest",
+	"nicktet",
+	"niemand",
+	"niere",
+	"nimmst",
+	"nimmt",
+	"nochmal",
+	"nonne",
+	"notar",
+	"notiere",
+	"notieren",
+	"notierst",
+	"notiert",
+	"notierte",
+	"notizen",
+	"nudeln",
+	"nummern",
+	"nunmehr",
+	"nutze",
+	"nutzend",
+	"nutzt",
+	"nutzte",
+	"nutzten",
+	"nutztest",
+	"nutztet",
+	"nylon",
+	"nächte",
+	"nägel",
+	"nähend",
+	"nähst",
+	"näht",
+	"nähte",
+	"nähten",
+	"nähtest",
+	"nähtet",
+	"obhut",
+	"ochsen",
+	"oder",
+	"ofens",
+	"offene",
+	"offenem",
+	"offenen",
+	"offener",
+	"offenere",
+	"offenes",
+	"offenste",
+	"ohren",
+	"oktober",
+	"oliven",
+	"omega",
+	"operiere",
+	"operiert",
+	"orgel",
+	"ozean",
+	"pacht",
+	"packe",
+	"packend",
+	"packst",
+	"packt",
+	"packte",
+	"packten",
+	"packtest",
+	"packtet",
+	"pakete",
+	"palmen",
+	"panik",
+	"panne",
+	"papiere",
+	"papst",
+	"parks",
+	"partys",
+	"passiere",
+	"passiert",
+	"paste",
+	"pater",
+	"pausen",
+	"pedal",
+	"pegel",
+	"pelle",
+	"penne",
+	"perlen",
+	"pfade",
+	"pfand",
+	"pfannen",
+	"pfeifen",
+	"pfeile",
+	"pferde",
+	"pflanzen",
+	"pflege",
+	"pflegend",
+	"pflegst",
+	"pflegt",
+	"pflegte",
+	"pflegten",
+	"pflegtet",
+	"pfote",
+	"pfähle",
+	"phase",
+	"piano",
+	"pille",
+	"pilze",
+	"pinie",
+	"piraten",
+	"piste",
+	"pixel",
+	"pizza",
+	"plage",
+	"plane",
+	"planend",
+	"planst",
+	"plant",
+	"plante",
+	"planten",
+	"plantest",
+	"plantet",
+	"plump",
+	"pläne",
+	"plätze",
+	"pokal",
+	"polen",
+	"porto",
+	"posse",
+	"poster",
+	"preise",
+	"prinz",
+	"prise",
+	"proben",
+	"probiere",
+	"probiert",
+	"profi",
+	"prosa",
+	"protz",
+	"psalm",
+	"pudel",
+	"puder",
+	"puffer",
+	"pulle",
+	"pulver",
+	"pumpe",
+	"punkt",
+	"puppen",
+	"puter",
+	"putze",
+	"putzend",
+	"putzt",
+	"putzte",
+	"putzten",
+	"putztest",
+	"putztet",
+	"pässe",
+	"qualm",
+	"quark",
+	"quellen",
+	"quote",
+	"raben",
+	"rampe",
+	"ranke",
+	"rannte",
+	"rasche",
+	"raschem",
+	"raschen",
+	"rascher",
+	"raschere",
+	"rasches",
+	"rase",
+	"rasend",
+	"rasse",
+	"raste",
+	"rasten",
+	"rastest",
+	"rastet",
+	"rate",
+	"ratend",
+	"ratten",
+	"raube",
+	"raubend",
+	"raubst",
+	"raubt",
+	"raubte",
+	"raubten",
+	"raubtest",
+	"raubtet",
+	"rauche",
+	"rauchend",
+	"rauchst",
+	"raucht",
+	"rauchte",
+	"rauchten",
+	"rauchtet",
+	"raupen",
+	"razzia",
+	"rebell",
+	"reben",
+	"rechne",
+	"rechnend",
+	"rechnst",
+	"rechnt",
+	"rechnte",
+	"rechnten",
+	"rechntet",
+	"rechte",
+	"rechtem",
+	"rechten",
+	"rechter",
+	"rechtere",
+	"rechtes",
+	"recke",
+	"rede",
+	"redend",
+	"redest",
+	"redet",
+	"redete",
+	"redeten",
+	"redetest",
+	"redetet",
+	"reede",
+	"regale",
+	"regeln",
+	"reger",
+	"regiere",
+	"regieren",
+	"regierst",
+	"regiert",
+	"regierte",
+	"regne",
+	"regnend",
+	"regnest",
+	"regnet",
+	"regnete",
+	"regneten",
+	"regnetet",
+	"reibend",
+	"reibt",
+	"reiche",
+	"reichem",
+	"reichen",
+	"reicher",
+	"reichere",
+	"reiches",
+	"reichste",
+	"reife",
+	"reifem",
+	"reifen",
+	"reifer",
+	"reifere",
+	"reiferen",
+	"reiferes",
+	"reifes",
+	"reifste",
+	"reifsten",
+	"reihen",
+	"reine",
+	"reinem",
+	"reinen",
+	"reiner",
+	"reinere",
+	"reineren",
+	"reineres",
+	"reines",
+	"reinste",
+	"reinsten",
+	"reisend",
+	"reist",
+	"reiste",
+	"reisten",
+	"reistest",
+	"reistet",
+	"reite",
+	"reitend",
+	"renne",
+	"rennend",
+	"rennt",
+	"rente",
+	"reste",
+	"rette",
+	"rettend",
+	"rettest",
+	"rettet",
+	"rettete",
+	"retteten",
+	"rettetet",
+	"revue",
+	"riechend",
+	"riecht",
+	"rief",
+	"riefen",
+	"riege",
+	"riese",
+	"riet",
+	"rille",
+	"rinden",
+	"ringe",
+	"ringend",
+	"ringt",
+	"rinne",
+	"rippe",
+	"rispe",
+	"ritt",
+	"robbe",
+	"rodel",
+	"rohe",
+	"rohem",
+	"rohen",
+	"roher",
+	"rohere",
+	"roheren",
+	"roheres",
+	"rohes",
+	"rohre",
+	"rolle",
+	"rollend",
+	"rollst",
+	"rollt",
+	"rollte",
+	"rollten",
+	"rolltest",
+	"rolltet",
+	"roman",
+	"rosen",
+	"rosse",
+	"rote",
+	"rotem",
+	"roten",
+	"roter",
+	"rotere",
+	"roteren",
+	"roteres",
+	"rotes",
+	"roteste",
+	"rotesten",
+	"route",
+	"rubin",
+	"rufe",
+	"rufend",
+	"rufst",
+	"ruft",
+	"rugby",
+	"ruine",
+	"rummel",
+	"rumpf",
+	"runde",
+	"rundem",
+	"runden",
+	"runder",
+	"rundere",
+	"runderen",
+	"runderes",
+	"rundes",
+	"rundeste",
+	"ränder",
+	"ränge",
+	"rätst",
+	"räume",
+	"röcke",
+	"rüben",
+	"rühre",
+	"rührend",
+	"rührst",
+	"rührt",
+	"rührte",
+	"rührten",
+	"rührtest",
+	"rührtet",
+	"rüpel",
+	"rüste",
+	"sachen",
+	"sage",
+	"sagend",
+	"sagst",
+	"sagt",
+	"sagte",
+	"sagten",
+	"sagtest",
+	"sagtet",
+	"sahen",
+	"saiten",
+	"salate",
+	"salbe",
+	"salon",
+	"salze",
+	"samba",
+	"samstag",
+	"sanfte",
+	"sanftem",
+	"sanften",
+	"sanfter",
+	"sanftere",
+	"sanftes",
+	"sang",
+	"sangen",
+	"sank",
+	"sass",
+	"sassen",
+	"satin",
+	"satte",
+	"sattem",
+	"satten",
+	"satter",
+	"sattere",
+	"satteren",
+	"satteres",
+	"sattes",
+	"satteste",
+	"sauge",
+	"saugend",
+	"saugst",
+	"saugt",
+	"saugte",
+	"saugten",
+	"saugtest",
+	"saugtet",
+	"sauna",
+	"schafe",
+	"schafft",
+	"schal",
+	"schalen",
+	"schar",
+	"scharfe",
+	"scharfem",
+	"scharfen",
+	"scharfer",
+	"scharfes",
+	"schaue",
+	"schauend",
+	"schaum",
+	"schaust",
+	"schaut",
+	"schaute",
+	"schauten",
+	"schautet",
+	"scheint",
+	"scheren",
+	"schiebt",
+	"schiefe",
+	"schiefem",
+	"schiefen",
+	"schiefer",
+	"schiefes",
+	"schiesse",
+	"schiesst",
+	"schiffe",
+	"schilder",
+	"schlafe",
+	"schlaft",
+	"schlage",
+	"schlagt",
+	"schlanke",
+	"schlaue",
+	"schlauem",
+	"schlauen",
+	"schlauer",
+	"schlaues",
+	"schlief",
+	"schlug",
+	"schläfst",
+	"schläft",
+	"schlägt",
+	"schmale",
+	"schmalem",
+	"schmalen",
+	"schmaler",
+	"schmales",
+	"schmaus",
+	"schmelzt",
+	"schneide",
+	"schnelle",
+	"schnitt",
+	"schnur",
+	"schon",
+	"schopf",
+	"schoss",
+	"schreibe",
+	"schreibt",
+	"schreit",
+	"schrieb",
+	"schräge",
+	"schrägem",
+	"schrägen",
+	"schräger",
+	"schräges",
+	"schränke",
+	"schub",
+	"schuhe",
+	"schulden",
+	"schulen",
+	"schur",
+	"schutz",
+	"schwache",
+	"schwamm",
+	"schwarze",
+	"schweige",
+	"schweigt",
+	"schwere",
+	"schwerem",
+	"schweren",
+	"schwerer",
+	"schweres",
+	"schwieg",
+	"schwimme",
+	"schwimmt",
+	"schwäne",
+	"schärfer",
+	"schätze",
+	"schöne",
+	"schönem",
+	"schönen",
+	"schöner",
+	"schönere",
+	"schönes",
+	"schönste",
+	"sechs",
+	"seele",
+	"segen",
+	"sehe",
+	"sehend",
+	"sehne",
+	"sehr",
+	"seht",
+	"seid",
+	"seide",
+	"seien",
+	"seifen",
+	"seile",
+	"seine",
+	"seinem",
+	"seinen",
+	"seiner",
+	"seiten",
+	"sekte",
+	"sekunden",
+	"selbst",
+	"senat",
+	"sendend",
+	"senke",
+	"sense",
+	"serie",
+	"sesam",
+	"setze",
+	"setzend",
+	"setzt",
+	"setzte",
+	"setzten",
+	"setztest",
+	"setztet",
+	"sich",
+	"siebe",
+	"sieben",
+	"sieger",
+	"siehst",
+	"sieht",
+	"silbe",
+	"sind",
+	"singe",
+	"singend",
+	"singst",
+	"singt",
+	"sinke",
+	"sinkend",
+	"sinkt",
+	"sinne",
+	"sippe",
+	"sirup",
+	"sitten",
+	"sitze",
+	"sitzend",
+	"sitzt",
+	"skala",
+	"skizze",
+	"slang",
+	"socken",
+	"sofas",
+	"sogar",
+	"sohle",
+	"solche",
+	"soll",
+	"sollst",
+	"sollte",
+	"sollten",
+	"sonde",
+	"sonnen",
+	"sonntag",
+	"sonst",
+	"sorgend",
+	"sorgst",
+	"sorgt",
+	"sorgte",
+	"sorgten",
+	"sorgtest",
+	"sorgtet",
+	"sorte",
+	"spalt",
+	"spangen",
+	"spann",
+	"spare",
+	"sparend",
+	"sparst",
+	"spart",
+	"sparte",
+	"sparten",
+	"spartest",
+	"spartet",
+	"spatz",
+	"speck",
+	"speer",
+	"sperre",
+	"spiele",
+	"spielend",
+	"spielst",
+	"spielt",
+	"spielte",
+	"spielten",
+	"spieltet",
+	"spinnen",
+	"spion",
+	"spitzen",
+	"sporn",
+	"spott",
+	"sprach",
+	"sprachen",
+	"sprang",
+	"spreche",
+	"sprecht",
+	"spreu",
+	"sprichst",
+	"spricht",
+	"springe",
+	"springt",
+	"spuren",
+	"späte",
+	"spätem",
+	"späten",
+	"später",
+	"spätere",
+	"späteren",
+	"späteres",
+	"spätes",
+	"späteste",
+	"staaten",
+	"stach",
+	"stahl",
+	"stand",
+	"standen",
+	"stapel",
+	"starb",
+	"starben",
+	"starke",
+	"starkem",
+	"starken",
+	"starker",
+	"starkes",
+	"start",
+	"statt",
+	"steak",
+	"steche",
+	"stechend",
+	"stecht",
+	"stecke",
+	"steckend",
+	"steckst",
+	"steckt",
+	"steckte",
+	"steckten",
+	"stecktet",
+	"stehe",
+	"stehend",
+	"stehle",
+	"stehlend",
+	"stehlt",
+	"stehst",
+	"steht",
+	"steige",
+	"steigend",
+	"steigst",
+	"steigt",
+	"steile",
+	"steilem",
+	"steilen",
+	"steiler",
+	"steilere",
+	"steiles",
+	"steilste",
+	"steine",
+	"stellend",
+	"stellst",
+	"stellt",
+	"stellte",
+	"stellten",
+	"stelltet",
+	"sterbe",
+	"sterbend",
+	"sterbt",
+	"sterne",
+	"stich",
+	"sticht",
+	"stieg",
+	"stiegen",
+	"stiehlt",
+	"stiel",
+	"stier",
+	"stift",
+	"stille",
+	"stillem",
+	"stillen",
+	"stiller",
+	"stillere",
+	"stilles",
+	"stillste",
+	"stimmen",
+	"stirbst",
+	"stirbt",
+	"stoffe",
+	"stolze",
+	"stolzem",
+	"stolzen",
+	"stolzer",
+	"stolzere",
+	"stolzes",
+	"storch",
+	"stoss",
+	"stossend",
+	"stosst",
+	"strassen",
+	"streite",
+	"strick",
+	"strände",
+	"ströme",
+	"stube",
+	"stuck",
+	"studiere",
+	"studiert",
+	"stufen",
+	"stumme",
+	"stummem",
+	"stummen",
+	"stummer",
+	"stummere",
+	"stummes",
+	"stummste",
+	"stumpf",
+	"stunden",
+	"stute",
+	"stäbe",
+	"städte",
+	"ställe",
+	"stämme",
+	"stärker",
+	"stärkere",
+	"stärkste",
+	"stöcke",
+	"stücke",
+	"stühle",
+	"stürme",
+	"suche",
+	"suchend",
+	"suchst",
+	"sucht",
+	"suchte",
+	"suchten",
+	"suchtest",
+	"suchtet",
+	"summe",
+	"sumpf",
+	"suppen",
+	"szene",
+	"säcke",
+	"säfte",
+	"sägen",
+	"säle",
+	"sättel",
+	"sätze",
+	"söhne",
+	"sülze",
+	"sünde",
+	"süsse",
+	"süssem",
+	"süssen",
+	"süsser",
+	"süssere",
+	"süsseren",
+	"süsseres",
+	"süsses",
+	"süsseste",
+	"tabak",
+	"tafeln",
+	"tage",
+	"taler",
+	"talon",
+	"tango",
+	"tanten",
+	"tanze",
+	"tanzend",
+	"tanzt",
+	"tanzte",
+	"tanzten",
+	"tanztest",
+	"tanztet",
+	"tarif",
+	"taschen",
+	"tassen",
+	"taten",
+	"tatze",
+	"tauben",
+	"tauche",
+	"tauchend",
+	"tauchst",
+	"taucht",
+	"tauchte",
+	"tauchten",
+	"tauchtet",
+	"tausend",
+	"taxis",
+	"teich",
+	"teile",
+	"teilend",
+	"teilst",
+	"teilt",
+	"teilte",
+	"teilten",
+	"teiltest",
+	"teiltet",
+	"teint",
+	"tempo",
+	"tenne",
+	"tenor",
+	"terror",
+	"teste",
+	"testen",
+	"testend",
+	"testest",
+	"testet",
+	"testete",
+	"testeten",
+	"testetet",
+	"teufel",
+	"theke",
+	"thema",
+	"thron",
+	"tiefe",
+	"tiefem",
+	"tiefen",
+	"tiefer",
+	"tiefere",
+	"tieferen",
+	"tieferes",
+	"tiefes",
+	"tiefste",
+	"tiefsten",
+	"tiere",
+	"tiger",
+	"tische",
+	"titan",
+	"titel",
+	"toast",
+	"tolle",
+	"tollem",
+	"tollen",
+	"toller",
+	"tollere",
+	"tolleren",
+	"tolleres",
+	"tolles",
+	"tollste",
+	"tollsten",
+	"tonne",
+	"tore",
+	"torte",
+	"traf",
+	"trafen",
+	"trage",
+	"tragend",
+	"tragt",
+	"trank",
+	"tranken",
+	"trasse",
+	"trat",
+	"treck",
+	"treffe",
+	"treffend",
+	"trefft",
+	"treibe",
+	"treibend",
+	"treibt",
+	"trend",
+	"tretend",
+	"treuem",
+	"treuen",
+	"treuer",
+	"treuere",
+	"treueren",
+	"treueres",
+	"treues",
+	"treuste",
+	"treusten",
+	"trick",
+	"trieb",
+	"triffst",
+	"trifft",
+	"trinke",
+	"trinkend",
+	"trinkst",
+	"trinkt",
+	"tritt",
+	"trockene",
+	"troll",
+	"trommeln",
+	"tross",
+	"trost",
+	"trotz",
+	"trug",
+	"trugen",
+	"trupp",
+	"trägst",
+	"trägt",
+	"träume",
+	"träumend",
+	"träumst",
+	"träumt",
+	"träumte",
+	"träumten",
+	"träumtet",
+	"tuben",
+	"tugenden",
+	"tulpen",
+	"tumor",
+	"tunke",
+	"tupfer",
+	"turbo",
+	"tusche",
+	"tust",
+	"typen",
+	"tänze",
+	"töpfe",
+	"töte",
+	"tötend",
+	"tötest",
+	"tötet",
+	"tötete",
+	"töteten",
+	"tötetest",
+	"tötetet",
+	"tücher",
+	"türme",
+	"uhren",
+	"umbau",
+	"umweg",
+	"unfälle",
+	"union",
+	"unmut",
+	"unrat",
+	"unser",
+	"unsere",
+	"untat",
+	"unten",
+	"unter",
+	"urahn",
+	"vasen",
+	"ventil",
+	"vergass",
+	"vergesse",
+	"vergisst",
+	"verliere",
+	"verliert",
+	"verlor",
+	"verloren",
+	"verse",
+	"video",
+	"viel",
+	"viele",
+	"vielen",
+	"vier",
+	"villen",
+	"visum",
+	"vorbei",
+	"vorher",
+	"vorn",
+	"vorne",
+	"votum",
+	"vögel",
+	"waagen",
+	"wache",
+	"wachem",
+	"wachen",
+	"wacher",
+	"wachere",
+	"wacheren",
+	"wacheres",
+	"waches",
+	"wachs",
+	"wachse",
+	"wachsend",
+	"wachst",
+	"wachste",
+	"wachsten",
+	"waden",
+	"wafer",
+	"waffen",
+	"wage",
+	"wagend",
+	"wagst",
+	"wagt",
+	"wagte",
+	"wagten",
+	"wagtest",
+	"wagtet",
+	"wahlen",
+	"wahre",
+	"wahrem",
+	"wahren",
+	"wahrer",
+	"wahrere",
+	"wahreren",
+	"wahreres",
+	"wahres",
+	"wahrste",
+	"wahrsten",
+	"waise",
+	"walze",
+	"wangen",
+	"wanne",
+	"wanze",
+	"waren",
+	"warf",
+	"warfen",
+	"warme",
+	"warmem",
+	"warmen",
+	"warmer",
+	"warmes",
+	"warst",
+	"wart",
+	"warte",
+	"wartend",
+	"wartest",
+	"wartet",
+	"wartete",
+	"warteten",
+	"wartetet",
+	"warum",
+	"warze",
+	"wasche",
+	"waschend",
+	"wascht",
+	"webend",
+	"weber",
+	"webt",
+	"wecke",
+	"weckend",
+	"weckst",
+	"weckt",
+	"weckte",
+	"weckten",
+	"wecktest",
+	"wecktet",
+	"wedel",
+	"weder",
+	"wegen",
+	"wehen",
+	"weiche",
+	"weichem",
+	"weichen",
+	"weicher",
+	"weichere",
+	"weiches",
+	"weichste",
+	"weiher",
+	"weil",
+	"weine",
+	"weinend",
+	"weinst",
+	"weint",
+	"weinte",
+	"weinten",
+	"weintest",
+	"weintet",
+	"weisem",
+	"weisen",
+	"weiser",
+	"weises",
+	"weisse",
+	"weissem",
+	"weissen",
+	"weisser",
+	"weissere",
+	"weisses",
+	"weisst",
+	"weite",
+	"weitem",
+	"weiten",
+	"weiter",
+	"weitere",
+	"weiteren",
+	"weiteres",
+	"weites",
+	"weiteste",
+	"welche",
+	"welcher",
+	"wellen",
+	"welten",
+	"wende",
+	"wenig",
+	"wenn",
+	"werde",
+	"werdend",
+	"werdet",
+	"werfe",
+	"werfend",
+	"werft",
+	"werte",
+	"wespen",
+	"wessen",
+	"weste",
+	"wette",
+	"wicht",
+	"wider",
+	"wieder",
+	"wiege",
+	"wiegend",
+	"wiegt",
+	"wiesen",
+	"wieso",
+	"wilde",
+	"wildem",
+	"wilden",
+	"wilder",
+	"wildere",
+	"wilderen",
+	"wilderes",
+	"wildes",
+	"wildeste",
+	"will",
+	"willst",
+	"wimper",
+	"winde",
+	"winke",
+	"winkend",
+	"winkst",
+	"winkt",
+	"winkte",
+	"winkten",
+	"winktest",
+	"winktet",
+	"wirbel",
+	"wird",
+	"wirfst",
+	"wirft",
+	"wirst",
+	"wissend",
+	"wisst",
+	"witwe",
+	"witze",
+	"woche",
+	"wochen",
+	"wogen",
+	"woher",
+	"wohin",
+	"wohne",
+	"wohnend",
+	"wohnst",
+	"wohnt",
+	"wohnte",
+	"wohnten",
+	"wohntest",
+	"wohntet",
+	"wolken",
+	"wollte",
+	"wollten",
+	"wonne",
+	"worte",
+	"wrack",
+	"wuchs",
+	"wucht",
+	"wunden",
+	"wurde",
+	"wurden",
+	"wurdest",
+	"wusch",
+	"wusste",
+	"wussten",
+	"wächst",
+	"wähle",
+	"wählend",
+	"wählst",
+	"wählt",
+	"wählte",
+	"wählten",
+	"wähltest",
+	"wähltet",
+	"wälder",
+	"wände",
+	"wäre",
+	"wären",
+	"wärmer",
+	"wärmere",
+	"wärmeren",
+	"wärmeres",
+	"wärmste",
+	"wärmsten",
+	"wäschst",
+	"wäscht",
+	"wölfe",
+	"wörter",
+	"wünsche",
+	"wünschst",
+	"wünscht",
+	"wünschte",
+	"würden",
+	"würfel",
+	"würmer",
+	"würste",
+	"wüsten",
+	"yacht",
+	"zacke",
+	"zahle",
+	"zahlend",
+	"zahlst",
+	"zahlt",
+	"zahlte",
+	"zahlten",
+	"zahltest",
+	"zahltet",
+	"zahme",
+	"zahmem",
+	"zahmen",
+	"zahmer",
+	"zahmere",
+	"zahmeren",
+	"zahmeres",
+	"zahmes",
+	"zahmste",
+	"zahmsten",
+	"zangen",
+	"zapfen",
+	"zarte",
+	"zartem",
+	"zarten",
+	"zarter",
+	"zartere",
+	"zarteren",
+	"zarteres",
+	"zartes",
+	"zarteste",
+	"zeche",
+	"zecke",
+	"zehen",
+	"zehn",
+	"zeige",
+	"zeigend",
+	"zeigst",
+	"zeigt",
+	"zeigte",
+	"zeigten",
+	"zeigtest",
+	"zeigtet",
+	"zeilen",
+	"zeiten",
+	"zelle",
+	"zelte",
+	"zenit",
+	"zeuge",
+	"ziegen",
+	"ziehe",
+	"ziehend",
+	"ziehst",
+	"zieht",
+	"ziele",
+	"zielend",
+	"zielst",
+	"zielt",
+	"zielte",
+	"zielten",
+	"zieltest",
+	"zieltet",
+	"zitat",
+	"zitiere",
+	"zitieren",
+	"zitierst",
+	"zitiert",
+	"zitierte",
+	"zitze",
+	"zocker",
+	"zogen",
+	"zuerst",
+	"zunft",
+	"zurück",
+	"zusammen",
+	"zwang",
+	"zwanzig",
+	"zwar",
+	"zwecke",
+	"zwei",
+	"zweige",
+	"zweite",
+	"zwerge",
+	"zwist",
+	"zwölf",
+	"zähe",
+	"zähem",
+	"zähen",
+	"zäher",
+	"zähere",
+	"zäheren",
+	"zäheres",
+	"zähes",
+	"zähle",
+	"zählend",
+	"zählst",
+	"zählt",
+	"zählte",
+	"zählten",
+	"zähltest",
+	"zähltet",
+	"zähne",
+	"zäune",
+	"zölle",
+	"zöpfe",
+	"züge",
+	"äcker",
+	"älter",
+	"ältere",
+	"älteren",
+	"älteres",
+	"älteste",
+	"ältesten",
+	"ängste",
+	"äpfel",
+	"ärmer",
+	"ärmere",
+	"ärmeren",
+	"ärmeres",
+	"ärmste",
+	"ärmsten",
+	"öffne",
+	"öffnend",
+	"öffnest",
+	"öffnet",
+	"öffnete",
+	"öffneten",
+	"öffnetet",
+	"übend",
+	"über",
+	"überall",
+	"übst",
+	"übte",
+	"übten",
+	"übtest",
+	"übtet",
+	"übungen",
+}
@@ -0,0 +1,10691 @@
+package words
+
+// Spanish answers of four to eight letters, from the BIP39 Spanish word list.
+var spanishAnswers = [...]string{
+	"abaco",
+	"abdomen",
+	"abeja",
+	"abierto",
+	"abogado",
+	"abono",
+	"aborto",
+	"abrazo",
+	"abrir",
+	"abuelo",
+	"abuso",
+	"acabar",
+	"academia",
+	"acceso",
+	"accion",
+	"aceite",
+	"acelga",
+	"acento",
+	"aceptar",
+	"acido",
+	"aclarar",
+	"acne",
+	"acoger",
+	"acoso",
+	"activo",
+	"acto",
+	"actriz",
+	"actuar",
+	"acudir",
+	"acuerdo",
+	"acusar",
+	"adicto",
+	"admitir",
+	"adoptar",
+	"adorno",
+	"aduana",
+	"adulto",
+	"aereo",
+	"afectar",
+	"aficion",
+	"afinar",
+	"afirmar",
+	"agil",
+	"agitar",
+	"agonia",
+	"agosto",
+	"agotar",
+	"agregar",
+	"agrio",
+	"agua",
+	"agudo",
+	"aguila",
+	"aguja",
+	"ahogo",
+	"ahorro",
+	"aire",
+	"aislar",
+	"ajedrez",
+	"ajeno",
+	"ajuste",
+	"alacran",
+	"alambre",
+	"alarma",
+	"alba",
+	"album",
+	"alcalde",
+	"aldea",
+	"alegre",
+	"alejar",
+	"alerta",
+	"aleta",
+	"alfiler",
+	"alga",
+	"algodon",
+	"aliado",
+	"aliento",
+	"alivio",
+	"alma",
+	"almeja",
+	"almibar",
+	"altar",
+	"alteza",
+	"altivo",
+	"alto",
+	"altura",
+	"alumno",
+	"alzar",
+	"amable",
+	"amante",
+	"amapola",
+	"amargo",
+	"amasar",
+	"ambar",
+	"ambito",
+	"ameno",
+	"amigo",
+	"amistad",
+	"amor",
+	"amparo",
+	"amplio",
+	"ancho",
+	"anciano",
+	"ancla",
+	"andar",
+	"anden",
+	"anemia",
+	"angulo",
+	"anillo",
+	"animo",
+	"anis",
+	"anotar",
+	"antena",
+	"antiguo",
+	"antojo",
+	"anual",
+	"anular",
+	"anuncio",
+	"apagar",
+	"aparato",
+	"apetito",
+	"apio",
+	"aplicar",
+	"apodo",
+	"aporte",
+	"apoyo",
+	"aprender",
+	"aprobar",
+	"apuesta",
+	"apuro",
+	"arado",
+	"arar",
+	"araña",
+	"arbitro",
+	"arbol",
+	"arbusto",
+	"archivo",
+	"arco",
+	"arder",
+	"ardilla",
+	"arduo",
+	"area",
+	"arido",
+	"aries",
+	"armonia",
+	"arnes",
+	"aroma",
+	"arpa",
+	"arpon",
+	"arreglo",
+	"arroz",
+	"arruga",
+	"arte",
+	"artista",
+	"asado",
+	"asalto",
+	"ascenso",
+	"asegurar",
+	"aseo",
+	"asesor",
+	"asiento",
+	"asilo",
+	"asistir",
+	"asno",
+	"asombro",
+	"aspero",
+	"astilla",
+	"astro",
+	"astuto",
+	"asumir",
+	"asunto",
+	"atajo",
+	"ataque",
+	"atar",
+	"atento",
+	"ateo",
+	"atico",
+	"atleta",
+	"atomo",
+	"atraer",
+	"atroz",
+	"atun",
+	"audaz",
+	"audio",
+	"auge",
+	"aula",
+	"aumento",
+	"ausente",
+	"autor",
+	"aval",
+	"avance",
+	"avaro",
+	"avellana",
+	"avena",
+	"avestruz",
+	"avion",
+	"aviso",
+	"ayer",
+	"ayuda",
+	"ayuno",
+	"azafran",
+	"azar",
+	"azote",
+	"azucar",
+	"azufre",
+	"azul",
+	"añadir",
+	"añejo",
+	"baba",
+	"babor",
+	"bache",
+	"bahia",
+	"baile",
+	"bajar",
+	"balanza",
+	"balcon",
+	"balde",
+	"bambu",
+	"banco",
+	"banda",
+	"barba",
+	"barco",
+	"barniz",
+	"barro",
+	"bascula",
+	"baston",
+	"basura",
+	"batalla",
+	"bateria",
+	"batir",
+	"batuta",
+	"baul",
+	"bazar",
+	"baño",
+	"bebe",
+	"bebida",
+	"bello",
+	"besar",
+	"beso",
+	"bestia",
+	"bicho",
+	"bien",
+	"bingo",
+	"blanco",
+	"bloque",
+	"blusa",
+	"bobina",
+	"bobo",
+	"boca",
+	"bocina",
+	"boda",
+	"bodega",
+	"boina",
+	"bola",
+	"bolero",
+	"bolsa",
+	"bomba",
+	"bondad",
+	"bonito",
+	"bono",
+	"bonsai",
+	"borde",
+	"borrar",
+	"bosque",
+	"bote",
+	"botin",
+	"boveda",
+	"bozal",
+	"bravo",
+	"brazo",
+	"brecha",
+	"breve",
+	"brillo",
+	"brinco",
+	"brisa",
+	"broca",
+	"broma",
+	"bronce",
+	"brote",
+	"bruja",
+	"brusco",
+	"bruto",
+	"buceo",
+	"bucle",
+	"bueno",
+	"buey",
+	"bufanda",
+	"bufon",
+	"buho",
+	"buitre",
+	"bulto",
+	"burbuja",
+	"burla",
+	"burro",
+	"buscar",
+	"butaca",
+	"buzon",
+	"caballo",
+	"cabeza",
+	"cabina",
+	"cabra",
+	"cacao",
+	"cadaver",
+	"cadena",
+	"caer",
+	"cafe",
+	"caida",
+	"caiman",
+	"caja",
+	"cajon",
+	"calamar",
+	"calcio",
+	"caldo",
+	"calidad",
+	"calle",
+	"calma",
+	"calor",
+	"calvo",
+	"cama",
+	"cambio",
+	"camello",
+	"camino",
+	"campo",
+	"cancer",
+	"candil",
+	"canela",
+	"canguro",
+	"canica",
+	"canto",
+	"caoba",
+	"caos",
+	"capaz",
+	"capitan",
+	"capote",
+	"captar",
+	"capucha",
+	"cara",
+	"carbon",
+	"carcel",
+	"careta",
+	"carga",
+	"cariño",
+	"carne",
+	"carpeta",
+	"carro",
+	"carta",
+	"casa",
+	"casco",
+	"casero",
+	"caspa",
+	"castor",
+	"catorce",
+	"catre",
+	"caudal",
+	"causa",
+	"cazo",
+	"caña",
+	"cañon",
+	"cebolla",
+	"ceder",
+	"cedro",
+	"celda",
+	"celebre",
+	"celoso",
+	"celula",
+	"cemento",
+	"ceniza",
+	"centro",
+	"cerca",
+	"cerdo",
+	"cereza",
+	"cero",
+	"cerrar",
+	"certeza",
+	"cesped",
+	"cetro",
+	"chacal",
+	"chaleco",
+	"champu",
+	"chancla",
+	"chapa",
+	"charla",
+	"chico",
+	"chiste",
+	"chivo",
+	"choque",
+	"choza",
+	"chuleta",
+	"chupar",
+	"ciclon",
+	"ciego",
+	"cielo",
+	"cien",
+	"cierto",
+	"cifra",
+	"cigarro",
+	"cima",
+	"cinco",
+	"cine",
+	"cinta",
+	"cipres",
+	"circo",
+	"ciruela",
+	"cisne",
+	"cita",
+	"ciudad",
+	"clamor",
+	"clan",
+	"claro",
+	"clase",
+	"clave",
+	"cliente",
+	"clima",
+	"clinica",
+	"cobre",
+	"coccion",
+	"cochino",
+	"cocina",
+	"coco",
+	"codigo",
+	"codo",
+	"cofre",
+	"coger",
+	"cohete",
+	"cojin",
+	"cojo",
+	"cola",
+	"colcha",
+	"colegio",
+	"colgar",
+	"colina",
+	"collar",
+	"colmo",
+	"columna",
+	"combate",
+	"comer",
+	"comida",
+	"comodo",
+	"compra",
+	"conde",
+	"conejo",
+	"conga",
+	"conocer",
+	"consejo",
+	"contar",
+	"copa",
+	"copia",
+	"corazon",
+	"corbata",
+	"corcho",
+	"cordon",
+	"corona",
+	"correr",
+	"coser",
+	"cosmos",
+	"costa",
+	"craneo",
+	"crater",
+	"crear",
+	"crecer",
+	"creido",
+	"crema",
+	"cria",
+	"crimen",
+	"cripta",
+	"crisis",
+	"cromo",
+	"cronica",
+	"croqueta",
+	"crudo",
+	"cruz",
+	"cuadro",
+	"cuarto",
+	"cuatro",
+	"cubo",
+	"cubrir",
+	"cuchara",
+	"cuello",
+	"cuento",
+	"cuerda",
+	"cuesta",
+	"cueva",
+	"cuidar",
+	"culebra",
+	"culpa",
+	"culto",
+	"cumbre",
+	"cumplir",
+	"cuna",
+	"cuneta",
+	"cuota",
+	"cupon",
+	"cupula",
+	"curar",
+	"curioso",
+	"curso",
+	"curva",
+	"cutis",
+	"dama",
+	"danza",
+	"dardo",
+	"datil",
+	"deber",
+	"debil",
+	"decada",
+	"decir",
+	"dedo",
+	"defensa",
+	"definir",
+	"dejar",
+	"delfin",
+	"delgado",
+	"delito",
+	"demora",
+	"denso",
+	"dental",
+	"deporte",
+	"derecho",
+	"derrota",
+	"desayuno",
+	"deseo",
+	"desfile",
+	"desnudo",
+	"destino",
+	"desvio",
+	"detalle",
+	"detener",
+	"deuda",
+	"diablo",
+	"diadema",
+	"diamante",
+	"diana",
+	"diario",
+	"dibujo",
+	"dictar",
+	"diente",
+	"dieta",
+	"diez",
+	"dificil",
+	"digno",
+	"dilema",
+	"diluir",
+	"dinero",
+	"directo",
+	"dirigir",
+	"disco",
+	"diseño",
+	"disfraz",
+	"diva",
+	"divino",
+	"doble",
+	"doce",
+	"dolor",
+	"domingo",
+	"donar",
+	"dorado",
+	"dormir",
+	"dorso",
+	"dosis",
+	"dragon",
+	"droga",
+	"ducha",
+	"duda",
+	"duelo",
+	"dueño",
+	"dulce",
+	"duque",
+	"durar",
+	"dureza",
+	"duro",
+	"ebano",
+	"ebrio",
+	"echar",
+	"ecuador",
+	"edad",
+	"edicion",
+	"edificio",
+	"editor",
+	"educar",
+	"efecto",
+	"eficaz",
+	"ejemplo",
+	"elefante",
+	"elegir",
+	"elemento",
+	"elevar",
+	"elipse",
+	"elite",
+	"elixir",
+	"elogio",
+	"eludir",
+	"embudo",
+	"emitir",
+	"emocion",
+	"empate",
+	"empeño",
+	"empleo",
+	"empresa",
+	"enano",
+	"encargo",
+	"enchufe",
+	"encia",
+	"enemigo",
+	"enero",
+	"enfado",
+	"enfermo",
+	"engaño",
+	"enigma",
+	"enlace",
+	"enorme",
+	"enredo",
+	"ensayo",
+	"enseñar",
+	"entero",
+	"entrar",
+	"envase",
+	"envio",
+	"epoca",
+	"equipo",
+	"erizo",
+	"escala",
+	"escena",
+	"escolar",
+	"escribir",
+	"escudo",
+	"esencia",
+	"esfera",
+	"esfuerzo",
+	"espada",
+	"espejo",
+	"espia",
+	"esposa",
+	"espuma",
+	"esqui",
+	"estar",
+	"este",
+	"estilo",
+	"estufa",
+	"etapa",
+	"eterno",
+	"etica",
+	"etnia",
+	"evadir",
+	"evaluar",
+	"evento",
+	"evitar",
+	"exacto",
+	"examen",
+	"exceso",
+	"excusa",
+	"exento",
+	"exigir",
+	"exilio",
+	"existir",
+	"exito",
+	"experto",
+	"explicar",
+	"exponer",
+	"extremo",
+	"fabrica",
+	"fabula",
+	"fachada",
+	"facil",
+	"factor",
+	"faena",
+	"faja",
+	"falda",
+	"fallo",
+	"falso",
+	"faltar",
+	"fama",
+	"familia",
+	"famoso",
+	"faraon",
+	"farmacia",
+	"farol",
+	"farsa",
+	"fase",
+	"fatiga",
+	"fauna",
+	"favor",
+	"febrero",
+	"fecha",
+	"feliz",
+	"feria",
+	"feroz",
+	"fertil",
+	"fervor",
+	"festin",
+	"fiable",
+	"fianza",
+	"fiar",
+	"fibra",
+	"ficcion",
+	"ficha",
+	"fideo",
+	"fiebre",
+	"fiel",
+	"fiera",
+	"fiesta",
+	"figura",
+	"fijar",
+	"fijo",
+	"fila",
+	"filete",
+	"filial",
+	"filtro",
+	"finca",
+	"fingir",
+	"finito",
+	"firma",
+	"flaco",
+	"flauta",
+	"flecha",
+	"flor",
+	"flota",
+	"fluir",
+	"flujo",
+	"fluor",
+	"fobia",
+	"foca",
+	"fogata",
+	"fogon",
+	"folio",
+	"folleto",
+	"fondo",
+	"forma",
+	"forro",
+	"fortuna",
+	"forzar",
+	"fosa",
+	"foto",
+	"fracaso",
+	"fragil",
+	"franja",
+	"frase",
+	"fraude",
+	"freir",
+	"freno",
+	"fresa",
+	"frio",
+	"frito",
+	"fruta",
+	"fuego",
+	"fuente",
+	"fuerza",
+	"fuga",
+	"fumar",
+	"funcion",
+	"funda",
+	"furgon",
+	"furia",
+	"fusil",
+	"futbol",
+	"futuro",
+	"gacela",
+	"gafas",
+	"gaita",
+	"gajo",
+	"gala",
+	"galeria",
+	"gallo",
+	"gamba",
+	"ganar",
+	"gancho",
+	"ganga",
+	"ganso",
+	"garaje",
+	"garza",
+	"gasolina",
+	"gastar",
+	"gato",
+	"gavilan",
+	"gemelo",
+	"gemir",
+	"genero",
+	"genio",
+	"gente",
+	"geranio",
+	"gerente",
+	"germen",
+	"gesto",
+	"gigante",
+	"gimnasio",
+	"girar",
+	"giro",
+	"glaciar",
+	"globo",
+	"gloria",
+	"golfo",
+	"goloso",
+	"golpe",
+	"goma",
+	"gordo",
+	"gorila",
+	"gorra",
+	"gota",
+	"goteo",
+	"gozar",
+	"grada",
+	"grafico",
+	"grano",
+	"grasa",
+	"gratis",
+	"grave",
+	"grieta",
+	"grillo",
+	"gripe",
+	"gris",
+	"grito",
+	"grosor",
+	"grua",
+	"grueso",
+	"grumo",
+	"grupo",
+	"guante",
+	"guapo",
+	"guardia",
+	"guerra",
+	"guia",
+	"guion",
+	"guiso",
+	"guitarra",
+	"guiño",
+	"gusano",
+	"gustar",
+	"haber",
+	"habil",
+	"hablar",
+	"hacer",
+	"hacha",
+	"hada",
+	"hallar",
+	"hamaca",
+	"harina",
+	"hazaña",
+	"hebilla",
+	"hebra",
+	"hecho",
+	"helado",
+	"helio",
+	"hembra",
+	"herir",
+	"hermano",
+	"heroe",
+	"hervir",
+	"hielo",
+	"hierro",
+	"higado",
+	"higiene",
+	"hijo",
+	"himno",
+	"historia",
+	"hocico",
+	"hogar",
+	"hoguera",
+	"hoja",
+	"hombre",
+	"hongo",
+	"honor",
+	"honra",
+	"hora",
+	"hormiga",
+	"horno",
+	"hostil",
+	"hoyo",
+	"hueco",
+	"huelga",
+	"huerta",
+	"hueso",
+	"huevo",
+	"huida",
+	"huir",
+	"humano",
+	"humedo",
+	"humilde",
+	"humo",
+	"hundir",
+	"huracan",
+	"hurto",
+	"icono",
+	"ideal",
+	"idioma",
+	"idolo",
+	"iglesia",
+	"iglu",
+	"igual",
+	"ilegal",
+	"ilusion",
+	"imagen",
+	"iman",
+	"imitar",
+	"impar",
+	"imperio",
+	"imponer",
+	"impulso",
+	"incapaz",
+	"indice",
+	"inerte",
+	"infiel",
+	"informe",
+	"ingenio",
+	"inicio",
+	"inmenso",
+	"inmune",
+	"innato",
+	"insecto",
+	"instante",
+	"interes",
+	"intimo",
+	"intuir",
+	"inutil",
+	"invierno",
+	"iris",
+	"ironia",
+	"isla",
+	"islote",
+	"jabali",
+	"jabon",
+	"jamon",
+	"jarabe",
+	"jardin",
+	"jarra",
+	"jaula",
+	"jazmin",
+	"jefe",
+	"jeringa",
+	"jinete",
+	"jornada",
+	"joroba",
+	"joven",
+	"joya",
+	"juerga",
+	"jueves",
+	"juez",
+	"jugador",
+	"jugo",
+	"juguete",
+	"juicio",
+	"junco",
+	"jungla",
+	"junio",
+	"juntar",
+	"jupiter",
+	"jurar",
+	"justo",
+	"juvenil",
+	"juzgar",
+	"kilo",
+	"koala",
+	"labio",
+	"lacio",
+	"lacra",
+	"lado",
+	"ladron",
+	"lagarto",
+	"lagrima",
+	"laguna",
+	"laico",
+	"lamer",
+	"lamina",
+	"lampara",
+	"lana",
+	"lancha",
+	"langosta",
+	"lanza",
+	"lapiz",
+	"largo",
+	"larva",
+	"lastima",
+	"lata",
+	"latex",
+	"latir",
+	"laurel",
+	"lavar",
+	"lazo",
+	"leal",
+	"leccion",
+	"leche",
+	"lector",
+	"leer",
+	"legion",
+	"legumbre",
+	"lejano",
+	"lengua",
+	"lento",
+	"leon",
+	"leopardo",
+	"lesion",
+	"letal",
+	"letra",
+	"leve",
+	"leyenda",
+	"leña",
+	"libertad",
+	"libro",
+	"licor",
+	"lider",
+	"lidiar",
+	"lienzo",
+	"liga",
+	"ligero",
+	"lima",
+	"limite",
+	"limon",
+	"limpio",
+	"lince",
+	"lindo",
+	"linea",
+	"lingote",
+	"lino",
+	"linterna",
+	"liquido",
+	"liso",
+	"lista",
+	"litera",
+	"litio",
+	"litro",
+	"llaga",
+	"llama",
+	"llanto",
+	"llave",
+	"llegar",
+	"llenar",
+	"llevar",
+	"llorar",
+	"llover",
+	"lluvia",
+	"lobo",
+	"locion",
+	"loco",
+	"locura",
+	"logica",
+	"logro",
+	"lombriz",
+	"lomo",
+	"lonja",
+	"lote",
+	"lucha",
+	"lucir",
+	"lugar",
+	"lujo",
+	"luna",
+	"lunes",
+	"lupa",
+	"lustro",
+	"luto",
+	"maceta",
+	"macho",
+	"madera",
+	"madre",
+	"maduro",
+	"maestro",
+	"mafia",
+	"magia",
+	"mago",
+	"maiz",
+	"maldad",
+	"maleta",
+	"malla",
+	"malo",
+	"mama",
+	"mambo",
+	"mamut",
+	"manco",
+	"mando",
+	"manejar",
+	"manga",
+	"maniqui",
+	"manjar",
+	"mano",
+	"manso",
+	"manta",
+	"mapa",
+	"maquina",
+	"marco",
+	"marea",
+	"marfil",
+	"margen",
+	"marido",
+	"marmol",
+	"marron",
+	"martes",
+	"marzo",
+	"masa",
+	"mascara",
+	"masivo",
+	"matar",
+	"materia",
+	"matiz",
+	"matriz",
+	"maximo",
+	"mayor",
+	"mazorca",
+	"mañana",
+	"mecha",
+	"medalla",
+	"medio",
+	"medula",
+	"mejilla",
+	"mejor",
+	"melena",
+	"melon",
+	"memoria",
+	"menor",
+	"mensaje",
+	"mente",
+	"menu",
+	"mercado",
+	"merengue",
+	"merito",
+	"meson",
+	"meta",
+	"meter",
+	"metodo",
+	"metro",
+	"mezcla",
+	"miedo",
+	"miel",
+	"miembro",
+	"miga",
+	"milagro",
+	"militar",
+	"millon",
+	"mimo",
+	"mina",
+	"minero",
+	"minimo",
+	"minuto",
+	"miope",
+	"mirar",
+	"misa",
+	"miseria",
+	"misil",
+	"mismo",
+	"mitad",
+	"mito",
+	"mochila",
+	"mocion",
+	"moda",
+	"modelo",
+	"moho",
+	"mojar",
+	"molde",
+	"moler",
+	"molino",
+	"momento",
+	"momia",
+	"monarca",
+	"moneda",
+	"monja",
+	"monto",
+	"morada",
+	"morder",
+	"moreno",
+	"morir",
+	"morro",
+	"morsa",
+	"mortal",
+	"mosca",
+	"mostrar",
+	"motivo",
+	"mover",
+	"movil",
+	"mozo",
+	"moño",
+	"mucho",
+	"mudar",
+	"mueble",
+	"muela",
+	"muerte",
+	"muestra",
+	"mugre",
+	"mujer",
+	"mula",
+	"muleta",
+	"multa",
+	"mundo",
+	"mural",
+	"muro",
+	"musculo",
+	"museo",
+	"musgo",
+	"musica",
+	"muslo",
+	"muñeca",
+	"nacar",
+	"nacion",
+	"nadar",
+	"naipe",
+	"naranja",
+	"nariz",
+	"narrar",
+	"nasal",
+	"natal",
+	"nativo",
+	"natural",
+	"nausea",
+	"naval",
+	"nave",
+	"navidad",
+	"necio",
+	"nectar",
+	"negar",
+	"negocio",
+	"negro",
+	"neon",
+	"nervio",
+	"neto",
+	"neutro",
+	"nevar",
+	"nevera",
+	"nicho",
+	"nido",
+	"niebla",
+	"nieto",
+	"nitido",
+	"nivel",
+	"niñez",
+	"niño",
+	"nobleza",
+	"noche",
+	"nomina",
+	"noria",
+	"norma",
+	"norte",
+	"nota",
+	"noticia",
+	"novato",
+	"novela",
+	"novio",
+	"nube",
+	"nuca",
+	"nucleo",
+	"nudillo",
+	"nudo",
+	"nuera",
+	"nueve",
+	"nuez",
+	"nulo",
+	"numero",
+	"nutria",
+	"oasis",
+	"obeso",
+	"obispo",
+	"objeto",
+	"obra",
+	"obrero",
+	"observar",
+	"obtener",
+	"obvio",
+	"ocaso",
+	"oceano",
+	"ochenta",
+	"ocho",
+	"ocio",
+	"ocre",
+	"octavo",
+	"octubre",
+	"oculto",
+	"ocupar",
+	"ocurrir",
+	"odiar",
+	"odio",
+	"odisea",
+	"oeste",
+	"ofensa",
+	"oferta",
+	"oficio",
+	"ofrecer",
+	"ogro",
+	"oido",
+	"oleada",
+	"olfato",
+	"olivo",
+	"olla",
+	"olmo",
+	"olor",
+	"olvido",
+	"ombligo",
+	"onda",
+	"onza",
+	"opaco",
+	"opcion",
+	"opera",
+	"opinar",
+	"oponer",
+	"optar",
+	"optica",
+	"opuesto",
+	"oracion",
+	"orador",
+	"oral",
+	"orbita",
+	"orca",
+	"orden",
+	"oreja",
+	"organo",
+	"orgia",
+	"orgullo",
+	"oriente",
+	"origen",
+	"orilla",
+	"orquesta",
+	"oruga",
+	"osadia",
+	"oscuro",
+	"osezno",
+	"ostra",
+	"otoño",
+	"otro",
+	"oveja",
+	"ovulo",
+	"oxido",
+	"oxigeno",
+	"oyente",
+	"ozono",
+	"pacto",
+	"padre",
+	"paella",
+	"pagina",
+	"pago",
+	"pais",
+	"pajaro",
+	"palabra",
+	"palco",
+	"paleta",
+	"palido",
+	"palma",
+	"paloma",
+	"palpar",
+	"panal",
+	"panico",
+	"pantera",
+	"papa",
+	"papel",
+	"papilla",
+	"paquete",
+	"parar",
+	"parcela",
+	"pared",
+	"parir",
+	"paro",
+	"parpado",
+	"parque",
+	"parrafo",
+	"parte",
+	"pasar",
+	"paseo",
+	"pasion",
+	"paso",
+	"pasta",
+	"pata",
+	"patio",
+	"patria",
+	"pausa",
+	"pauta",
+	"pavo",
+	"payaso",
+	"pañuelo",
+	"peaton",
+	"pecado",
+	"pecera",
+	"pecho",
+	"pedal",
+	"pedir",
+	"pegar",
+	"peine",
+	"pelar",
+	"peldaño",
+	"pelea",
+	"peligro",
+	"pellejo",
+	"pelo",
+	"peluca",
+	"pena",
+	"pensar",
+	"peon",
+	"peor",
+	"pepino",
+	"pequeño",
+	"pera",
+	"percha",
+	"perder",
+	"pereza",
+	"perfil",
+	"perico",
+	"perla",
+	"permiso",
+	"perro",
+	"persona",
+	"pesa",
+	"pesca",
+	"pesimo",
+	"pestaña",
+	"petalo",
+	"petroleo",
+	"pezuña",
+	"peñon",
+	"picar",
+	"pichon",
+	"piedra",
+	"pierna",
+	"pieza",
+	"pijama",
+	"pilar",
+	"piloto",
+	"pimienta",
+	"pino",
+	"pintor",
+	"pinza",
+	"piojo",
+	"pipa",
+	"pirata",
+	"pisar",
+	"piscina",
+	"piso",
+	"pista",
+	"piton",
+	"pizca",
+	"piña",
+	"placa",
+	"plan",
+	"plata",
+	"playa",
+	"plaza",
+	"pleito",
+	"pleno",
+	"plomo",
+	"pluma",
+	"plural",
+	"pobre",
+	"poco",
+	"poder",
+	"podio",
+	"poema",
+	"poesia",
+	"poeta",
+	"polen",
+	"policia",
+	"pollo",
+	"polvo",
+	"pomada",
+	"pomelo",
+	"pomo",
+	"pompa",
+	"poner",
+	"porcion",
+	"portal",
+	"posada",
+	"poseer",
+	"posible",
+	"poste",
+	"potencia",
+	"potro",
+	"pozo",
+	"prado",
+	"precoz",
+	"pregunta",
+	"premio",
+	"prensa",
+	"preso",
+	"previo",
+	"primo",
+	"principe",
+	"prision",
+	"privar",
+	"proa",
+	"probar",
+	"proceso",
+	"producto",
+	"proeza",
+	"profesor",
+	"programa",
+	"prole",
+	"promesa",
+	"pronto",
+	"propio",
+	"proximo",
+	"prueba",
+	"publico",
+	"puchero",
+	"pudor",
+	"pueblo",
+	"puerta",
+	"puesto",
+	"pulga",
+	"pulir",
+	"pulmon",
+	"pulpo",
+	"pulso",
+	"puma",
+	"punto",
+	"pupa",
+	"pupila",
+	"pure",
+	"puñal",
+	"puño",
+	"quedar",
+	"queja",
+	"quemar",
+	"querer",
+	"queso",
+	"quieto",
+	"quimica",
+	"quince",
+	"quitar",
+	"rabano",
+	"rabia",
+	"rabo",
+	"racion",
+	"radical",
+	"raiz",
+	"rama",
+	"rampa",
+	"rancho",
+	"rango",
+	"rapaz",
+	"rapido",
+	"rapto",
+	"rasgo",
+	"raspa",
+	"rato",
+	"rayo",
+	"raza",
+	"razon",
+	"reaccion",
+	"realidad",
+	"rebaño",
+	"rebote",
+	"recaer",
+	"receta",
+	"rechazo",
+	"recoger",
+	"recreo",
+	"recto",
+	"recurso",
+	"redondo",
+	"reducir",
+	"reflejo",
+	"reforma",
+	"refran",
+	"refugio",
+	"regalo",
+	"regir",
+	"regla",
+	"regreso",
+	"rehen",
+	"reino",
+	"reir",
+	"reja",
+	"relato",
+	"relevo",
+	"relieve",
+	"relleno",
+	"reloj",
+	"remar",
+	"remedio",
+	"remo",
+	"rencor",
+	"rendir",
+	"renta",
+	"reparto",
+	"repetir",
+	"reposo",
+	"reptil",
+	"rescate",
+	"resina",
+	"respeto",
+	"resto",
+	"resumen",
+	"retiro",
+	"retorno",
+	"retrato",
+	"reunir",
+	"reves",
+	"revista",
+	"rezar",
+	"rico",
+	"riego",
+	"rienda",
+	"riesgo",
+	"rifa",
+	"rigido",
+	"rigor",
+	"rincon",
+	"riqueza",
+	"risa",
+	"ritmo",
+	"rito",
+	"rizo",
+	"riñon",
+	"roble",
+	"roce",
+	"rociar",
+	"rodar",
+	"rodeo",
+	"rodilla",
+	"roer",
+	"rojizo",
+	"rojo",
+	"romero",
+	"romper",
+	"ronco",
+	"ronda",
+	"ropa",
+	"ropero",
+	"rosa",
+	"rosca",
+	"rostro",
+	"rotar",
+	"rubi",
+	"rubor",
+	"rudo",
+	"rueda",
+	"rugir",
+	"ruido",
+	"ruina",
+	"ruleta",
+	"rulo",
+	"rumbo",
+	"rumor",
+	"ruptura",
+	"ruta",
+	"rutina",
+	"sabado",
+	"saber",
+	"sabio",
+	"sable",
+	"sacar",
+	"sagaz",
+	"sagrado",
+	"sala",
+	"saldo",
+	"salero",
+	"salir",
+	"salmon",
+	"salon",
+	"salsa",
+	"salto",
+	"salud",
+	"salvar",
+	"samba",
+	"sancion",
+	"sandia",
+	"sanear",
+	"sangre",
+	"sanidad",
+	"sano",
+	"santo",
+	"sapo",
+	"saque",
+	"sardina",
+	"sarten",
+	"sastre",
+	"satan",
+	"sauna",
+	"saxofon",
+	"seccion",
+	"seco",
+	"secreto",
+	"secta",
+	"seguir",
+	"seis",
+	"sello",
+	"selva",
+	"semana",
+	"semilla",
+	"senda",
+	"sensor",
+	"separar",
+	"sepia",
+	"sequia",
+	"serie",
+	"sermon",
+	"servir",
+	"sesenta",
+	"sesion",
+	"seta",
+	"setenta",
+	"severo",
+	"sexo",
+	"sexto",
+	"señal",
+	"señor",
+	"sidra",
+	"siesta",
+	"siete",
+	"siglo",
+	"signo",
+	"silaba",
+	"silbar",
+	"silencio",
+	"silla",
+	"simbolo",
+	"simio",
+	"sirena",
+	"sistema",
+	"sitio",
+	"situar",
+	"sobre",
+	"socio",
+	"sodio",
+	"solapa",
+	"soldado",
+	"soledad",
+	"solido",
+	"soltar",
+	"solucion",
+	"sombra",
+	"sondeo",
+	"sonido",
+	"sonoro",
+	"sonrisa",
+	"sopa",
+	"soplar",
+	"soporte",
+	"sordo",
+	"sorpresa",
+	"sorteo",
+	"sosten",
+	"sotano",
+	"suave",
+	"subir",
+	"suceso",
+	"sudor",
+	"suegra",
+	"suelo",
+	"suerte",
+	"sueño",
+	"sufrir",
+	"sujeto",
+	"sultan",
+	"sumar",
+	"superar",
+	"suplir",
+	"suponer",
+	"supremo",
+	"surco",
+	"sureño",
+	"surgir",
+	"susto",
+	"sutil",
+	"tabaco",
+	"tabique",
+	"tabla",
+	"tabu",
+	"taco",
+	"tacto",
+	"tajo",
+	"talar",
+	"talco",
+	"talento",
+	"talla",
+	"talon",
+	"tamaño",
+	"tambor",
+	"tango",
+	"tanque",
+	"tapa",
+	"tapete",
+	"tapia",
+	"tapon",
+	"taquilla",
+	"tarde",
+	"tarea",
+	"tarifa",
+	"tarjeta",
+	"tarot",
+	"tarro",
+	"tarta",
+	"tatuaje",
+	"tauro",
+	"taza",
+	"tazon",
+	"teatro",
+	"techo",
+	"tecla",
+	"tecnica",
+	"tejado",
+	"tejer",
+	"tejido",
+	"tela",
+	"telefono",
+	"tema",
+	"temor",
+	"templo",
+	"tenaz",
+	"tender",
+	"tener",
+	"tenis",
+	"tenso",
+	"teoria",
+	"terapia",
+	"terco",
+	"termino",
+	"ternura",
+	"terror",
+	"tesis",
+	"tesoro",
+	"testigo",
+	"tetera",
+	"texto",
+	"tibio",
+	"tiburon",
+	"tiempo",
+	"tienda",
+	"tierra",
+	"tieso",
+	"tigre",
+	"tijera",
+	"tilde",
+	"timbre",
+	"timido",
+	"timo",
+	"tinta",
+	"tipico",
+	"tipo",
+	"tira",
+	"tiron",
+	"titan",
+	"titere",
+	"titulo",
+	"tiza",
+	"toalla",
+	"tobillo",
+	"tocar",
+	"tocino",
+	"todo",
+	"toga",
+	"toldo",
+	"tomar",
+	"tono",
+	"tonto",
+	"topar",
+	"tope",
+	"toque",
+	"torax",
+	"torero",
+	"tormenta",
+	"torneo",
+	"toro",
+	"torpedo",
+	"torre",
+	"torso",
+	"tortuga",
+	"tosco",
+	"toser",
+	"toxico",
+	"trabajo",
+	"tractor",
+	"traer",
+	"trafico",
+	"trago",
+	"traje",
+	"tramo",
+	"trance",
+	"trato",
+	"trauma",
+	"trazar",
+	"trebol",
+	"tregua",
+	"treinta",
+	"tren",
+	"trepar",
+	"tres",
+	"tribu",
+	"trigo",
+	"tripa",
+	"triste",
+	"triunfo",
+	"trofeo",
+	"trompa",
+	"tronco",
+	"tropa",
+	"trote",
+	"trozo",
+	"truco",
+	"trueno",
+	"trufa",
+	"tuberia",
+	"tubo",
+	"tuerto",
+	"tumba",
+	"tumor",
+	"tunel",
+	"tunica",
+	"turbina",
+	"turismo",
+	"turno",
+	"tutor",
+	"ubicar",
+	"ulcera",
+	"umbral",
+	"unidad",
+	"unir",
+	"universo",
+	"untar",
+	"urbano",
+	"urbe",
+	"urgente",
+	"urna",
+	"usar",
+	"usuario",
+	"util",
+	"utopia",
+	"vaca",
+	"vacio",
+	"vacuna",
+	"vagar",
+	"vago",
+	"vaina",
+	"vajilla",
+	"vale",
+	"valido",
+	"valle",
+	"valor",
+	"valvula",
+	"vampiro",
+	"vara",
+	"variar",
+	"varon",
+	"vaso",
+	"vecino",
+	"vector",
+	"vehiculo",
+	"veinte",
+	"vejez",
+	"vela",
+	"velero",
+	"veloz",
+	"vena",
+	"vencer",
+	"venda",
+	"veneno",
+	"vengar",
+	"venir",
+	"venta",
+	"venus",
+	"verano",
+	"verbo",
+	"verde",
+	"vereda",
+	"verja",
+	"verso",
+	"verter",
+	"viaje",
+	"vibrar",
+	"vicio",
+	"victima",
+	"vida",
+	"video",
+	"vidrio",
+	"viejo",
+	"viernes",
+	"vigor",
+	"villa",
+	"vinagre",
+	"vino",
+	"violin",
+	"viral",
+	"virgo",
+	"virtud",
+	"visor",
+	"vispera",
+	"vista",
+	"vitamina",
+	"viudo",
+	"vivaz",
+	"vivero",
+	"vivir",
+	"vivo",
+	"viñedo",
+	"volcan",
+	"volumen",
+	"volver",
+	"voraz",
+	"votar",
+	"voto",
+	"vuelo",
+	"vulgar",
+	"yacer",
+	"yate",
+	"yegua",
+	"yema",
+	"yerno",
+	"yeso",
+	"yodo",
+	"yoga",
+	"yogur",
+	"zafiro",
+	"zanja",
+	"zapato",
+	"zarza",
+	"zona",
+	"zorro",
+	"zumo",
+	"zurdo",
+}
+
+// Other Spanish words that may be guessed: common words missing from the
+// answers, and the plurals and verb forms of the answers.
+var spanishAllowed = [...]string{
+	"abacos",
+	"abajo",
+	"abejas",
+	"abeto",
+	"abierta",
+	"abiertas",
+	"abiertos",
+	"abogada",
+	"abogadas",
+	"abogados",
+	"abonos",
+	"abortos",
+	"abrazos",
+	"abria",
+	"abriamos",
+	"abrian",
+	"abrias",
+	"abriendo",
+	"abril",
+	"abrimos",
+	"abrira",
+	"abriran",
+	"abriras",
+	"abrire",
+	"abriria",
+	"abririan",
+	"abririas",
+	"abuela",
+	"abuelas",
+	"abuelos",
+	"aburrida",
+	"aburrido",
+	"abusos",
+	"acababa",
+	"acababan",
+	"acababas",
+	"acabada",
+	"acabadas",
+	"acabado",
+	"acabados",
+	"acabamos",
+	"acabando",
+	"acabara",
+	"acabaran",
+	"acabaras",
+	"acabare",
+	"acabaria",
+	"acabaron",
+	"acabase",
+	"acabasen",
+	"acabaste",
+	"acabe",
+	"acabo",
+	"acaso",
+	"accesos",
+	"acciones",
+	"aceites",
+	"acelgas",
+	"acentos",
+	"aceptaba",
+	"aceptada",
+	"aceptado",
+	"aceptara",
+	"aceptare",
+	"aceptase",
+	"acepte",
+	"acepto",
+	"acero",
+	"acida",
+	"acidas",
+	"acidos",
+	"aclaraba",
+	"aclarada",
+	"aclarado",
+	"aclarara",
+	"aclarare",
+	"aclarase",
+	"aclare",
+	"aclaro",
+	"acnes",
+	"acogemos",
+	"acogera",
+	"acogeran",
+	"acogeras",
+	"acogere",
+	"acogeria",
+	"acogia",
+	"acogian",
+	"acogias",
+	"acogida",
+	"acogidas",
+	"acogido",
+	"acogidos",
+	"acosos",
+	"activa",
+	"activas",
+	"activos",
+	"actor",
+	"actos",
+	"actrices",
+	"actuaba",
+	"actuaban",
+	"actuabas",
+	"actuada",
+	"actuadas",
+	"actuado",
+	"actuados",
+	"actuamos",
+	"actuando",
+	"actuara",
+	"actuaran",
+	"actuaras",
+	"actuare",
+	"actuaria",
+	"actuaron",
+	"actuase",
+	"actuasen",
+	"actuaste",
+	"actue",
+	"actuo",
+	"acudia",
+	"acudian",
+	"acudias",
+	"acudida",
+	"acudidas",
+	"acudido",
+	"acudidos",
+	"acudimos",
+	"acudira",
+	"acudiran",
+	"acudiras",
+	"acudire",
+	"acudiria",
+	"acuerdos",
+	"acusa",
+	"acusaba",
+	"acusaban",
+	"acusabas",
+	"acusada",
+	"acusadas",
+	"acusado",
+	"acusados",
+	"acusamos",
+	"acusando",
+	"acusara",
+	"acusaran",
+	"acusaras",
+	"acusare",
+	"acusaria",
+	"acusaron",
+	"acusase",
+	"acusasen",
+	"acusaste",
+	"acuse",
+	"acuso",
+	"adelante",
+	"ademas",
+	"adentro",
+	"adicta",
+	"adictas",
+	"adictos",
+	"adios",
+	"admitia",
+	"admitian",
+	"admitias",
+	"admitida",
+	"admitido",
+	"admitira",
+	"admitire",
+	"adobe",
+	"adonde",
+	"adoptaba",
+	"adoptada",
+	"adoptado",
+	"adoptara",
+	"adoptare",
+	"adoptase",
+	"adopte",
+	"adopto",
+	"adornos",
+	"aduanas",
+	"adulta",
+	"adultas",
+	"adultos",
+	"aerea",
+	"aereas",
+	"aereos",
+	"afectaba",
+	"afectada",
+	"afectado",
+	"afectara",
+	"afectare",
+	"afectase",
+	"afecte",
+	"afecto",
+	"afinaba",
+	"afinaban",
+	"afinabas",
+	"afinada",
+	"afinadas",
+	"afinado",
+	"afinados",
+	"afinamos",
+	"afinando",
+	"afinara",
+	"afinaran",
+	"afinaras",
+	"afinare",
+	"afinaria",
+	"afinaron",
+	"afinase",
+	"afinasen",
+	"afinaste",
+	"afine",
+	"afino",
+	"afirmaba",
+	"afirmada",
+	"afirmado",
+	"afirmara",
+	"afirmare",
+	"afirmase",
+	"afirme",
+	"afirmo",
+	"afuera",
+	"agiles",
+	"agitaba",
+	"agitaban",
+	"agitabas",
+	"agitada",
+	"agitadas",
+	"agitado",
+	"agitados",
+	"agitamos",
+	"agitando",
+	"agitara",
+	"agitaran",
+	"agitaras",
+	"agitare",
+	"agitaria",
+	"agitaron",
+	"agitase",
+	"agitasen",
+	"agitaste",
+	"agite",
+	"agito",
+	"agonias",
+	"agostos",
+	"agotaba",
+	"agotaban",
+	"agotabas",
+	"agotada",
+	"agotadas",
+	"agotado",
+	"agotados",
+	"agotamos",
+	"agotando",
+	"agotara",
+	"agotaran",
+	"agotaras",
+	"agotare",
+	"agotaria",
+	"agotaron",
+	"agotase",
+	"agotasen",
+	"agotaste",
+	"agote",
+	"agoto",
+	"agregaba",
+	"agregada",
+	"agregado",
+	"agregara",
+	"agregare",
+	"agregase",
+	"agrego",
+	"agregue",
+	"agria",
+	"agrias",
+	"agrios",
+	"aguas",
+	"aguda",
+	"agudas",
+	"agudos",
+	"aguilas",
+	"agujas",
+	"ahogos",
+	"ahora",
+	"ahorita",
+	"ahorros",
+	"aires",
+	"aislaba",
+	"aislaban",
+	"aislabas",
+	"aislada",
+	"aisladas",
+	"aislado",
+	"aislados",
+	"aislamos",
+	"aislando",
+	"aislara",
+	"aislaran",
+	"aislaras",
+	"aislare",
+	"aislaria",
+	"aislaron",
+	"aislase",
+	"aislasen",
+	"aislaste",
+	"aisle",
+	"aislo",
+	"ajena",
+	"ajenas",
+	"ajenos",
+	"ajustes",
+	"alado",
+	"alambres",
+	"alamo",
+	"alarmas",
+	"albas",
+	"alcaldes",
+	"alce",
+	"alcoba",
+	"aldeas",
+	"alegres",
+	"alegria",
+	"alejaba",
+	"alejaban",
+	"alejabas",
+	"alejada",
+	"alejadas",
+	"alejado",
+	"alejados",
+	"alejamos",
+	"alejando",
+	"alejara",
+	"alejaran",
+	"alejaras",
+	"alejare",
+	"alejaria",
+	"alejaron",
+	"alejase",
+	"alejasen",
+	"alejaste",
+	"aleje",
+	"alejo",
+	"alero",
+	"alertas",
+	"aletas",
+	"algas",
+	"algo",
+	"alguien",
+	"alguna",
+	"algunas",
+	"alguno",
+	"algunos",
+	"aliada",
+	"aliadas",
+	"aliados",
+	"aliar",
+	"alias",
+	"alientos",
+	"alivios",
+	"alla",
+	"alli",
+	"almas",
+	"almejas",
+	"almorce",
+	"almorzar",
+	"almorzo",
+	"almuerzo",
+	"alta",
+	"altares",
+	"altas",
+	"altezas",
+	"altiva",
+	"altivas",
+	"altivos",
+	"altos",
+	"alturas",
+	"alumna",
+	"alumnas",
+	"alumnos",
+	"alzaba",
+	"alzaban",
+	"alzabas",
+	"alzada",
+	"alzadas",
+	"alzado",
+	"alzados",
+	"alzamos",
+	"alzan",
+	"alzando",
+	"alzara",
+	"alzaran",
+	"alzaras",
+	"alzare",
+	"alzaria",
+	"alzarian",
+	"alzarias",
+	"alzaron",
+	"alzase",
+	"alzasen",
+	"alzaste",
+	"alzo",
+	"amaba",
+	"amabamos",
+	"amaban",
+	"amabas",
+	"amables",
+	"amada",
+	"amadas",
+	"amado",
+	"amados",
+	"amamos",
+	"amando",
+	"amantes",
+	"amapolas",
+	"amar",
+	"amara",
+	"amaran",
+	"amaras",
+	"amare",
+	"amaremos",
+	"amarga",
+	"amargas",
+	"amargos",
+	"amaria",
+	"amarian",
+	"amarias",
+	"amarilla",
+	"amarillo",
+	"amaron",
+	"amasaba",
+	"amasaban",
+	"amasabas",
+	"amasada",
+	"amasadas",
+	"amasado",
+	"amasados",
+	"amasamos",
+	"amasando",
+	"amasara",
+	"amasaran",
+	"amasaras",
+	"amasare",
+	"amasaria",
+	"amasaron",
+	"amasase",
+	"amasasen",
+	"amasaste",
+	"amase",
+	"amasen",
+	"amaso",
+	"amaste",
+	"ambares",
+	"ambas",
+	"ambitos",
+	"ambos",
+	"amena",
+	"amenas",
+	"amenos",
+	"amiga",
+	"amigas",
+	"amigos",
+	"amparos",
+	"amplia",
+	"amplias",
+	"amplios",
+	"ancha",
+	"anchas",
+	"anchos",
+	"anciana",
+	"ancianas",
+	"ancianos",
+	"anclas",
+	"anda",
+	"andaba",
+	"andado",
+	"andan",
+	"andando",
+	"andenes",
+	"ando",
+	"anduve",
+	"anduvo",
+	"anemias",
+	"anexo",
+	"angel",
+	"angulos",
+	"anillos",
+	"anima",
+	"animos",
+	"anoche",
+	"anotaba",
+	"anotaban",
+	"anotabas",
+	"anotada",
+	"anotadas",
+	"anotado",
+	"anotados",
+	"anotamos",
+	"anotando",
+	"anotara",
+	"anotaran",
+	"anotaras",
+	"anotare",
+	"anotaria",
+	"anotaron",
+	"anotase",
+	"anotasen",
+	"anotaste",
+	"anote",
+	"anoto",
+	"antenas",
+	"antes",
+	"antigua",
+	"antiguas",
+	"antiguos",
+	"antojos",
+	"anuales",
+	"anulaba",
+	"anulaban",
+	"anulabas",
+	"anulada",
+	"anuladas",
+	"anulado",
+	"anulados",
+	"anulamos",
+	"anulando",
+	"anulara",
+	"anularan",
+	"anularas",
+	"anulare",
+	"anularia",
+	"anularon",
+	"anulase",
+	"anulasen",
+	"anulaste",
+	"anule",
+	"anulo",
+	"anuncios",
+	"apagaba",
+	"apagaban",
+	"apagabas",
+	"apagada",
+	"apagadas",
+	"apagado",
+	"apagados",
+	"apagamos",
+	"apagando",
+	"apagara",
+	"apagaran",
+	"apagaras",
+	"apagare",
+	"apagaria",
+	"apagaron",
+	"apagase",
+	"apagasen",
+	"apagaste",
+	"apago",
+	"apague",
+	"aparatos",
+	"aparecer",
+	"aparecia",
+	"apellido",
+	"apenas",
+	"apetitos",
+	"apios",
+	"aplicaba",
+	"aplicada",
+	"aplicado",
+	"aplicara",
+	"aplicare",
+	"aplicase",
+	"aplico",
+	"aplique",
+	"apodos",
+	"aportes",
+	"apoyos",
+	"aprendia",
+	"aprobaba",
+	"aprobada",
+	"aprobado",
+	"aprobara",
+	"aprobare",
+	"aprobase",
+	"aprobe",
+	"aprobo",
+	"apuestas",
+	"apuros",
+	"aquel",
+	"aquella",
+	"aquellas",
+	"aquellos",
+	"aqui",
+	"araba",
+	"arabamos",
+	"araban",
+	"arabas",
+	"arada",
+	"aradas",
+	"arados",
+	"aramos",
+	"arando",
+	"arara",
+	"araran",
+	"araras",
+	"arare",
+	"araremos",
+	"araria",
+	"ararian",
+	"ararias",
+	"araron",
+	"arase",
+	"arasen",
+	"araste",
+	"arañas",
+	"arbitros",
+	"arboles",
+	"arbustos",
+	"archivos",
+	"arcos",
+	"ardemos",
+	"ardera",
+	"arderan",
+	"arderas",
+	"ardere",
+	"arderia",
+	"arderian",
+	"arderias",
+	"ardia",
+	"ardiamos",
+	"ardian",
+	"ardias",
+	"ardida",
+	"ardidas",
+	"ardido",
+	"ardidos",
+	"ardiendo",
+	"ardillas",
+	"ardua",
+	"arduas",
+	"arduos",
+	"areas",
+	"arena",
+	"arete",
+	"argot",
+	"arida",
+	"aridas",
+	"aridos",
+	"armas",
+	"armonias",
+	"aromas",
+	"arpas",
+	"arpones",
+	"arreglos",
+	"arriba",
+	"arroces",
+	"arrugas",
+	"artes",
+	"artistas",
+	"asados",
+	"asaltos",
+	"ascensos",
+	"asear",
+	"asegure",
+	"aseguro",
+	"aseos",
+	"asientos",
+	"asilos",
+	"asistia",
+	"asistian",
+	"asistias",
+	"asistida",
+	"asistido",
+	"asistira",
+	"asistire",
+	"asnos",
+	"asombros",
+	"aspera",
+	"asperas",
+	"asperos",
+	"astillas",
+	"astros",
+	"astuta",
+	"astutas",
+	"astutos",
+	"asumia",
+	"asumian",
+	"asumias",
+	"asumida",
+	"asumidas",
+	"asumido",
+	"asumidos",
+	"asumimos",
+	"asumira",
+	"asumiran",
+	"asumiras",
+	"asumire",
+	"asumiria",
+	"asuntos",
+	"ataba",
+	"atabamos",
+	"ataban",
+	"atabas",
+	"atada",
+	"atadas",
+	"atado",
+	"atados",
+	"atajos",
+	"atamos",
+	"atando",
+	"ataques",
+	"atara",
+	"ataran",
+	"ataras",
+	"atare",
+	"ataremos",
+	"ataria",
+	"atarian",
+	"atarias",
+	"ataron",
+	"atase",
+	"atasen",
+	"ataste",
+	"atea",
+	"ateas",
+	"atenta",
+	"atentas",
+	"atentos",
+	"ateos",
+	"aticos",
+	"atlas",
+	"atletas",
+	"atomos",
+	"atraemos",
+	"atraera",
+	"atraeran",
+	"atraeras",
+	"atraere",
+	"atraeria",
+	"atraia",
+	"atraian",
+	"atraias",
+	"atraida",
+	"atraidas",
+	"atraido",
+	"atraidos",
+	"atras",
+	"atroces",
+	"atunes",
+	"audaces",
+	"audios",
+	"auges",
+	"aulas",
+	"aumentos",
+	"aunar",
+	"aunque",
+	"ausentes",
+	"avales",
+	"avances",
+	"avara",
+	"avaras",
+	"avaros",
+	"avenas",
+	"aviones",
+	"avisos",
+	"ayudaba",
+	"ayudaban",
+	"ayudabas",
+	"ayudada",
+	"ayudadas",
+	"ayudado",
+	"ayudados",
+	"ayudamos",
+	"ayudando",
+	"ayudar",
+	"ayudara",
+	"ayudaran",
+	"ayudaras",
+	"ayudare",
+	"ayudaria",
+	"ayudaron",
+	"ayudas",
+	"ayudase",
+	"ayudasen",
+	"ayudaste",
+	"ayude",
+	"ayudo",
+	"ayunos",
+	"azares",
+	"azotes",
+	"azucares",
+	"azufres",
+	"azules",
+	"añadia",
+	"añadian",
+	"añadias",
+	"añadida",
+	"añadidas",
+	"añadido",
+	"añadidos",
+	"añadimos",
+	"añadira",
+	"añadiran",
+	"añadiras",
+	"añadire",
+	"añadiria",
+	"añejos",
+	"años",
+	"babas",
+	"baches",
+	"bahias",
+	"bailaba",
+	"bailaban",
+	"bailabas",
+	"bailada",
+	"bailadas",
+	"bailado",
+	"bailados",
+	"bailamos",
+	"bailando",
+	"bailar",
+	"bailara",
+	"bailaran",
+	"bailaras",
+	"bailare",
+	"bailaria",
+	"bailaron",
+	"bailase",
+	"bailasen",
+	"bailaste",
+	"bailes",
+	"bailo",
+	"baja",
+	"bajaba",
+	"bajaban",
+	"bajabas",
+	"bajada",
+	"bajadas",
+	"bajado",
+	"bajados",
+	"bajamos",
+	"bajando",
+	"bajara",
+	"bajaran",
+	"bajaras",
+	"bajare",
+	"bajaria",
+	"bajarian",
+	"bajarias",
+	"bajaron",
+	"bajas",
+	"bajase",
+	"bajasen",
+	"bajaste",
+	"baje",
+	"bajo",
+	"bajos",
+	"balanzas",
+	"balas",
+	"balcones",
+	"baldes",
+	"balsa",
+	"bambus",
+	"bancos",
+	"bandas",
+	"barata",
+	"baratas",
+	"barato",
+	"baratos",
+	"barbas",
+	"barca",
+	"barcos",
+	"barnices",
+	"barra",
+	"barremos",
+	"barrer",
+	"barrera",
+	"barreran",
+	"barreras",
+	"barrere",
+	"barreria",
+	"barria",
+	"barrian",
+	"barrias",
+	"barrida",
+	"barridas",
+	"barrido",
+	"barridos",
+	"barros",
+	"basculas",
+	"basta",
+	"bastante",
+	"bastones",
+	"basuras",
+	"bata",
+	"batallas",
+	"baterias",
+	"batia",
+	"batiamos",
+	"batian",
+	"batias",
+	"batida",
+	"batidas",
+	"batido",
+	"batidos",
+	"batiendo",
+	"batimos",
+	"batira",
+	"batiran",
+	"batiras",
+	"batire",
+	"batiria",
+	"batirian",
+	"batirias",
+	"batutas",
+	"baules",
+	"bayas",
+	"bazares",
+	"baños",
+	"bebemos",
+	"beber",
+	"bebera",
+	"beberan",
+	"beberas",
+	"bebere",
+	"beberia",
+	"beberian",
+	"beberias",
+	"bebes",
+	"bebia",
+	"bebiamos",
+	"bebian",
+	"bebias",
+	"bebidas",
+	"bebido",
+	"bebidos",
+	"bebiendo",
+	"bella",
+	"bellas",
+	"bellos",
+	"besaba",
+	"besaban",
+	"besabas",
+	"besada",
+	"besadas",
+	"besado",
+	"besados",
+	"besamos",
+	"besando",
+	"besara",
+	"besaran",
+	"besaras",
+	"besare",
+	"besaria",
+	"besarian",
+	"besarias",
+	"besaron",
+	"besase",
+	"besasen",
+	"besaste",
+	"bese",
+	"besos",
+	"bestias",
+	"bichos",
+	"bienes",
+	"bingos",
+	"bizco",
+	"blanca",
+	"blancas",
+	"blancos",
+	"bloques",
+	"blusas",
+	"boba",
+	"bobas",
+	"bobinas",
+	"bobos",
+	"bocas",
+	"bocinas",
+	"bodas",
+	"bodegas",
+	"boinas",
+	"bolas",
+	"boleros",
+	"bolsas",
+	"bombas",
+	"bondades",
+	"bonita",
+	"bonitas",
+	"bonitos",
+	"bonos",
+	"bonsais",
+	"bordes",
+	"borraba",
+	"borraban",
+	"borrabas",
+	"borrada",
+	"borradas",
+	"borrado",
+	"borrados",
+	"borramos",
+	"borrando",
+	"borrara",
+	"borraran",
+	"borraras",
+	"borrare",
+	"borraria",
+	"borraron",
+	"borrase",
+	"borrasen",
+	"borraste",
+	"borre",
+	"borro",
+	"bosques",
+	"botella",
+	"botes",
+	"botines",
+	"bovedas",
+	"bozales",
+	"brava",
+	"bravas",
+	"bravos",
+	"brazos",
+	"brechas",
+	"breves",
+	"brillos",
+	"brincos",
+	"brisas",
+	"brocas",
+	"bromas",
+	"bronces",
+	"brotes",
+	"brujas",
+	"brusca",
+	"bruscas",
+	"bruscos",
+	"bruta",
+	"brutas",
+	"brutos",
+	"buceos",
+	"bucles",
+	"buena",
+	"buenas",
+	"buenos",
+	"bueyes",
+	"bufandas",
+	"bufones",
+	"buhos",
+	"buitres",
+	"bultos",
+	"burbujas",
+	"burlas",
+	"burra",
+	"burras",
+	"burros",
+	"buscaba",
+	"buscaban",
+	"buscabas",
+	"buscada",
+	"buscadas",
+	"buscado",
+	"buscados",
+	"buscamos",
+	"buscando",
+	"buscara",
+	"buscaran",
+	"buscaras",
+	"buscare",
+	"buscaria",
+	"buscaron",
+	"buscase",
+	"buscasen",
+	"buscaste",
+	"busco",
+	"busque",
+	"butacas",
+	"buzones",
+	"buzos",
+	"caballos",
+	"caber",
+	"cabezas",
+	"cabinas",
+	"cable",
+	"cabras",
+	"cacaos",
+	"cace",
+	"cada",
+	"cadenas",
+	"caemos",
+	"caen",
+	"caera",
+	"caeran",
+	"caeras",
+	"caere",
+	"caeremos",
+	"caeria",
+	"caerian",
+	"caerias",
+	"cafes",
+	"caia",
+	"caiamos",
+	"caian",
+	"caias",
+	"caidas",
+	"caido",
+	"caidos",
+	"caiga",
+	"caigo",
+	"caimanes",
+	"cajas",
+	"cajones",
+	"calcios",
+	"caldos",
+	"caliente",
+	"callaba",
+	"callaban",
+	"callabas",
+	"callada",
+	"calladas",
+	"callado",
+	"callados",
+	"callamos",
+	"callando",
+	"callar",
+	"callara",
+	"callaran",
+	"callaras",
+	"callare",
+	"callaria",
+	"callaron",
+	"callase",
+	"callasen",
+	"callaste",
+	"calles",
+	"callo",
+	"calmaba",
+	"calmaban",
+	"calmabas",
+	"calmada",
+	"calmadas",
+	"calmado",
+	"calmados",
+	"calmamos",
+	"calmando",
+	"calmar",
+	"calmara",
+	"calmaran",
+	"calmaras",
+	"calmare",
+	"calmaria",
+	"calmaron",
+	"calmas",
+	"calmase",
+	"calmasen",
+	"calmaste",
+	"calme",
+	"calmo",
+	"calva",
+	"calvas",
+	"calvos",
+	"camas",
+	"cambios",
+	"camellos",
+	"caminos",
+	"campos",
+	"canal",
+	"canceres",
+	"cancion",
+	"candiles",
+	"canelas",
+	"canguros",
+	"canicas",
+	"canoa",
+	"cansaba",
+	"cansaban",
+	"cansabas",
+	"cansada",
+	"cansadas",
+	"cansado",
+	"cansados",
+	"cansamos",
+	"cansando",
+	"cansar",
+	"cansara",
+	"cansaran",
+	"cansaras",
+	"cansare",
+	"cansaria",
+	"cansaron",
+	"cansase",
+	"cansasen",
+	"cansaste",
+	"canse",
+	"canso",
+	"cantaba",
+	"cantaban",
+	"cantabas",
+	"cantada",
+	"cantadas",
+	"cantado",
+	"cantados",
+	"cantamos",
+	"cantando",
+	"cantar",
+	"cantara",
+	"cantaran",
+	"cantaras",
+	"cantare",
+	"cantaria",
+	"cantaron",
+	"cantase",
+	"cantasen",
+	"cantaste",
+	"cante",
+	"cantos",
+	"caobas",
+	"capaces",
+	"capotes",
+	"captaba",
+	"captaban",
+	"captabas",
+	"captada",
+	"captadas",
+	"captado",
+	"captados",
+	"captamos",
+	"captando",
+	"captara",
+	"captaran",
+	"captaras",
+	"captare",
+	"captaria",
+	"captaron",
+	"captase",
+	"captasen",
+	"captaste",
+	"capte",
+	"capto",
+	"capuchas",
+	"caqui",
+	"caras",
+	"carbones",
+	"carceles",
+	"caretas",
+	"cargaba",
+	"cargaban",
+	"cargabas",
+	"cargada",
+	"cargadas",
+	"cargado",
+	"cargados",
+	"cargamos",
+	"cargando",
+	"cargar",
+	"cargara",
+	"cargaran",
+	"cargaras",
+	"cargare",
+	"cargaria",
+	"cargaron",
+	"cargas",
+	"cargase",
+	"cargasen",
+	"cargaste",
+	"cargo",
+	"cargue",
+	"cariños",
+	"carnes",
+	"caro",
+	"caros",
+	"carpa",
+	"carpetas",
+	"carros",
+	"cartas",
+	"casaba",
+	"casaban",
+	"casabas",
+	"casada",
+	"casadas",
+	"casado",
+	"casados",
+	"casamos",
+	"casando",
+	"casar",
+	"casara",
+	"casaran",
+	"casaras",
+	"casare",
+	"casaria",
+	"casarian",
+	"casarias",
+	"casaron",
+	"casas",
+	"casase",
+	"casasen",
+	"casaste",
+	"cascos",
+	"case",
+	"casera",
+	"caseras",
+	"caseros",
+	"casi",
+	"caso",
+	"caspas",
+	"catorces",
+	"catres",
+	"caudales",
+	"causaba",
+	"causaban",
+	"causabas",
+	"causada",
+	"causadas",
+	"causado",
+	"causados",
+	"causamos",
+	"causando",
+	"causar",
+	"causara",
+	"causaran",
+	"causaras",
+	"causare",
+	"causaria",
+	"causaron",
+	"causas",
+	"causase",
+	"causasen",
+	"causaste",
+	"cause",
+	"causo",
+	"cayendo",
+	"cayeron",
+	"cayo",
+	"cazaba",
+	"cazaban",
+	"cazabas",
+	"cazada",
+	"cazadas",
+	"cazado",
+	"cazados",
+	"cazamos",
+	"cazando",
+	"cazar",
+	"cazara",
+	"cazaran",
+	"cazaras",
+	"cazare",
+	"cazaria",
+	"cazarian",
+	"cazarias",
+	"cazaron",
+	"cazase",
+	"cazasen",
+	"cazaste",
+	"cazos",
+	"cañas",
+	"cañones",
+	"cebollas",
+	"cedemos",
+	"cedera",
+	"cederan",
+	"cederas",
+	"cedere",
+	"cederia",
+	"cederian",
+	"cederias",
+	"cedia",
+	"cediamos",
+	"cedian",
+	"cedias",
+	"cedida",
+	"cedidas",
+	"cedido",
+	"cedidos",
+	"cediendo",
+	"cedros",
+	"cejas",
+	"celdas",
+	"celebrar",
+	"celebres",
+	"celebro",
+	"celosa",
+	"celosas",
+	"celosos",
+	"celulas",
+	"cementos",
+	"cena",
+	"cenaba",
+	"cenaban",
+	"cenabas",
+	"cenada",
+	"cenadas",
+	"cenado",
+	"cenados",
+	"cenamos",
+	"cenando",
+	"cenar",
+	"cenara",
+	"cenaran",
+	"cenaras",
+	"cenare",
+	"cenaria",
+	"cenarian",
+	"cenarias",
+	"cenaron",
+	"cenase",
+	"cenasen",
+	"cenaste",
+	"cene",
+	"cenizas",
+	"ceno",
+	"centros",
+	"cercas",
+	"cerda",
+	"cerdas",
+	"cerdos",
+	"cerezas",
+	"ceros",
+	"cerraba",
+	"cerraban",
+	"cerrabas",
+	"cerrada",
+	"cerradas",
+	"cerrado",
+	"cerrados",
+	"cerramos",
+	"cerrando",
+	"cerrara",
+	"cerraran",
+	"cerraras",
+	"cerrare",
+	"cerraria",
+	"cerraron",
+	"cerrase",
+	"cerrasen",
+	"cerraste",
+	"cerre",
+	"cerro",
+	"certezas",
+	"cerveza",
+	"cespedes",
+	"cesta",
+	"cetros",
+	"chacales",
+	"chalecos",
+	"champus",
+	"chanclas",
+	"chapas",
+	"charlaba",
+	"charlada",
+	"charlado",
+	"charlar",
+	"charlara",
+	"charlare",
+	"charlas",
+	"charlase",
+	"charle",
+	"charlo",
+	"chica",
+	"chicas",
+	"chicos",
+	"chino",
+	"chistes",
+	"chivos",
+	"chocaba",
+	"chocaban",
+	"chocabas",
+	"chocada",
+	"chocadas",
+	"chocado",
+	"chocados",
+	"chocamos",
+	"chocando",
+	"chocar",
+	"chocara",
+	"chocaran",
+	"chocaras",
+	"chocare",
+	"chocaria",
+	"chocaron",
+	"chocase",
+	"chocasen",
+	"chocaste",
+	"choco",
+	"choques",
+	"chozas",
+	"chuletas",
+	"chupaba",
+	"chupaban",
+	"chupabas",
+	"chupada",
+	"chupadas",
+	"chupado",
+	"chupados",
+	"chupamos",
+	"chupando",
+	"chupara",
+	"chuparan",
+	"chuparas",
+	"chupare",
+	"chuparia",
+	"chuparon",
+	"chupase",
+	"chupasen",
+	"chupaste",
+	"chupe",
+	"chupo",
+	"ciclones",
+	"ciega",
+	"ciegas",
+	"ciegos",
+	"cielos",
+	"ciento",
+	"cientos",
+	"cierra",
+	"cierro",
+	"cierta",
+	"ciertas",
+	"ciertos",
+	"cifras",
+	"cigarros",
+	"cimas",
+	"cincos",
+	"cines",
+	"cintas",
+	"circos",
+	"ciruelas",
+	"cisnes",
+	"citaba",
+	"citaban",
+	"citabas",
+	"citada",
+	"citadas",
+	"citado",
+	"citados",
+	"citamos",
+	"citando",
+	"citar",
+	"citara",
+	"citaran",
+	"citaras",
+	"citare",
+	"citaria",
+	"citarian",
+	"citarias",
+	"citaron",
+	"citas",
+	"citase",
+	"citasen",
+	"citaste",
+	"cite",
+	"cito",
+	"ciudades",
+	"civil",
+	"clanes",
+	"clara",
+	"claros",
+	"clases",
+	"clavaba",
+	"clavaban",
+	"clavabas",
+	"clavada",
+	"clavadas",
+	"clavado",
+	"clavados",
+	"clavamos",
+	"clavando",
+	"clavar",
+	"clavara",
+	"clavaran",
+	"clavaras",
+	"clavare",
+	"clavaria",
+	"clavaron",
+	"clavase",
+	"clavasen",
+	"clavaste",
+	"claves",
+	"clavo",
+	"clientes",
+	"climas",
+	"clinicas",
+	"cobraba",
+	"cobraban",
+	"cobrabas",
+	"cobrada",
+	"cobradas",
+	"cobrado",
+	"cobrados",
+	"cobramos",
+	"cobrando",
+	"cobrar",
+	"cobrara",
+	"cobraran",
+	"cobraras",
+	"cobrare",
+	"cobraria",
+	"cobraron",
+	"cobrase",
+	"cobrasen",
+	"cobraste",
+	"cobres",
+	"cobro",
+	"cocemos",
+	"cocer",
+	"cocera",
+	"coceran",
+	"coceras",
+	"cocere",
+	"coceria",
+	"cocerian",
+	"cocerias",
+	"coche",
+	"coches",
+	"cochina",
+	"cochinas",
+	"cochinos",
+	"cocia",
+	"cociamos",
+	"cocian",
+	"cocias",
+	"cocida",
+	"cocidas",
+	"cocido",
+	"cocidos",
+	"cocinaba",
+	"cocinada",
+	"cocinado",
+	"cocinar",
+	"cocinara",
+	"cocinare",
+	"cocinas",
+	"cocinase",
+	"cocine",
+	"cocino",
+	"cocos",
+	"codigos",
+	"codos",
+	"cofres",
+	"cogemos",
+	"cogen",
+	"cogera",
+	"cogeran",
+	"cogeras",
+	"cogere",
+	"cogeria",
+	"cogerian",
+	"cogerias",
+	"cogia",
+	"cogiamos",
+	"cogian",
+	"cogias",
+	"cogida",
+	"cogidas",
+	"cogido",
+	"cogidos",
+	"cogiendo",
+	"cohetes",
+	"coja",
+	"cojas",
+	"cojines",
+	"cojos",
+	"colas",
+	"colchas",
+	"colegios",
+	"colgaba",
+	"colgaban",
+	"colgabas",
+	"colgada",
+	"colgadas",
+	"colgado",
+	"colgados",
+	"colgamos",
+	"colgando",
+	"colgara",
+	"colgaran",
+	"colgaras",
+	"colgare",
+	"colgaria",
+	"colgaron",
+	"colgase",
+	"colgasen",
+	"colgaste",
+	"colgo",
+	"colgue",
+	"colinas",
+	"collares",
+	"colmos",
+	"colocaba",
+	"colocada",
+	"colocado",
+	"colocar",
+	"colocara",
+	"colocare",
+	"colocase",
+	"coloco",
+	"coloque",
+	"color",
+	"columnas",
+	"combates",
+	"comemos",
+	"comence",
+	"comentar",
+	"comente",
+	"comento",
+	"comenzar",
+	"comenzo",
+	"comera",
+	"comeran",
+	"comeras",
+	"comere",
+	"comeria",
+	"comerian",
+	"comerias",
+	"comia",
+	"comiamos",
+	"comian",
+	"comias",
+	"comidas",
+	"comido",
+	"comidos",
+	"comiendo",
+	"como",
+	"comoda",
+	"comodas",
+	"comodos",
+	"complete",
+	"completo",
+	"compraba",
+	"comprada",
+	"comprado",
+	"comprar",
+	"comprara",
+	"comprare",
+	"compras",
+	"comprase",
+	"compre",
+	"compro",
+	"comun",
+	"condes",
+	"conduce",
+	"conducia",
+	"conducir",
+	"conduzco",
+	"coneja",
+	"conejas",
+	"conejos",
+	"confiaba",
+	"confiada",
+	"confiado",
+	"confiar",
+	"confiara",
+	"confiare",
+	"confiase",
+	"confie",
+	"confio",
+	"congas",
+	"conoce",
+	"conocen",
+	"conocera",
+	"conocere",
+	"conocia",
+	"conocian",
+	"conocias",
+	"conocida",
+	"conocido",
+	"conozco",
+	"consejos",
+	"contaba",
+	"contaban",
+	"contabas",
+	"contada",
+	"contadas",
+	"contado",
+	"contados",
+	"contamos",
+	"contando",
+	"contara",
+	"contaran",
+	"contaras",
+	"contare",
+	"contaria",
+	"contaron",
+	"contase",
+	"contasen",
+	"contaste",
+	"conte",
+	"contenta",
+	"contento",
+	"conteste",
+	"contesto",
+	"conto",
+	"contra",
+	"contrate",
+	"contrato",
+	"copas",
+	"copiaba",
+	"copiaban",
+	"copiabas",
+	"copiada",
+	"copiadas",
+	"copiado",
+	"copiados",
+	"copiamos",
+	"copiando",
+	"copiar",
+	"copiara",
+	"copiaran",
+	"copiaras",
+	"copiare",
+	"copiaria",
+	"copiaron",
+	"copias",
+	"copiase",
+	"copiasen",
+	"copiaste",
+	"copie",
+	"copio",
+	"coral",
+	"corbatas",
+	"corchos",
+	"cordones",
+	"coronas",
+	"corregia",
+	"corregir",
+	"corremos",
+	"correra",
+	"correran",
+	"correras",
+	"correre",
+	"correria",
+	"corria",
+	"corrian",
+	"corrias",
+	"corrida",
+	"corridas",
+	"corrido",
+	"corridos",
+	"corro",
+	"corta",
+	"cortaba",
+	"cortaban",
+	"cortabas",
+	"cortada",
+	"cortadas",
+	"cortado",
+	"cortados",
+	"cortamos",
+	"cortando",
+	"cortar",
+	"cortara",
+	"cortaran",
+	"cortaras",
+	"cortare",
+	"cortaria",
+	"cortaron",
+	"cortas",
+	"cortase",
+	"cortasen",
+	"cortaste",
+	"corte",
+	"corto",
+	"cortos",
+	"cosa",
+	"cosas",
+	"cosemos",
+	"cosera",
+	"coseran",
+	"coseras",
+	"cosere",
+	"coseria",
+	"coserian",
+	"coserias",
+	"cosia",
+	"cosiamos",
+	"cosian",
+	"cosias",
+	"cosida",
+	"cosidas",
+	"cosido",
+	"cosidos",
+	"cosiendo",
+	"costaba",
+	"costaban",
+	"costabas",
+	"costada",
+	"costadas",
+	"costado",
+	"costados",
+	"costamos",
+	"costando",
+	"costar",
+	"costara",
+	"costaran",
+	"costaras",
+	"costare",
+	"costaria",
+	"costaron",
+	"costas",
+	"costase",
+	"costasen",
+	"costaste",
+	"coste",
+	"costo",
+	"craneos",
+	"crateres",
+	"creaba",
+	"creaban",
+	"creabas",
+	"creada",
+	"creadas",
+	"creado",
+	"creados",
+	"creamos",
+	"creando",
+	"creara",
+	"crearan",
+	"crearas",
+	"creare",
+	"crearia",
+	"crearian",
+	"crearias",
+	"crearon",
+	"crease",
+	"creasen",
+	"creaste",
+	"crece",
+	"crecemos",
+	"crecen",
+	"crecera",
+	"creceran",
+	"creceras",
+	"crecere",
+	"creceria",
+	"crecia",
+	"crecian",
+	"crecias",
+	"crecida",
+	"crecidas",
+	"crecido",
+	"crecidos",
+	"cree",
+	"creemos",
+	"creen",
+	"creer",
+	"creera",
+	"creeran",
+	"creeras",
+	"creere",
+	"creeria",
+	"creerian",
+	"creerias",
+	"creia",
+	"creiamos",
+	"creian",
+	"creias",
+	"creida",
+	"creidas",
+	"creidos",
+	"cremas",
+	"creo",
+	"creyendo",
+	"creyo",
+	"crezco",
+	"criaba",
+	"criaban",
+	"criabas",
+	"criada",
+	"criadas",
+	"criado",
+	"criados",
+	"criamos",
+	"criando",
+	"criar",
+	"criara",
+	"criaran",
+	"criaras",
+	"criare",
+	"criaria",
+	"criarian",
+	"criarias",
+	"criaron",
+	"crias",
+	"criase",
+	"criasen",
+	"criaste",
+	"crie",
+	"crimenes",
+	"crio",
+	"criptas",
+	"cromos",
+	"cronicas",
+	"cruce",
+	"cruces",
+	"cruda",
+	"crudas",
+	"crudos",
+	"cruel",
+	"cruzaba",
+	"cruzaban",
+	"cruzabas",
+	"cruzada",
+	"cruzadas",
+	"cruzado",
+	"cruzados",
+	"cruzamos",
+	"cruzando",
+	"cruzar",
+	"cruzara",
+	"cruzaran",
+	"cruzaras",
+	"cruzare",
+	"cruzaria",
+	"cruzaron",
+	"cruzase",
+	"cruzasen",
+	"cruzaste",
+	"cruzo",
+	"cuadros",
+	"cual",
+	"cuales",
+	"cuando",
+	"cuanta",
+	"cuantas",
+	"cuanto",
+	"cuantos",
+	"cuarenta",
+	"cuarta",
+	"cuartas",
+	"cuartos",
+	"cuatros",
+	"cubierto",
+	"cubos",
+	"cubria",
+	"cubrian",
+	"cubrias",
+	"cubrimos",
+	"cubrira",
+	"cubriran",
+	"cubriras",
+	"cubrire",
+	"cubriria",
+	"cucharas",
+	"cuchillo",
+	"cuellos",
+	"cuenta",
+	"cuentan",
+	"cuentas",
+	"cuentos",
+	"cuerdas",
+	"cuero",
+	"cuerpo",
+	"cuestan",
+	"cuestas",
+	"cuevas",
+	"cuidaba",
+	"cuidaban",
+	"cuidabas",
+	"cuidada",
+	"cuidadas",
+	"cuidado",
+	"cuidados",
+	"cuidamos",
+	"cuidando",
+	"cuidara",
+	"cuidaran",
+	"cuidaras",
+	"cuidare",
+	"cuidaria",
+	"cuidaron",
+	"cuidase",
+	"cuidasen",
+	"cuidaste",
+	"cuide",
+	"cuido",
+	"culebras",
+	"culpas",
+	"cultos",
+	"cultura",
+	"cumbres",
+	"cumplia",
+	"cumplian",
+	"cumplias",
+	"cumplida",
+	"cumplido",
+	"cumplira",
+	"cumplire",
+	"cunas",
+	"cunetas",
+	"cuotas",
+	"cupones",
+	"cupulas",
+	"curaba",
+	"curaban",
+	"curabas",
+	"curada",
+	"curadas",
+	"curado",
+	"curados",
+	"curamos",
+	"curando",
+	"curara",
+	"curaran",
+	"curaras",
+	"curare",
+	"curaria",
+	"curarian",
+	"curarias",
+	"curaron",
+	"curase",
+	"curasen",
+	"curaste",
+	"cure",
+	"curiosa",
+	"curiosas",
+	"curiosos",
+	"curo",
+	"cursos",
+	"curvas",
+	"cuya",
+	"cuyo",
+	"daba",
+	"daban",
+	"dado",
+	"dados",
+	"damas",
+	"damos",
+	"dance",
+	"dando",
+	"danzaba",
+	"danzaban",
+	"danzabas",
+	"danzada",
+	"danzadas",
+	"danzado",
+	"danzados",
+	"danzamos",
+	"danzando",
+	"danzar",
+	"danzara",
+	"danzaran",
+	"danzaras",
+	"danzare",
+	"danzaria",
+	"danzaron",
+	"danzas",
+	"danzase",
+	"danzasen",
+	"danzaste",
+	"danzo",
+	"dara",
+	"dardos",
+	"dare",
+	"daria",
+	"datiles",
+	"datos",
+	"dañaba",
+	"dañaban",
+	"dañabas",
+	"dañada",
+	"dañadas",
+	"dañado",
+	"dañados",
+	"dañamos",
+	"dañando",
+	"dañar",
+	"dañara",
+	"dañaran",
+	"dañaras",
+	"dañare",
+	"dañaria",
+	"dañarian",
+	"dañarias",
+	"dañaron",
+	"dañase",
+	"dañasen",
+	"dañaste",
+	"dañe",
+	"daño",
+	"debajo",
+	"debemos",
+	"debera",
+	"deberan",
+	"deberas",
+	"debere",
+	"deberia",
+	"deberian",
+	"deberias",
+	"debia",
+	"debiamos",
+	"debian",
+	"debias",
+	"debida",
+	"debidas",
+	"debido",
+	"debidos",
+	"debiendo",
+	"debiles",
+	"decadas",
+	"decia",
+	"deciamos",
+	"decian",
+	"decias",
+	"decidia",
+	"decidian",
+	"decidias",
+	"decidida",
+	"decidido",
+	"decidir",
+	"decidira",
+	"decidire",
+	"decima",
+	"decimo",
+	"decimos",
+	"decoraba",
+	"decorada",
+	"decorado",
+	"decorar",
+	"decorara",
+	"decorare",
+	"decorase",
+	"decore",
+	"decoro",
+	"dedos",
+	"defensas",
+	"definia",
+	"definian",
+	"definias",
+	"definida",
+	"definido",
+	"definira",
+	"definire",
+	"dejaba",
+	"dejaban",
+	"dejabas",
+	"dejada",
+	"dejadas",
+	"dejado",
+	"dejados",
+	"dejamos",
+	"dejando",
+	"dejara",
+	"dejaran",
+	"dejaras",
+	"dejare",
+	"dejaria",
+	"dejarian",
+	"dejarias",
+	"dejaron",
+	"dejase",
+	"dejasen",
+	"dejaste",
+	"deje",
+	"dejo",
+	"delante",
+	"delfines",
+	"delgada",
+	"delgadas",
+	"delgados",
+	"delitos",
+	"demoras",
+	"demostre",
+	"demostro",
+	"densa",
+	"densas",
+	"densos",
+	"dentales",
+	"dentro",
+	"denuncie",
+	"denuncio",
+	"depender",
+	"dependia",
+	"deportes",
+	"deposite",
+	"deposito",
+	"deprisa",
+	"derecha",
+	"derechas",
+	"derechos",
+	"derrotar",
+	"derrotas",
+	"derrote",
+	"derroto",
+	"desayune",
+	"descanse",
+	"descanso",
+	"desde",
+	"deseaba",
+	"deseaban",
+	"deseabas",
+	"deseada",
+	"deseadas",
+	"deseado",
+	"deseados",
+	"deseamos",
+	"deseando",
+	"desear",
+	"deseara",
+	"desearan",
+	"desearas",
+	"deseare",
+	"desearia",
+	"desearon",
+	"desease",
+	"deseasen",
+	"deseaste",
+	"desee",
+	"deseos",
+	"desfiles",
+	"desnuda",
+	"desnudas",
+	"desnudos",
+	"despacio",
+	"desperte",
+	"desperto",
+	"despues",
+	"destacar",
+	"destaco",
+	"destaque",
+	"destinos",
+	"desvios",
+	"detalles",
+	"detenia",
+	"detenian",
+	"detenias",
+	"detenida",
+	"detenido",
+	"detras",
+	"deudas",
+	"devolver",
+	"devolvia",
+	"diablos",
+	"diademas",
+	"dianas",
+	"diarios",
+	"dias",
+	"dibujaba",
+	"dibujada",
+	"dibujado",
+	"dibujar",
+	"dibujara",
+	"dibujare",
+	"dibujase",
+	"dibuje",
+	"dibujos",
+	"dice",
+	"dicen",
+	"dices",
+	"dicha",
+	"dicho",
+	"diciendo",
+	"dictaba",
+	"dictaban",
+	"dictabas",
+	"dictada",
+	"dictadas",
+	"dictado",
+	"dictados",
+	"dictamos",
+	"dictando",
+	"dictara",
+	"dictaran",
+	"dictaras",
+	"dictare",
+	"dictaria",
+	"dictaron",
+	"dictase",
+	"dictasen",
+	"dictaste",
+	"dicte",
+	"dicto",
+	"dieces",
+	"dientes",
+	"dieron",
+	"dietas",
+	"diga",
+	"digan",
+	"digas",
+	"digna",
+	"dignas",
+	"dignos",
+	"digo",
+	"dije",
+	"dijeron",
+	"dijimos",
+	"dijo",
+	"dilemas",
+	"diluia",
+	"diluian",
+	"diluias",
+	"diluida",
+	"diluidas",
+	"diluido",
+	"diluidos",
+	"diluimos",
+	"diluira",
+	"diluiran",
+	"diluiras",
+	"diluire",
+	"diluiria",
+	"dimos",
+	"dineros",
+	"diosa",
+	"dira",
+	"dire",
+	"directa",
+	"directas",
+	"directos",
+	"diria",
+	"dirigia",
+	"dirigian",
+	"dirigias",
+	"dirigida",
+	"dirigido",
+	"dirigira",
+	"dirigire",
+	"discos",
+	"discutia",
+	"discutir",
+	"diseñaba",
+	"diseñada",
+	"diseñado",
+	"diseñar",
+	"diseñara",
+	"diseñare",
+	"diseñase",
+	"diseñe",
+	"diseños",
+	"disfrute",
+	"disfruto",
+	"distinto",
+	"divas",
+	"divina",
+	"divinas",
+	"divinos",
+	"doblaba",
+	"doblaban",
+	"doblabas",
+	"doblada",
+	"dobladas",
+	"doblado",
+	"doblados",
+	"doblamos",
+	"doblando",
+	"doblar",
+	"doblara",
+	"doblaran",
+	"doblaras",
+	"doblare",
+	"doblaria",
+	"doblaron",
+	"doblase",
+	"doblasen",
+	"doblaste",
+	"dobles",
+	"doblo",
+	"doces",
+	"dolemos",
+	"doler",
+	"dolera",
+	"doleran",
+	"doleras",
+	"dolere",
+	"doleria",
+	"dolerian",
+	"dolerias",
+	"dolia",
+	"doliamos",
+	"dolian",
+	"dolias",
+	"dolida",
+	"dolidas",
+	"dolido",
+	"dolidos",
+	"domar",
+	"domingos",
+	"donaba",
+	"donaban",
+	"donabas",
+	"donada",
+	"donadas",
+	"donado",
+	"donados",
+	"donamos",
+	"donando",
+	"donara",
+	"donaran",
+	"donaras",
+	"donare",
+	"donaria",
+	"donarian",
+	"donarias",
+	"donaron",
+	"donase",
+	"donasen",
+	"donaste",
+	"donde",
+	"done",
+	"dones",
+	"dono",
+	"dorada",
+	"doradas",
+	"dorados",
+	"dormia",
+	"dormian",
+	"dormias",
+	"dormida",
+	"dormidas",
+	"dormido",
+	"dormidos",
+	"dormimos",
+	"dormira",
+	"dormiran",
+	"dormiras",
+	"dormire",
+	"dormiria",
+	"dorsos",
+	"dotes",
+	"dragones",
+	"drama",
+	"drogas",
+	"duchas",
+	"dudaba",
+	"dudaban",
+	"dudabas",
+	"dudada",
+	"dudadas",
+	"dudado",
+	"dudados",
+	"dudamos",
+	"dudando",
+	"dudar",
+	"dudara",
+	"dudaran",
+	"dudaras",
+	"dudare",
+	"dudaria",
+	"dudarian",
+	"dudarias",
+	"dudaron",
+	"dudas",
+	"dudase",
+	"dudasen",
+	"dudaste",
+	"dude",
+	"dudo",
+	"duelos",
+	"duerme",
+	"duermen",
+	"duermes",
+	"duermo",
+	"dueña",
+	"dueños",
+	"dulces",
+	"dunas",
+	"duques",
+	"dura",
+	"duraba",
+	"duraban",
+	"durabas",
+	"durada",
+	"duradas",
+	"durado",
+	"durados",
+	"duramos",
+	"durando",
+	"durante",
+	"durara",
+	"duraran",
+	"duraras",
+	"durare",
+	"duraria",
+	"durarian",
+	"durarias",
+	"duraron",
+	"duras",
+	"durase",
+	"durasen",
+	"duraste",
+	"dure",
+	"durezas",
+	"durmio",
+	"duros",
+	"ebanos",
+	"ebria",
+	"ebrias",
+	"ebrios",
+	"echaba",
+	"echaban",
+	"echabas",
+	"echada",
+	"echadas",
+	"echado",
+	"echados",
+	"echamos",
+	"echando",
+	"echara",
+	"echaran",
+	"echaras",
+	"echare",
+	"echaria",
+	"echarian",
+	"echarias",
+	"echaron",
+	"echase",
+	"echasen",
+	"echaste",
+	"eche",
+	"echo",
+	"edades",
+	"educaba",
+	"educaban",
+	"educabas",
+	"educada",
+	"educadas",
+	"educado",
+	"educados",
+	"educamos",
+	"educando",
+	"educara",
+	"educaran",
+	"educaras",
+	"educare",
+	"educaria",
+	"educaron",
+	"educase",
+	"educasen",
+	"educaste",
+	"educo",
+	"eduque",
+	"efectos",
+	"eficaces",
+	"ejemplos",
+	"ejercer",
+	"ejercera",
+	"ejercere",
+	"ejercia",
+	"ejercian",
+	"ejercias",
+	"ejercida",
+	"ejercido",
+	"elegia",
+	"elegian",
+	"elegias",
+	"elegida",
+	"elegidas",
+	"elegido",
+	"elegidos",
+	"elegimos",
+	"elegira",
+	"elegiran",
+	"elegiras",
+	"elegire",
+	"elegiria",
+	"elevaba",
+	"elevaban",
+	"elevabas",
+	"elevada",
+	"elevadas",
+	"elevado",
+	"elevados",
+	"elevamos",
+	"elevando",
+	"elevara",
+	"elevaran",
+	"elevaras",
+	"elevare",
+	"elevaria",
+	"elevaron",
+	"elevase",
+	"elevasen",
+	"elevaste",
+	"eleve",
+	"elevo",
+	"elige",
+	"elipses",
+	"elites",
+	"ella",
+	"ellas",
+	"ellos",
+	"elogios",
+	"embudos",
+	"emitia",
+	"emitian",
+	"emitias",
+	"emitida",
+	"emitidas",
+	"emitido",
+	"emitidos",
+	"emitimos",
+	"emitira",
+	"emitiran",
+	"emitiras",
+	"emitire",
+	"emitiria",
+	"empates",
+	"empece",
+	"empezaba",
+	"empezada",
+	"empezado",
+	"empezar",
+	"empezara",
+	"empezare",
+	"empezase",
+	"empezo",
+	"empeños",
+	"empieza",
+	"empiezan",
+	"empiezo",
+	"empleos",
+	"empresas",
+	"empujaba",
+	"empujada",
+	"empujado",
+	"empujar",
+	"empujara",
+	"empujare",
+	"empujase",
+	"empuje",
+	"empujo",
+	"enana",
+	"enanas",
+	"enanos",
+	"encargos",
+	"enchufes",
+	"encias",
+	"encima",
+	"encontre",
+	"encontro",
+	"enemiga",
+	"enemigas",
+	"enemigos",
+	"eneros",
+	"enfados",
+	"enferma",
+	"enfermas",
+	"enfermos",
+	"enfrente",
+	"engañaba",
+	"engañada",
+	"engañado",
+	"engañar",
+	"engañara",
+	"engañare",
+	"engañase",
+	"engañe",
+	"engaños",
+	"enigmas",
+	"enlaces",
+	"enojaba",
+	"enojaban",
+	"enojabas",
+	"enojada",
+	"enojadas",
+	"enojado",
+	"enojados",
+	"enojamos",
+	"enojando",
+	"enojar",
+	"enojara",
+	"enojaran",
+	"enojaras",
+	"enojare",
+	"enojaria",
+	"enojaron",
+	"enojase",
+	"enojasen",
+	"enojaste",
+	"enoje",
+	"enojo",
+	"enormes",
+	"enredos",
+	"ensayos",
+	"enseñaba",
+	"enseñada",
+	"enseñado",
+	"enseñara",
+	"enseñare",
+	"enseñase",
+	"enseñe",
+	"enseño",
+	"entera",
+	"enteras",
+	"enteros",
+	"entonces",
+	"entraba",
+	"entraban",
+	"entrabas",
+	"entrada",
+	"entradas",
+	"entrado",
+	"entrados",
+	"entramos",
+	"entrando",
+	"entrara",
+	"entraran",
+	"entraras",
+	"entrare",
+	"entraria",
+	"entraron",
+	"entrase",
+	"entrasen",
+	"entraste",
+	"entre",
+	"entregar",
+	"entrego",
+	"entregue",
+	"entro",
+	"envases",
+	"enviaba",
+	"enviaban",
+	"enviabas",
+	"enviada",
+	"enviadas",
+	"enviado",
+	"enviados",
+	"enviamos",
+	"enviando",
+	"enviar",
+	"enviara",
+	"enviaran",
+	"enviaras",
+	"enviare",
+	"enviaria",
+	"enviaron",
+	"enviase",
+	"enviasen",
+	"enviaste",
+	"envie",
+	"envios",
+	"epocas",
+	"equipos",
+	"eramos",
+	"eran",
+	"eras",
+	"eres",
+	"erizos",
+	"error",
+	"esas",
+	"escalas",
+	"escapaba",
+	"escapada",
+	"escapado",
+	"escapar",
+	"escapara",
+	"escapare",
+	"escapase",
+	"escape",
+	"escapo",
+	"escenas",
+	"escoba",
+	"esconder",
+	"escondia",
+	"escribia",
+	"escrita",
+	"escrito",
+	"escuchar",
+	"escuche",
+	"escucho",
+	"escudos",
+	"escuela",
+	"esencias",
+	"esferas",
+	"esos",
+	"espadas",
+	"espalda",
+	"espantar",
+	"espante",
+	"espanto",
+	"espejos",
+	"esperaba",
+	"esperada",
+	"esperado",
+	"esperar",
+	"esperara",
+	"esperare",
+	"esperase",
+	"espere",
+	"espero",
+	"espias",
+	"esposas",
+	"esposo",
+	"espumas",
+	"esquis",
+	"esta",
+	"estaba",
+	"estaban",
+	"estado",
+	"estamos",
+	"estan",
+	"estando",
+	"estas",
+	"esten",
+	"estes",
+	"estilos",
+	"estimaba",
+	"estimada",
+	"estimado",
+	"estimar",
+	"estimara",
+	"estimare",
+	"estimase",
+	"estime",
+	"estimo",
+	"esto",
+	"estos",
+	"estoy",
+	"estrecha",
+	"estrecho",
+	"estrella",
+	"estudiar",
+	"estudie",
+	"estudio",
+	"estufas",
+	"estuve",
+	"estuvo",
+	"etapas",
+	"eterna",
+	"eternas",
+	"eternos",
+	"eticas",
+	"etnias",
+	"euros",
+	"evadia",
+	"evadian",
+	"evadias",
+	"evadida",
+	"evadidas",
+	"evadido",
+	"evadidos",
+	"evadimos",
+	"evadira",
+	"evadiran",
+	"evadiras",
+	"evadire",
+	"evadiria",
+	"evaluaba",
+	"evaluada",
+	"evaluado",
+	"evaluara",
+	"evaluare",
+	"evaluase",
+	"evalue",
+	"evaluo",
+	"eventos",
+	"evitaba",
+	"evitaban",
+	"evitabas",
+	"evitada",
+	"evitadas",
+	"evitado",
+	"evitados",
+	"evitamos",
+	"evitando",
+	"evitara",
+	"evitaran",
+	"evitaras",
+	"evitare",
+	"evitaria",
+	"evitaron",
+	"evitase",
+	"evitasen",
+	"evitaste",
+	"evite",
+	"evito",
+	"exacta",
+	"exactas",
+	"exactos",
+	"examenes",
+	"excepto",
+	"excesos",
+	"excusas",
+	"exenta",
+	"exentas",
+	"exentos",
+	"exigia",
+	"exigian",
+	"exigias",
+	"exigida",
+	"exigidas",
+	"exigido",
+	"exigidos",
+	"exigimos",
+	"exigira",
+	"exigiran",
+	"exigiras",
+	"exigire",
+	"exigiria",
+	"exilios",
+	"existia",
+	"existian",
+	"existias",
+	"existida",
+	"existido",
+	"existira",
+	"existire",
+	"exitos",
+	"experta",
+	"expertas",
+	"expertos",
+	"explico",
+	"explique",
+	"exponia",
+	"exponian",
+	"exponias",
+	"expresar",
+	"exprese",
+	"expreso",
+	"extra",
+	"extrema",
+	"extremas",
+	"extremos",
+	"fabricas",
+	"fabulas",
+	"fachadas",
+	"faciles",
+	"faenas",
+	"fajas",
+	"faldas",
+	"fallaba",
+	"fallaban",
+	"fallabas",
+	"fallada",
+	"falladas",
+	"fallado",
+	"fallados",
+	"fallamos",
+	"fallando",
+	"fallar",
+	"fallara",
+	"fallaran",
+	"fallaras",
+	"fallare",
+	"fallaria",
+	"fallaron",
+	"fallase",
+	"fallasen",
+	"fallaste",
+	"falle",
+	"fallos",
+	"falsa",
+	"falsas",
+	"falsos",
+	"falta",
+	"faltaba",
+	"faltaban",
+	"faltabas",
+	"faltada",
+	"faltadas",
+	"faltado",
+	"faltados",
+	"faltamos",
+	"faltando",
+	"faltara",
+	"faltaran",
+	"faltaras",
+	"faltare",
+	"faltaria",
+	"faltaron",
+	"faltase",
+	"faltasen",
+	"faltaste",
+	"falte",
+	"falto",
+	"famas",
+	"familias",
+	"famosa",
+	"famosas",
+	"famosos",
+	"fango",
+	"faraones",
+	"fardo",
+	"faroles",
+	"farsas",
+	"fases",
+	"fatigas",
+	"faunas",
+	"feas",
+	"febreros",
+	"fechas",
+	"felices",
+	"felicite",
+	"felicito",
+	"feos",
+	"ferias",
+	"feroces",
+	"fertiles",
+	"festines",
+	"fiaba",
+	"fiabamos",
+	"fiaban",
+	"fiabas",
+	"fiables",
+	"fiada",
+	"fiadas",
+	"fiado",
+	"fiados",
+	"fiamos",
+	"fiando",
+	"fianzas",
+	"fiara",
+	"fiaran",
+	"fiaras",
+	"fiare",
+	"fiaremos",
+	"fiaria",
+	"fiarian",
+	"fiarias",
+	"fiaron",
+	"fiase",
+	"fiasen",
+	"fiaste",
+	"fibras",
+	"fichas",
+	"fideos",
+	"fiebres",
+	"fieles",
+	"fieras",
+	"fiestas",
+	"figuras",
+	"fija",
+	"fijaba",
+	"fijaban",
+	"fijabas",
+	"fijada",
+	"fijadas",
+	"fijado",
+	"fijados",
+	"fijamos",
+	"fijando",
+	"fijara",
+	"fijaran",
+	"fijaras",
+	"fijare",
+	"fijaria",
+	"fijarian",
+	"fijarias",
+	"fijaron",
+	"fijas",
+	"fijase",
+	"fijasen",
+	"fijaste",
+	"fije",
+	"fijos",
+	"filas",
+	"filetes",
+	"filiales",
+	"filtros",
+	"final",
+	"fincas",
+	"fingia",
+	"fingian",
+	"fingias",
+	"fingida",
+	"fingidas",
+	"fingido",
+	"fingidos",
+	"fingimos",
+	"fingira",
+	"fingiran",
+	"fingiras",
+	"fingire",
+	"fingiria",
+	"finita",
+	"finitas",
+	"finitos",
+	"firmaba",
+	"firmaban",
+	"firmabas",
+	"firmada",
+	"firmadas",
+	"firmado",
+	"firmados",
+	"firmamos",
+	"firmando",
+	"firmar",
+	"firmara",
+	"firmaran",
+	"firmaras",
+	"firmare",
+	"firmaria",
+	"firmaron",
+	"firmas",
+	"firmase",
+	"firmasen",
+	"firmaste",
+	"firme",
+	"firmo",
+	"flaca",
+	"flacas",
+	"flacos",
+	"flautas",
+	"flechas",
+	"floja",
+	"flojas",
+	"flojo",
+	"flojos",
+	"flores",
+	"flotas",
+	"fluia",
+	"fluiamos",
+	"fluian",
+	"fluias",
+	"fluida",
+	"fluidas",
+	"fluido",
+	"fluidos",
+	"fluimos",
+	"fluira",
+	"fluiran",
+	"fluiras",
+	"fluire",
+	"fluiria",
+	"fluirian",
+	"fluirias",
+	"flujos",
+	"fobias",
+	"focas",
+	"fogatas",
+	"fogones",
+	"folios",
+	"folletos",
+	"fondos",
+	"force",
+	"formaba",
+	"formaban",
+	"formabas",
+	"formada",
+	"formadas",
+	"formado",
+	"formados",
+	"formamos",
+	"formando",
+	"formar",
+	"formara",
+	"formaran",
+	"formaras",
+	"formare",
+	"formaria",
+	"formaron",
+	"formas",
+	"formase",
+	"formasen",
+	"formaste",
+	"forme",
+	"formo",
+	"forros",
+	"fortunas",
+	"forzaba",
+	"forzaban",
+	"forzabas",
+	"forzada",
+	"forzadas",
+	"forzado",
+	"forzados",
+	"forzamos",
+	"forzando",
+	"forzara",
+	"forzaran",
+	"forzaras",
+	"forzare",
+	"forzaria",
+	"forzaron",
+	"forzase",
+	"forzasen",
+	"forzaste",
+	"forzo",
+	"fosas",
+	"fosil",
+	"fotos",
+	"fracasar",
+	"fracase",
+	"fracasos",
+	"fragiles",
+	"franjas",
+	"frases",
+	"fraudes",
+	"freia",
+	"freiamos",
+	"freian",
+	"freias",
+	"freimos",
+	"freira",
+	"freiran",
+	"freiras",
+	"freire",
+	"freiria",
+	"freirian",
+	"freirias",
+	"frenaba",
+	"frenaban",
+	"frenabas",
+	"frenada",
+	"frenadas",
+	"frenado",
+	"frenados",
+	"frenamos",
+	"frenando",
+	"frenar",
+	"frenara",
+	"frenaran",
+	"frenaras",
+	"frenare",
+	"frenaria",
+	"frenaron",
+	"frenase",
+	"frenasen",
+	"frenaste",
+	"frene",
+	"frenos",
+	"fresas",
+	"fria",
+	"frias",
+	"frios",
+	"frita",
+	"fritas",
+	"fritos",
+	"frutas",
+	"fuegos",
+	"fuentes",
+	"fuera",
+	"fueron",
+	"fuerte",
+	"fuertes",
+	"fuerzas",
+	"fugas",
+	"fugaz",
+	"fuimos",
+	"fuiste",
+	"fumaba",
+	"fumaban",
+	"fumabas",
+	"fumada",
+	"fumadas",
+	"fumado",
+	"fumados",
+	"fumamos",
+	"fumando",
+	"fumara",
+	"fumaran",
+	"fumaras",
+	"fumare",
+	"fumaria",
+	"fumarian",
+	"fumarias",
+	"fumaron",
+	"fumase",
+	"fumasen",
+	"fumaste",
+	"fume",
+	"fumo",
+	"fundas",
+	"furgones",
+	"furias",
+	"fusiles",
+	"futboles",
+	"futuros",
+	"gacelas",
+	"gaitas",
+	"gajos",
+	"galas",
+	"galerias",
+	"gallos",
+	"gambas",
+	"ganaba",
+	"ganaban",
+	"ganabas",
+	"ganada",
+	"ganadas",
+	"ganado",
+	"ganados",
+	"ganamos",
+	"ganando",
+	"ganara",
+	"ganaran",
+	"ganaras",
+	"ganare",
+	"ganaria",
+	"ganarian",
+	"ganarias",
+	"ganaron",
+	"ganas",
+	"ganase",
+	"ganasen",
+	"ganaste",
+	"ganchos",
+	"gane",
+	"gangas",
+	"gano",
+	"gansos",
+	"garajes",
+	"garra",
+	"garzas",
+	"gastaba",
+	"gastaban",
+	"gastabas",
+	"gastada",
+	"gastadas",
+	"gastado",
+	"gastados",
+	"gastamos",
+	"gastando",
+	"gastara",
+	"gastaran",
+	"gastaras",
+	"gastare",
+	"gastaria",
+	"gastaron",
+	"gastase",
+	"gastasen",
+	"gastaste",
+	"gaste",
+	"gasto",
+	"gata",
+	"gatas",
+	"gatos",
+	"gemela",
+	"gemelas",
+	"gemelos",
+	"gemia",
+	"gemiamos",
+	"gemian",
+	"gemias",
+	"gemida",
+	"gemidas",
+	"gemido",
+	"gemidos",
+	"gemimos",
+	"gemira",
+	"gemiran",
+	"gemiras",
+	"gemire",
+	"gemiria",
+	"gemirian",
+	"gemirias",
+	"generos",
+	"genios",
+	"gentes",
+	"geranios",
+	"gerentes",
+	"germenes",
+	"gestos",
+	"gigantes",
+	"giraba",
+	"giraban",
+	"girabas",
+	"girada",
+	"giradas",
+	"girado",
+	"girados",
+	"giramos",
+	"girando",
+	"girara",
+	"giraran",
+	"giraras",
+	"girare",
+	"giraria",
+	"girarian",
+	"girarias",
+	"giraron",
+	"girase",
+	"girasen",
+	"giraste",
+	"gire",
+	"giros",
+	"globos",
+	"glorias",
+	"gobierno",
+	"goce",
+	"goles",
+	"golfos",
+	"golosa",
+	"golosas",
+	"golosos",
+	"golpeaba",
+	"golpeada",
+	"golpeado",
+	"golpear",
+	"golpeara",
+	"golpeare",
+	"golpease",
+	"golpee",
+	"golpeo",
+	"golpes",
+	"gomas",
+	"gorda",
+	"gordas",
+	"gordos",
+	"gorilas",
+	"gorras",
+	"gotas",
+	"goteos",
+	"gozaba",
+	"gozaban",
+	"gozabas",
+	"gozada",
+	"gozadas",
+	"gozado",
+	"gozados",
+	"gozamos",
+	"gozando",
+	"gozara",
+	"gozaran",
+	"gozaras",
+	"gozare",
+	"gozaria",
+	"gozarian",
+	"gozarias",
+	"gozaron",
+	"gozase",
+	"gozasen",
+	"gozaste",
+	"gozo",
+	"grababa",
+	"grababan",
+	"grababas",
+	"grabada",
+	"grabadas",
+	"grabado",
+	"grabados",
+	"grabamos",
+	"grabando",
+	"grabar",
+	"grabara",
+	"grabaran",
+	"grabaras",
+	"grabare",
+	"grabaria",
+	"grabaron",
+	"grabase",
+	"grabasen",
+	"grabaste",
+	"grabe",
+	"grabo",
+	"gracias",
+	"gradas",
+	"grado",
+	"graficos",
+	"grande",
+	"grandes",
+	"granos",
+	"grasas",
+	"graves",
+	"grietas",
+	"grifo",
+	"grillos",
+	"gripes",
+	"gritaba",
+	"gritaban",
+	"gritabas",
+	"gritada",
+	"gritadas",
+	"gritado",
+	"gritados",
+	"gritamos",
+	"gritando",
+	"gritar",
+	"gritara",
+	"gritaran",
+	"gritaras",
+	"gritare",
+	"gritaria",
+	"gritaron",
+	"gritase",
+	"gritasen",
+	"gritaste",
+	"grite",
+	"gritos",
+	"gruas",
+	"gruesa",
+	"gruesas",
+	"gruesos",
+	"grumos",
+	"grupos",
+	"guantes",
+	"guapa",
+	"guapas",
+	"guapos",
+	"guardaba",
+	"guardada",
+	"guardado",
+	"guardar",
+	"guardara",
+	"guardare",
+	"guardase",
+	"guarde",
+	"guardias",
+	"guardo",
+	"guerras",
+	"guiar",
+	"guias",
+	"guiones",
+	"guisos",
+	"guiños",
+	"gusanos",
+	"gustaba",
+	"gustaban",
+	"gustabas",
+	"gustada",
+	"gustadas",
+	"gustado",
+	"gustados",
+	"gustamos",
+	"gustando",
+	"gustara",
+	"gustaran",
+	"gustaras",
+	"gustare",
+	"gustaria",
+	"gustaron",
+	"gustase",
+	"gustasen",
+	"gustaste",
+	"guste",
+	"gusto",
+	"habemos",
+	"habia",
+	"habiamos",
+	"habian",
+	"habias",
+	"habida",
+	"habidas",
+	"habido",
+	"habidos",
+	"habiendo",
+	"habiles",
+	"habla",
+	"hablaba",
+	"hablaban",
+	"hablabas",
+	"hablada",
+	"habladas",
+	"hablado",
+	"hablados",
+	"hablamos",
+	"hablando",
+	"hablara",
+	"hablaran",
+	"hablaras",
+	"hablare",
+	"hablaria",
+	"hablaron",
+	"hablase",
+	"hablasen",
+	"hablaste",
+	"hable",
+	"hablo",
+	"habra",
+	"habria",
+	"hace",
+	"hacemos",
+	"hacen",
+	"haces",
+	"hachas",
+	"hacia",
+	"haciamos",
+	"hacian",
+	"hacias",
+	"haciendo",
+	"hadas",
+	"haga",
+	"hagan",
+	"hagas",
+	"hago",
+	"hallaba",
+	"hallaban",
+	"hallabas",
+	"hallada",
+	"halladas",
+	"hallado",
+	"hallados",
+	"hallamos",
+	"hallando",
+	"hallara",
+	"hallaran",
+	"hallaras",
+	"hallare",
+	"hallaria",
+	"hallaron",
+	"hallase",
+	"hallasen",
+	"hallaste",
+	"halle",
+	"hallo",
+	"hamacas",
+	"hara",
+	"haran",
+	"hare",
+	"haria",
+	"harinas",
+	"harto",
+	"hasta",
+	"haya",
+	"hayan",
+	"hazañas",
+	"hebillas",
+	"hebras",
+	"hecha",
+	"hechas",
+	"hechos",
+	"helada",
+	"heladas",
+	"helados",
+	"helios",
+	"hembras",
+	"hemos",
+	"heredaba",
+	"heredada",
+	"heredado",
+	"heredar",
+	"heredara",
+	"heredare",
+	"heredase",
+	"herede",
+	"heredo",
+	"heria",
+	"heriamos",
+	"herian",
+	"herias",
+	"herida",
+	"heridas",
+	"herido",
+	"heridos",
+	"herimos",
+	"herira",
+	"heriran",
+	"heriras",
+	"herire",
+	"heriria",
+	"heririan",
+	"heririas",
+	"hermana",
+	"hermanas",
+	"hermanos",
+	"hermosa",
+	"hermosas",
+	"hermoso",
+	"hermosos",
+	"heroes",
+	"hervia",
+	"hervian",
+	"hervias",
+	"hervida",
+	"hervidas",
+	"hervido",
+	"hervidos",
+	"hervimos",
+	"hervira",
+	"herviran",
+	"herviras",
+	"hervire",
+	"herviria",
+	"hice",
+	"hicieron",
+	"hicimos",
+	"hielos",
+	"hierba",
+	"hierros",
+	"higados",
+	"higienes",
+	"higos",
+	"hija",
+	"hijas",
+	"hijos",
+	"himnos",
+	"hizo",
+	"hocicos",
+	"hogares",
+	"hogueras",
+	"hojas",
+	"hola",
+	"hombres",
+	"hongos",
+	"honras",
+	"horas",
+	"hormigas",
+	"hornos",
+	"hostiles",
+	"hotel",
+	"hoyos",
+	"hube",
+	"hubo",
+	"huecos",
+	"huelgas",
+	"huertas",
+	"huesos",
+	"huevos",
+	"huia",
+	"huiamos",
+	"huian",
+	"huias",
+	"huidas",
+	"huido",
+	"huidos",
+	"huimos",
+	"huira",
+	"huiran",
+	"huiras",
+	"huire",
+	"huiremos",
+	"huiria",
+	"huirian",
+	"huirias",
+	"humana",
+	"humanas",
+	"humanos",
+	"humeda",
+	"humedas",
+	"humedos",
+	"humildes",
+	"humor",
+	"humos",
+	"hundia",
+	"hundian",
+	"hundias",
+	"hundida",
+	"hundidas",
+	"hundido",
+	"hundidos",
+	"hundimos",
+	"hundira",
+	"hundiran",
+	"hundiras",
+	"hundire",
+	"hundiria",
+	"hurtos",
+	"ibamos",
+	"iban",
+	"ibas",
+	"iconos",
+	"idea",
+	"ideales",
+	"ideas",
+	"idiomas",
+	"idolos",
+	"iglesias",
+	"iglus",
+	"iguales",
+	"ilegales",
+	"imagenes",
+	"imanes",
+	"imitaba",
+	"imitaban",
+	"imitabas",
+	"imitada",
+	"imitadas",
+	"imitado",
+	"imitados",
+	"imitamos",
+	"imitando",
+	"imitara",
+	"imitaran",
+	"imitaras",
+	"imitare",
+	"imitaria",
+	"imitaron",
+	"imitase",
+	"imitasen",
+	"imitaste",
+	"imite",
+	"imito",
+	"impares",
+	"imperios",
+	"imponia",
+	"imponian",
+	"imponias",
+	"impreso",
+	"impulsos",
+	"incluso",
+	"indices",
+	"indio",
+	"inertes",
+	"infieles",
+	"informes",
+	"ingenios",
+	"iniciaba",
+	"iniciada",
+	"iniciado",
+	"iniciar",
+	"iniciara",
+	"iniciare",
+	"iniciase",
+	"inicie",
+	"inicios",
+	"inmensa",
+	"inmensas",
+	"inmensos",
+	"inmunes",
+	"innata",
+	"innatas",
+	"innatos",
+	"insectos",
+	"intentar",
+	"intente",
+	"intento",
+	"intima",
+	"intimas",
+	"intimos",
+	"intuia",
+	"intuian",
+	"intuias",
+	"intuida",
+	"intuidas",
+	"intuido",
+	"intuidos",
+	"intuimos",
+	"intuira",
+	"intuiran",
+	"intuiras",
+	"intuire",
+	"intuiria",
+	"inutiles",
+	"inventar",
+	"invente",
+	"invento",
+	"invitaba",
+	"invitada",
+	"invitado",
+	"invitar",
+	"invitara",
+	"invitare",
+	"invitase",
+	"invite",
+	"invito",
+	"ironias",
+	"islas",
+	"islotes",
+	"jabalis",
+	"jabones",
+	"jamas",
+	"jamones",
+	"jarabes",
+	"jardines",
+	"jarras",
+	"jaulas",
+	"jazmines",
+	"jefa",
+	"jefes",
+	"jeringas",
+	"jinetes",
+	"jornadas",
+	"jorobas",
+	"jovenes",
+	"joyas",
+	"judia",
+	"jueces",
+	"juega",
+	"juegan",
+	"juegas",
+	"juego",
+	"juegos",
+	"juergas",
+	"jugaba",
+	"jugado",
+	"jugamos",
+	"jugando",
+	"jugar",
+	"jugos",
+	"jugue",
+	"juguetes",
+	"juicios",
+	"julio",
+	"juncos",
+	"junglas",
+	"junios",
+	"junta",
+	"juntaba",
+	"juntaban",
+	"juntabas",
+	"juntada",
+	"juntadas",
+	"juntado",
+	"juntados",
+	"juntamos",
+	"juntando",
+	"juntara",
+	"juntaran",
+	"juntaras",
+	"juntare",
+	"juntaria",
+	"juntaron",
+	"juntas",
+	"juntase",
+	"juntasen",
+	"juntaste",
+	"junte",
+	"junto",
+	"juntos",
+	"juraba",
+	"juraban",
+	"jurabas",
+	"jurada",
+	"juradas",
+	"jurado",
+	"jurados",
+	"juramos",
+	"jurando",
+	"jurara",
+	"juraran",
+	"juraras",
+	"jurare",
+	"juraria",
+	"jurarian",
+	"jurarias",
+	"juraron",
+	"jurase",
+	"jurasen",
+	"juraste",
+	"jure",
+	"juro",
+	"justa",
+	"justas",
+	"justos",
+	"juzgaba",
+	"juzgaban",
+	"juzgabas",
+	"juzgada",
+	"juzgadas",
+	"juzgado",
+	"juzgados",
+	"juzgamos",
+	"juzgando",
+	"juzgara",
+	"juzgaran",
+	"juzgaras",
+	"juzgare",
+	"juzgaria",
+	"juzgaron",
+	"juzgase",
+	"juzgasen",
+	"juzgaste",
+	"juzgo",
+	"juzgue",
+	"kilos",
+	"koalas",
+	"labios",
+	"lacia",
+	"lacias",
+	"lacios",
+	"lacras",
+	"lados",
+	"ladrones",
+	"lagartos",
+	"lago",
+	"lagos",
+	"lagrimas",
+	"lagunas",
+	"laica",
+	"laicas",
+	"laicos",
+	"lamemos",
+	"lamera",
+	"lameran",
+	"lameras",
+	"lamere",
+	"lameria",
+	"lamerian",
+	"lamerias",
+	"lamia",
+	"lamiamos",
+	"lamian",
+	"lamias",
+	"lamida",
+	"lamidas",
+	"lamido",
+	"lamidos",
+	"lamiendo",
+	"laminas",
+	"lamparas",
+	"lanas",
+	"lance",
+	"lanchas",
+	"lanzaba",
+	"lanzaban",
+	"lanzabas",
+	"lanzada",
+	"lanzadas",
+	"lanzado",
+	"lanzados",
+	"lanzamos",
+	"lanzando",
+	"lanzar",
+	"lanzara",
+	"lanzaran",
+	"lanzaras",
+	"lanzare",
+	"lanzaria",
+	"lanzaron",
+	"lanzas",
+	"lanzase",
+	"lanzasen",
+	"lanzaste",
+	"lanzo",
+	"lapices",
+	"larga",
+	"largas",
+	"largos",
+	"larvas",
+	"lastimas",
+	"latas",
+	"latia",
+	"latiamos",
+	"latian",
+	"latias",
+	"latida",
+	"latidas",
+	"latido",
+	"latidos",
+	"latiendo",
+	"latimos",
+	"latira",
+	"latiran",
+	"latiras",
+	"latire",
+	"latiria",
+	"latirian",
+	"latirias",
+	"laureles",
+	"lavaba",
+	"lavaban",
+	"lavabas",
+	"lavada",
+	"lavadas",
+	"lavado",
+	"lavados",
+	"lavamos",
+	"lavando",
+	"lavara",
+	"lavaran",
+	"lavaras",
+	"lavare",
+	"lavaria",
+	"lavarian",
+	"lavarias",
+	"lavaron",
+	"lavase",
+	"lavasen",
+	"lavaste",
+	"lave",
+	"lavo",
+	"lazos",
+	"leales",
+	"leches",
+	"leemos",
+	"leen",
+	"leera",
+	"leeran",
+	"leeras",
+	"leere",
+	"leeremos",
+	"leeria",
+	"leerian",
+	"leerias",
+	"legal",
+	"legiones",
+	"leia",
+	"leiamos",
+	"leian",
+	"leias",
+	"leida",
+	"leidas",
+	"leido",
+	"leidos",
+	"lejana",
+	"lejanas",
+	"lejanos",
+	"lejos",
+	"lemas",
+	"lenguas",
+	"lenta",
+	"lentas",
+	"lentos",
+	"leones",
+	"lesiones",
+	"letales",
+	"letras",
+	"leves",
+	"leyendas",
+	"leyendo",
+	"leyeron",
+	"leyes",
+	"leyo",
+	"leñas",
+	"libre",
+	"libros",
+	"lideres",
+	"lidiaba",
+	"lidiaban",
+	"lidiabas",
+	"lidiada",
+	"lidiadas",
+	"lidiado",
+	"lidiados",
+	"lidiamos",
+	"lidiando",
+	"lidiara",
+	"lidiaran",
+	"lidiaras",
+	"lidiare",
+	"lidiaria",
+	"lidiaron",
+	"lidiase",
+	"lidiasen",
+	"lidiaste",
+	"lidie",
+	"lidio",
+	"lienzos",
+	"ligas",
+	"ligera",
+	"ligeras",
+	"ligeros",
+	"limas",
+	"limites",
+	"limones",
+	"limpia",
+	"limpiaba",
+	"limpiada",
+	"limpiado",
+	"limpiar",
+	"limpiara",
+	"limpiare",
+	"limpias",
+	"limpiase",
+	"limpie",
+	"limpios",
+	"linces",
+	"linda",
+	"lindas",
+	"lindos",
+	"lineas",
+	"lingotes",
+	"linos",
+	"liquida",
+	"liquidas",
+	"liquidos",
+	"lisa",
+	"lisas",
+	"lisos",
+	"listas",
+	"listo",
+	"listos",
+	"literas",
+	"litios",
+	"litros",
+	"llagas",
+	"llamaba",
+	"llamaban",
+	"llamabas",
+	"llamada",
+	"llamadas",
+	"llamado",
+	"llamados",
+	"llamamos",
+	"llamando",
+	"llamar",
+	"llamara",
+	"llamaran",
+	"llamaras",
+	"llamare",
+	"llamaria",
+	"llamaron",
+	"llamas",
+	"llamase",
+	"llamasen",
+	"llamaste",
+	"llame",
+	"llamo",
+	"llantos",
+	"llaves",
+	"llegaba",
+	"llegaban",
+	"llegabas",
+	"llegada",
+	"llegadas",
+	"llegado",
+	"llegados",
+	"llegamos",
+	"llegando",
+	"llegara",
+	"llegaran",
+	"llegaras",
+	"llegare",
+	"llegaria",
+	"llegaron",
+	"llegase",
+	"llegasen",
+	"llegaste",
+	"llego",
+	"llegue",
+	"llena",
+	"llenaba",
+	"llenaban",
+	"llenabas",
+	"llenada",
+	"llenadas",
+	"llenado",
+	"llenados",
+	"llenamos",
+	"llenando",
+	"llenara",
+	"llenaran",
+	"llenaras",
+	"llenare",
+	"llenaria",
+	"llenaron",
+	"llenas",
+	"llenase",
+	"llenasen",
+	"llenaste",
+	"llene",
+	"lleno",
+	"llenos",
+	"llevaba",
+	"llevaban",
+	"llevabas",
+	"llevada",
+	"llevadas",
+	"llevado",
+	"llevados",
+	"llevamos",
+	"llevando",
+	"llevara",
+	"llevaran",
+	"llevaras",
+	"llevare",
+	"llevaria",
+	"llevaron",
+	"llevase",
+	"llevasen",
+	"llevaste",
+	"lleve",
+	"llevo",
+	"lloraba",
+	"lloraban",
+	"llorabas",
+	"llorada",
+	"lloradas",
+	"llorado",
+	"llorados",
+	"lloramos",
+	"llorando",
+	"llorara",
+	"lloraran",
+	"lloraras",
+	"llorare",
+	"lloraria",
+	"lloraron",
+	"llorase",
+	"llorasen",
+	"lloraste",
+	"llore",
+	"lloro",
+	"llovemos",
+	"llovera",
+	"lloveran",
+	"lloveras",
+	"llovere",
+	"lloveria",
+	"llovia",
+	"llovian",
+	"llovias",
+	"llovida",
+	"llovidas",
+	"llovido",
+	"llovidos",
+	"lluvias",
+	"loba",
+	"lobas",
+	"lobos",
+	"loca",
+	"local",
+	"locas",
+	"lociones",
+	"locos",
+	"locuras",
+	"logicas",
+	"lograba",
+	"lograban",
+	"lograbas",
+	"lograda",
+	"logradas",
+	"logrado",
+	"logrados",
+	"logramos",
+	"logrando",
+	"lograr",
+	"lograra",
+	"lograran",
+	"lograras",
+	"lograre",
+	"lograria",
+	"lograron",
+	"lograse",
+	"lograsen",
+	"lograste",
+	"logre",
+	"logros",
+	"lomas",
+	"lomos",
+	"lonjas",
+	"lotes",
+	"luchaba",
+	"luchaban",
+	"luchabas",
+	"luchada",
+	"luchadas",
+	"luchado",
+	"luchados",
+	"luchamos",
+	"luchando",
+	"luchar",
+	"luchara",
+	"lucharan",
+	"lucharas",
+	"luchare",
+	"lucharia",
+	"lucharon",
+	"luchas",
+	"luchase",
+	"luchasen",
+	"luchaste",
+	"luche",
+	"lucho",
+	"lucia",
+	"luciamos",
+	"lucian",
+	"lucias",
+	"lucida",
+	"lucidas",
+	"lucido",
+	"lucidos",
+	"luciendo",
+	"lucimos",
+	"lucira",
+	"luciran",
+	"luciras",
+	"lucire",
+	"luciria",
+	"lucirian",
+	"lucirias",
+	"luego",
+	"lugares",
+	"lujos",
+	"lunar",
+	"lunas",
+	"lupas",
+	"lustros",
+	"lutos",
+	"macetas",
+	"machos",
+	"maderas",
+	"madres",
+	"madura",
+	"maduras",
+	"maduros",
+	"maestra",
+	"maestras",
+	"maestros",
+	"mafias",
+	"magias",
+	"magos",
+	"maices",
+	"mala",
+	"malas",
+	"maldades",
+	"maletas",
+	"mallas",
+	"malos",
+	"mamas",
+	"mambos",
+	"manca",
+	"mancas",
+	"mancos",
+	"mandaba",
+	"mandaban",
+	"mandabas",
+	"mandada",
+	"mandadas",
+	"mandado",
+	"mandados",
+	"mandamos",
+	"mandando",
+	"mandar",
+	"mandara",
+	"mandaran",
+	"mandaras",
+	"mandare",
+	"mandaria",
+	"mandaron",
+	"mandase",
+	"mandasen",
+	"mandaste",
+	"mande",
+	"mandos",
+	"manejaba",
+	"manejada",
+	"manejado",
+	"manejara",
+	"manejare",
+	"manejase",
+	"maneje",
+	"manejo",
+	"manera",
+	"mangas",
+	"mango",
+	"mania",
+	"maniquis",
+	"manjares",
+	"manos",
+	"mansa",
+	"mansas",
+	"mansos",
+	"mantas",
+	"manzana",
+	"mapas",
+	"maquinas",
+	"marca",
+	"marcaba",
+	"marcaban",
+	"marcabas",
+	"marcada",
+	"marcadas",
+	"marcado",
+	"marcados",
+	"marcamos",
+	"marcando",
+	"marcar",
+	"marcara",
+	"marcaran",
+	"marcaras",
+	"marcare",
+	"marcaria",
+	"marcaron",
+	"marcase",
+	"marcasen",
+	"marcaste",
+	"marchaba",
+	"marchada",
+	"marchado",
+	"marchar",
+	"marchara",
+	"marchare",
+	"marchase",
+	"marche",
+	"marcho",
+	"marcos",
+	"mareas",
+	"mares",
+	"marfiles",
+	"margenes",
+	"maridos",
+	"marmoles",
+	"marque",
+	"marrones",
+	"marzos",
+	"masas",
+	"mascaras",
+	"masiva",
+	"masivas",
+	"masivos",
+	"mataba",
+	"mataban",
+	"matabas",
+	"matada",
+	"matadas",
+	"matado",
+	"matados",
+	"matamos",
+	"matando",
+	"matara",
+	"mataran",
+	"mataras",
+	"matare",
+	"mataria",
+	"matarian",
+	"matarias",
+	"mataron",
+	"matase",
+	"matasen",
+	"mataste",
+	"mate",
+	"materias",
+	"matices",
+	"mato",
+	"matrices",
+	"maxima",
+	"maximas",
+	"maximos",
+	"mayo",
+	"mayores",
+	"mazorcas",
+	"mañanas",
+	"mechas",
+	"medallas",
+	"media",
+	"mediamos",
+	"median",
+	"mediante",
+	"medias",
+	"medica",
+	"medicaba",
+	"medicada",
+	"medicado",
+	"medicar",
+	"medicara",
+	"medicare",
+	"medicase",
+	"medico",
+	"medida",
+	"medidas",
+	"medido",
+	"medidos",
+	"medimos",
+	"medios",
+	"medique",
+	"medir",
+	"medira",
+	"mediran",
+	"mediras",
+	"medire",
+	"mediria",
+	"medirian",
+	"medirias",
+	"meditaba",
+	"meditada",
+	"meditado",
+	"meditar",
+	"meditara",
+	"meditare",
+	"meditase",
+	"medite",
+	"medito",
+	"medulas",
+	"mejillas",
+	"mejoraba",
+	"mejorada",
+	"mejorado",
+	"mejorar",
+	"mejorara",
+	"mejorare",
+	"mejorase",
+	"mejore",
+	"mejores",
+	"mejoro",
+	"melenas",
+	"melones",
+	"memorias",
+	"menores",
+	"menos",
+	"mensajes",
+	"mentaba",
+	"mentaban",
+	"mentabas",
+	"mentada",
+	"mentadas",
+	"mentado",
+	"mentados",
+	"mentamos",
+	"mentando",
+	"mentar",
+	"mentara",
+	"mentaran",
+	"mentaras",
+	"mentare",
+	"mentaria",
+	"mentaron",
+	"mentase",
+	"mentasen",
+	"mentaste",
+	"mentes",
+	"mentia",
+	"mentian",
+	"mentias",
+	"mentida",
+	"mentidas",
+	"mentido",
+	"mentidos",
+	"mentimos",
+	"mentir",
+	"mentira",
+	"mentiran",
+	"mentiras",
+	"mentire",
+	"mentiria",
+	"mento",
+	"menus",
+	"mercados",
+	"merecer",
+	"merecera",
+	"merecere",
+	"merecia",
+	"merecian",
+	"merecias",
+	"merecida",
+	"merecido",
+	"meritos",
+	"mesa",
+	"mesas",
+	"meses",
+	"mesones",
+	"metal",
+	"metas",
+	"metemos",
+	"metera",
+	"meteran",
+	"meteras",
+	"metere",
+	"meteria",
+	"meterian",
+	"meterias",
+	"metia",
+	"metiamos",
+	"metian",
+	"metias",
+	"metida",
+	"metidas",
+	"metido",
+	"metidos",
+	"metiendo",
+	"metodos",
+	"metros",
+	"mezclaba",
+	"mezclada",
+	"mezclado",
+	"mezclar",
+	"mezclara",
+	"mezclare",
+	"mezclas",
+	"mezclase",
+	"mezcle",
+	"mezclo",
+	"mias",
+	"mide",
+	"midio",
+	"mido",
+	"miedos",
+	"mieles",
+	"miembros",
+	"miente",
+	"miento",
+	"mientras",
+	"migas",
+	"milagros",
+	"miles",
+	"millones",
+	"mimos",
+	"minas",
+	"minera",
+	"mineras",
+	"mineros",
+	"minima",
+	"minimas",
+	"minimos",
+	"mintio",
+	"minutos",
+	"miopes",
+	"mios",
+	"miraba",
+	"miraban",
+	"mirabas",
+	"mirada",
+	"miradas",
+	"mirado",
+	"mirados",
+	"miramos",
+	"mirando",
+	"mirara",
+	"miraran",
+	"miraras",
+	"mirare",
+	"miraria",
+	"mirarian",
+	"mirarias",
+	"miraron",
+	"mirase",
+	"mirasen",
+	"miraste",
+	"mire",
+	"miro",
+	"misas",
+	"miserias",
+	"misiles",
+	"misma",
+	"mismas",
+	"mismos",
+	"mitades",
+	"mitos",
+	"mochilas",
+	"mociones",
+	"modas",
+	"modelos",
+	"modo",
+	"modos",
+	"mohos",
+	"mojaba",
+	"mojaban",
+	"mojabas",
+	"mojada",
+	"mojadas",
+	"mojado",
+	"mojados",
+	"mojamos",
+	"mojando",
+	"mojara",
+	"mojaran",
+	"mojaras",
+	"mojare",
+	"mojaria",
+	"mojarian",
+	"mojarias",
+	"mojaron",
+	"mojase",
+	"mojasen",
+	"mojaste",
+	"moje",
+	"mojo",
+	"moldes",
+	"molemos",
+	"molera",
+	"moleran",
+	"moleras",
+	"molere",
+	"moleria",
+	"molerian",
+	"molerias",
+	"molia",
+	"moliamos",
+	"molian",
+	"molias",
+	"molida",
+	"molidas",
+	"molido",
+	"molidos",
+	"moliendo",
+	"molinos",
+	"momentos",
+	"momias",
+	"monarcas",
+	"monedas",
+	"monjas",
+	"monos",
+	"montaba",
+	"montaban",
+	"montabas",
+	"montada",
+	"montadas",
+	"montado",
+	"montados",
+	"montamos",
+	"montando",
+	"montar",
+	"montara",
+	"montaran",
+	"montaras",
+	"montare",
+	"montaria",
+	"montaron",
+	"montase",
+	"montasen",
+	"montaste",
+	"montaña",
+	"monte",
+	"montes",
+	"montos",
+	"moradas",
+	"morado",
+	"morados",
+	"moral",
+	"mordemos",
+	"mordera",
+	"morderan",
+	"morderas",
+	"mordere",
+	"morderia",
+	"mordia",
+	"mordian",
+	"mordias",
+	"mordida",
+	"mordidas",
+	"mordido",
+	"mordidos",
+	"morena",
+	"morenas",
+	"morenos",
+	"moria",
+	"moriamos",
+	"morian",
+	"morias",
+	"morimos",
+	"morira",
+	"moriran",
+	"moriras",
+	"morire",
+	"moriria",
+	"moririan",
+	"moririas",
+	"morros",
+	"morsas",
+	"mortales",
+	"moscas",
+	"mostraba",
+	"mostrada",
+	"mostrado",
+	"mostrara",
+	"mostrare",
+	"mostrase",
+	"mostre",
+	"mostro",
+	"motivos",
+	"motor",
+	"movemos",
+	"movera",
+	"moveran",
+	"moveras",
+	"movere",
+	"moveria",
+	"moverian",
+	"moverias",
+	"movia",
+	"moviamos",
+	"movian",
+	"movias",
+	"movida",
+	"movidas",
+	"movido",
+	"movidos",
+	"moviendo",
+	"moviles",
+	"mozos",
+	"moños",
+	"mucha",
+	"muchas",
+	"muchos",
+	"mudaba",
+	"mudaban",
+	"mudabas",
+	"mudada",
+	"mudadas",
+	"mudado",
+	"mudados",
+	"mudamos",
+	"mudando",
+	"mudara",
+	"mudaran",
+	"mudaras",
+	"mudare",
+	"mudaria",
+	"mudarian",
+	"mudarias",
+	"mudaron",
+	"mudase",
+	"mudasen",
+	"mudaste",
+	"mude",
+	"mudo",
+	"muebles",
+	"muelas",
+	"muere",
+	"mueren",
+	"muero",
+	"muerta",
+	"muertas",
+	"muertes",
+	"muerto",
+	"muertos",
+	"muestras",
+	"muestro",
+	"mugres",
+	"mujeres",
+	"mulas",
+	"muletas",
+	"multas",
+	"mundos",
+	"murales",
+	"murio",
+	"muros",
+	"musculos",
+	"museos",
+	"musgos",
+	"musicas",
+	"muslos",
+	"muñecas",
+	"nacares",
+	"nace",
+	"nacemos",
+	"nacen",
+	"nacer",
+	"nacera",
+	"naceran",
+	"naceras",
+	"nacere",
+	"naceria",
+	"nacerian",
+	"nacerias",
+	"nacia",
+	"naciamos",
+	"nacian",
+	"nacias",
+	"nacida",
+	"nacidas",
+	"nacido",
+	"nacidos",
+	"naciones",
+	"nada",
+	"nadaba",
+	"nadaban",
+	"nadabas",
+	"nadada",
+	"nadadas",
+	"nadado",
+	"nadados",
+	"nadamos",
+	"nadando",
+	"nadara",
+	"nadaran",
+	"nadaras",
+	"nadare",
+	"nadaria",
+	"nadarian",
+	"nadarias",
+	"nadaron",
+	"nadase",
+	"nadasen",
+	"nadaste",
+	"nade",
+	"nadie",
+	"nado",
+	"naipes",
+	"naranjas",
+	"narices",
+	"narraba",
+	"narraban",
+	"narrabas",
+	"narrada",
+	"narradas",
+	"narrado",
+	"narrados",
+	"narramos",
+	"narrando",
+	"narrara",
+	"narraran",
+	"narraras",
+	"narrare",
+	"narraria",
+	"narraron",
+	"narrase",
+	"narrasen",
+	"narraste",
+	"narre",
+	"narro",
+	"nasales",
+	"natales",
+	"nativa",
+	"nativas",
+	"nativos",
+	"nauseas",
+	"navales",
+	"naves",
+	"nazco",
+	"necesite",
+	"necesito",
+	"necia",
+	"necias",
+	"necios",
+	"nectares",
+	"negaba",
+	"negaban",
+	"negabas",
+	"negada",
+	"negadas",
+	"negado",
+	"negados",
+	"negamos",
+	"negando",
+	"negara",
+	"negaran",
+	"negaras",
+	"negare",
+	"negaria",
+	"negarian",
+	"negarias",
+	"negaron",
+	"negase",
+	"negasen",
+	"negaste",
+	"nego",
+	"negociar",
+	"negocie",
+	"negocios",
+	"negra",
+	"negras",
+	"negros",
+	"negue",
+	"neones",
+	"nervios",
+	"neta",
+	"netas",
+	"netos",
+	"neutra",
+	"neutras",
+	"neutros",
+	"nevaba",
+	"nevaban",
+	"nevabas",
+	"nevada",
+	"nevadas",
+	"nevado",
+	"nevados",
+	"nevamos",
+	"nevando",
+	"nevara",
+	"nevaran",
+	"nevaras",
+	"nevare",
+	"nevaria",
+	"nevarian",
+	"nevarias",
+	"nevaron",
+	"nevase",
+	"nevasen",
+	"nevaste",
+	"neve",
+	"neveras",
+	"nevo",
+	"nichos",
+	"nidos",
+	"nieblas",
+	"nieta",
+	"nietas",
+	"nietos",
+	"nieve",
+	"ninguna",
+	"ninguno",
+	"nitida",
+	"nitidas",
+	"nitidos",
+	"niveles",
+	"niña",
+	"niñas",
+	"niñeces",
+	"niños",
+	"noble",
+	"noblezas",
+	"noches",
+	"nombraba",
+	"nombrada",
+	"nombrado",
+	"nombrar",
+	"nombrara",
+	"nombrare",
+	"nombrase",
+	"nombre",
+	"nombres",
+	"nombro",
+	"nominas",
+	"norias",
+	"normas",
+	"nortes",
+	"nosotras",
+	"nosotros",
+	"notaba",
+	"notaban",
+	"notabas",
+	"notada",
+	"notadas",
+	"notado",
+	"notados",
+	"notamos",
+	"notando",
+	"notar",
+	"notara",
+	"notaran",
+	"notaras",
+	"notare",
+	"notaria",
+	"notarian",
+	"notarias",
+	"notaron",
+	"notas",
+	"notase",
+	"notasen",
+	"notaste",
+	"note",
+	"noticias",
+	"noto",
+	"novata",
+	"novatas",
+	"novatos",
+	"novelas",
+	"noventa",
+	"novia",
+	"novias",
+	"novios",
+	"nubes",
+	"nucas",
+	"nucleos",
+	"nudillos",
+	"nudos",
+	"nueces",
+	"nueras",
+	"nuestra",
+	"nuestras",
+	"nuestro",
+	"nuestros",
+	"nueva",
+	"nuevas",
+	"nueves",
+	"nuevo",
+	"nuevos",
+	"nula",
+	"nulas",
+	"nulos",
+	"numeros",
+	"nunca",
+	"nutrias",
+	"obesa",
+	"obesas",
+	"obesos",
+	"obispos",
+	"objetos",
+	"obligaba",
+	"obligada",
+	"obligado",
+	"obligar",
+	"obligara",
+	"obligare",
+	"obligase",
+	"obligo",
+	"obligue",
+	"obras",
+	"obrera",
+	"obreras",
+	"obreros",
+	"observe",
+	"observo",
+	"obtenia",
+	"obtenian",
+	"obtenias",
+	"obtenida",
+	"obtenido",
+	"obvia",
+	"obvias",
+	"obvios",
+	"ocasos",
+	"oceanos",
+	"ochentas",
+	"ochos",
+	"ocios",
+	"ocres",
+	"octava",
+	"octavas",
+	"octavos",
+	"octubres",
+	"oculta",
+	"ocultas",
+	"ocultos",
+	"ocupaba",
+	"ocupaban",
+	"ocupabas",
+	"ocupada",
+	"ocupadas",
+	"ocupado",
+	"ocupados",
+	"ocupamos",
+	"ocupando",
+	"ocupara",
+	"ocuparan",
+	"ocuparas",
+	"ocupare",
+	"ocuparia",
+	"ocuparon",
+	"ocupase",
+	"ocupasen",
+	"ocupaste",
+	"ocupe",
+	"ocupo",
+	"ocurria",
+	"ocurrian",
+	"ocurrias",
+	"ocurrida",
+	"ocurrido",
+	"ocurrira",
+	"ocurrire",
+	"odiaba",
+	"odiaban",
+	"odiabas",
+	"odiada",
+	"odiadas",
+	"odiado",
+	"odiados",
+	"odiamos",
+	"odiando",
+	"odiara",
+	"odiaran",
+	"odiaras",
+	"odiare",
+	"odiaria",
+	"odiarian",
+	"odiarias",
+	"odiaron",
+	"odiase",
+	"odiasen",
+	"odiaste",
+	"odie",
+	"odios",
+	"odiseas",
+	"oestes",
+	"ofensas",
+	"ofertas",
+	"oficina",
+	"oficios",
+	"ofrece",
+	"ofrecera",
+	"ofrecere",
+	"ofrecia",
+	"ofrecian",
+	"ofrecias",
+	"ofrecida",
+	"ofrecido",
+	"ofrezco",
+	"ogros",
+	"oidos",
+	"oiga",
+	"oigo",
+	"oimos",
+	"ojala",
+	"ojera",
+	"ojos",
+	"oleadas",
+	"olemos",
+	"oler",
+	"olera",
+	"oleran",
+	"oleras",
+	"olere",
+	"oleremos",
+	"oleria",
+	"olerian",
+	"olerias",
+	"olfatos",
+	"olia",
+	"oliamos",
+	"olian",
+	"olias",
+	"olida",
+	"olidas",
+	"olido",
+	"olidos",
+	"olivos",
+	"ollas",
+	"olmos",
+	"olvidaba",
+	"olvidada",
+	"olvidado",
+	"olvidar",
+	"olvidara",
+	"olvidare",
+	"olvidase",
+	"olvide",
+	"olvidos",
+	"ombligos",
+	"once",
+	"ondas",
+	"onzas",
+	"opaca",
+	"opacas",
+	"opacos",
+	"opciones",
+	"operas",
+	"opinaba",
+	"opinaban",
+	"opinabas",
+	"opinada",
+	"opinadas",
+	"opinado",
+	"opinados",
+	"opinamos",
+	"opinando",
+	"opinara",
+	"opinaran",
+	"opinaras",
+	"opinare",
+	"opinaria",
+	"opinaron",
+	"opinase",
+	"opinasen",
+	"opinaste",
+	"opine",
+	"opino",
+	"oponemos",
+	"oponia",
+	"oponian",
+	"oponias",
+	"optaba",
+	"optaban",
+	"optabas",
+	"optada",
+	"optadas",
+	"optado",
+	"optados",
+	"optamos",
+	"optando",
+	"optara",
+	"optaran",
+	"optaras",
+	"optare",
+	"optaria",
+	"optarian",
+	"optarias",
+	"optaron",
+	"optase",
+	"optasen",
+	"optaste",
+	"opte",
+	"opticas",
+	"opto",
+	"opuesta",
+	"opuestas",
+	"opuestos",
+	"orales",
+	"orbitas",
+	"orcas",
+	"ordenaba",
+	"ordenada",
+	"ordenado",
+	"ordenar",
+	"ordenara",
+	"ordenare",
+	"ordenase",
+	"ordene",
+	"ordenes",
+	"ordeno",
+	"orejas",
+	"organice",
+	"organizo",
+	"organos",
+	"orgias",
+	"orgullos",
+	"orientes",
+	"origenes",
+	"orillas",
+	"orina",
+	"orugas",
+	"osadias",
+	"oscura",
+	"oscuras",
+	"oscuros",
+	"oseznos",
+	"ostras",
+	"otoños",
+	"otra",
+	"otras",
+	"otros",
+	"ovejas",
+	"ovulos",
+	"oxidos",
+	"oxigenos",
+	"oyen",
+	"oyendo",
+	"oyentes",
+	"oyeron",
+	"oyes",
+	"ozonos",
+	"pactaba",
+	"pactaban",
+	"pactabas",
+	"pactada",
+	"pactadas",
+	"pactado",
+	"pactados",
+	"pactamos",
+	"pactando",
+	"pactar",
+	"pactara",
+	"pactaran",
+	"pactaras",
+	"pactare",
+	"pactaria",
+	"pactaron",
+	"pactase",
+	"pactasen",
+	"pactaste",
+	"pacte",
+	"pactos",
+	"padres",
+	"paellas",
+	"pagaba",
+	"pagaban",
+	"pagabas",
+	"pagada",
+	"pagadas",
+	"pagado",
+	"pagados",
+	"pagamos",
+	"pagando",
+	"pagar",
+	"pagara",
+	"pagaran",
+	"pagaras",
+	"pagare",
+	"pagaria",
+	"pagarian",
+	"pagarias",
+	"pagaron",
+	"pagase",
+	"pagasen",
+	"pagaste",
+	"paginas",
+	"pagos",
+	"pague",
+	"paises",
+	"pajaros",
+	"pajas",
+	"palabras",
+	"palcos",
+	"paletas",
+	"palida",
+	"palidas",
+	"palidos",
+	"palmas",
+	"palomas",
+	"palos",
+	"palpaba",
+	"palpaban",
+	"palpabas",
+	"palpada",
+	"palpadas",
+	"palpado",
+	"palpados",
+	"palpamos",
+	"palpando",
+	"palpara",
+	"palparan",
+	"palparas",
+	"palpare",
+	"palparia",
+	"palparon",
+	"palpase",
+	"palpasen",
+	"palpaste",
+	"palpe",
+	"palpo",
+	"panales",
+	"panicos",
+	"panteras",
+	"panza",
+	"papas",
+	"papeles",
+	"papillas",
+	"paquetes",
+	"paraba",
+	"paraban",
+	"parabas",
+	"parada",
+	"paradas",
+	"parado",
+	"parados",
+	"paramos",
+	"parando",
+	"parara",
+	"pararan",
+	"pararas",
+	"parare",
+	"pararia",
+	"pararian",
+	"pararias",
+	"pararon",
+	"parase",
+	"parasen",
+	"paraste",
+	"parcelas",
+	"pare",
+	"parece",
+	"parecen",
+	"parecer",
+	"parecera",
+	"parecere",
+	"parecia",
+	"parecian",
+	"parecias",
+	"parecida",
+	"parecido",
+	"paredes",
+	"parezco",
+	"paria",
+	"pariamos",
+	"parian",
+	"parias",
+	"parida",
+	"paridas",
+	"parido",
+	"paridos",
+	"pariendo",
+	"parimos",
+	"parira",
+	"pariran",
+	"pariras",
+	"parire",
+	"pariria",
+	"paririan",
+	"paririas",
+	"paros",
+	"parpados",
+	"parques",
+	"parrafos",
+	"partes",
+	"partia",
+	"partian",
+	"partias",
+	"partida",
+	"partidas",
+	"partido",
+	"partidos",
+	"partimos",
+	"partir",
+	"partira",
+	"partiran",
+	"partiras",
+	"partire",
+	"partiria",
+	"pasaba",
+	"pasaban",
+	"pasabas",
+	"pasada",
+	"pasadas",
+	"pasado",
+	"pasados",
+	"pasamos",
+	"pasando",
+	"pasara",
+	"pasaran",
+	"pasaras",
+	"pasare",
+	"pasaria",
+	"pasarian",
+	"pasarias",
+	"pasaron",
+	"pasase",
+	"pasasen",
+	"pasaste",
+	"pase",
+	"paseos",
+	"pasiones",
+	"pasos",
+	"pastas",
+	"patas",
+	"patinaba",
+	"patinada",
+	"patinado",
+	"patinar",
+	"patinara",
+	"patinare",
+	"patinase",
+	"patine",
+	"patino",
+	"patios",
+	"patrias",
+	"pausas",
+	"pautas",
+	"pava",
+	"pavas",
+	"pavos",
+	"payasa",
+	"payasas",
+	"payasos",
+	"pañuelos",
+	"peatones",
+	"pecados",
+	"peceras",
+	"peces",
+	"pechos",
+	"pedales",
+	"pedia",
+	"pediamos",
+	"pedian",
+	"pedias",
+	"pedida",
+	"pedidas",
+	"pedido",
+	"pedidos",
+	"pedimos",
+	"pedira",
+	"pediran",
+	"pediras",
+	"pedire",
+	"pediria",
+	"pedirian",
+	"pedirias",
+	"pegaba",
+	"pegaban",
+	"pegabas",
+	"pegada",
+	"pegadas",
+	"pegado",
+	"pegados",
+	"pegamos",
+	"pegando",
+	"pegara",
+	"pegaran",
+	"pegaras",
+	"pegare",
+	"pegaria",
+	"pegarian",
+	"pegarias",
+	"pegaron",
+	"pegase",
+	"pegasen",
+	"pegaste",
+	"pego",
+	"pegue",
+	"peines",
+	"pelaba",
+	"pelaban",
+	"pelabas",
+	"pelada",
+	"peladas",
+	"pelado",
+	"pelados",
+	"pelamos",
+	"pelando",
+	"pelara",
+	"pelaran",
+	"pelaras",
+	"pelare",
+	"pelaria",
+	"pelarian",
+	"pelarias",
+	"pelaron",
+	"pelase",
+	"pelasen",
+	"pelaste",
+	"peldaños",
+	"pele",
+	"peleaba",
+	"peleaban",
+	"peleabas",
+	"peleada",
+	"peleadas",
+	"peleado",
+	"peleados",
+	"peleamos",
+	"peleando",
+	"pelear",
+	"peleara",
+	"pelearan",
+	"pelearas",
+	"peleare",
+	"pelearia",
+	"pelearon",
+	"peleas",
+	"pelease",
+	"peleasen",
+	"peleaste",
+	"pelee",
+	"peleo",
+	"peligros",
+	"pellejos",
+	"pelos",
+	"pelota",
+	"pelucas",
+	"penal",
+	"penas",
+	"pensaba",
+	"pensaban",
+	"pensabas",
+	"pensada",
+	"pensadas",
+	"pensado",
+	"pensados",
+	"pensamos",
+	"pensando",
+	"pensara",
+	"pensaran",
+	"pensaras",
+	"pensare",
+	"pensaria",
+	"pensaron",
+	"pensase",
+	"pensasen",
+	"pensaste",
+	"pense",
+	"penso",
+	"peones",
+	"peores",
+	"pepinos",
+	"pequeña",
+	"pequeñas",
+	"pequeños",
+	"peras",
+	"perchas",
+	"perdemos",
+	"perdera",
+	"perderan",
+	"perderas",
+	"perdere",
+	"perderia",
+	"perdia",
+	"perdian",
+	"perdias",
+	"perdida",
+	"perdidas",
+	"perdido",
+	"perdidos",
+	"perdonar",
+	"perdone",
+	"perdono",
+	"perezas",
+	"perfiles",
+	"pericos",
+	"perlas",
+	"permisos",
+	"permitia",
+	"permitir",
+	"perra",
+	"perras",
+	"perros",
+	"personas",
+	"pesaba",
+	"pesaban",
+	"pesabas",
+	"pesada",
+	"pesadas",
+	"pesado",
+	"pesados",
+	"pesamos",
+	"pesando",
+	"pesar",
+	"pesara",
+	"pesaran",
+	"pesaras",
+	"pesare",
+	"pesaria",
+	"pesarian",
+	"pesarias",
+	"pesaron",
+	"pesas",
+	"pesase",
+	"pesasen",
+	"pesaste",
+	"pescado",
+	"pescas",
+	"pese",
+	"pesima",
+	"pesimas",
+	"pesimos",
+	"peso",
+	"pesos",
+	"pestañas",
+	"petalos",
+	"pezuñas",
+	"peñones",
+	"piano",
+	"picaba",
+	"picaban",
+	"picabas",
+	"picada",
+	"picadas",
+	"picado",
+	"picados",
+	"picamos",
+	"picando",
+	"picara",
+	"picaran",
+	"picaras",
+	"picare",
+	"picaria",
+	"picarian",
+	"picarias",
+	"picaron",
+	"picase",
+	"picasen",
+	"picaste",
+	"pichones",
+	"pico",
+	"pide",
+	"piden",
+	"pides",
+	"pidiendo",
+	"pidio",
+	"pido",
+	"piedras",
+	"piel",
+	"piensa",
+	"piensan",
+	"pienso",
+	"piernas",
+	"pies",
+	"piezas",
+	"pijamas",
+	"pilares",
+	"pilas",
+	"pilotos",
+	"pinos",
+	"pinta",
+	"pintaba",
+	"pintaban",
+	"pintabas",
+	"pintada",
+	"pintadas",
+	"pintado",
+	"pintados",
+	"pintamos",
+	"pintando",
+	"pintar",
+	"pintara",
+	"pintaran",
+	"pintaras",
+	"pintare",
+	"pintaria",
+	"pintaron",
+	"pintase",
+	"pintasen",
+	"pintaste",
+	"pinte",
+	"pinto",
+	"pinzas",
+	"piojos",
+	"pipas",
+	"pique",
+	"piratas",
+	"pisaba",
+	"pisaban",
+	"pisabas",
+	"pisada",
+	"pisadas",
+	"pisado",
+	"pisados",
+	"pisamos",
+	"pisando",
+	"pisara",
+	"pisaran",
+	"pisaras",
+	"pisare",
+	"pisaria",
+	"pisarian",
+	"pisarias",
+	"pisaron",
+	"pisase",
+	"pisasen",
+	"pisaste",
+	"piscinas",
+	"pise",
+	"pisos",
+	"pistas",
+	"pitones",
+	"pizcas",
+	"piñas",
+	"placas",
+	"plaga",
+	"planchar",
+	"planche",
+	"plancho",
+	"planes",
+	"plano",
+	"plantaba",
+	"plantada",
+	"plantado",
+	"plantar",
+	"plantara",
+	"plantare",
+	"plantase",
+	"plante",
+	"planto",
+	"platano",
+	"platas",
+	"plato",
+	"platos",
+	"playas",
+	"plazas",
+	"plazo",
+	"pleitos",
+	"plena",
+	"plenas",
+	"plenos",
+	"plomos",
+	"plumas",
+	"plurales",
+	"poblaba",
+	"poblaban",
+	"poblabas",
+	"poblada",
+	"pobladas",
+	"poblado",
+	"poblados",
+	"poblamos",
+	"poblando",
+	"poblar",
+	"poblara",
+	"poblaran",
+	"poblaras",
+	"poblare",
+	"poblaria",
+	"poblaron",
+	"poblase",
+	"poblasen",
+	"poblaste",
+	"poble",
+	"poblo",
+	"pobres",
+	"poca",
+	"pocas",
+	"pocos",
+	"podemos",
+	"podia",
+	"podiamos",
+	"podian",
+	"podias",
+	"podida",
+	"podidas",
+	"podido",
+	"podidos",
+	"podios",
+	"podra",
+	"podre",
+	"podria",
+	"poemas",
+	"poesias",
+	"poetas",
+	"polenes",
+	"policias",
+	"pollos",
+	"polvos",
+	"pomadas",
+	"pomelos",
+	"pomos",
+	"pompas",
+	"pondra",
+	"pondre",
+	"pondria",
+	"pone",
+	"ponemos",
+	"ponen",
+	"pones",
+	"ponga",
+	"pongan",
+	"pongo",
+	"ponia",
+	"poniamos",
+	"ponian",
+	"ponias",
+	"poniendo",
+	"porque",
+	"portaba",
+	"portaban",
+	"portabas",
+	"portada",
+	"portadas",
+	"portado",
+	"portados",
+	"portales",
+	"portamos",
+	"portando",
+	"portar",
+	"portara",
+	"portaran",
+	"portaras",
+	"portare",
+	"portaria",
+	"portaron",
+	"portase",
+	"portasen",
+	"portaste",
+	"porte",
+	"porto",
+	"posadas",
+	"posar",
+	"poseemos",
+	"poseera",
+	"poseeran",
+	"poseeras",
+	"poseere",
+	"poseeria",
+	"poseia",
+	"poseian",
+	"poseias",
+	"poseida",
+	"poseidas",
+	"poseido",
+	"poseidos",
+	"posibles",
+	"postes",
+	"potros",
+	"pozos",
+	"practico",
+	"prados",
+	"precio",
+	"preciosa",
+	"precioso",
+	"precoces",
+	"pregunte",
+	"pregunto",
+	"premiaba",
+	"premiada",
+	"premiado",
+	"premiar",
+	"premiara",
+	"premiare",
+	"premiase",
+	"premie",
+	"premios",
+	"prensas",
+	"preparar",
+	"prepare",
+	"preparo",
+	"presente",
+	"presento",
+	"presos",
+	"prestaba",
+	"prestada",
+	"prestado",
+	"prestar",
+	"prestara",
+	"prestare",
+	"prestase",
+	"preste",
+	"presto",
+	"previa",
+	"previas",
+	"previos",
+	"prima",
+	"primas",
+	"primera",
+	"primero",
+	"primos",
+	"prisa",
+	"privaba",
+	"privaban",
+	"privabas",
+	"privada",
+	"privadas",
+	"privado",
+	"privados",
+	"privamos",
+	"privando",
+	"privara",
+	"privaran",
+	"privaras",
+	"privare",
+	"privaria",
+	"privaron",
+	"privase",
+	"privasen",
+	"privaste",
+	"prive",
+	"privo",
+	"proas",
+	"probaba",
+	"probaban",
+	"probabas",
+	"probada",
+	"probadas",
+	"probado",
+	"probados",
+	"probamos",
+	"probando",
+	"probara",
+	"probaran",
+	"probaras",
+	"probare",
+	"probaria",
+	"probaron",
+	"probase",
+	"probasen",
+	"probaste",
+	"probe",
+	"problema",
+	"probo",
+	"procesos",
+	"procurar",
+	"procure",
+	"procuro",
+	"producia",
+	"producir",
+	"proezas",
+	"programe",
+	"programo",
+	"proles",
+	"promesas",
+	"prometer",
+	"prometia",
+	"prontos",
+	"propia",
+	"propias",
+	"propios",
+	"prosa",
+	"proxima",
+	"proximas",
+	"proximos",
+	"pruebas",
+	"pruebo",
+	"publica",
+	"publicar",
+	"publicas",
+	"publicos",
+	"publique",
+	"pucheros",
+	"pude",
+	"pudiendo",
+	"pudieron",
+	"pudimos",
+	"pudo",
+	"pueblos",
+	"pueda",
+	"puedan",
+	"puede",
+	"pueden",
+	"puedes",
+	"puedo",
+	"puertas",
+	"pues",
+	"puesta",
+	"puestos",
+	"pulgas",
+	"pulia",
+	"puliamos",
+	"pulian",
+	"pulias",
+	"pulida",
+	"pulidas",
+	"pulido",
+	"pulidos",
+	"puliendo",
+	"pulimos",
+	"pulira",
+	"puliran",
+	"puliras",
+	"pulire",
+	"puliria",
+	"pulirian",
+	"pulirias",
+	"pulmones",
+	"pulpos",
+	"pulsos",
+	"pumas",
+	"punta",
+	"puntos",
+	"pupas",
+	"pupilas",
+	"pures",
+	"puse",
+	"pusieron",
+	"pusimos",
+	"puso",
+	"puñales",
+	"puños",
+	"quebraba",
+	"quebrada",
+	"quebrado",
+	"quebrar",
+	"quebrara",
+	"quebrare",
+	"quebrase",
+	"quebre",
+	"quebro",
+	"quedaba",
+	"quedaban",
+	"quedabas",
+	"quedada",
+	"quedadas",
+	"quedado",
+	"quedados",
+	"quedamos",
+	"quedando",
+	"quedara",
+	"quedaran",
+	"quedaras",
+	"quedare",
+	"quedaria",
+	"quedaron",
+	"quedase",
+	"quedasen",
+	"quedaste",
+	"quede",
+	"quedo",
+	"quejas",
+	"quemaba",
+	"quemaban",
+	"quemabas",
+	"quemada",
+	"quemadas",
+	"quemado",
+	"quemados",
+	"quemamos",
+	"quemando",
+	"quemara",
+	"quemaran",
+	"quemaras",
+	"quemare",
+	"quemaria",
+	"quemaron",
+	"quemase",
+	"quemasen",
+	"quemaste",
+	"queme",
+	"quemo",
+	"queremos",
+	"queria",
+	"querian",
+	"querias",
+	"querida",
+	"queridas",
+	"querido",
+	"queridos",
+	"querra",
+	"querre",
+	"querria",
+	"quesos",
+	"quien",
+	"quienes",
+	"quiera",
+	"quieran",
+	"quiere",
+	"quieren",
+	"quieres",
+	"quiero",
+	"quieta",
+	"quietas",
+	"quietos",
+	"quimicas",
+	"quinces",
+	"quinta",
+	"quintas",
+	"quinto",
+	"quintos",
+	"quise",
+	"quisimos",
+	"quiso",
+	"quitaba",
+	"quitaban",
+	"quitabas",
+	"quitada",
+	"quitadas",
+	"quitado",
+	"quitados",
+	"quitamos",
+	"quitando",
+	"quitara",
+	"quitaran",
+	"quitaras",
+	"quitare",
+	"quitaria",
+	"quitaron",
+	"quitase",
+	"quitasen",
+	"quitaste",
+	"quite",
+	"quito",
+	"quiza",
+	"quizas",
+	"rabanos",
+	"rabias",
+	"rabos",
+	"raciones",
+	"radar",
+	"radio",
+	"raices",
+	"ramas",
+	"rampas",
+	"ranchos",
+	"rangos",
+	"rapaces",
+	"rapida",
+	"rapidas",
+	"rapidos",
+	"raptos",
+	"rara",
+	"raras",
+	"raro",
+	"raros",
+	"rasgos",
+	"raspas",
+	"ratas",
+	"ratos",
+	"rayas",
+	"rayos",
+	"razas",
+	"razones",
+	"rebaños",
+	"rebotes",
+	"recaemos",
+	"recaera",
+	"recaeran",
+	"recaeras",
+	"recaere",
+	"recaeria",
+	"recaia",
+	"recaian",
+	"recaias",
+	"recaida",
+	"recaidas",
+	"recaido",
+	"recaidos",
+	"rece",
+	"recetas",
+	"rechazos",
+	"recibia",
+	"recibian",
+	"recibias",
+	"recibida",
+	"recibido",
+	"recibir",
+	"recibira",
+	"recibire",
+	"reclamar",
+	"reclame",
+	"reclamo",
+	"recogera",
+	"recogere",
+	"recogia",
+	"recogian",
+	"recogias",
+	"recogida",
+	"recogido",
+	"recordar",
+	"recorde",
+	"recordo",
+	"recreos",
+	"recta",
+	"rectas",
+	"rectos",
+	"recuerda",
+	"recuerdo",
+	"recursos",
+	"redes",
+	"redonda",
+	"redondas",
+	"redondos",
+	"reducia",
+	"reducian",
+	"reducias",
+	"reducida",
+	"reducido",
+	"reducira",
+	"reducire",
+	"reflejos",
+	"reformas",
+	"refranes",
+	"refugios",
+	"regalaba",
+	"regalada",
+	"regalado",
+	"regalar",
+	"regalara",
+	"regalare",
+	"regalase",
+	"regale",
+	"regalos",
+	"regia",
+	"regiamos",
+	"regian",
+	"regias",
+	"regida",
+	"regidas",
+	"regido",
+	"regidos",
+	"regimos",
+	"regira",
+	"regiran",
+	"regiras",
+	"regire",
+	"regiria",
+	"regirian",
+	"regirias",
+	"registre",
+	"registro",
+	"reglas",
+	"regresar",
+	"regrese",
+	"regresos",
+	"rehenes",
+	"reia",
+	"reiamos",
+	"reian",
+	"reias",
+	"reida",
+	"reidas",
+	"reido",
+	"reidos",
+	"reimos",
+	"reina",
+	"reinos",
+	"reira",
+	"reiran",
+	"reiras",
+	"reire",
+	"reiremos",
+	"reiria",
+	"reirian",
+	"reirias",
+	"rejas",
+	"relatos",
+	"relevos",
+	"relieves",
+	"rellenar",
+	"rellene",
+	"rellenos",
+	"relojes",
+	"remaba",
+	"remaban",
+	"remabas",
+	"remada",
+	"remadas",
+	"remado",
+	"remados",
+	"remamos",
+	"remando",
+	"remara",
+	"remaran",
+	"remaras",
+	"remare",
+	"remaria",
+	"remarian",
+	"remarias",
+	"remaron",
+	"remase",
+	"remasen",
+	"remaste",
+	"reme",
+	"remedios",
+	"remos",
+	"rendia",
+	"rendian",
+	"rendias",
+	"rendida",
+	"rendidas",
+	"rendido",
+	"rendidos",
+	"rendimos",
+	"rendira",
+	"rendiran",
+	"rendiras",
+	"rendire",
+	"rendiria",
+	"rentas",
+	"reparaba",
+	"reparada",
+	"reparado",
+	"reparar",
+	"reparara",
+	"reparare",
+	"reparase",
+	"repare",
+	"reparo",
+	"repartos",
+	"repasaba",
+	"repasada",
+	"repasado",
+	"repasar",
+	"repasara",
+	"repasare",
+	"repasase",
+	"repase",
+	"repaso",
+	"repetia",
+	"repetian",
+	"repetias",
+	"repetida",
+	"repetido",
+	"repetira",
+	"repetire",
+	"reposos",
+	"reptiles",
+	"rescates",
+	"reservar",
+	"reserve",
+	"reservo",
+	"reses",
+	"resinas",
+	"resolver",
+	"resolvia",
+	"respetar",
+	"respete",
+	"respetos",
+	"respirar",
+	"respire",
+	"respiro",
+	"resta",
+	"restos",
+	"resuelto",
+	"retiraba",
+	"retirada",
+	"retirado",
+	"retirar",
+	"retirara",
+	"retirare",
+	"retirase",
+	"retire",
+	"retiros",
+	"retornos",
+	"retos",
+	"retratos",
+	"reunia",
+	"reunian",
+	"reunias",
+	"reunida",
+	"reunidas",
+	"reunido",
+	"reunidos",
+	"reunimos",
+	"reunira",
+	"reuniran",
+	"reuniras",
+	"reunire",
+	"reuniria",
+	"revistas",
+	"rezaba",
+	"rezaban",
+	"rezabas",
+	"rezada",
+	"rezadas",
+	"rezado",
+	"rezados",
+	"rezamos",
+	"rezando",
+	"rezara",
+	"rezaran",
+	"rezaras",
+	"rezare",
+	"rezaria",
+	"rezarian",
+	"rezarias",
+	"rezaron",
+	"rezase",
+	"rezasen",
+	"rezaste",
+	"rezo",
+	"reñia",
+	"reñiamos",
+	"reñian",
+	"reñias",
+	"reñida",
+	"reñidas",
+	"reñido",
+	"reñidos",
+	"reñimos",
+	"reñir",
+	"reñira",
+	"reñiran",
+	"reñiras",
+	"reñire",
+	"reñiria",
+	"reñirian",
+	"reñirias",
+	"rica",
+	"ricas",
+	"ricos",
+	"riegos",
+	"riendas",
+	"riesgos",
+	"rifas",
+	"rifle",
+	"rigida",
+	"rigidas",
+	"rigidos",
+	"rimas",
+	"rincones",
+	"rios",
+	"riquezas",
+	"risas",
+	"ritmos",
+	"ritos",
+	"rival",
+	"rizos",
+	"riñones",
+	"robaba",
+	"robaban",
+	"robabas",
+	"robada",
+	"robadas",
+	"robado",
+	"robados",
+	"robamos",
+	"robando",
+	"robar",
+	"robara",
+	"robaran",
+	"robaras",
+	"robare",
+	"robaria",
+	"robarian",
+	"robarias",
+	"robaron",
+	"robase",
+	"robasen",
+	"robaste",
+	"robe",
+	"robles",
+	"robo",
+	"rocas",
+	"roces",
+	"rociaba",
+	"rociaban",
+	"rociabas",
+	"rociada",
+	"rociadas",
+	"rociado",
+	"rociados",
+	"rociamos",
+	"rociando",
+	"rociara",
+	"rociaran",
+	"rociaras",
+	"rociare",
+	"rociaria",
+	"rociaron",
+	"rociase",
+	"rociasen",
+	"rociaste",
+	"rocie",
+	"rocio",
+	"rodaba",
+	"rodaban",
+	"rodabas",
+	"rodada",
+	"rodadas",
+	"rodado",
+	"rodados",
+	"rodamos",
+	"rodando",
+	"rodara",
+	"rodaran",
+	"rodaras",
+	"rodare",
+	"rodaria",
+	"rodarian",
+	"rodarias",
+	"rodaron",
+	"rodase",
+	"rodasen",
+	"rodaste",
+	"rode",
+	"rodeos",
+	"rodillas",
+	"rodo",
+	"roemos",
+	"roera",
+	"roeran",
+	"roeras",
+	"roere",
+	"roeremos",
+	"roeria",
+	"roerian",
+	"roerias",
+	"rogaba",
+	"rogaban",
+	"rogabas",
+	"rogada",
+	"rogadas",
+	"rogado",
+	"rogados",
+	"rogamos",
+	"rogando",
+	"rogar",
+	"rogara",
+	"rogaran",
+	"rogaras",
+	"rogare",
+	"rogaria",
+	"rogarian",
+	"rogarias",
+	"rogaron",
+	"rogase",
+	"rogasen",
+	"rogaste",
+	"rogo",
+	"rogue",
+	"roia",
+	"roiamos",
+	"roian",
+	"roias",
+	"roida",
+	"roidas",
+	"roido",
+	"roidos",
+	"roja",
+	"rojas",
+	"rojiza",
+	"rojizas",
+	"rojizos",
+	"rojos",
+	"rollo",
+	"romeros",
+	"rompe",
+	"rompemos",
+	"rompera",
+	"romperan",
+	"romperas",
+	"rompere",
+	"romperia",
+	"rompia",
+	"rompian",
+	"rompias",
+	"ronca",
+	"roncas",
+	"roncos",
+	"rondas",
+	"ropas",
+	"roperos",
+	"rosada",
+	"rosadas",
+	"rosado",
+	"rosados",
+	"rosas",
+	"roscas",
+	"rostros",
+	"rota",
+	"rotaba",
+	"rotaban",
+	"rotabas",
+	"rotada",
+	"rotadas",
+	"rotado",
+	"rotados",
+	"rotamos",
+	"rotando",
+	"rotara",
+	"rotaran",
+	"rotaras",
+	"rotare",
+	"rotaria",
+	"rotarian",
+	"rotarias",
+	"rotaron",
+	"rotase",
+	"rotasen",
+	"rotaste",
+	"rote",
+	"roto",
+	"rubia",
+	"rubias",
+	"rubio",
+	"rubios",
+	"rubis",
+	"ruda",
+	"rudas",
+	"rudos",
+	"ruedas",
+	"rugia",
+	"rugiamos",
+	"rugian",
+	"rugias",
+	"rugida",
+	"rugidas",
+	"rugido",
+	"rugidos",
+	"rugiendo",
+	"rugimos",
+	"rugira",
+	"rugiran",
+	"rugiras",
+	"rugire",
+	"rugiria",
+	"rugirian",
+	"rugirias",
+	"ruidos",
+	"ruinas",
+	"ruletas",
+	"rulos",
+	"rumbos",
+	"rupturas",
+	"rutas",
+	"rutinas",
+	"sabados",
+	"sabe",
+	"sabemos",
+	"saben",
+	"sabes",
+	"sabia",
+	"sabiamos",
+	"sabian",
+	"sabias",
+	"sabida",
+	"sabidas",
+	"sabido",
+	"sabidos",
+	"sabiendo",
+	"sabios",
+	"sables",
+	"sabor",
+	"sabra",
+	"sabre",
+	"sabria",
+	"sabrosa",
+	"sabrosas",
+	"sabroso",
+	"sabrosos",
+	"sacaba",
+	"sacaban",
+	"sacabas",
+	"sacada",
+	"sacadas",
+	"sacado",
+	"sacados",
+	"sacamos",
+	"sacando",
+	"sacara",
+	"sacaran",
+	"sacaras",
+	"sacare",
+	"sacaria",
+	"sacarian",
+	"sacarias",
+	"sacaron",
+	"sacase",
+	"sacasen",
+	"sacaste",
+	"saco",
+	"sacos",
+	"sagaces",
+	"sagrada",
+	"sagradas",
+	"sagrados",
+	"salas",
+	"saldos",
+	"saldra",
+	"saldre",
+	"saldria",
+	"sale",
+	"salen",
+	"saleros",
+	"sales",
+	"salga",
+	"salgan",
+	"salgo",
+	"salia",
+	"saliamos",
+	"salian",
+	"salias",
+	"salida",
+	"salidas",
+	"salido",
+	"salidos",
+	"saliendo",
+	"salimos",
+	"salmones",
+	"salones",
+	"salsas",
+	"saltaba",
+	"saltaban",
+	"saltabas",
+	"saltada",
+	"saltadas",
+	"saltado",
+	"saltados",
+	"saltamos",
+	"saltando",
+	"saltar",
+	"saltara",
+	"saltaran",
+	"saltaras",
+	"saltare",
+	"saltaria",
+	"saltaron",
+	"saltase",
+	"saltasen",
+	"saltaste",
+	"salte",
+	"saltos",
+	"saludaba",
+	"saludada",
+	"saludado",
+	"saludar",
+	"saludara",
+	"saludare",
+	"saludase",
+	"salude",
+	"saludes",
+	"saludo",
+	"salvaba",
+	"salvaban",
+	"salvabas",
+	"salvada",
+	"salvadas",
+	"salvado",
+	"salvados",
+	"salvamos",
+	"salvando",
+	"salvara",
+	"salvaran",
+	"salvaras",
+	"salvare",
+	"salvaria",
+	"salvaron",
+	"salvase",
+	"salvasen",
+	"salvaste",
+	"salve",
+	"salvo",
+	"sambas",
+	"sana",
+	"sanas",
+	"sandias",
+	"saneaba",
+	"saneaban",
+	"saneabas",
+	"saneada",
+	"saneadas",
+	"saneado",
+	"saneados",
+	"saneamos",
+	"saneando",
+	"saneara",
+	"sanearan",
+	"sanearas",
+	"saneare",
+	"sanearia",
+	"sanearon",
+	"sanease",
+	"saneasen",
+	"saneaste",
+	"sanee",
+	"saneo",
+	"sangraba",
+	"sangrada",
+	"sangrado",
+	"sangrar",
+	"sangrara",
+	"sangrare",
+	"sangrase",
+	"sangres",
+	"sangro",
+	"sanos",
+	"santa",
+	"santas",
+	"santos",
+	"sapos",
+	"saques",
+	"sardinas",
+	"sartenes",
+	"sastres",
+	"sauce",
+	"saunas",
+	"seamos",
+	"sean",
+	"seas",
+	"seca",
+	"secaba",
+	"secaban",
+	"secabas",
+	"secada",
+	"secadas",
+	"secado",
+	"secados",
+	"secamos",
+	"secando",
+	"secar",
+	"secara",
+	"secaran",
+	"secaras",
+	"secare",
+	"secaria",
+	"secarian",
+	"secarias",
+	"secaron",
+	"secas",
+	"secase",
+	"secasen",
+	"secaste",
+	"secos",
+	"secretos",
+	"sectas",
+	"sedal",
+	"seguia",
+	"seguian",
+	"seguias",
+	"seguida",
+	"seguidas",
+	"seguido",
+	"seguidos",
+	"seguimos",
+	"seguira",
+	"seguiran",
+	"seguiras",
+	"seguire",
+	"seguiria",
+	"segun",
+	"segunda",
+	"segundo",
+	"sellaba",
+	"sellaban",
+	"sellabas",
+	"sellada",
+	"selladas",
+	"sellado",
+	"sellados",
+	"sellamos",
+	"sellando",
+	"sellar",
+	"sellara",
+	"sellaran",
+	"sellaras",
+	"sellare",
+	"sellaria",
+	"sellaron",
+	"sellase",
+	"sellasen",
+	"sellaste",
+	"selle",
+	"sellos",
+	"selvas",
+	"semanas",
+	"semillas",
+	"sendas",
+	"sentaba",
+	"sentaban",
+	"sentabas",
+	"sentada",
+	"sentadas",
+	"sentado",
+	"sentados",
+	"sentamos",
+	"sentando",
+	"sentar",
+	"sentara",
+	"sentaran",
+	"sentaras",
+	"sentare",
+	"sentaria",
+	"sentaron",
+	"sentase",
+	"sentasen",
+	"sentaste",
+	"sente",
+	"sentia",
+	"sentian",
+	"sentias",
+	"sentida",
+	"sentidas",
+	"sentido",
+	"sentidos",
+	"sentimos",
+	"sentir",
+	"sentira",
+	"sentiran",
+	"sentiras",
+	"sentire",
+	"sentiria",
+	"sento",
+	"sepa",
+	"sepan",
+	"separaba",
+	"separada",
+	"separado",
+	"separara",
+	"separare",
+	"separase",
+	"separe",
+	"separo",
+	"sepas",
+	"sepias",
+	"seque",
+	"sequias",
+	"seria",
+	"serias",
+	"series",
+	"serio",
+	"serios",
+	"sermones",
+	"servia",
+	"servian",
+	"servias",
+	"servida",
+	"servidas",
+	"servido",
+	"servidos",
+	"servimos",
+	"servira",
+	"serviran",
+	"serviras",
+	"servire",
+	"serviria",
+	"sesentas",
+	"sesiones",
+	"sesos",
+	"setas",
+	"setentas",
+	"severa",
+	"severas",
+	"severos",
+	"sexos",
+	"sexta",
+	"sextas",
+	"sextos",
+	"señalaba",
+	"señalada",
+	"señalado",
+	"señalar",
+	"señalara",
+	"señalare",
+	"señalase",
+	"señale",
+	"señales",
+	"señalo",
+	"señora",
+	"señores",
+	"sido",
+	"sidras",
+	"siempre",
+	"siendo",
+	"siente",
+	"sienten",
+	"sientes",
+	"siento",
+	"siestas",
+	"sietes",
+	"siglos",
+	"signos",
+	"sigo",
+	"sigue",
+	"siguen",
+	"siguio",
+	"silabas",
+	"silbaba",
+	"silbaban",
+	"silbabas",
+	"silbada",
+	"silbadas",
+	"silbado",
+	"silbados",
+	"silbamos",
+	"silbando",
+	"silbara",
+	"silbaran",
+	"silbaras",
+	"silbare",
+	"silbaria",
+	"silbaron",
+	"silbase",
+	"silbasen",
+	"silbaste",
+	"silbe",
+	"silbo",
+	"sillas",
+	"simbolos",
+	"simios",
+	"sino",
+	"sintio",
+	"sirenas",
+	"sirve",
+	"sirven",
+	"sirvio",
+	"sirvo",
+	"sistemas",
+	"sitios",
+	"situaba",
+	"situaban",
+	"situabas",
+	"situada",
+	"situadas",
+	"situado",
+	"situados",
+	"situamos",
+	"situando",
+	"situara",
+	"situaran",
+	"situaras",
+	"situare",
+	"situaria",
+	"situaron",
+	"situase",
+	"situasen",
+	"situaste",
+	"situe",
+	"situo",
+	"sobres",
+	"socia",
+	"socias",
+	"socios",
+	"sodios",
+	"sois",
+	"solapas",
+	"solar",
+	"soldados",
+	"soler",
+	"solida",
+	"solidas",
+	"solidos",
+	"soltaba",
+	"soltaban",
+	"soltabas",
+	"soltada",
+	"soltadas",
+	"soltado",
+	"soltados",
+	"soltamos",
+	"soltando",
+	"soltara",
+	"soltaran",
+	"soltaras",
+	"soltare",
+	"soltaria",
+	"soltaron",
+	"soltase",
+	"soltasen",
+	"soltaste",
+	"solte",
+	"solto",
+	"sombras",
+	"somos",
+	"sonaba",
+	"sonaban",
+	"sonabas",
+	"sonada",
+	"sonadas",
+	"sonado",
+	"sonados",
+	"sonamos",
+	"sonando",
+	"sonar",
+	"sonara",
+	"sonaran",
+	"sonaras",
+	"sonare",
+	"sonaria",
+	"sonarian",
+	"sonarias",
+	"sonaron",
+	"sonase",
+	"sonasen",
+	"sonaste",
+	"sondeos",
+	"sone",
+	"sonidos",
+	"sono",
+	"sonora",
+	"sonoras",
+	"sonoros",
+	"sonrisas",
+	"sopas",
+	"soplaba",
+	"soplaban",
+	"soplabas",
+	"soplada",
+	"sopladas",
+	"soplado",
+	"soplados",
+	"soplamos",
+	"soplando",
+	"soplara",
+	"soplaran",
+	"soplaras",
+	"soplare",
+	"soplaria",
+	"soplaron",
+	"soplase",
+	"soplasen",
+	"soplaste",
+	"sople",
+	"soplo",
+	"soportar",
+	"soportes",
+	"soporto",
+	"sorda",
+	"sordas",
+	"sordos",
+	"sorteaba",
+	"sorteada",
+	"sorteado",
+	"sortear",
+	"sorteara",
+	"sorteare",
+	"sortease",
+	"sortee",
+	"sorteos",
+	"sostenes",
+	"sotanos",
+	"soñaba",
+	"soñaban",
+	"soñabas",
+	"soñada",
+	"soñadas",
+	"soñado",
+	"soñados",
+	"soñamos",
+	"soñando",
+	"soñar",
+	"soñara",
+	"soñaran",
+	"soñaras",
+	"soñare",
+	"soñaria",
+	"soñarian",
+	"soñarias",
+	"soñaron",
+	"soñase",
+	"soñasen",
+	"soñaste",
+	"soñe",
+	"soño",
+	"suaves",
+	"subia",
+	"subiamos",
+	"subian",
+	"subias",
+	"subida",
+	"subidas",
+	"subido",
+	"subidos",
+	"subimos",
+	"subira",
+	"subiran",
+	"subiras",
+	"subire",
+	"subiria",
+	"subirian",
+	"subirias",
+	"sucesos",
+	"sucia",
+	"sucias",
+	"sucio",
+	"sucios",
+	"suegras",
+	"suelos",
+	"suena",
+	"suero",
+	"suertes",
+	"sueña",
+	"sueños",
+	"sufria",
+	"sufrian",
+	"sufrias",
+	"sufrida",
+	"sufridas",
+	"sufrido",
+	"sufridos",
+	"sufrimos",
+	"sufrira",
+	"sufriran",
+	"sufriras",
+	"sufrire",
+	"sufriria",
+	"sujetos",
+	"sultanes",
+	"suma",
+	"sumaba",
+	"sumaban",
+	"sumabas",
+	"sumada",
+	"sumadas",
+	"sumado",
+	"sumados",
+	"sumamos",
+	"sumando",
+	"sumara",
+	"sumaran",
+	"sumaras",
+	"sumare",
+	"sumaria",
+	"sumarian",
+	"sumarias",
+	"sumaron",
+	"sumase",
+	"sumasen",
+	"sumaste",
+	"sume",
+	"sumo",
+	"supe",
+	"super",
+	"superaba",
+	"superada",
+	"superado",
+	"superara",
+	"superare",
+	"superase",
+	"supere",
+	"supero",
+	"supieron",
+	"supimos",
+	"suplia",
+	"suplian",
+	"suplias",
+	"suplida",
+	"suplidas",
+	"suplido",
+	"suplidos",
+	"suplimos",
+	"suplira",
+	"supliran",
+	"supliras",
+	"suplire",
+	"supliria",
+	"supo",
+	"suponia",
+	"suponian",
+	"suponias",
+	"suprema",
+	"supremas",
+	"supremos",
+	"surcos",
+	"sureña",
+	"sureñas",
+	"sureños",
+	"surgia",
+	"surgian",
+	"surgias",
+	"surgida",
+	"surgidas",
+	"surgido",
+	"surgidos",
+	"surgimos",
+	"surgira",
+	"surgiran",
+	"surgiras",
+	"surgire",
+	"surgiria",
+	"sustos",
+	"sutiles",
+	"suya",
+	"suyas",
+	"suyo",
+	"suyos",
+	"tabacos",
+	"tabiques",
+	"tablas",
+	"tabus",
+	"tacos",
+	"tactos",
+	"tajos",
+	"talaba",
+	"talaban",
+	"talabas",
+	"talada",
+	"taladas",
+	"talado",
+	"talados",
+	"talamos",
+	"talando",
+	"talara",
+	"talaran",
+	"talaras",
+	"talare",
+	"talaria",
+	"talarian",
+	"talarias",
+	"talaron",
+	"talase",
+	"talasen",
+	"talaste",
+	"talcos",
+	"tale",
+	"talentos",
+	"tallas",
+	"talo",
+	"talones",
+	"tamaños",
+	"tambien",
+	"tampoco",
+	"tangos",
+	"tanques",
+	"tanta",
+	"tantas",
+	"tanto",
+	"tantos",
+	"tapas",
+	"tapetes",
+	"tapias",
+	"tapiz",
+	"tapones",
+	"tardaba",
+	"tardaban",
+	"tardabas",
+	"tardada",
+	"tardadas",
+	"tardado",
+	"tardados",
+	"tardamos",
+	"tardando",
+	"tardar",
+	"tardara",
+	"tardaran",
+	"tardaras",
+	"tardare",
+	"tardaria",
+	"tardaron",
+	"tardase",
+	"tardasen",
+	"tardaste",
+	"tardes",
+	"tardia",
+	"tardias",
+	"tardio",
+	"tardios",
+	"tardo",
+	"tareas",
+	"tarifas",
+	"tarjetas",
+	"tarros",
+	"tartas",
+	"tatuajes",
+	"tauros",
+	"taxis",
+	"tazas",
+	"tazones",
+	"teatros",
+	"techos",
+	"teclas",
+	"tecnicas",
+	"tejados",
+	"tejas",
+	"tejemos",
+	"tejera",
+	"tejeran",
+	"tejeras",
+	"tejere",
+	"tejeria",
+	"tejerian",
+	"tejerias",
+	"tejia",
+	"tejiamos",
+	"tejian",
+	"tejias",
+	"tejida",
+	"tejidas",
+	"tejidos",
+	"tejiendo",
+	"telas",
+	"temas",
+	"tememos",
+	"temer",
+	"temera",
+	"temeran",
+	"temeras",
+	"temere",
+	"temeria",
+	"temerian",
+	"temerias",
+	"temia",
+	"temiamos",
+	"temian",
+	"temias",
+	"temida",
+	"temidas",
+	"temido",
+	"temidos",
+	"temiendo",
+	"templos",
+	"temprana",
+	"temprano",
+	"tenaces",
+	"tendemos",
+	"tendera",
+	"tenderan",
+	"tenderas",
+	"tendere",
+	"tenderia",
+	"tendia",
+	"tendian",
+	"tendias",
+	"tendida",
+	"tendidas",
+	"tendido",
+	"tendidos",
+	"tendra",
+	"tendre",
+	"tendria",
+	"tenedor",
+	"tenemos",
+	"tenga",
+	"tengan",
+	"tengas",
+	"tengo",
+	"tenia",
+	"teniamos",
+	"tenian",
+	"tenias",
+	"tenida",
+	"tenidas",
+	"tenido",
+	"tenidos",
+	"teniendo",
+	"tenor",
+	"tensa",
+	"tensas",
+	"tensos",
+	"teorias",
+	"terapias",
+	"terca",
+	"tercas",
+	"tercera",
+	"tercero",
+	"tercos",
+	"terminar",
+	"termine",
+	"terminos",
+	"ternuras",
+	"tesoros",
+	"testigos",
+	"teteras",
+	"textos",
+	"tibia",
+	"tibias",
+	"tibios",
+	"tiempos",
+	"tiendas",
+	"tiene",
+	"tienen",
+	"tienes",
+	"tierras",
+	"tiesa",
+	"tiesas",
+	"tiesos",
+	"tigres",
+	"tijeras",
+	"tildes",
+	"timbres",
+	"timida",
+	"timidas",
+	"timidos",
+	"timos",
+	"tintas",
+	"tipica",
+	"tipicas",
+	"tipicos",
+	"tipos",
+	"tiraba",
+	"tiraban",
+	"tirabas",
+	"tirada",
+	"tiradas",
+	"tirado",
+	"tirados",
+	"tiramos",
+	"tirando",
+	"tirar",
+	"tirara",
+	"tiraran",
+	"tiraras",
+	"tirare",
+	"tiraria",
+	"tirarian",
+	"tirarias",
+	"tiraron",
+	"tiras",
+	"tirase",
+	"tirasen",
+	"tiraste",
+	"tire",
+	"tiro",
+	"tirones",
+	"titanes",
+	"titeres",
+	"titulos",
+	"tizas",
+	"toallas",
+	"tobas",
+	"tobillos",
+	"tocaba",
+	"tocaban",
+	"tocabas",
+	"tocada",
+	"tocadas",
+	"tocado",
+	"tocados",
+	"tocamos",
+	"tocando",
+	"tocara",
+	"tocaran",
+	"tocaras",
+	"tocare",
+	"tocaria",
+	"tocarian",
+	"tocarias",
+	"tocaron",
+	"tocase",
+	"tocasen",
+	"tocaste",
+	"tocinos",
+	"toco",
+	"toda",
+	"todas",
+	"todavia",
+	"todos",
+	"togas",
+	"toldos",
+	"tomaba",
+	"tomaban",
+	"tomabas",
+	"tomada",
+	"tomadas",
+	"tomado",
+	"tomados",
+	"tomamos",
+	"tomando",
+	"tomara",
+	"tomaran",
+	"tomaras",
+	"tomare",
+	"tomaria",
+	"tomarian",
+	"tomarias",
+	"tomaron",
+	"tomase",
+	"tomasen",
+	"tomaste",
+	"tome",
+	"tomo",
+	"tonos",
+	"tonta",
+	"tontas",
+	"tontos",
+	"topaba",
+	"topaban",
+	"topabas",
+	"topada",
+	"topadas",
+	"topado",
+	"topados",
+	"topamos",
+	"topando",
+	"topara",
+	"toparan",
+	"toparas",
+	"topare",
+	"toparia",
+	"toparian",
+	"toparias",
+	"toparon",
+	"topase",
+	"topasen",
+	"topaste",
+	"topes",
+	"topo",
+	"toques",
+	"torcemos",
+	"torcer",
+	"torcera",
+	"torceran",
+	"torceras",
+	"torcere",
+	"torceria",
+	"torcia",
+	"torcian",
+	"torcias",
+	"torcida",
+	"torcidas",
+	"torcido",
+	"torcidos",
+	"torera",
+	"toreras",
+	"toreros",
+	"torneos",
+	"toros",
+	"torpedos",
+	"torres",
+	"torsos",
+	"tortugas",
+	"tosca",
+	"toscas",
+	"toscos",
+	"tosemos",
+	"tosera",
+	"toseran",
+	"toseras",
+	"tosere",
+	"toseria",
+	"toserian",
+	"toserias",
+	"tosia",
+	"tosiamos",
+	"tosian",
+	"tosias",
+	"tosida",
+	"tosidas",
+	"tosido",
+	"tosidos",
+	"tosiendo",
+	"tostaba",
+	"tostaban",
+	"tostabas",
+	"tostada",
+	"tostadas",
+	"tostado",
+	"tostados",
+	"tostamos",
+	"tostando",
+	"tostar",
+	"tostara",
+	"tostaran",
+	"tostaras",
+	"tostare",
+	"tostaria",
+	"tostaron",
+	"tostase",
+	"tostasen",
+	"tostaste",
+	"toste",
+	"tosto",
+	"total",
+	"toxica",
+	"toxicas",
+	"toxicos",
+	"trababa",
+	"trababan",
+	"trababas",
+	"trabada",
+	"trabadas",
+	"trabado",
+	"trabados",
+	"trabajar",
+	"trabaje",
+	"trabajos",
+	"trabamos",
+	"trabando",
+	"trabar",
+	"trabara",
+	"trabaran",
+	"trabaras",
+	"trabare",
+	"trabaria",
+	"trabaron",
+	"trabase",
+	"trabasen",
+	"trabaste",
+	"trabe",
+	"trabo",
+	"trace",
+	"traducia",
+	"traducir",
+	"traduzco",
+	"trae",
+	"traemos",
+	"traen",
+	"traera",
+	"traeran",
+	"traeras",
+	"traere",
+	"traeria",
+	"traerian",
+	"traerias",
+	"traficos",
+	"tragaba",
+	"tragaban",
+	"tragabas",
+	"tragada",
+	"tragadas",
+	"tragado",
+	"tragados",
+	"tragamos",
+	"tragando",
+	"tragar",
+	"tragara",
+	"tragaran",
+	"tragaras",
+	"tragare",
+	"tragaria",
+	"tragaron",
+	"tragase",
+	"tragasen",
+	"tragaste",
+	"tragos",
+	"trague",
+	"traia",
+	"traiamos",
+	"traian",
+	"traias",
+	"traida",
+	"traidas",
+	"traido",
+	"traidos",
+	"traiga",
+	"traigo",
+	"trajeron",
+	"trajes",
+	"trajo",
+	"trama",
+	"tramos",
+	"trances",
+	"trapo",
+	"tras",
+	"traslade",
+	"traslado",
+	"trataba",
+	"trataban",
+	"tratabas",
+	"tratada",
+	"tratadas",
+	"tratado",
+	"tratados",
+	"tratamos",
+	"tratando",
+	"tratar",
+	"tratara",
+	"trataran",
+	"trataras",
+	"tratare",
+	"trataria",
+	"trataron",
+	"tratase",
+	"tratasen",
+	"trataste",
+	"trate",
+	"tratos",
+	"traumas",
+	"trazaba",
+	"trazaban",
+	"trazabas",
+	"trazada",
+	"trazadas",
+	"trazado",
+	"trazados",
+	"trazamos",
+	"trazando",
+	"trazara",
+	"trazaran",
+	"trazaras",
+	"trazare",
+	"trazaria",
+	"trazaron",
+	"trazase",
+	"trazasen",
+	"trazaste",
+	"trazo",
+	"treboles",
+	"trece",
+	"treguas",
+	"treintas",
+	"trenes",
+	"trepaba",
+	"trepaban",
+	"trepabas",
+	"trepada",
+	"trepadas",
+	"trepado",
+	"trepados",
+	"trepamos",
+	"trepando",
+	"trepara",
+	"treparan",
+	"treparas",
+	"trepare",
+	"treparia",
+	"treparon",
+	"trepase",
+	"trepasen",
+	"trepaste",
+	"trepe",
+	"trepo",
+	"tribus",
+	"trigos",
+	"tripas",
+	"tristes",
+	"triunfar",
+	"triunfe",
+	"triunfos",
+	"trofeos",
+	"trompas",
+	"troncos",
+	"tropas",
+	"trotes",
+	"trozos",
+	"trucha",
+	"trucos",
+	"truenos",
+	"trufas",
+	"tuberias",
+	"tubos",
+	"tuerta",
+	"tuertas",
+	"tuertos",
+	"tumbas",
+	"tuneles",
+	"tunicas",
+	"turbinas",
+	"turismos",
+	"turnos",
+	"tuve",
+	"tuvieron",
+	"tuvimos",
+	"tuvo",
+	"tuya",
+	"tuyas",
+	"tuyo",
+	"tuyos",
+	"ubicaba",
+	"ubicaban",
+	"ubicabas",
+	"ubicada",
+	"ubicadas",
+	"ubicado",
+	"ubicados",
+	"ubicamos",
+	"ubicando",
+	"ubicara",
+	"ubicaran",
+	"ubicaras",
+	"ubicare",
+	"ubicaria",
+	"ubicaron",
+	"ubicase",
+	"ubicasen",
+	"ubicaste",
+	"ubico",
+	"ubique",
+	"ulceras",
+	"ultima",
+	"ultimo",
+	"umbrales",
+	"unas",
+	"unia",
+	"uniamos",
+	"unian",
+	"unias",
+	"unico",
+	"unida",
+	"unidades",
+	"unidas",
+	"unido",
+	"unidos",
+	"uniendo",
+	"unimos",
+	"union",
+	"unira",
+	"uniran",
+	"uniras",
+	"unire",
+	"uniremos",
+	"uniria",
+	"unirian",
+	"unirias",
+	"unos",
+	"untaba",
+	"untaban",
+	"untabas",
+	"untada",
+	"untadas",
+	"untado",
+	"untados",
+	"untamos",
+	"untando",
+	"untara",
+	"untaran",
+	"untaras",
+	"untare",
+	"untaria",
+	"untarian",
+	"untarias",
+	"untaron",
+	"untase",
+	"untasen",
+	"untaste",
+	"unte",
+	"unto",
+	"urbana",
+	"urbanas",
+	"urbanos",
+	"urbes",
+	"urgentes",
+	"urnas",
+	"usaba",
+	"usabamos",
+	"usaban",
+	"usabas",
+	"usada",
+	"usadas",
+	"usado",
+	"usados",
+	"usamos",
+	"usando",
+	"usara",
+	"usaran",
+	"usaras",
+	"usare",
+	"usaremos",
+	"usaria",
+	"usarian",
+	"usarias",
+	"usaron",
+	"usase",
+	"usasen",
+	"usaste",
+	"usted",
+	"ustedes",
+	"usuaria",
+	"usuarias",
+	"usuarios",
+	"utiles",
+	"utopias",
+	"uvas",
+	"vacas",
+	"vacia",
+	"vaciaba",
+	"vaciaban",
+	"vaciabas",
+	"vaciada",
+	"vaciadas",
+	"vaciado",
+	"vaciados",
+	"vaciamos",
+	"vaciando",
+	"vaciar",
+	"vaciara",
+	"vaciaran",
+	"vaciaras",
+	"vaciare",
+	"vaciaria",
+	"vaciaron",
+	"vacias",
+	"vaciase",
+	"vaciasen",
+	"vaciaste",
+	"vacie",
+	"vacios",
+	"vacunas",
+	"vaga",
+	"vagaba",
+	"vagaban",
+	"vagabas",
+	"vagada",
+	"vagadas",
+	"vagado",
+	"vagados",
+	"vagamos",
+	"vagando",
+	"vagara",
+	"vagaran",
+	"vagaras",
+	"vagare",
+	"vagaria",
+	"vagarian",
+	"vagarias",
+	"vagaron",
+	"vagas",
+	"vagase",
+	"vagasen",
+	"vagaste",
+	"vagon",
+	"vagos",
+	"vague",
+	"vainas",
+	"vajillas",
+	"vales",
+	"valida",
+	"validaba",
+	"validada",
+	"validado",
+	"validar",
+	"validara",
+	"validare",
+	"validas",
+	"validase",
+	"valide",
+	"validos",
+	"valles",
+	"valoraba",
+	"valorada",
+	"valorado",
+	"valorar",
+	"valorara",
+	"valorare",
+	"valorase",
+	"valore",
+	"valoro",
+	"valvulas",
+	"vamos",
+	"vampiros",
+	"vapor",
+	"varas",
+	"variaba",
+	"variaban",
+	"variabas",
+	"variada",
+	"variadas",
+	"variado",
+	"variados",
+	"variamos",
+	"variando",
+	"variara",
+	"variaran",
+	"variaras",
+	"variare",
+	"variaria",
+	"variaron",
+	"variase",
+	"variasen",
+	"variaste",
+	"varie",
+	"vario",
+	"varones",
+	"vasos",
+	"vaya",
+	"vayamos",
+	"vayan",
+	"vayas",
+	"vean",
+	"veas",
+	"veces",
+	"vecina",
+	"vecinas",
+	"vecinos",
+	"veia",
+	"veian",
+	"veintes",
+	"vejeces",
+	"velaba",
+	"velaban",
+	"velabas",
+	"velada",
+	"veladas",
+	"velado",
+	"velados",
+	"velamos",
+	"velando",
+	"velar",
+	"velara",
+	"velaran",
+	"velaras",
+	"velare",
+	"velaria",
+	"velarian",
+	"velarias",
+	"velaron",
+	"velas",
+	"velase",
+	"velasen",
+	"velaste",
+	"vele",
+	"veleros",
+	"vello",
+	"velo",
+	"veloces",
+	"vemos",
+	"venas",
+	"vencemos",
+	"vencera",
+	"venceran",
+	"venceras",
+	"vencere",
+	"venceria",
+	"vencia",
+	"vencian",
+	"vencias",
+	"vencida",
+	"vencidas",
+	"vencido",
+	"vencidos",
+	"vendas",
+	"vendemos",
+	"vender",
+	"vendera",
+	"venderan",
+	"venderas",
+	"vendere",
+	"venderia",
+	"vendia",
+	"vendian",
+	"vendias",
+	"vendida",
+	"vendidas",
+	"vendido",
+	"vendidos",
+	"vendra",
+	"vendre",
+	"vendria",
+	"venenos",
+	"venga",
+	"vengaba",
+	"vengaban",
+	"vengabas",
+	"vengada",
+	"vengadas",
+	"vengado",
+	"vengados",
+	"vengamos",
+	"vengan",
+	"vengando",
+	"vengara",
+	"vengaran",
+	"vengaras",
+	"vengare",
+	"vengaria",
+	"vengaron",
+	"vengase",
+	"vengasen",
+	"vengaste",
+	"vengo",
+	"vengue",
+	"venia",
+	"veniamos",
+	"venian",
+	"venias",
+	"venida",
+	"venidas",
+	"venido",
+	"venidos",
+	"venimos",
+	"ventana",
+	"ventanas",
+	"ventas",
+	"ventilar",
+	"ventile",
+	"ventilo",
+	"vera",
+	"veranos",
+	"verbos",
+	"verdad",
+	"verdes",
+	"vere",
+	"veredas",
+	"veria",
+	"verifico",
+	"verjas",
+	"versos",
+	"vertemos",
+	"vertera",
+	"verteran",
+	"verteras",
+	"vertere",
+	"verteria",
+	"vertia",
+	"vertian",
+	"vertias",
+	"vertida",
+	"vertidas",
+	"vertido",
+	"vertidos",
+	"vestia",
+	"vestian",
+	"vestias",
+	"vestida",
+	"vestidas",
+	"vestido",
+	"vestidos",
+	"vestimos",
+	"vestir",
+	"vestira",
+	"vestiran",
+	"vestiras",
+	"vestire",
+	"vestiria",
+	"viajaba",
+	"viajaban",
+	"viajabas",
+	"viajada",
+	"viajadas",
+	"viajado",
+	"viajados",
+	"viajamos",
+	"viajando",
+	"viajar",
+	"viajara",
+	"viajaran",
+	"viajaras",
+	"viajare",
+	"viajaria",
+	"viajaron",
+	"viajase",
+	"viajasen",
+	"viajaste",
+	"viajes",
+	"viajo",
+	"vibraba",
+	"vibraban",
+	"vibrabas",
+	"vibrada",
+	"vibradas",
+	"vibrado",
+	"vibrados",
+	"vibramos",
+	"vibrando",
+	"vibrara",
+	"vibraran",
+	"vibraras",
+	"vibrare",
+	"vibraria",
+	"vibraron",
+	"vibrase",
+	"vibrasen",
+	"vibraste",
+	"vibre",
+	"vibro",
+	"vicios",
+	"victimas",
+	"vidas",
+	"videos",
+	"vidrios",
+	"vieja",
+	"viejas",
+	"viejos",
+	"viendo",
+	"viene",
+	"vienen",
+	"vienes",
+	"viento",
+	"vieron",
+	"vigilaba",
+	"vigilada",
+	"vigilado",
+	"vigilar",
+	"vigilara",
+	"vigilare",
+	"vigilase",
+	"vigile",
+	"vigilo",
+	"villas",
+	"vimos",
+	"vinagres",
+	"vine",
+	"viniendo",
+	"vinieron",
+	"vinimos",
+	"vinos",
+	"violaba",
+	"violaban",
+	"violabas",
+	"violada",
+	"violadas",
+	"violado",
+	"violados",
+	"violamos",
+	"violando",
+	"violar",
+	"violara",
+	"violaran",
+	"violaras",
+	"violare",
+	"violaria",
+	"violaron",
+	"violase",
+	"violasen",
+	"violaste",
+	"viole",
+	"violines",
+	"violo",
+	"virales",
+	"virgos",
+	"virtudes",
+	"visitaba",
+	"visitada",
+	"visitado",
+	"visitar",
+	"visitara",
+	"visitare",
+	"visitase",
+	"visite",
+	"visito",
+	"visperas",
+	"vistas",
+	"visto",
+	"viuda",
+	"viudas",
+	"viudos",
+	"viva",
+	"vivaces",
+	"vivas",
+	"viveros",
+	"vivia",
+	"viviamos",
+	"vivian",
+	"vivias",
+	"vivida",
+	"vividas",
+	"vivido",
+	"vividos",
+	"viviendo",
+	"vivimos",
+	"vivira",
+	"viviran",
+	"viviras",
+	"vivire",
+	"viviria",
+	"vivirian",
+	"vivirias",
+	"vivos",
+	"viñedos",
+	"vocal",
+	"volaba",
+	"volaban",
+	"volabas",
+	"volada",
+	"voladas",
+	"volado",
+	"volados",
+	"volamos",
+	"volando",
+	"volar",
+	"volara",
+	"volaran",
+	"volaras",
+	"volare",
+	"volaria",
+	"volarian",
+	"volarias",
+	"volaron",
+	"volase",
+	"volasen",
+	"volaste",
+	"volcanes",
+	"vole",
+	"volo",
+	"volvemos",
+	"volvera",
+	"volveran",
+	"volveras",
+	"volvere",
+	"volveria",
+	"volvia",
+	"volvian",
+	"volvias",
+	"vomitaba",
+	"vomitada",
+	"vomitado",
+	"vomitar",
+	"vomitara",
+	"vomitare",
+	"vomitase",
+	"vomite",
+	"vomito",
+	"voraces",
+	"vosotras",
+	"vosotros",
+	"votaba",
+	"votaban",
+	"votabas",
+	"votada",
+	"votadas",
+	"votado",
+	"votados",
+	"votamos",
+	"votando",
+	"votara",
+	"votaran",
+	"votaras",
+	"votare",
+	"votaria",
+	"votarian",
+	"votarias",
+	"votaron",
+	"votase",
+	"votasen",
+	"votaste",
+	"vote",
+	"votos",
+	"vuela",
+	"vuelos",
+	"vuelta",
+	"vueltas",
+	"vuelto",
+	"vuelve",
+	"vuelven",
+	"vuelvo",
+	"vuestra",
+	"vuestro",
+	"vulgares",
+	"yacemos",
+	"yacera",
+	"yaceran",
+	"yaceras",
+	"yacere",
+	"yaceria",
+	"yacerian",
+	"yacerias",
+	"yacia",
+	"yaciamos",
+	"yacian",
+	"yacias",
+	"yacida",
+	"yacidas",
+	"yacido",
+	"yacidos",
+	"yaciendo",
+	"yates",
+	"yeguas",
+	"yemas",
+	"yendo",
+	"yerba",
+	"yernos",
+	"yesos",
+	"yodos",
+	"yogas",
+	"zafiros",
+	"zanjas",
+	"zapatear",
+	"zapatee",
+	"zapateo",
+	"zapatos",
+	"zarpa",
+	"zarzas",
+	"zonas",
+	"zorra",
+	"zorras",
+	"zorros",
+	"zumba",
+	"zumos",
+	"zurda",
+	"zurdas",
+	"zurdos",
+}
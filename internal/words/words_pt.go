@@ -0,0 +1,3977 @@
+package words
+
+// Portuguese answers of four to eight letters: common words in their usual
+// form.
+var portugueseAnswers = [...]string{
+	"abelha",
+	"aberto",
+	"abraco",
+	"abrigo",
+	"abril",
+	"acaso",
+	"aceitar",
+	"achar",
+	"acordo",
+	"acucar",
+	"adeus",
+	"afeto",
+	"agora",
+	"agosto",
+	"agua",
+	"aguia",
+	"agulha",
+	"ainda",
+	"alegre",
+	"alegria",
+	"algum",
+	"alma",
+	"almoco",
+	"altar",
+	"alto",
+	"aluno",
+	"amante",
+	"amar",
+	"amarelo",
+	"amigo",
+	"amor",
+	"andar",
+	"anel",
+	"animal",
+	"animo",
+	"anjo",
+	"antes",
+	"apoio",
+	"aranha",
+	"areia",
+	"arma",
+	"armario",
+	"arroz",
+	"arte",
+	"arvore",
+	"assunto",
+	"atencao",
+	"ator",
+	"atras",
+	"aula",
+	"autor",
+	"avenida",
+	"aviao",
+	"azul",
+	"bairro",
+	"baixo",
+	"balao",
+	"banco",
+	"banho",
+	"barba",
+	"barco",
+	"barro",
+	"base",
+	"bater",
+	"beber",
+	"beijo",
+	"beleza",
+	"bicho",
+	"boca",
+	"bola",
+	"bolo",
+	"bolsa",
+	"bonito",
+	"bosque",
+	"braco",
+	"branco",
+	"bravo",
+	"breve",
+	"brilho",
+	"brincar",
+	"bruxa",
+	"burro",
+	"buscar",
+	"cabeca",
+	"cabelo",
+	"cabo",
+	"cabra",
+	"caca",
+	"cachorro",
+	"cadeira",
+	"cafe",
+	"caixa",
+	"calor",
+	"cama",
+	"caminho",
+	"campo",
+	"cancao",
+	"caneta",
+	"cantar",
+	"capa",
+	"cara",
+	"carne",
+	"caro",
+	"carro",
+	"carta",
+	"casa",
+	"casaco",
+	"castelo",
+	"causa",
+	"cavalo",
+	"cedo",
+	"chao",
+	"chapeu",
+	"chave",
+	"chefe",
+	"cheio",
+	"cheiro",
+	"chover",
+	"chuva",
+	"cidade",
+	"ciencia",
+	"cinco",
+	"cinema",
+	"cinza",
+	"claro",
+	"classe",
+	"cobra",
+	"coelho",
+	"coisa",
+	"colher",
+	"comeco",
+	"comer",
+	"comida",
+	"conta",
+	"contar",
+	"copo",
+	"coracao",
+	"corda",
+	"corpo",
+	"correr",
+	"corte",
+	"costa",
+	"couro",
+	"crenca",
+	"crianca",
+	"cruz",
+	"cuidado",
+	"culpa",
+	"curto",
+	"danca",
+	"dancar",
+	"data",
+	"dedo",
+	"deixar",
+	"dente",
+	"depois",
+	"desejo",
+	"destino",
+	"deus",
+	"dever",
+	"dinheiro",
+	"doce",
+	"doenca",
+	"dois",
+	"dono",
+	"dormir",
+	"droga",
+	"duro",
+	"duvida",
+	"edificio",
+	"educar",
+	"eleicao",
+	"elogio",
+	"energia",
+	"enorme",
+	"entrada",
+	"entre",
+	"epoca",
+	"erro",
+	"escada",
+	"escola",
+	"escolha",
+	"escrever",
+	"escuro",
+	"esforco",
+	"espaco",
+	"espada",
+	"espelho",
+	"esperar",
+	"espirito",
+	"esposa",
+	"estado",
+	"estrada",
+	"estrela",
+	"exame",
+	"exemplo",
+	"faca",
+	"facil",
+	"falar",
+	"falta",
+	"familia",
+	"farinha",
+	"fazenda",
+	"fazer",
+	"feio",
+	"feira",
+	"feliz",
+	"festa",
+	"ficar",
+	"figura",
+	"filho",
+	"filme",
+	"firme",
+	"flor",
+	"floresta",
+	"fogo",
+	"folha",
+	"fome",
+	"fonte",
+	"forca",
+	"forma",
+	"forte",
+	"foto",
+	"fraco",
+	"frase",
+	"frente",
+	"frio",
+	"fruta",
+	"fugir",
+	"fumo",
+	"fundo",
+	"futuro",
+	"gado",
+	"galinha",
+	"ganhar",
+	"garfo",
+	"garota",
+	"gato",
+	"gelo",
+	"gente",
+	"gesto",
+	"gordo",
+	"gosto",
+	"governo",
+	"grande",
+	"gravata",
+	"grito",
+	"grupo",
+	"guerra",
+	"habito",
+	"heroi",
+	"historia",
+	"hoje",
+	"homem",
+	"hora",
+	"horta",
+	"hospital",
+	"hotel",
+	"humor",
+	"ideia",
+	"igreja",
+	"ilha",
+	"imagem",
+	"irma",
+	"irmao",
+	"janela",
+	"jantar",
+	"jardim",
+	"jeito",
+	"jogar",
+	"jogo",
+	"jovem",
+	"juiz",
+	"julho",
+	"junho",
+	"justo",
+	"lado",
+	"ladrao",
+	"lago",
+	"lama",
+	"lampada",
+	"lapis",
+	"largo",
+	"leao",
+	"leite",
+	"lembrar",
+	"lenco",
+	"lento",
+	"letra",
+	"leve",
+	"licao",
+	"limao",
+	"limpo",
+	"lindo",
+	"lingua",
+	"linha",
+	"lista",
+	"livre",
+	"livro",
+	"lixo",
+	"lobo",
+	"local",
+	"logo",
+	"loja",
+	"longe",
+	"louco",
+	"lugar",
+	"luta",
+	"luva",
+	"macaco",
+	"madeira",
+	"maior",
+	"mala",
+	"manha",
+	"mapa",
+	"maquina",
+	"marca",
+	"marco",
+	"marido",
+	"massa",
+	"matar",
+	"mato",
+	"medo",
+	"meio",
+	"melhor",
+	"menino",
+	"menor",
+	"mentira",
+	"mercado",
+	"mesa",
+	"metade",
+	"metro",
+	"milho",
+	"missa",
+	"moca",
+	"moeda",
+	"molho",
+	"montanha",
+	"morte",
+	"mosca",
+	"motor",
+	"muito",
+	"mulher",
+	"mundo",
+	"muro",
+	"musica",
+	"nada",
+	"nadar",
+	"namoro",
+	"nariz",
+	"navio",
+	"negro",
+	"nervo",
+	"neve",
+	"ninho",
+	"noite",
+	"nome",
+	"norte",
+	"nota",
+	"noticia",
+	"novo",
+	"nunca",
+	"nuvem",
+	"obra",
+	"oceano",
+	"oculos",
+	"odio",
+	"olho",
+	"onda",
+	"ontem",
+	"orelha",
+	"osso",
+	"ouro",
+	"outono",
+	"outro",
+	"ovelha",
+	"padre",
+	"pagar",
+	"pais",
+	"palavra",
+	"papel",
+	"parede",
+	"parque",
+	"parte",
+	"passaro",
+	"passo",
+	"pato",
+	"patria",
+	"pedra",
+	"peito",
+	"peixe",
+	"pele",
+	"pena",
+	"pensar",
+	"pente",
+	"pequeno",
+	"perigo",
+	"perna",
+	"perto",
+	"peso",
+	"piano",
+	"pilha",
+	"pimenta",
+	"pincel",
+	"pintar",
+	"pobre",
+	"poder",
+	"poema",
+	"poeta",
+	"ponte",
+	"porco",
+	"porta",
+	"praia",
+	"prato",
+	"prazer",
+	"preco",
+	"prego",
+	"preto",
+	"primo",
+	"problema",
+	"prova",
+	"pulo",
+	"quadro",
+	"quarto",
+	"queijo",
+	"quente",
+	"querer",
+	"quilo",
+	"rainha",
+	"raiz",
+	"ramo",
+	"rapaz",
+	"raposa",
+	"razao",
+	"rede",
+	"reino",
+	"relogio",
+	"resto",
+	"revista",
+	"rico",
+	"risco",
+	"riso",
+	"roda",
+	"rosa",
+	"rosto",
+	"roupa",
+	"ruido",
+	"ruim",
+	"sabado",
+	"saber",
+	"saco",
+	"saia",
+	"sala",
+	"salto",
+	"sangue",
+	"santo",
+	"sapato",
+	"sapo",
+	"saude",
+	"seco",
+	"sede",
+	"segredo",
+	"seguro",
+	"selo",
+	"semana",
+	"sempre",
+	"senhor",
+	"serra",
+	"sete",
+	"sexta",
+	"silencio",
+	"sinal",
+	"sino",
+	"sitio",
+	"sobre",
+	"solo",
+	"sombra",
+	"sonho",
+	"sono",
+	"sopa",
+	"sorriso",
+	"sorte",
+	"suco",
+	"sujo",
+	"susto",
+	"tarde",
+	"tempo",
+	"terra",
+	"tesouro",
+	"tigre",
+	"tinta",
+	"tipo",
+	"toalha",
+	"todo",
+	"touro",
+	"trabalho",
+	"trem",
+	"trigo",
+	"triste",
+	"tronco",
+	"trono",
+	"turma",
+	"ultimo",
+	"vaca",
+	"valor",
+	"vapor",
+	"vela",
+	"velho",
+	"vento",
+	"verao",
+	"verdade",
+	"verde",
+	"vestido",
+	"viagem",
+	"vida",
+	"vidro",
+	"vila",
+	"vinho",
+	"vinte",
+	"viola",
+	"visita",
+	"vitoria",
+	"viver",
+	"vizinho",
+	"voar",
+	"voltar",
+	"xicara",
+	"zebra",
+}
+
+// Other Portuguese words that may be guessed: plurals, verb forms and less
+// common words.
+var portugueseAllowed = [...]string{
+	"abade",
+	"abaixo",
+	"abalo",
+	"abelhas",
+	"aberta",
+	"abertas",
+	"abertos",
+	"abracos",
+	"abre",
+	"abrem",
+	"abres",
+	"abria",
+	"abrigos",
+	"abrimos",
+	"abrindo",
+	"abrir",
+	"abriram",
+	"abriu",
+	"abro",
+	"acaba",
+	"acabada",
+	"acabadas",
+	"acabado",
+	"acabados",
+	"acabam",
+	"acabamos",
+	"acabando",
+	"acabar",
+	"acabara",
+	"acabaram",
+	"acabarei",
+	"acabarem",
+	"acabaria",
+	"acabas",
+	"acabasse",
+	"acabaste",
+	"acabava",
+	"acabavam",
+	"acabavas",
+	"acabei",
+	"acabo",
+	"acabou",
+	"acasos",
+	"aceita",
+	"aceitada",
+	"aceitado",
+	"aceitam",
+	"aceitara",
+	"aceitas",
+	"aceitava",
+	"aceitei",
+	"aceito",
+	"aceitou",
+	"acha",
+	"achada",
+	"achadas",
+	"achado",
+	"achados",
+	"acham",
+	"achamos",
+	"achando",
+	"achara",
+	"acharam",
+	"acharei",
+	"acharem",
+	"acharia",
+	"achas",
+	"achasse",
+	"achaste",
+	"achava",
+	"achavam",
+	"achavas",
+	"achei",
+	"acho",
+	"achou",
+	"acima",
+	"acoes",
+	"acola",
+	"acordos",
+	"acucares",
+	"adega",
+	"admite",
+	"admitem",
+	"admites",
+	"admiti",
+	"admitia",
+	"admitiam",
+	"admitida",
+	"admitido",
+	"admitir",
+	"admitiu",
+	"admito",
+	"afetos",
+	"agostos",
+	"aguas",
+	"agudo",
+	"aguias",
+	"agulhas",
+	"ajuda",
+	"ajudada",
+	"ajudadas",
+	"ajudado",
+	"ajudados",
+	"ajudam",
+	"ajudamos",
+	"ajudando",
+	"ajudar",
+	"ajudara",
+	"ajudaram",
+	"ajudarei",
+	"ajudarem",
+	"ajudaria",
+	"ajudas",
+	"ajudasse",
+	"ajudaste",
+	"ajudava",
+	"ajudavam",
+	"ajudavas",
+	"ajudei",
+	"ajudo",
+	"ajudou",
+	"alado",
+	"aldeia",
+	"alegres",
+	"alegrias",
+	"alemao",
+	"alface",
+	"algas",
+	"algo",
+	"alguem",
+	"alguma",
+	"algumas",
+	"alguns",
+	"almas",
+	"almocos",
+	"alta",
+	"altares",
+	"altas",
+	"altos",
+	"aluga",
+	"alugada",
+	"alugadas",
+	"alugado",
+	"alugados",
+	"alugam",
+	"alugamos",
+	"alugando",
+	"alugar",
+	"alugara",
+	"alugaram",
+	"alugarei",
+	"alugarem",
+	"alugaria",
+	"alugas",
+	"alugasse",
+	"alugaste",
+	"alugava",
+	"alugavam",
+	"alugavas",
+	"alugo",
+	"alugou",
+	"aluguei",
+	"alunos",
+	"amada",
+	"amadas",
+	"amado",
+	"amados",
+	"amam",
+	"amamos",
+	"amando",
+	"amanha",
+	"amantes",
+	"amara",
+	"amaram",
+	"amarei",
+	"amarela",
+	"amarelas",
+	"amarelos",
+	"amarem",
+	"amaria",
+	"amas",
+	"amasse",
+	"amaste",
+	"amava",
+	"amavam",
+	"amavas",
+	"amaveis",
+	"ambas",
+	"ambos",
+	"amei",
+	"amiga",
+	"amigas",
+	"amigos",
+	"amores",
+	"amou",
+	"anda",
+	"andada",
+	"andadas",
+	"andado",
+	"andados",
+	"andam",
+	"andamos",
+	"andando",
+	"andara",
+	"andaram",
+	"andarei",
+	"andarem",
+	"andaria",
+	"andas",
+	"andasse",
+	"andaste",
+	"andava",
+	"andavam",
+	"andavas",
+	"andei",
+	"ando",
+	"andou",
+	"aneis",
+	"anexo",
+	"animais",
+	"animos",
+	"anjos",
+	"anos",
+	"anual",
+	"aonde",
+	"apaga",
+	"apagada",
+	"apagadas",
+	"apagado",
+	"apagados",
+	"apagam",
+	"apagamos",
+	"apagando",
+	"apagar",
+	"apagara",
+	"apagaram",
+	"apagarei",
+	"apagarem",
+	"apagaria",
+	"apagas",
+	"apagasse",
+	"apagaste",
+	"apagava",
+	"apagavam",
+	"apagavas",
+	"apago",
+	"apagou",
+	"apaguei",
+	"apanha",
+	"apanhada",
+	"apanhado",
+	"apanham",
+	"apanhar",
+	"apanhara",
+	"apanhas",
+	"apanhava",
+	"apanhei",
+	"apanho",
+	"apanhou",
+	"apelo",
+	"apenas",
+	"apoios",
+	"aprende",
+	"aprendem",
+	"aprender",
+	"aprendes",
+	"aprendeu",
+	"aprendi",
+	"aprendia",
+	"aprendo",
+	"aquela",
+	"aquele",
+	"aquem",
+	"aqui",
+	"aquilo",
+	"arabe",
+	"aranhas",
+	"areal",
+	"areias",
+	"arena",
+	"armarios",
+	"armas",
+	"aroma",
+	"arraia",
+	"arrozes",
+	"arruma",
+	"arrumada",
+	"arrumado",
+	"arrumam",
+	"arrumar",
+	"arrumara",
+	"arrumas",
+	"arrumava",
+	"arrumei",
+	"arrumo",
+	"arrumou",
+	"artes",
+	"arvores",
+	"assim",
+	"assiste",
+	"assistem",
+	"assistes",
+	"assisti",
+	"assistia",
+	"assistir",
+	"assistiu",
+	"assisto",
+	"assuntos",
+	"astro",
+	"atlas",
+	"atores",
+	"atriz",
+	"atual",
+	"audaz",
+	"aulas",
+	"autores",
+	"avaro",
+	"aveia",
+	"avenidas",
+	"avioes",
+	"aviso",
+	"avos",
+	"azuis",
+	"baile",
+	"bairros",
+	"baixa",
+	"baixada",
+	"baixadas",
+	"baixado",
+	"baixados",
+	"baixam",
+	"baixamos",
+	"baixando",
+	"baixar",
+	"baixara",
+	"baixaram",
+	"baixarei",
+	"baixarem",
+	"baixaria",
+	"baixas",
+	"baixasse",
+	"baixaste",
+	"baixava",
+	"baixavam",
+	"baixavas",
+	"baixei",
+	"baixos",
+	"baixou",
+	"balde",
+	"baloes",
+	"balsa",
+	"banca",
+	"bancos",
+	"banda",
+	"banhos",
+	"barao",
+	"barbas",
+	"barcos",
+	"barra",
+	"barros",
+	"bases",
+	"bastante",
+	"bate",
+	"batem",
+	"batemos",
+	"batendo",
+	"bateram",
+	"baterei",
+	"baterem",
+	"bateria",
+	"bates",
+	"bateu",
+	"bati",
+	"batia",
+	"batiam",
+	"batida",
+	"batido",
+	"batidos",
+	"bato",
+	"batom",
+	"beata",
+	"bebe",
+	"bebem",
+	"bebemos",
+	"bebendo",
+	"beberam",
+	"beberei",
+	"beberem",
+	"beberia",
+	"bebes",
+	"bebeu",
+	"bebi",
+	"bebia",
+	"bebiam",
+	"bebida",
+	"bebido",
+	"bebidos",
+	"bebo",
+	"beija",
+	"beijada",
+	"beijadas",
+	"beijado",
+	"beijados",
+	"beijam",
+	"beijamos",
+	"beijando",
+	"beijar",
+	"beijara",
+	"beijaram",
+	"beijarei",
+	"beijarem",
+	"beijaria",
+	"beijas",
+	"beijasse",
+	"beijaste",
+	"beijava",
+	"beijavam",
+	"beijavas",
+	"beijei",
+	"beijos",
+	"beijou",
+	"beira",
+	"belezas",
+	"belga",
+	"bens",
+	"bento",
+	"berco",
+	"besta",
+	"bichos",
+	"bingo",
+	"bispo",
+	"blusa",
+	"bocas",
+	"bolas",
+	"bolha",
+	"bolos",
+	"bolsas",
+	"bomba",
+	"bonita",
+	"bonitas",
+	"bonitos",
+	"bons",
+	"borda",
+	"bosques",
+	"bossa",
+	"botao",
+	"bracos",
+	"branca",
+	"brancas",
+	"brancos",
+	"brasa",
+	"brava",
+	"bravas",
+	"bravos",
+	"breves",
+	"briga",
+	"brilhos",
+	"brinca",
+	"brincada",
+	"brincado",
+	"brincam",
+	"brincara",
+	"brincas",
+	"brincava",
+	"brinco",
+	"brincou",
+	"brinquei",
+	"brisa",
+	"bruto",
+	"bruxas",
+	"bucho",
+	"burla",
+	"burros",
+	"busca",
+	"buscada",
+	"buscadas",
+	"buscado",
+	"buscados",
+	"buscam",
+	"buscamos",
+	"buscando",
+	"buscara",
+	"buscaram",
+	"buscarei",
+	"buscarem",
+	"buscaria",
+	"buscas",
+	"buscasse",
+	"buscaste",
+	"buscava",
+	"buscavam",
+	"buscavas",
+	"busco",
+	"buscou",
+	"busquei",
+	"cabecas",
+	"cabelos",
+	"cabos",
+	"cabras",
+	"cacas",
+	"cacau",
+	"cada",
+	"cadeiras",
+	"caem",
+	"caes",
+	"cafes",
+	"caia",
+	"caido",
+	"caimos",
+	"caindo",
+	"caio",
+	"cair",
+	"cairam",
+	"cais",
+	"caiu",
+	"caixas",
+	"calda",
+	"calma",
+	"calmas",
+	"calmo",
+	"calmos",
+	"calores",
+	"calvo",
+	"camas",
+	"caminhos",
+	"campos",
+	"cancoes",
+	"canetas",
+	"canoa",
+	"cansa",
+	"cansada",
+	"cansadas",
+	"cansado",
+	"cansados",
+	"cansam",
+	"cansamos",
+	"cansando",
+	"cansar",
+	"cansara",
+	"cansaram",
+	"cansarei",
+	"cansarem",
+	"cansaria",
+	"cansas",
+	"cansasse",
+	"cansaste",
+	"cansava",
+	"cansavam",
+	"cansavas",
+	"cansei",
+	"canso",
+	"cansou",
+	"canta",
+	"cantada",
+	"cantadas",
+	"cantado",
+	"cantados",
+	"cantam",
+	"cantamos",
+	"cantando",
+	"cantara",
+	"cantaram",
+	"cantarei",
+	"cantarem",
+	"cantaria",
+	"cantas",
+	"cantasse",
+	"cantaste",
+	"cantava",
+	"cantavam",
+	"cantavas",
+	"cantei",
+	"canto",
+	"cantou",
+	"capas",
+	"capim",
+	"capuz",
+	"caras",
+	"carga",
+	"carnes",
+	"caros",
+	"carpa",
+	"carros",
+	"cartas",
+	"casacos",
+	"casada",
+	"casadas",
+	"casado",
+	"casados",
+	"casal",
+	"casam",
+	"casamos",
+	"casando",
+	"casar",
+	"casara",
+	"casaram",
+	"casarei",
+	"casarem",
+	"casaria",
+	"casas",
+	"casasse",
+	"casaste",
+	"casava",
+	"casavam",
+	"casavas",
+	"casca",
+	"casco",
+	"casei",
+	"caso",
+	"casou",
+	"caspa",
+	"castelos",
+	"catorze",
+	"causas",
+	"cavalos",
+	"cavar",
+	"cedro",
+	"cegos",
+	"cenas",
+	"censo",
+	"cento",
+	"certo",
+	"cesta",
+	"ceus",
+	"chama",
+	"chamada",
+	"chamadas",
+	"chamado",
+	"chamados",
+	"chamam",
+	"chamamos",
+	"chamando",
+	"chamar",
+	"chamara",
+	"chamaram",
+	"chamarei",
+	"chamarem",
+	"chamaria",
+	"chamas",
+	"chamasse",
+	"chamaste",
+	"chamava",
+	"chamavam",
+	"chamavas",
+	"chamei",
+	"chamo",
+	"chamou",
+	"chapeus",
+	"chaves",
+	"chefes",
+	"chega",
+	"chegada",
+	"chegadas",
+	"chegado",
+	"chegados",
+	"chegam",
+	"chegamos",
+	"chegando",
+	"chegar",
+	"chegara",
+	"chegaram",
+	"chegarei",
+	"chegarem",
+	"chegaria",
+	"chegas",
+	"chegasse",
+	"chegaste",
+	"chegava",
+	"chegavam",
+	"chegavas",
+	"chego",
+	"chegou",
+	"cheguei",
+	"cheia",
+	"cheias",
+	"cheios",
+	"cheiros",
+	"chora",
+	"chorada",
+	"choradas",
+	"chorado",
+	"chorados",
+	"choram",
+	"choramos",
+	"chorando",
+	"chorar",
+	"chorara",
+	"choraram",
+	"chorarei",
+	"chorarem",
+	"choraria",
+	"choras",
+	"chorasse",
+	"choraste",
+	"chorava",
+	"choravam",
+	"choravas",
+	"chorei",
+	"choro",
+	"chorou",
+	"chuvas",
+	"cidades",
+	"ciencias",
+	"cifra",
+	"cinemas",
+	"cinto",
+	"cinzas",
+	"circo",
+	"citar",
+	"civil",
+	"clara",
+	"claras",
+	"claros",
+	"classes",
+	"clima",
+	"cobras",
+	"cobre",
+	"coelhos",
+	"cofre",
+	"coisas",
+	"cola",
+	"colada",
+	"coladas",
+	"colado",
+	"colados",
+	"colam",
+	"colamos",
+	"colando",
+	"colar",
+	"colara",
+	"colaram",
+	"colarei",
+	"colarem",
+	"colaria",
+	"colas",
+	"colasse",
+	"colaste",
+	"colava",
+	"colavam",
+	"colavas",
+	"colega",
+	"colegas",
+	"colei",
+	"colheres",
+	"colo",
+	"colou",
+	"combo",
+	"come",
+	"comecos",
+	"comem",
+	"comemos",
+	"comendo",
+	"comeram",
+	"comerei",
+	"comerem",
+	"comeria",
+	"comes",
+	"comeu",
+	"comi",
+	"comia",
+	"comiam",
+	"comidas",
+	"comido",
+	"comidos",
+	"como",
+	"compra",
+	"comprada",
+	"comprado",
+	"compram",
+	"comprar",
+	"comprara",
+	"compras",
+	"comprava",
+	"comprei",
+	"compro",
+	"comprou",
+	"comum",
+	"conde",
+	"conhecem",
+	"conhecer",
+	"conheceu",
+	"conheci",
+	"conhecia",
+	"contada",
+	"contadas",
+	"contado",
+	"contados",
+	"contam",
+	"contamos",
+	"contando",
+	"contara",
+	"contaram",
+	"contarei",
+	"contarem",
+	"contaria",
+	"contas",
+	"contasse",
+	"contaste",
+	"contava",
+	"contavam",
+	"contavas",
+	"contei",
+	"conto",
+	"contou",
+	"contra",
+	"copos",
+	"coracoes",
+	"cordas",
+	"cores",
+	"corpos",
+	"corre",
+	"correm",
+	"corremos",
+	"correndo",
+	"correram",
+	"correrei",
+	"correrem",
+	"correria",
+	"corres",
+	"correu",
+	"corri",
+	"corria",
+	"corriam",
+	"corrida",
+	"corrido",
+	"corridos",
+	"corro",
+	"corta",
+	"cortada",
+	"cortadas",
+	"cortado",
+	"cortados",
+	"cortam",
+	"cortamos",
+	"cortando",
+	"cortar",
+	"cortara",
+	"cortaram",
+	"cortarei",
+	"cortarem",
+	"cortaria",
+	"cortas",
+	"cortasse",
+	"cortaste",
+	"cortava",
+	"cortavam",
+	"cortavas",
+	"cortei",
+	"cortes",
+	"corto",
+	"cortou",
+	"corvo",
+	"costas",
+	"couros",
+	"couve",
+	"covas",
+	"cravo",
+	"credo",
+	"creem",
+	"creio",
+	"creme",
+	"cremos",
+	"crencas",
+	"cres",
+	"crescem",
+	"crescer",
+	"cresceu",
+	"cresci",
+	"crescia",
+	"cresciam",
+	"crescida",
+	"crescido",
+	"creu",
+	"cria",
+	"criada",
+	"criadas",
+	"criado",
+	"criados",
+	"criam",
+	"criamos",
+	"criancas",
+	"criando",
+	"criar",
+	"criara",
+	"criaram",
+	"criarei",
+	"criarem",
+	"criaria",
+	"crias",
+	"criasse",
+	"criaste",
+	"criava",
+	"criavam",
+	"criavas",
+	"crido",
+	"criei",
+	"crime",
+	"crio",
+	"criou",
+	"crise",
+	"cruzes",
+	"cubos",
+	"cuida",
+	"cuidada",
+	"cuidadas",
+	"cuidados",
+	"cuidam",
+	"cuidamos",
+	"cuidando",
+	"cuidar",
+	"cuidara",
+	"cuidaram",
+	"cuidarei",
+	"cuidarem",
+	"cuidaria",
+	"cuidas",
+	"cuidasse",
+	"cuidaste",
+	"cuidava",
+	"cuidavam",
+	"cuidavas",
+	"cuidei",
+	"cuido",
+	"cuidou",
+	"cuja",
+	"cujo",
+	"culpas",
+	"culto",
+	"cumpre",
+	"cumprem",
+	"cumpres",
+	"cumpri",
+	"cumpria",
+	"cumpriam",
+	"cumprida",
+	"cumprido",
+	"cumprir",
+	"cumpriu",
+	"cumpro",
+	"cunha",
+	"curso",
+	"curta",
+	"curtas",
+	"curtos",
+	"curva",
+	"dado",
+	"dados",
+	"damas",
+	"damos",
+	"dancada",
+	"dancadas",
+	"dancado",
+	"dancados",
+	"dancam",
+	"dancamos",
+	"dancando",
+	"dancara",
+	"dancaram",
+	"dancarei",
+	"dancarem",
+	"dancaria",
+	"dancas",
+	"dancasse",
+	"dancaste",
+	"dancava",
+	"dancavam",
+	"dancavas",
+	"dancei",
+	"danco",
+	"dancou",
+	"dando",
+	"dardo",
+	"darem",
+	"datas",
+	"dava",
+	"davam",
+	"decide",
+	"decidem",
+	"decides",
+	"decidi",
+	"decidia",
+	"decidiam",
+	"decidida",
+	"decidido",
+	"decidir",
+	"decidiu",
+	"decido",
+	"dedos",
+	"deixa",
+	"deixada",
+	"deixadas",
+	"deixado",
+	"deixados",
+	"deixam",
+	"deixamos",
+	"deixando",
+	"deixara",
+	"deixaram",
+	"deixarei",
+	"deixarem",
+	"deixaria",
+	"deixas",
+	"deixasse",
+	"deixaste",
+	"deixava",
+	"deixavam",
+	"deixavas",
+	"deixei",
+	"deixo",
+	"deixou",
+	"dela",
+	"delas",
+	"dele",
+	"deles",
+	"demais",
+	"demos",
+	"denso",
+	"dentes",
+	"dentro",
+	"deram",
+	"descem",
+	"descemos",
+	"descendo",
+	"descer",
+	"desceram",
+	"descerei",
+	"descerem",
+	"desceria",
+	"desceu",
+	"desci",
+	"descia",
+	"desciam",
+	"descida",
+	"descido",
+	"descidos",
+	"desde",
+	"desejos",
+	"destinos",
+	"deusa",
+	"deve",
+	"devem",
+	"devemos",
+	"devendo",
+	"deveram",
+	"deverei",
+	"deverem",
+	"deveria",
+	"deves",
+	"deveu",
+	"devi",
+	"devia",
+	"deviam",
+	"devida",
+	"devido",
+	"devidos",
+	"devo",
+	"dezembro",
+	"dezoito",
+	"diante",
+	"dias",
+	"dicas",
+	"dificeis",
+	"diga",
+	"digam",
+	"digno",
+	"digo",
+	"dira",
+	"direi",
+	"diria",
+	"disco",
+	"discute",
+	"discutem",
+	"discutes",
+	"discuti",
+	"discutia",
+	"discutir",
+	"discutiu",
+	"discuto",
+	"disse",
+	"dissemos",
+	"disseram",
+	"dito",
+	"divide",
+	"dividem",
+	"divides",
+	"dividi",
+	"dividia",
+	"dividiam",
+	"dividida",
+	"dividido",
+	"dividir",
+	"dividiu",
+	"divido",
+	"dizem",
+	"dizemos",
+	"dizendo",
+	"dizer",
+	"dizes",
+	"dizia",
+	"diziam",
+	"dobro",
+	"doces",
+	"doencas",
+	"doido",
+	"domingo",
+	"donos",
+	"dores",
+	"dorme",
+	"dormem",
+	"dormes",
+	"dormia",
+	"dormido",
+	"dormimos",
+	"dormindo",
+	"dormiram",
+	"dormiu",
+	"doutor",
+	"doutora",
+	"doze",
+	"drama",
+	"drogas",
+	"dupla",
+	"duplo",
+	"dura",
+	"durada",
+	"duradas",
+	"durado",
+	"durados",
+	"duram",
+	"duramos",
+	"durando",
+	"durar",
+	"durara",
+	"duraram",
+	"durarei",
+	"durarem",
+	"duraria",
+	"duras",
+	"durasse",
+	"duraste",
+	"durava",
+	"duravam",
+	"duravas",
+	"durei",
+	"durmo",
+	"duros",
+	"durou",
+	"duvidas",
+	"duzentos",
+	"duzia",
+	"ecoar",
+	"educa",
+	"educada",
+	"educadas",
+	"educado",
+	"educados",
+	"educam",
+	"educamos",
+	"educando",
+	"educara",
+	"educaram",
+	"educarei",
+	"educarem",
+	"educaria",
+	"educas",
+	"educasse",
+	"educaste",
+	"educava",
+	"educavam",
+	"educavas",
+	"educo",
+	"educou",
+	"eduquei",
+	"elas",
+	"eleicoes",
+	"eles",
+	"elite",
+	"elogios",
+	"embora",
+	"energias",
+	"enfim",
+	"enormes",
+	"enquanto",
+	"ensaio",
+	"entao",
+	"entra",
+	"entradas",
+	"entrado",
+	"entrados",
+	"entram",
+	"entramos",
+	"entrando",
+	"entrar",
+	"entrara",
+	"entraram",
+	"entrarei",
+	"entrarem",
+	"entraria",
+	"entras",
+	"entrasse",
+	"entraste",
+	"entrava",
+	"entravam",
+	"entravas",
+	"entrei",
+	"entro",
+	"entrou",
+	"envia",
+	"enviada",
+	"enviadas",
+	"enviado",
+	"enviados",
+	"enviam",
+	"enviamos",
+	"enviando",
+	"enviar",
+	"enviara",
+	"enviaram",
+	"enviarei",
+	"enviarem",
+	"enviaria",
+	"envias",
+	"enviasse",
+	"enviaste",
+	"enviava",
+	"enviavam",
+	"enviavas",
+	"enviei",
+	"envio",
+	"enviou",
+	"epocas",
+	"eram",
+	"eramos",
+	"eras",
+	"ermos",
+	"errar",
+	"erros",
+	"escadas",
+	"escolas",
+	"escolhas",
+	"escrita",
+	"escrito",
+	"escura",
+	"escuras",
+	"escuros",
+	"escuta",
+	"escutada",
+	"escutado",
+	"escutam",
+	"escutar",
+	"escutara",
+	"escutas",
+	"escutava",
+	"escutei",
+	"escuto",
+	"escutou",
+	"esforcos",
+	"espacos",
+	"espadas",
+	"espelhos",
+	"espera",
+	"esperada",
+	"esperado",
+	"esperam",
+	"esperara",
+	"esperas",
+	"esperava",
+	"esperei",
+	"espero",
+	"esperou",
+	"esposas",
+	"esquecem",
+	"esquecer",
+	"esqueceu",
+	"esqueci",
+	"esquecia",
+	"essa",
+	"essas",
+	"esse",
+	"esses",
+	"esta",
+	"estados",
+	"estamos",
+	"estando",
+	"estao",
+	"estar",
+	"estas",
+	"estava",
+	"estavam",
+	"este",
+	"esteja",
+	"estes",
+	"esteve",
+	"estive",
+	"estou",
+	"estradas",
+	"estrelas",
+	"estuda",
+	"estudada",
+	"estudado",
+	"estudam",
+	"estudar",
+	"estudara",
+	"estudas",
+	"estudava",
+	"estudei",
+	"estudo",
+	"estudou",
+	"etapa",
+	"exames",
+	"exato",
+	"exemplos",
+	"exigir",
+	"existe",
+	"existem",
+	"existes",
+	"existi",
+	"existia",
+	"existiam",
+	"existida",
+	"existido",
+	"existir",
+	"existiu",
+	"existo",
+	"explica",
+	"explicam",
+	"explicar",
+	"explicas",
+	"explico",
+	"explicou",
+	"facam",
+	"facao",
+	"facas",
+	"faceis",
+	"facho",
+	"faco",
+	"fadas",
+	"faixa",
+	"fala",
+	"falada",
+	"faladas",
+	"falado",
+	"falados",
+	"falam",
+	"falamos",
+	"falando",
+	"falara",
+	"falaram",
+	"falarei",
+	"falarem",
+	"falaria",
+	"falas",
+	"falasse",
+	"falaste",
+	"falava",
+	"falavam",
+	"falavas",
+	"falei",
+	"falha",
+	"falo",
+	"falou",
+	"falso",
+	"faltas",
+	"familias",
+	"fara",
+	"fardo",
+	"farei",
+	"faria",
+	"farinhas",
+	"farol",
+	"farsa",
+	"fatal",
+	"fauna",
+	"favor",
+	"fazem",
+	"fazemos",
+	"fazendas",
+	"fazendo",
+	"fazes",
+	"fazia",
+	"faziam",
+	"febre",
+	"fecha",
+	"fechada",
+	"fechadas",
+	"fechado",
+	"fechados",
+	"fecham",
+	"fechamos",
+	"fechando",
+	"fechar",
+	"fechara",
+	"fecharam",
+	"fecharei",
+	"fecharem",
+	"fecharia",
+	"fechas",
+	"fechasse",
+	"fechaste",
+	"fechava",
+	"fechavam",
+	"fechavas",
+	"fechei",
+	"fecho",
+	"fechou",
+	"feia",
+	"feias",
+	"feios",
+	"feiras",
+	"feita",
+	"feito",
+	"feitos",
+	"feixe",
+	"felizes",
+	"feras",
+	"feroz",
+	"festas",
+	"fezes",
+	"fiado",
+	"fibra",
+	"fica",
+	"ficada",
+	"ficadas",
+	"ficado",
+	"ficados",
+	"ficam",
+	"ficamos",
+	"ficando",
+	"ficara",
+	"ficaram",
+	"ficarei",
+	"ficarem",
+	"ficaria",
+	"ficas",
+	"ficasse",
+	"ficaste",
+	"ficava",
+	"ficavam",
+	"ficavas",
+	"ficha",
+	"fico",
+	"ficou",
+	"figuras",
+	"filha",
+	"filhas",
+	"filhos",
+	"filmes",
+	"final",
+	"fins",
+	"fiquei",
+	"firma",
+	"firmes",
+	"fisco",
+	"fixar",
+	"fizemos",
+	"fizeram",
+	"flora",
+	"flores",
+	"fluir",
+	"focar",
+	"fogos",
+	"folga",
+	"folhas",
+	"folia",
+	"fomes",
+	"fomos",
+	"fontes",
+	"fora",
+	"foram",
+	"forcas",
+	"formas",
+	"forno",
+	"fortes",
+	"fosso",
+	"fotos",
+	"fracos",
+	"frade",
+	"frases",
+	"freio",
+	"frentes",
+	"fresca",
+	"frescas",
+	"fresco",
+	"frescos",
+	"fria",
+	"frias",
+	"frios",
+	"frota",
+	"frutas",
+	"fumar",
+	"fumos",
+	"fundos",
+	"furar",
+	"furor",
+	"fusao",
+	"futuros",
+	"gados",
+	"gaita",
+	"galao",
+	"galho",
+	"galinhas",
+	"ganha",
+	"ganhada",
+	"ganhadas",
+	"ganhado",
+	"ganhados",
+	"ganham",
+	"ganhamos",
+	"ganhando",
+	"ganhara",
+	"ganharam",
+	"ganharei",
+	"ganharem",
+	"ganharia",
+	"ganhas",
+	"ganhasse",
+	"ganhaste",
+	"ganhava",
+	"ganhavam",
+	"ganhavas",
+	"ganhei",
+	"ganho",
+	"ganhou",
+	"ganso",
+	"garante",
+	"garantem",
+	"garantes",
+	"garanti",
+	"garantia",
+	"garantir",
+	"garantiu",
+	"garanto",
+	"garfos",
+	"garotas",
+	"garoto",
+	"garotos",
+	"garra",
+	"gases",
+	"gastar",
+	"gatas",
+	"gatos",
+	"gelos",
+	"gemeo",
+	"gemer",
+	"genro",
+	"gentes",
+	"gentis",
+	"gesso",
+	"gestos",
+	"girar",
+	"globo",
+	"golfe",
+	"golpe",
+	"gorda",
+	"gordas",
+	"gordos",
+	"gorro",
+	"gosta",
+	"gostada",
+	"gostadas",
+	"gostado",
+	"gostados",
+	"gostam",
+	"gostamos",
+	"gostando",
+	"gostar",
+	"gostara",
+	"gostaram",
+	"gostarei",
+	"gostarem",
+	"gostaria",
+	"gostas",
+	"gostasse",
+	"gostaste",
+	"gostava",
+	"gostavam",
+	"gostavas",
+	"gostei",
+	"gostos",
+	"gostou",
+	"governos",
+	"graca",
+	"grade",
+	"grama",
+	"grandes",
+	"grato",
+	"gravatas",
+	"grave",
+	"graxa",
+	"grilo",
+	"gripe",
+	"grita",
+	"gritada",
+	"gritadas",
+	"gritado",
+	"gritados",
+	"gritam",
+	"gritamos",
+	"gritando",
+	"gritar",
+	"gritara",
+	"gritaram",
+	"gritarei",
+	"gritarem",
+	"gritaria",
+	"gritas",
+	"gritasse",
+	"gritaste",
+	"gritava",
+	"gritavam",
+	"gritavas",
+	"gritei",
+	"gritos",
+	"gritou",
+	"grupos",
+	"gruta",
+	"guarda",
+	"guardada",
+	"guardado",
+	"guardam",
+	"guardar",
+	"guardara",
+	"guardas",
+	"guardava",
+	"guardei",
+	"guardo",
+	"guardou",
+	"guerras",
+	"guiar",
+	"habitos",
+	"haste",
+	"herois",
+	"hiato",
+	"hifen",
+	"hinos",
+	"homens",
+	"horas",
+	"hortas",
+	"hoteis",
+	"humores",
+	"icone",
+	"idade",
+	"ideal",
+	"ideias",
+	"idolo",
+	"igrejas",
+	"igual",
+	"ilhas",
+	"imagens",
+	"indio",
+	"indo",
+	"inimiga",
+	"inimigo",
+	"insiste",
+	"insistem",
+	"insistes",
+	"insisti",
+	"insistia",
+	"insistir",
+	"insistiu",
+	"insisto",
+	"inverno",
+	"irmaos",
+	"ironia",
+	"isso",
+	"isto",
+	"jamais",
+	"janeiro",
+	"janelas",
+	"janta",
+	"jantada",
+	"jantadas",
+	"jantado",
+	"jantados",
+	"jantam",
+	"jantamos",
+	"jantando",
+	"jantara",
+	"jantaram",
+	"jantarei",
+	"jantarem",
+	"jantaria",
+	"jantas",
+	"jantasse",
+	"jantaste",
+	"jantava",
+	"jantavam",
+	"jantavas",
+	"jantei",
+	"janto",
+	"jantou",
+	"jardins",
+	"jarro",
+	"jaula",
+	"jeitos",
+	"joelho",
+	"joga",
+	"jogada",
+	"jogadas",
+	"jogado",
+	"jogados",
+	"jogam",
+	"jogamos",
+	"jogando",
+	"jogara",
+	"jogaram",
+	"jogarei",
+	"jogarem",
+	"jogaria",
+	"jogas",
+	"jogasse",
+	"jogaste",
+	"jogava",
+	"jogavam",
+	"jogavas",
+	"jogos",
+	"jogou",
+	"joguei",
+	"jovens",
+	"judeu",
+	"juizes",
+	"juizo",
+	"julhos",
+	"junhos",
+	"junta",
+	"juntas",
+	"junto",
+	"juntos",
+	"jura",
+	"jurada",
+	"juradas",
+	"jurado",
+	"jurados",
+	"juram",
+	"juramos",
+	"jurando",
+	"jurar",
+	"jurara",
+	"juraram",
+	"jurarei",
+	"jurarem",
+	"juraria",
+	"juras",
+	"jurasse",
+	"juraste",
+	"jurava",
+	"juravam",
+	"juravas",
+	"jurei",
+	"juro",
+	"jurou",
+	"justa",
+	"justas",
+	"justos",
+	"labor",
+	"lacre",
+	"lados",
+	"ladroes",
+	"lagoa",
+	"lagos",
+	"laico",
+	"lamas",
+	"lampadas",
+	"lanca",
+	"larga",
+	"largas",
+	"largos",
+	"larva",
+	"latim",
+	"lava",
+	"lavada",
+	"lavadas",
+	"lavado",
+	"lavados",
+	"lavam",
+	"lavamos",
+	"lavando",
+	"lavar",
+	"lavara",
+	"lavaram",
+	"lavarei",
+	"lavarem",
+	"lavaria",
+	"lavas",
+	"lavasse",
+	"lavaste",
+	"lavava",
+	"lavavam",
+	"lavavas",
+	"lavei",
+	"lavo",
+	"lavou",
+	"lazer",
+	"leem",
+	"legal",
+	"leigo",
+	"leio",
+	"leites",
+	"lemas",
+	"lembra",
+	"lembrada",
+	"lembrado",
+	"lembram",
+	"lembrara",
+	"lembras",
+	"lembrava",
+	"lembrei",
+	"lembro",
+	"lembrou",
+	"lemos",
+	"lencos",
+	"lenda",
+	"lendo",
+	"lenta",
+	"lentas",
+	"lentos",
+	"leoes",
+	"leque",
+	"leram",
+	"lesao",
+	"letal",
+	"letras",
+	"leva",
+	"levada",
+	"levadas",
+	"levado",
+	"levados",
+	"levam",
+	"levamos",
+	"levando",
+	"levar",
+	"levara",
+	"levaram",
+	"levarei",
+	"levarem",
+	"levaria",
+	"levas",
+	"levasse",
+	"levaste",
+	"levava",
+	"levavam",
+	"levavas",
+	"levei",
+	"leves",
+	"levo",
+	"levou",
+	"libra",
+	"licoes",
+	"licor",
+	"lidar",
+	"lido",
+	"limbo",
+	"limoes",
+	"limpa",
+	"limpada",
+	"limpadas",
+	"limpado",
+	"limpados",
+	"limpam",
+	"limpamos",
+	"limpando",
+	"limpar",
+	"limpara",
+	"limparam",
+	"limparei",
+	"limparem",
+	"limparia",
+	"limpas",
+	"limpasse",
+	"limpaste",
+	"limpava",
+	"limpavam",
+	"limpavas",
+	"limpei",
+	"limpos",
+	"limpou",
+	"linda",
+	"lindas",
+	"lindos",
+	"linguas",
+	"linhas",
+	"listas",
+	"litro",
+	"livres",
+	"livros",
+	"lixao",
+	"lixos",
+	"lobos",
+	"locais",
+	"locao",
+	"lojas",
+	"lomba",
+	"longo",
+	"louca",
+	"loucas",
+	"loucos",
+	"lousa",
+	"luas",
+	"lucro",
+	"lugares",
+	"lunar",
+	"lutar",
+	"lutas",
+	"luvas",
+	"luzes",
+	"macacos",
+	"macio",
+	"madeiras",
+	"maes",
+	"magia",
+	"magra",
+	"magras",
+	"magro",
+	"magros",
+	"maio",
+	"maiores",
+	"mais",
+	"malas",
+	"malha",
+	"malta",
+	"mamae",
+	"manda",
+	"mandada",
+	"mandadas",
+	"mandado",
+	"mandados",
+	"mandam",
+	"mandamos",
+	"mandando",
+	"mandar",
+	"mandara",
+	"mandaram",
+	"mandarei",
+	"mandarem",
+	"mandaria",
+	"mandas",
+	"mandasse",
+	"mandaste",
+	"mandava",
+	"mandavam",
+	"mandavas",
+	"mandei",
+	"mando",
+	"mandou",
+	"manga",
+	"manto",
+	"maos",
+	"mapas",
+	"maquinas",
+	"marcada",
+	"marcadas",
+	"marcado",
+	"marcados",
+	"marcam",
+	"marcamos",
+	"marcando",
+	"marcar",
+	"marcara",
+	"marcaram",
+	"marcarei",
+	"marcarem",
+	"marcaria",
+	"marcas",
+	"marcasse",
+	"marcaste",
+	"marcava",
+	"marcavam",
+	"marcavas",
+	"marcos",
+	"marcou",
+	"mares",
+	"maridos",
+	"marquei",
+	"massas",
+	"mata",
+	"matada",
+	"matadas",
+	"matado",
+	"matados",
+	"matam",
+	"matamos",
+	"matando",
+	"matara",
+	"mataram",
+	"matarei",
+	"matarem",
+	"mataria",
+	"matas",
+	"matasse",
+	"mataste",
+	"matava",
+	"matavam",
+	"matavas",
+	"matei",
+	"matos",
+	"matou",
+	"mecha",
+	"medica",
+	"medico",
+	"medio",
+	"medir",
+	"medos",
+	"meiga",
+	"meigo",
+	"meios",
+	"melao",
+	"melhores",
+	"menina",
+	"meninas",
+	"meninos",
+	"menores",
+	"menos",
+	"menta",
+	"mentiras",
+	"mercados",
+	"merecem",
+	"merecer",
+	"mereceu",
+	"mereci",
+	"merecia",
+	"mereciam",
+	"merecida",
+	"merecido",
+	"mesas",
+	"mesma",
+	"mesmas",
+	"mesmo",
+	"mesmos",
+	"metades",
+	"metal",
+	"meter",
+	"metros",
+	"mexe",
+	"mexem",
+	"mexemos",
+	"mexendo",
+	"mexer",
+	"mexeram",
+	"mexerei",
+	"mexerem",
+	"mexeria",
+	"mexes",
+	"mexeu",
+	"mexi",
+	"mexia",
+	"mexiam",
+	"mexida",
+	"mexido",
+	"mexidos",
+	"mexo",
+	"milhao",
+	"milhoes",
+	"milhos",
+	"miolo",
+	"missas",
+	"miudo",
+	"mocas",
+	"moco",
+	"modas",
+	"moedas",
+	"moita",
+	"molhada",
+	"molhadas",
+	"molhado",
+	"molhados",
+	"molhos",
+	"monte",
+	"mora",
+	"morada",
+	"moradas",
+	"morado",
+	"morados",
+	"moral",
+	"moram",
+	"moramos",
+	"morando",
+	"morar",
+	"morara",
+	"moraram",
+	"morarei",
+	"morarem",
+	"moraria",
+	"moras",
+	"morasse",
+	"moraste",
+	"morava",
+	"moravam",
+	"moravas",
+	"morei",
+	"morno",
+	"moro",
+	"morou",
+	"morre",
+	"morrem",
+	"morremos",
+	"morrendo",
+	"morrer",
+	"morreram",
+	"morres",
+	"morreu",
+	"morria",
+	"morro",
+	"morta",
+	"mortas",
+	"mortes",
+	"morto",
+	"mortos",
+	"moscas",
+	"mostra",
+	"mostrada",
+	"mostrado",
+	"mostram",
+	"mostrar",
+	"mostrara",
+	"mostras",
+	"mostrava",
+	"mostrei",
+	"mostro",
+	"mostrou",
+	"motores",
+	"moveis",
+	"movel",
+	"muda",
+	"mudada",
+	"mudadas",
+	"mudado",
+	"mudados",
+	"mudam",
+	"mudamos",
+	"mudando",
+	"mudar",
+	"mudara",
+	"mudaram",
+	"mudarei",
+	"mudarem",
+	"mudaria",
+	"mudas",
+	"mudasse",
+	"mudaste",
+	"mudava",
+	"mudavam",
+	"mudavas",
+	"mudei",
+	"mudo",
+	"mudou",
+	"muita",
+	"muitas",
+	"muitos",
+	"mulas",
+	"mulheres",
+	"multa",
+	"mundos",
+	"muros",
+	"museu",
+	"musicas",
+	"nabos",
+	"nacao",
+	"nadada",
+	"nadadas",
+	"nadado",
+	"nadados",
+	"nadam",
+	"nadamos",
+	"nadando",
+	"nadara",
+	"nadaram",
+	"nadarei",
+	"nadarem",
+	"nadaria",
+	"nadas",
+	"nadasse",
+	"nadaste",
+	"nadava",
+	"nadavam",
+	"nadavas",
+	"nadei",
+	"nado",
+	"nadou",
+	"naipe",
+	"namoros",
+	"narizes",
+	"nasal",
+	"nascem",
+	"nascemos",
+	"nascendo",
+	"nascer",
+	"nasceram",
+	"nascerei",
+	"nascerem",
+	"nasceria",
+	"nasceu",
+	"nasci",
+	"nascia",
+	"nasciam",
+	"nascida",
+	"nascido",
+	"nascidos",
+	"natal",
+	"naval",
+	"navios",
+	"negar",
+	"negra",
+	"negras",
+	"negros",
+	"nenhum",
+	"nenhuma",
+	"nervos",
+	"neta",
+	"netas",
+	"neto",
+	"netos",
+	"nevar",
+	"neves",
+	"ninguem",
+	"ninhos",
+	"nivel",
+	"nobre",
+	"noites",
+	"nomes",
+	"norma",
+	"nortes",
+	"nossa",
+	"nossas",
+	"nosso",
+	"nossos",
+	"notar",
+	"notas",
+	"noticias",
+	"nova",
+	"novas",
+	"nove",
+	"novel",
+	"novembro",
+	"noventa",
+	"novos",
+	"nuvens",
+	"obeso",
+	"obras",
+	"obrigada",
+	"obrigado",
+	"oceanos",
+	"odios",
+	"oitenta",
+	"oito",
+	"olha",
+	"olhada",
+	"olhadas",
+	"olhado",
+	"olhados",
+	"olham",
+	"olhamos",
+	"olhando",
+	"olhar",
+	"olhara",
+	"olharam",
+	"olharei",
+	"olharem",
+	"olharia",
+	"olhas",
+	"olhasse",
+	"olhaste",
+	"olhava",
+	"olhavam",
+	"olhavas",
+	"olhei",
+	"olhos",
+	"olhou",
+	"ombro",
+	"ondas",
+	"onde",
+	"onibus",
+	"onze",
+	"optar",
+	"orelhas",
+	"orfao",
+	"orgao",
+	"ossos",
+	"ouca",
+	"ouco",
+	"ouros",
+	"outonos",
+	"outra",
+	"outras",
+	"outros",
+	"outubro",
+	"ouve",
+	"ouvem",
+	"ouves",
+	"ouvido",
+	"ouvimos",
+	"ouvindo",
+	"ouvir",
+	"ouviram",
+	"ouviu",
+	"ovelhas",
+	"ovos",
+	"oxala",
+	"padres",
+	"paes",
+	"paga",
+	"pagada",
+	"pagadas",
+	"pagado",
+	"pagados",
+	"pagam",
+	"pagamos",
+	"pagando",
+	"pagara",
+	"pagaram",
+	"pagarei",
+	"pagarem",
+	"pagaria",
+	"pagas",
+	"pagasse",
+	"pagaste",
+	"pagava",
+	"pagavam",
+	"pagavas",
+	"pago",
+	"pagou",
+	"paguei",
+	"pajem",
+	"palavras",
+	"palco",
+	"palha",
+	"palma",
+	"panca",
+	"papai",
+	"papeis",
+	"para",
+	"parada",
+	"paradas",
+	"parado",
+	"parados",
+	"param",
+	"paramos",
+	"parando",
+	"parar",
+	"parara",
+	"pararam",
+	"pararei",
+	"pararem",
+	"pararia",
+	"paras",
+	"parasse",
+	"paraste",
+	"parava",
+	"paravam",
+	"paravas",
+	"pardo",
+	"parecem",
+	"parecer",
+	"pareceu",
+	"pareci",
+	"parecia",
+	"pareciam",
+	"parecida",
+	"parecido",
+	"paredes",
+	"parei",
+	"paro",
+	"parou",
+	"parques",
+	"partem",
+	"partes",
+	"parti",
+	"partia",
+	"partiam",
+	"partida",
+	"partido",
+	"partidos",
+	"partimos",
+	"partindo",
+	"partir",
+	"partiram",
+	"partirei",
+	"partirem",
+	"partiria",
+	"partiu",
+	"parto",
+	"passa",
+	"passada",
+	"passadas",
+	"passado",
+	"passados",
+	"passam",
+	"passamos",
+	"passando",
+	"passar",
+	"passara",
+	"passaram",
+	"passarei",
+	"passarem",
+	"passaria",
+	"passaros",
+	"passas",
+	"passasse",
+	"passaste",
+	"passava",
+	"passavam",
+	"passavas",
+	"passei",
+	"passos",
+	"passou",
+	"pasta",
+	"patio",
+	"patos",
+	"patrias",
+	"pausa",
+	"pavor",
+	"peca",
+	"peco",
+	"pedal",
+	"pede",
+	"pedem",
+	"pedes",
+	"pedido",
+	"pedimos",
+	"pedindo",
+	"pedir",
+	"pediram",
+	"pediu",
+	"pedras",
+	"pega",
+	"pegada",
+	"pegadas",
+	"pegado",
+	"pegados",
+	"pegam",
+	"pegamos",
+	"pegando",
+	"pegar",
+	"pegara",
+	"pegaram",
+	"pegarei",
+	"pegarem",
+	"pegaria",
+	"pegas",
+	"pegasse",
+	"pegaste",
+	"pegava",
+	"pegavam",
+	"pegavas",
+	"pego",
+	"pegou",
+	"peguei",
+	"peitos",
+	"peixes",
+	"pela",
+	"pelas",
+	"peles",
+	"pelo",
+	"pelos",
+	"penas",
+	"pensa",
+	"pensada",
+	"pensadas",
+	"pensado",
+	"pensados",
+	"pensam",
+	"pensamos",
+	"pensando",
+	"pensara",
+	"pensaram",
+	"pensarei",
+	"pensarem",
+	"pensaria",
+	"pensas",
+	"pensasse",
+	"pensaste",
+	"pensava",
+	"pensavam",
+	"pensavas",
+	"pensei",
+	"penso",
+	"pensou",
+	"pentes",
+	"pequena",
+	"pequenas",
+	"pequenos",
+	"perco",
+	"perde",
+	"perdem",
+	"perdemos",
+	"perdendo",
+	"perder",
+	"perderam",
+	"perdes",
+	"perdeu",
+	"perdia",
+	"perdido",
+	"pergunta",
+	"pergunto",
+	"perigos",
+	"permite",
+	"permitem",
+	"permites",
+	"permiti",
+	"permitia",
+	"permitir",
+	"permitiu",
+	"permito",
+	"pernas",
+	"pesar",
+	"pesos",
+	"pessoa",
+	"pessoas",
+	"peste",
+	"piada",
+	"pianos",
+	"pilhas",
+	"pimentas",
+	"pinceis",
+	"pinho",
+	"pinta",
+	"pintada",
+	"pintadas",
+	"pintado",
+	"pintados",
+	"pintam",
+	"pintamos",
+	"pintando",
+	"pintara",
+	"pintaram",
+	"pintarei",
+	"pintarem",
+	"pintaria",
+	"pintas",
+	"pintasse",
+	"pintaste",
+	"pintava",
+	"pintavam",
+	"pintavas",
+	"pintei",
+	"pinto",
+	"pintou",
+	"pirata",
+	"pisar",
+	"placa",
+	"plano",
+	"pleno",
+	"pluma",
+	"pneus",
+	"pobres",
+	"pode",
+	"podem",
+	"podemos",
+	"podendo",
+	"podera",
+	"poderei",
+	"poderia",
+	"podes",
+	"podia",
+	"podiam",
+	"podido",
+	"podre",
+	"poem",
+	"poemas",
+	"poes",
+	"poetas",
+	"polvo",
+	"pombo",
+	"pomos",
+	"pondo",
+	"ponha",
+	"ponho",
+	"ponta",
+	"pontes",
+	"porcos",
+	"porque",
+	"portas",
+	"possa",
+	"possam",
+	"posse",
+	"possivel",
+	"posso",
+	"posta",
+	"posto",
+	"pouca",
+	"poucas",
+	"pouco",
+	"poucos",
+	"povo",
+	"povos",
+	"praca",
+	"prado",
+	"praias",
+	"prata",
+	"pratos",
+	"prazeres",
+	"prazo",
+	"prece",
+	"precisa",
+	"precisam",
+	"precisar",
+	"precisas",
+	"precisei",
+	"preciso",
+	"precisou",
+	"precos",
+	"pregos",
+	"prepara",
+	"preparam",
+	"preparar",
+	"preparas",
+	"preparei",
+	"preparo",
+	"preparou",
+	"presa",
+	"preta",
+	"pretas",
+	"pretos",
+	"prima",
+	"primeira",
+	"primeiro",
+	"primos",
+	"procura",
+	"procuram",
+	"procurar",
+	"procuras",
+	"procurei",
+	"procuro",
+	"procurou",
+	"prosa",
+	"provada",
+	"provadas",
+	"provado",
+	"provados",
+	"provam",
+	"provamos",
+	"provando",
+	"provar",
+	"provara",
+	"provaram",
+	"provarei",
+	"provarem",
+	"provaria",
+	"provas",
+	"provasse",
+	"provaste",
+	"provava",
+	"provavam",
+	"provavas",
+	"provei",
+	"provo",
+	"provou",
+	"pude",
+	"pudemos",
+	"puderam",
+	"pudim",
+	"pular",
+	"pulga",
+	"pulos",
+	"pulso",
+	"punha",
+	"pusemos",
+	"puseram",
+	"puxa",
+	"puxada",
+	"puxadas",
+	"puxado",
+	"puxados",
+	"puxam",
+	"puxamos",
+	"puxando",
+	"puxar",
+	"puxara",
+	"puxaram",
+	"puxarei",
+	"puxarem",
+	"puxaria",
+	"puxas",
+	"puxasse",
+	"puxaste",
+	"puxava",
+	"puxavam",
+	"puxavas",
+	"puxei",
+	"puxo",
+	"puxou",
+	"quadros",
+	"quais",
+	"qual",
+	"quando",
+	"quanta",
+	"quantas",
+	"quanto",
+	"quantos",
+	"quarenta",
+	"quarta",
+	"quartos",
+	"quase",
+	"quatro",
+	"quebra",
+	"quebrada",
+	"quebrado",
+	"quebram",
+	"quebrar",
+	"quebrara",
+	"quebras",
+	"quebrava",
+	"quebrei",
+	"quebro",
+	"quebrou",
+	"queda",
+	"queijos",
+	"queira",
+	"quem",
+	"quentes",
+	"quer",
+	"querem",
+	"queremos",
+	"querendo",
+	"queres",
+	"queria",
+	"queriam",
+	"querido",
+	"quero",
+	"quieta",
+	"quietas",
+	"quieto",
+	"quietos",
+	"quilos",
+	"quinta",
+	"quinto",
+	"quinze",
+	"quis",
+	"quisemos",
+	"quiseram",
+	"quota",
+	"radar",
+	"rainhas",
+	"raiva",
+	"raizes",
+	"ramos",
+	"rampa",
+	"rapazes",
+	"raposas",
+	"raspa",
+	"ratos",
+	"razoes",
+	"reais",
+	"recebe",
+	"recebem",
+	"receber",
+	"recebes",
+	"recebeu",
+	"recebi",
+	"recebia",
+	"recebiam",
+	"recebida",
+	"recebido",
+	"recebo",
+	"recuo",
+	"redes",
+	"redor",
+	"regra",
+	"reinos",
+	"reles",
+	"relogios",
+	"remar",
+	"renda",
+	"resiste",
+	"resistem",
+	"resistes",
+	"resisti",
+	"resistia",
+	"resistir",
+	"resistiu",
+	"resisto",
+	"restos",
+	"reter",
+	"revistas",
+	"rezar",
+	"rica",
+	"ricas",
+	"ricos",
+	"rido",
+	"riem",
+	"rimas",
+	"rimos",
+	"rindo",
+	"riqueza",
+	"riram",
+	"riscos",
+	"risos",
+	"ritmo",
+	"rival",
+	"robo",
+	"rocha",
+	"rodar",
+	"rodas",
+	"rolha",
+	"rosas",
+	"rosca",
+	"rostos",
+	"roubo",
+	"rouca",
+	"roupas",
+	"rubro",
+	"rugir",
+	"ruidos",
+	"ruins",
+	"rumor",
+	"rural",
+	"sabados",
+	"sabe",
+	"sabem",
+	"sabemos",
+	"sabendo",
+	"saberei",
+	"saberia",
+	"sabes",
+	"sabia",
+	"sabiam",
+	"sabido",
+	"sabio",
+	"sabor",
+	"sacar",
+	"sacos",
+	"sacro",
+	"saem",
+	"safra",
+	"saias",
+	"saiba",
+	"saibam",
+	"saida",
+	"saido",
+	"saimos",
+	"saindo",
+	"saio",
+	"sair",
+	"sairam",
+	"sais",
+	"saiu",
+	"salao",
+	"salas",
+	"salsa",
+	"saltos",
+	"salvo",
+	"samba",
+	"sangues",
+	"santa",
+	"santos",
+	"sapatos",
+	"sapos",
+	"sarna",
+	"saudes",
+	"seca",
+	"secas",
+	"secos",
+	"sedes",
+	"segredos",
+	"segue",
+	"seguem",
+	"segues",
+	"seguia",
+	"seguido",
+	"seguimos",
+	"seguindo",
+	"seguir",
+	"seguiram",
+	"seguiu",
+	"segunda",
+	"segundo",
+	"segura",
+	"seguras",
+	"seguros",
+	"seis",
+	"seja",
+	"sejam",
+	"selos",
+	"selva",
+	"semanas",
+	"sendo",
+	"senha",
+	"senhores",
+	"sente",
+	"sentem",
+	"sentes",
+	"sentia",
+	"sentido",
+	"sentimos",
+	"sentindo",
+	"sentir",
+	"sentiram",
+	"sentiu",
+	"serras",
+	"sessenta",
+	"setembro",
+	"setenta",
+	"setimo",
+	"setor",
+	"sextas",
+	"sexto",
+	"sido",
+	"sigo",
+	"sinais",
+	"sinos",
+	"sinto",
+	"sitios",
+	"sobe",
+	"sobem",
+	"sobes",
+	"sobra",
+	"sobrinha",
+	"sobrinho",
+	"socio",
+	"sofre",
+	"sofrem",
+	"sofremos",
+	"sofrendo",
+	"sofrer",
+	"sofreram",
+	"sofrerei",
+	"sofrerem",
+	"sofreria",
+	"sofres",
+	"sofreu",
+	"sofri",
+	"sofria",
+	"sofriam",
+	"sofrida",
+	"sofrido",
+	"sofridos",
+	"sofro",
+	"sogra",
+	"sogro",
+	"solar",
+	"soldo",
+	"solos",
+	"sombras",
+	"somos",
+	"sonda",
+	"sonha",
+	"sonhada",
+	"sonhadas",
+	"sonhado",
+	"sonhados",
+	"sonham",
+	"sonhamos",
+	"sonhando",
+	"sonhar",
+	"sonhara",
+	"sonharam",
+	"sonharei",
+	"sonharem",
+	"sonharia",
+	"sonhas",
+	"sonhasse",
+	"sonhaste",
+	"sonhava",
+	"sonhavam",
+	"sonhavas",
+	"sonhei",
+	"sonhos",
+	"sonhou",
+	"sonos",
+	"sopas",
+	"sopro",
+	"sorrisos",
+	"sortes",
+	"sotao",
+	"soube",
+	"souberam",
+	"sozinha",
+	"sozinhas",
+	"sozinho",
+	"sozinhos",
+	"suave",
+	"subem",
+	"subi",
+	"subia",
+	"subiam",
+	"subida",
+	"subido",
+	"subidos",
+	"subimos",
+	"subindo",
+	"subir",
+	"subiram",
+	"subirei",
+	"subirem",
+	"subiria",
+	"subiu",
+	"subo",
+	"sucos",
+	"suja",
+	"sujar",
+	"sujas",
+	"sujos",
+	"sulco",
+	"sumir",
+	"super",
+	"surdo",
+	"surra",
+	"sustos",
+	"tabua",
+	"tacho",
+	"talao",
+	"talco",
+	"talho",
+	"talvez",
+	"tambem",
+	"tampa",
+	"tampouco",
+	"tanga",
+	"tanta",
+	"tantas",
+	"tanto",
+	"tantos",
+	"tardes",
+	"tarefa",
+	"taxar",
+	"tchau",
+	"tecer",
+	"tecla",
+	"teias",
+	"telha",
+	"teme",
+	"temem",
+	"tememos",
+	"temendo",
+	"temer",
+	"temeram",
+	"temerei",
+	"temerem",
+	"temeria",
+	"temes",
+	"temeu",
+	"temi",
+	"temia",
+	"temiam",
+	"temida",
+	"temido",
+	"temidos",
+	"temo",
+	"temor",
+	"temos",
+	"tempos",
+	"tenda",
+	"tendo",
+	"tenha",
+	"tenham",
+	"tenho",
+	"tenro",
+	"tens",
+	"tera",
+	"terca",
+	"terceira",
+	"terceiro",
+	"terei",
+	"teria",
+	"terno",
+	"terras",
+	"tesouros",
+	"teste",
+	"tetos",
+	"teve",
+	"texto",
+	"tias",
+	"tido",
+	"tigres",
+	"tinha",
+	"tinham",
+	"tintas",
+	"tipos",
+	"tira",
+	"tirada",
+	"tiradas",
+	"tirado",
+	"tirados",
+	"tiram",
+	"tiramos",
+	"tirando",
+	"tirar",
+	"tirara",
+	"tiraram",
+	"tirarei",
+	"tirarem",
+	"tiraria",
+	"tiras",
+	"tirasse",
+	"tiraste",
+	"tirava",
+	"tiravam",
+	"tiravas",
+	"tirei",
+	"tiro",
+	"tirou",
+	"tive",
+	"tivemos",
+	"tiveram",
+	"toalhas",
+	"toca",
+	"tocada",
+	"tocadas",
+	"tocado",
+	"tocados",
+	"tocam",
+	"tocamos",
+	"tocando",
+	"tocar",
+	"tocara",
+	"tocaram",
+	"tocarei",
+	"tocarem",
+	"tocaria",
+	"tocas",
+	"tocasse",
+	"tocaste",
+	"tocava",
+	"tocavam",
+	"tocavas",
+	"toco",
+	"tocou",
+	"toda",
+	"todas",
+	"todos",
+	"tolos",
+	"toma",
+	"tomada",
+	"tomadas",
+	"tomado",
+	"tomados",
+	"tomam",
+	"tomamos",
+	"tomando",
+	"tomar",
+	"tomara",
+	"tomaram",
+	"tomarei",
+	"tomarem",
+	"tomaria",
+	"tomas",
+	"tomasse",
+	"tomaste",
+	"tomava",
+	"tomavam",
+	"tomavas",
+	"tomei",
+	"tomo",
+	"tomou",
+	"tonto",
+	"toquei",
+	"torre",
+	"tosse",
+	"total",
+	"touca",
+	"touros",
+	"trabalha",
+	"traco",
+	"traga",
+	"trago",
+	"traje",
+	"trama",
+	"trapo",
+	"trata",
+	"tratada",
+	"tratadas",
+	"tratado",
+	"tratados",
+	"tratam",
+	"tratamos",
+	"tratando",
+	"tratar",
+	"tratara",
+	"trataram",
+	"tratarei",
+	"tratarem",
+	"trataria",
+	"tratas",
+	"tratasse",
+	"trataste",
+	"tratava",
+	"tratavam",
+	"tratavas",
+	"tratei",
+	"trato",
+	"tratou",
+	"trava",
+	"traz",
+	"trazem",
+	"trazemos",
+	"trazer",
+	"trazes",
+	"trazido",
+	"trens",
+	"tres",
+	"trevo",
+	"treze",
+	"tribo",
+	"trigos",
+	"trinta",
+	"tristes",
+	"troncos",
+	"tronos",
+	"tropa",
+	"trouxe",
+	"truta",
+	"tudo",
+	"tumba",
+	"turbo",
+	"turmas",
+	"turno",
+	"tutor",
+	"ultimos",
+	"unem",
+	"unes",
+	"unia",
+	"uniam",
+	"unico",
+	"unida",
+	"unido",
+	"unidos",
+	"unimos",
+	"unindo",
+	"unir",
+	"uniram",
+	"unirei",
+	"unirem",
+	"uniria",
+	"uniu",
+	"usada",
+	"usadas",
+	"usado",
+	"usados",
+	"usam",
+	"usamos",
+	"usando",
+	"usar",
+	"usara",
+	"usaram",
+	"usarei",
+	"usarem",
+	"usaria",
+	"usas",
+	"usasse",
+	"usaste",
+	"usava",
+	"usavam",
+	"usavas",
+	"usei",
+	"usou",
+	"usuais",
+	"uteis",
+	"vacas",
+	"vagao",
+	"vagar",
+	"vais",
+	"valer",
+	"valores",
+	"valsa",
+	"vamos",
+	"vapores",
+	"varal",
+	"varao",
+	"vazia",
+	"vazias",
+	"vazio",
+	"vazios",
+	"veado",
+	"veem",
+	"veio",
+	"veja",
+	"vejam",
+	"vejo",
+	"velas",
+	"velha",
+	"velhas",
+	"velhos",
+	"veloz",
+	"vemos",
+	"venda",
+	"vende",
+	"vendem",
+	"vendemos",
+	"vendendo",
+	"vender",
+	"venderam",
+	"venderei",
+	"venderem",
+	"venderia",
+	"vendes",
+	"vendeu",
+	"vendi",
+	"vendia",
+	"vendiam",
+	"vendida",
+	"vendido",
+	"vendidos",
+	"vendo",
+	"venha",
+	"venho",
+	"vens",
+	"ventos",
+	"vera",
+	"verbo",
+	"verdades",
+	"verdes",
+	"verei",
+	"veria",
+	"verso",
+	"vespa",
+	"vestidos",
+	"vetor",
+	"viagens",
+	"viaja",
+	"viajada",
+	"viajadas",
+	"viajado",
+	"viajados",
+	"viajam",
+	"viajamos",
+	"viajando",
+	"viajar",
+	"viajara",
+	"viajaram",
+	"viajarei",
+	"viajarem",
+	"viajaria",
+	"viajas",
+	"viajasse",
+	"viajaste",
+	"viajava",
+	"viajavam",
+	"viajavas",
+	"viajei",
+	"viajo",
+	"viajou",
+	"viam",
+	"vidas",
+	"vidros",
+	"viemos",
+	"vieram",
+	"vilas",
+	"vimos",
+	"vindo",
+	"vinha",
+	"vinham",
+	"vinhos",
+	"violas",
+	"viram",
+	"virus",
+	"visao",
+	"visitada",
+	"visitado",
+	"visitam",
+	"visitar",
+	"visitara",
+	"visitas",
+	"visitava",
+	"visitei",
+	"visito",
+	"visitou",
+	"vista",
+	"visto",
+	"vital",
+	"vitorias",
+	"viuva",
+	"vive",
+	"vivem",
+	"vivemos",
+	"vivendo",
+	"viveram",
+	"viverei",
+	"viverem",
+	"viveria",
+	"vives",
+	"viveu",
+	"vivi",
+	"vivia",
+	"viviam",
+	"vivida",
+	"vivido",
+	"vividos",
+	"vivo",
+	"vivos",
+	"vizinha",
+	"vizinhos",
+	"vocal",
+	"voce",
+	"voces",
+	"volta",
+	"voltada",
+	"voltadas",
+	"voltado",
+	"voltados",
+	"voltam",
+	"voltamos",
+	"voltando",
+	"voltara",
+	"voltaram",
+	"voltarei",
+	"voltarem",
+	"voltaria",
+	"voltas",
+	"voltasse",
+	"voltaste",
+	"voltava",
+	"voltavam",
+	"voltavas",
+	"voltei",
+	"volto",
+	"voltou",
+	"voraz",
+	"vossa",
+	"vosso",
+	"vota",
+	"votada",
+	"votadas",
+	"votado",
+	"votados",
+	"votam",
+	"votamos",
+	"votando",
+	"votar",
+	"votara",
+	"votaram",
+	"votarei",
+	"votarem",
+	"votaria",
+	"votas",
+	"votasse",
+	"votaste",
+	"votava",
+	"votavam",
+	"votavas",
+	"votei",
+	"voto",
+	"votou",
+	"vulto",
+	"xarope",
+	"xicaras",
+	"zebras",
+	"zelar",
+	"zinco",
+	"zonas",
+}
@@ -0,0 +1,4112 @@
+package words
+
+// Turkish answers of four to eight letters: common words in their usual form.
+var turkishAnswers = [...]string{
+	"adam",
+	"adres",
+	"aile",
+	"akraba",
+	"akıl",
+	"akşam",
+	"alan",
+	"alet",
+	"almak",
+	"altın",
+	"anahtar",
+	"anlamak",
+	"anne",
+	"araba",
+	"aramak",
+	"arka",
+	"arkadaş",
+	"armut",
+	"asker",
+	"aslan",
+	"ateş",
+	"atmak",
+	"avukat",
+	"avuç",
+	"ayak",
+	"ayna",
+	"ayran",
+	"açmak",
+	"açık",
+	"ağaç",
+	"ağır",
+	"ağız",
+	"baba",
+	"bahar",
+	"bahçe",
+	"bakmak",
+	"bakır",
+	"balkon",
+	"balta",
+	"balık",
+	"banka",
+	"banyo",
+	"bardak",
+	"barış",
+	"bavul",
+	"bayrak",
+	"başak",
+	"başka",
+	"bebek",
+	"beklemek",
+	"bekçi",
+	"berber",
+	"beyaz",
+	"beyin",
+	"bilek",
+	"bilet",
+	"bilgi",
+	"bilmek",
+	"bina",
+	"binmek",
+	"birlik",
+	"bitmek",
+	"boncuk",
+	"borç",
+	"boya",
+	"boyun",
+	"boğaz",
+	"bulaşık",
+	"bulmak",
+	"bulut",
+	"burun",
+	"büyük",
+	"bıyık",
+	"bıçak",
+	"cadde",
+	"cami",
+	"ceket",
+	"cevap",
+	"ceviz",
+	"ciddi",
+	"cilt",
+	"cuma",
+	"cüzdan",
+	"dalga",
+	"damat",
+	"damla",
+	"davet",
+	"dayı",
+	"defter",
+	"delik",
+	"demir",
+	"deniz",
+	"dere",
+	"deri",
+	"derin",
+	"ders",
+	"destek",
+	"değer",
+	"diken",
+	"dilek",
+	"dinlemek",
+	"dişi",
+	"doktor",
+	"dolap",
+	"dolu",
+	"domates",
+	"dost",
+	"doğa",
+	"doğmak",
+	"doğru",
+	"dudak",
+	"duman",
+	"durak",
+	"durmak",
+	"duvar",
+	"duygu",
+	"dökmek",
+	"dünya",
+	"düzen",
+	"düğme",
+	"düğün",
+	"düşmek",
+	"düşük",
+	"ekim",
+	"ekmek",
+	"ekran",
+	"elbise",
+	"eldiven",
+	"elma",
+	"elmas",
+	"elçi",
+	"emek",
+	"emir",
+	"enerji",
+	"engel",
+	"erik",
+	"erkek",
+	"eski",
+	"etek",
+	"etmek",
+	"evet",
+	"evlat",
+	"evren",
+	"eylül",
+	"eşek",
+	"fare",
+	"fayda",
+	"fener",
+	"fidan",
+	"fikir",
+	"fincan",
+	"fiyat",
+	"fındık",
+	"fırsat",
+	"fırça",
+	"fırın",
+	"fıstık",
+	"gazete",
+	"gece",
+	"gelin",
+	"gelir",
+	"gelmek",
+	"gemi",
+	"genç",
+	"gerçek",
+	"gezmek",
+	"girmek",
+	"gitmek",
+	"gurur",
+	"gökyüzü",
+	"gölge",
+	"gömlek",
+	"görmek",
+	"gözlük",
+	"gülmek",
+	"güneş",
+	"güven",
+	"güzel",
+	"haber",
+	"hafta",
+	"halk",
+	"halı",
+	"hamam",
+	"hamur",
+	"harf",
+	"harita",
+	"hasat",
+	"hasta",
+	"hata",
+	"hava",
+	"havuç",
+	"hayat",
+	"hayvan",
+	"hazır",
+	"hece",
+	"hedef",
+	"hediye",
+	"hemşire",
+	"hikaye",
+	"hoca",
+	"horoz",
+	"hurma",
+	"hırsız",
+	"iklim",
+	"ilaç",
+	"ilçe",
+	"ince",
+	"inci",
+	"inek",
+	"insan",
+	"ipek",
+	"irmik",
+	"isim",
+	"istemek",
+	"izin",
+	"içmek",
+	"iğne",
+	"işaret",
+	"işçi",
+	"kabak",
+	"kabuk",
+	"kadın",
+	"kafa",
+	"kafes",
+	"kahve",
+	"kale",
+	"kalem",
+	"kalmak",
+	"kalın",
+	"kalıp",
+	"kamyon",
+	"kanal",
+	"kanat",
+	"kapak",
+	"kapamak",
+	"kaplan",
+	"kapı",
+	"karar",
+	"kardeş",
+	"kare",
+	"karga",
+	"karpuz",
+	"kartal",
+	"kasa",
+	"kasap",
+	"kasım",
+	"katır",
+	"kavga",
+	"kavun",
+	"kaya",
+	"kayık",
+	"kazak",
+	"kazan",
+	"kazmak",
+	"kağıt",
+	"kaşar",
+	"kaşık",
+	"kedi",
+	"kelime",
+	"kemer",
+	"kemik",
+	"kent",
+	"keçi",
+	"kibrit",
+	"kilo",
+	"kira",
+	"kiraz",
+	"kirli",
+	"kitap",
+	"kolay",
+	"koltuk",
+	"komşu",
+	"konak",
+	"konu",
+	"konuk",
+	"korku",
+	"kova",
+	"koyun",
+	"koşmak",
+	"kukla",
+	"kulak",
+	"kumaş",
+	"kural",
+	"kutu",
+	"kuyruk",
+	"kuyu",
+	"kuzen",
+	"kuzey",
+	"kuzu",
+	"köpek",
+	"köprü",
+	"köpük",
+	"küçük",
+	"kılıç",
+	"kırmızı",
+	"lale",
+	"lamba",
+	"lastik",
+	"lider",
+	"liman",
+	"limon",
+	"lise",
+	"lokanta",
+	"maden",
+	"mahalle",
+	"makas",
+	"masa",
+	"masal",
+	"maske",
+	"mavi",
+	"maymun",
+	"mağara",
+	"mektup",
+	"melek",
+	"mendil",
+	"merdiven",
+	"merkez",
+	"mesaj",
+	"meslek",
+	"mevsim",
+	"meydan",
+	"meyve",
+	"mezar",
+	"meşe",
+	"mide",
+	"mimar",
+	"minder",
+	"misafir",
+	"mutfak",
+	"mutlu",
+	"müzik",
+	"mısır",
+	"nane",
+	"nefes",
+	"nehir",
+	"nine",
+	"nisan",
+	"nokta",
+	"nöbet",
+	"nüfus",
+	"okul",
+	"okumak",
+	"olmak",
+	"ordu",
+	"orman",
+	"ortak",
+	"otel",
+	"otobüs",
+	"oyun",
+	"oyuncak",
+	"palto",
+	"pamuk",
+	"para",
+	"parmak",
+	"pasta",
+	"patates",
+	"pazar",
+	"pekmez",
+	"pembe",
+	"pencere",
+	"perde",
+	"peynir",
+	"pide",
+	"pilav",
+	"pirinç",
+	"postacı",
+	"pusula",
+	"pınar",
+	"radyo",
+	"rakam",
+	"rehber",
+	"renk",
+	"resim",
+	"rüya",
+	"rüzgar",
+	"sabah",
+	"sabun",
+	"sahil",
+	"salata",
+	"salon",
+	"sanat",
+	"sandalye",
+	"sandık",
+	"saray",
+	"sarı",
+	"satmak",
+	"savaş",
+	"saymak",
+	"sayı",
+	"sebep",
+	"sebze",
+	"sehpa",
+	"sekiz",
+	"selam",
+	"semt",
+	"sepet",
+	"serçe",
+	"sesli",
+	"sevgi",
+	"sevmek",
+	"silgi",
+	"silmek",
+	"simit",
+	"sincap",
+	"sinema",
+	"sirke",
+	"siyah",
+	"soba",
+	"sofra",
+	"sokak",
+	"somun",
+	"sonra",
+	"sonuç",
+	"sopa",
+	"sormak",
+	"soru",
+	"sorun",
+	"soğan",
+	"soğuk",
+	"spor",
+	"sucuk",
+	"sulu",
+	"susmak",
+	"sözlük",
+	"sünger",
+	"sürü",
+	"sıcak",
+	"sınav",
+	"sınır",
+	"sıra",
+	"sıçan",
+	"tabak",
+	"tabut",
+	"tahta",
+	"tahıl",
+	"takvim",
+	"takım",
+	"tarak",
+	"tarih",
+	"tarla",
+	"tatil",
+	"tatlı",
+	"tavan",
+	"tavla",
+	"tavuk",
+	"tavşan",
+	"taze",
+	"tebeşir",
+	"tekne",
+	"telefon",
+	"tembel",
+	"temiz",
+	"tencere",
+	"tepe",
+	"tepsi",
+	"terlik",
+	"terzi",
+	"tilki",
+	"tohum",
+	"toprak",
+	"topuk",
+	"torba",
+	"turuncu",
+	"turşu",
+	"tutmak",
+	"tuzlu",
+	"tuğla",
+	"tören",
+	"tünel",
+	"türkü",
+	"tırnak",
+	"ucuz",
+	"ufuk",
+	"ulus",
+	"umut",
+	"usta",
+	"uyku",
+	"uyumak",
+	"uzak",
+	"uzun",
+	"uçak",
+	"uçmak",
+	"uğur",
+	"vadi",
+	"vakit",
+	"vapur",
+	"vatan",
+	"vazo",
+	"vergi",
+	"vermek",
+	"yabancı",
+	"yakın",
+	"yalan",
+	"yanak",
+	"yanlış",
+	"yapmak",
+	"yaprak",
+	"yarın",
+	"yarış",
+	"yastık",
+	"yatak",
+	"yayla",
+	"yazar",
+	"yazmak",
+	"yazı",
+	"yağmur",
+	"yaşlı",
+	"yelek",
+	"yemek",
+	"yengeç",
+	"yeni",
+	"yetenek",
+	"yeşil",
+	"yokuş",
+	"yorgan",
+	"yorgun",
+	"yosun",
+	"yudum",
+	"yumruk",
+	"yumurta",
+	"yumuşak",
+	"yurt",
+	"yüzmek",
+	"yüzük",
+	"yıldız",
+	"zafer",
+	"zaman",
+	"zambak",
+	"zarf",
+	"zayıf",
+	"zemin",
+	"zengin",
+	"zeytin",
+	"zincir",
+	"çadır",
+	"çakal",
+	"çalmak",
+	"çamur",
+	"çanta",
+	"çarşı",
+	"çatal",
+	"çatı",
+	"çekiç",
+	"çekmek",
+	"çelik",
+	"çene",
+	"çift",
+	"çimen",
+	"çizgi",
+	"çizme",
+	"çiçek",
+	"çocuk",
+	"çorap",
+	"çorba",
+	"çünkü",
+	"çıkmak",
+	"çınar",
+	"ödev",
+	"ödül",
+	"öfke",
+	"ölmek",
+	"ölüm",
+	"önce",
+	"ördek",
+	"örgü",
+	"örnek",
+	"öykü",
+	"özel",
+	"öğle",
+	"öğrenci",
+	"ücret",
+	"ülke",
+	"üzüm",
+	"ılık",
+	"ırmak",
+	"ışık",
+	"şahin",
+	"şans",
+	"şapka",
+	"şarkı",
+	"şehir",
+	"şeker",
+	"şelale",
+	"şemsiye",
+	"şiir",
+	"şimdi",
+	"şimşek",
+	"şirket",
+	"şişe",
+	"şoför",
+}
+
+// Other Turkish words that may be guessed: plurals and case endings of nouns,
+// verb forms and less common words.
+var turkishAllowed = [...]string{
+	"acıda",
+	"acıdan",
+	"acılar",
+	"acılarda",
+	"acıyla",
+	"adamda",
+	"adamdan",
+	"adamla",
+	"adamlar",
+	"adresle",
+	"adresler",
+	"adreste",
+	"adresten",
+	"ahşap",
+	"ahşapla",
+	"ahşaplar",
+	"ahşapta",
+	"ahşaptan",
+	"ailede",
+	"aileden",
+	"aileler",
+	"aileyle",
+	"akrabada",
+	"akılda",
+	"akıldan",
+	"akılla",
+	"akıllar",
+	"akıllı",
+	"akıllıda",
+	"akşamda",
+	"akşamdan",
+	"akşamla",
+	"akşamlar",
+	"alacak",
+	"alanda",
+	"alandan",
+	"alanla",
+	"alanlar",
+	"aldı",
+	"aldık",
+	"aldılar",
+	"aldım",
+	"aldın",
+	"aletle",
+	"aletler",
+	"alette",
+	"aletten",
+	"alma",
+	"almadı",
+	"almadık",
+	"almadım",
+	"almış",
+	"almışlar",
+	"altmış",
+	"altı",
+	"altında",
+	"altından",
+	"altınla",
+	"altınlar",
+	"alıyor",
+	"alıyorum",
+	"alıyoruz",
+	"ancak",
+	"anla",
+	"anladı",
+	"anladık",
+	"anladım",
+	"anladın",
+	"anlama",
+	"anlamadı",
+	"anlamış",
+	"anlayan",
+	"anlıyor",
+	"annede",
+	"anneden",
+	"anneler",
+	"anneyle",
+	"arabada",
+	"arabadan",
+	"arabalar",
+	"arabayla",
+	"aradı",
+	"aradık",
+	"aradılar",
+	"aradım",
+	"aradın",
+	"aralık",
+	"arama",
+	"aramadı",
+	"aramadık",
+	"aramadım",
+	"aramış",
+	"arayacak",
+	"arayan",
+	"arkada",
+	"arkadan",
+	"arkalar",
+	"arkayla",
+	"armutla",
+	"armutlar",
+	"armutta",
+	"armuttan",
+	"artık",
+	"arıda",
+	"arıdan",
+	"arılar",
+	"arılarda",
+	"arıyla",
+	"arıyor",
+	"arıyorum",
+	"arıyoruz",
+	"askerde",
+	"askerden",
+	"askerle",
+	"askerler",
+	"asla",
+	"aslanda",
+	"aslandan",
+	"aslanla",
+	"aslanlar",
+	"atacak",
+	"atan",
+	"ateşle",
+	"ateşler",
+	"ateşte",
+	"ateşten",
+	"atla",
+	"atlar",
+	"atlarda",
+	"atlardan",
+	"atma",
+	"atmadı",
+	"atmadık",
+	"atmadım",
+	"atmış",
+	"atmışlar",
+	"atta",
+	"attan",
+	"attı",
+	"attık",
+	"attılar",
+	"attım",
+	"attın",
+	"atıyor",
+	"atıyorum",
+	"atıyoruz",
+	"avukatla",
+	"avukatta",
+	"avuçla",
+	"avuçlar",
+	"avuçta",
+	"avuçtan",
+	"ayakla",
+	"ayaklar",
+	"ayakta",
+	"ayaktan",
+	"ayda",
+	"aydan",
+	"ayla",
+	"aylar",
+	"aylarda",
+	"aylardan",
+	"aynada",
+	"aynadan",
+	"aynalar",
+	"aynayla",
+	"aynı",
+	"aynıda",
+	"aynıdan",
+	"aynılar",
+	"aynıyla",
+	"ayranda",
+	"ayrandan",
+	"ayranla",
+	"ayranlar",
+	"ayıda",
+	"ayıdan",
+	"ayılar",
+	"ayılarda",
+	"ayıyla",
+	"açacak",
+	"açan",
+	"açma",
+	"açmadı",
+	"açmadık",
+	"açmadım",
+	"açmış",
+	"açmışlar",
+	"açtı",
+	"açtık",
+	"açtılar",
+	"açtım",
+	"açtın",
+	"açıkla",
+	"açıklar",
+	"açıkta",
+	"açıktan",
+	"açıyor",
+	"açıyorum",
+	"açıyoruz",
+	"ağaçla",
+	"ağaçlar",
+	"ağaçta",
+	"ağaçtan",
+	"ağda",
+	"ağdan",
+	"ağla",
+	"ağlar",
+	"ağlarda",
+	"ağlardan",
+	"ağustos",
+	"ağırda",
+	"ağırdan",
+	"ağırla",
+	"ağırlar",
+	"ağızda",
+	"ağızdan",
+	"ağızla",
+	"ağızlar",
+	"aşağı",
+	"babada",
+	"babadan",
+	"babalar",
+	"babayla",
+	"baharda",
+	"bahardan",
+	"baharla",
+	"baharlar",
+	"bahçede",
+	"bahçeden",
+	"bahçeler",
+	"bahçeyle",
+	"bakacak",
+	"bakan",
+	"bakanda",
+	"bakandan",
+	"bakanla",
+	"bakanlar",
+	"bakkal",
+	"bakkalda",
+	"bakkalla",
+	"bakma",
+	"bakmadı",
+	"bakmadık",
+	"bakmadım",
+	"bakmış",
+	"baktı",
+	"baktık",
+	"baktılar",
+	"baktım",
+	"baktın",
+	"bakırda",
+	"bakırdan",
+	"bakırla",
+	"bakırlar",
+	"bakıyor",
+	"balda",
+	"baldan",
+	"balkonda",
+	"balkonla",
+	"balla",
+	"ballar",
+	"ballarda",
+	"baltada",
+	"baltadan",
+	"baltalar",
+	"baltayla",
+	"balıkla",
+	"balıklar",
+	"balıkta",
+	"balıktan",
+	"bana",
+	"bankada",
+	"bankadan",
+	"bankalar",
+	"bankayla",
+	"banyoda",
+	"banyodan",
+	"banyolar",
+	"banyoyla",
+	"bardakla",
+	"bardakta",
+	"barışla",
+	"barışlar",
+	"barışta",
+	"barıştan",
+	"bavulda",
+	"bavuldan",
+	"bavulla",
+	"bavullar",
+	"bayrakla",
+	"bayrakta",
+	"bazen",
+	"bazı",
+	"başakla",
+	"başaklar",
+	"başakta",
+	"başaktan",
+	"başkada",
+	"başkadan",
+	"başkalar",
+	"başkayla",
+	"başla",
+	"başlar",
+	"başlarda",
+	"başta",
+	"baştan",
+	"bebekle",
+	"bebekler",
+	"bebekte",
+	"bebekten",
+	"beden",
+	"bedende",
+	"bedenden",
+	"bedenle",
+	"bedenler",
+	"bekle",
+	"bekledi",
+	"bekledik",
+	"bekledim",
+	"bekledin",
+	"bekleme",
+	"beklemiş",
+	"bekleyen",
+	"bekliyor",
+	"bekçide",
+	"bekçiden",
+	"bekçiler",
+	"bekçiyle",
+	"belki",
+	"benim",
+	"berberde",
+	"berberle",
+	"beyazda",
+	"beyazdan",
+	"beyazla",
+	"beyazlar",
+	"beyinde",
+	"beyinden",
+	"beyinle",
+	"beyinler",
+	"beşik",
+	"beşikle",
+	"beşikler",
+	"beşikte",
+	"beşikten",
+	"beşinci",
+	"bildi",
+	"bildik",
+	"bildiler",
+	"bildim",
+	"bildin",
+	"bilecek",
+	"bilekle",
+	"bilekler",
+	"bilekte",
+	"bilekten",
+	"bilen",
+	"biletle",
+	"biletler",
+	"bilette",
+	"biletten",
+	"bilgide",
+	"bilgiden",
+	"bilgiler",
+	"bilgiyle",
+	"biliyor",
+	"bilme",
+	"bilmedi",
+	"bilmedik",
+	"bilmedim",
+	"bilmiş",
+	"binada",
+	"binadan",
+	"binalar",
+	"binayla",
+	"bindi",
+	"bindik",
+	"bindiler",
+	"bindim",
+	"bindin",
+	"binecek",
+	"binen",
+	"biniyor",
+	"binme",
+	"binmedi",
+	"binmedik",
+	"binmedim",
+	"binmiş",
+	"biraz",
+	"birinci",
+	"birisi",
+	"birlikle",
+	"birlikte",
+	"bisiklet",
+	"bitecek",
+	"biten",
+	"bitiyor",
+	"bitme",
+	"bitmedi",
+	"bitmedik",
+	"bitmedim",
+	"bitmiş",
+	"bitti",
+	"bittik",
+	"bittiler",
+	"bittim",
+	"bittin",
+	"bize",
+	"bizim",
+	"boncukla",
+	"boncukta",
+	"borçla",
+	"borçlar",
+	"borçta",
+	"borçtan",
+	"boyada",
+	"boyadan",
+	"boyalar",
+	"boyayla",
+	"boyda",
+	"boydan",
+	"boyla",
+	"boylar",
+	"boylarda",
+	"boyunda",
+	"boyundan",
+	"boyunla",
+	"boyunlar",
+	"boğazda",
+	"boğazdan",
+	"boğazla",
+	"boğazlar",
+	"boşla",
+	"boşlar",
+	"boşlarda",
+	"boşluk",
+	"boşlukla",
+	"boşlukta",
+	"boşta",
+	"boştan",
+	"bucak",
+	"bucakla",
+	"bucaklar",
+	"bucakta",
+	"bucaktan",
+	"budak",
+	"budakla",
+	"budaklar",
+	"budakta",
+	"budaktan",
+	"bugün",
+	"bulacak",
+	"bulan",
+	"buldu",
+	"bulduk",
+	"buldular",
+	"buldum",
+	"buldun",
+	"bulma",
+	"bulmadı",
+	"bulmadık",
+	"bulmadım",
+	"bulmuş",
+	"bulutla",
+	"bulutlar",
+	"bulutta",
+	"buluttan",
+	"buluyor",
+	"bunlar",
+	"bunu",
+	"burada",
+	"buraya",
+	"burunda",
+	"burundan",
+	"burunla",
+	"burunlar",
+	"buzda",
+	"buzdan",
+	"buzla",
+	"buzlar",
+	"buzlarda",
+	"böyle",
+	"büyükle",
+	"büyükler",
+	"büyükte",
+	"büyükten",
+	"bıyıkla",
+	"bıyıklar",
+	"bıyıkta",
+	"bıyıktan",
+	"bıçakla",
+	"bıçaklar",
+	"bıçakta",
+	"bıçaktan",
+	"caddede",
+	"caddeden",
+	"caddeler",
+	"caddeyle",
+	"cadı",
+	"cadıda",
+	"cadıdan",
+	"cadılar",
+	"cadıyla",
+	"camide",
+	"camiden",
+	"camiler",
+	"camiyle",
+	"canda",
+	"candan",
+	"canla",
+	"canlar",
+	"canlarda",
+	"ceketle",
+	"ceketler",
+	"cekette",
+	"ceketten",
+	"cevapla",
+	"cevaplar",
+	"cevapta",
+	"cevaptan",
+	"cevizde",
+	"cevizden",
+	"cevizle",
+	"cevizler",
+	"ciddide",
+	"ciddiden",
+	"ciddiler",
+	"ciddiyle",
+	"ciltle",
+	"ciltler",
+	"ciltte",
+	"ciltten",
+	"cumada",
+	"cumadan",
+	"cumalar",
+	"cumayla",
+	"cüzdanda",
+	"cüzdanla",
+	"daha",
+	"daima",
+	"dalgada",
+	"dalgadan",
+	"dalgalar",
+	"dalgayla",
+	"damatla",
+	"damatlar",
+	"damatta",
+	"damattan",
+	"damlada",
+	"damladan",
+	"damlalar",
+	"damlayla",
+	"davetle",
+	"davetler",
+	"davette",
+	"davetten",
+	"dayıda",
+	"dayıdan",
+	"dayılar",
+	"dayıyla",
+	"dağda",
+	"dağdan",
+	"dağla",
+	"dağlar",
+	"dağlarda",
+	"dedi",
+	"dedik",
+	"dedim",
+	"defterde",
+	"defterle",
+	"delikle",
+	"delikler",
+	"delikte",
+	"delikten",
+	"demet",
+	"demetle",
+	"demetler",
+	"demette",
+	"demetten",
+	"demirde",
+	"demirden",
+	"demirle",
+	"demirler",
+	"denizde",
+	"denizden",
+	"denizle",
+	"denizler",
+	"derede",
+	"dereden",
+	"dereler",
+	"dereyle",
+	"deride",
+	"deriden",
+	"deriler",
+	"derinde",
+	"derinden",
+	"derinle",
+	"derinler",
+	"deriyle",
+	"dersle",
+	"dersler",
+	"derste",
+	"dersten",
+	"deste",
+	"destede",
+	"desteden",
+	"destekle",
+	"destekte",
+	"desteler",
+	"desteyle",
+	"değerde",
+	"değerden",
+	"değerle",
+	"değerler",
+	"dikende",
+	"dikenden",
+	"dikenle",
+	"dikenler",
+	"dilde",
+	"dilden",
+	"dilekle",
+	"dilekler",
+	"dilekte",
+	"dilekten",
+	"dilim",
+	"dilimde",
+	"dilimden",
+	"dilimle",
+	"dilimler",
+	"dille",
+	"diller",
+	"dillerde",
+	"dinle",
+	"dinledi",
+	"dinledik",
+	"dinledim",
+	"dinledin",
+	"dinleme",
+	"dinlemiş",
+	"dinleyen",
+	"dinliyor",
+	"dinç",
+	"dinçle",
+	"dinçler",
+	"dinçte",
+	"dinçten",
+	"direk",
+	"direkle",
+	"direkler",
+	"direkte",
+	"direkten",
+	"diyecek",
+	"diyor",
+	"diyorum",
+	"dizi",
+	"dizide",
+	"diziden",
+	"diziler",
+	"diziyle",
+	"dişide",
+	"dişiden",
+	"dişiler",
+	"dişiyle",
+	"dişle",
+	"dişler",
+	"dişlerde",
+	"dişte",
+	"dişten",
+	"doksan",
+	"doktorda",
+	"doktorla",
+	"dokuz",
+	"dolapla",
+	"dolaplar",
+	"dolapta",
+	"dolaptan",
+	"doluda",
+	"doludan",
+	"dolular",
+	"doluyla",
+	"doruk",
+	"dorukla",
+	"doruklar",
+	"dorukta",
+	"doruktan",
+	"dostla",
+	"dostlar",
+	"dostta",
+	"dosttan",
+	"doğacak",
+	"doğada",
+	"doğadan",
+	"doğalar",
+	"doğan",
+	"doğayla",
+	"doğdu",
+	"doğduk",
+	"doğdular",
+	"doğdum",
+	"doğdun",
+	"doğma",
+	"doğmadı",
+	"doğmadık",
+	"doğmadım",
+	"doğmuş",
+	"doğruda",
+	"doğrudan",
+	"doğrular",
+	"doğruyla",
+	"doğuyor",
+	"dudakla",
+	"dudaklar",
+	"dudakta",
+	"dudaktan",
+	"dumanda",
+	"dumandan",
+	"dumanla",
+	"dumanlar",
+	"duracak",
+	"durakla",
+	"duraklar",
+	"durakta",
+	"duraktan",
+	"duran",
+	"durdu",
+	"durduk",
+	"durdular",
+	"durdum",
+	"durdun",
+	"durma",
+	"durmadı",
+	"durmadık",
+	"durmadım",
+	"durmuş",
+	"duruyor",
+	"duvak",
+	"duvakla",
+	"duvaklar",
+	"duvakta",
+	"duvaktan",
+	"duvarda",
+	"duvardan",
+	"duvarla",
+	"duvarlar",
+	"duyguda",
+	"duygudan",
+	"duygular",
+	"duyguyla",
+	"dökecek",
+	"döken",
+	"dökme",
+	"dökmedi",
+	"dökmedik",
+	"dökmedim",
+	"dökmüş",
+	"döktü",
+	"döktük",
+	"döktüler",
+	"döktüm",
+	"döktün",
+	"döküyor",
+	"dördüncü",
+	"dört",
+	"dövme",
+	"dövmede",
+	"dövmeden",
+	"dövmeler",
+	"dövmeyle",
+	"düdük",
+	"düdükle",
+	"düdükler",
+	"düdükte",
+	"düdükten",
+	"dünyada",
+	"dünyadan",
+	"dünyalar",
+	"dünyayla",
+	"düzende",
+	"düzenden",
+	"düzenle",
+	"düzenler",
+	"düğmede",
+	"düğmeden",
+	"düğmeler",
+	"düğmeyle",
+	"düğünde",
+	"düğünden",
+	"düğünle",
+	"düğünler",
+	"düşecek",
+	"düşen",
+	"düşme",
+	"düşmedi",
+	"düşmedik",
+	"düşmedim",
+	"düşmüş",
+	"düştü",
+	"düştük",
+	"düştüler",
+	"düştüm",
+	"düştün",
+	"düşükle",
+	"düşükler",
+	"düşükte",
+	"düşükten",
+	"düşüyor",
+	"dışarı",
+	"edecek",
+	"ediyor",
+	"ediyorum",
+	"ekimde",
+	"ekimden",
+	"ekimle",
+	"ekimler",
+	"ekmekle",
+	"ekmekler",
+	"ekmekte",
+	"ekmekten",
+	"ekranda",
+	"ekrandan",
+	"ekranla",
+	"ekranlar",
+	"elbisede",
+	"elde",
+	"elden",
+	"elle",
+	"eller",
+	"ellerde",
+	"ellerden",
+	"elli",
+	"elmada",
+	"elmadan",
+	"elmalar",
+	"elmasla",
+	"elmaslar",
+	"elmasta",
+	"elmastan",
+	"elmayla",
+	"elçide",
+	"elçiden",
+	"elçiler",
+	"elçiyle",
+	"emekle",
+	"emekler",
+	"emekte",
+	"emekten",
+	"emirde",
+	"emirden",
+	"emirle",
+	"emirler",
+	"emlak",
+	"emlakla",
+	"emlaklar",
+	"emlakta",
+	"emlaktan",
+	"enerjide",
+	"engelde",
+	"engelden",
+	"engelle",
+	"engeller",
+	"erikle",
+	"erikler",
+	"erikte",
+	"erikten",
+	"erkekle",
+	"erkekler",
+	"erkekte",
+	"erkekten",
+	"erken",
+	"eskide",
+	"eskiden",
+	"eskiler",
+	"eskiyle",
+	"etekle",
+	"etekler",
+	"etekte",
+	"etekten",
+	"eten",
+	"etme",
+	"etmedi",
+	"etmedik",
+	"etmedim",
+	"etmiş",
+	"etmişler",
+	"etti",
+	"ettik",
+	"ettiler",
+	"ettim",
+	"ettin",
+	"evde",
+	"evden",
+	"evlatla",
+	"evlatlar",
+	"evlatta",
+	"evlattan",
+	"evle",
+	"evler",
+	"evlerde",
+	"evlerden",
+	"evrak",
+	"evrakla",
+	"evraklar",
+	"evrakta",
+	"evraktan",
+	"evrende",
+	"evrenden",
+	"evrenle",
+	"evrenler",
+	"eylülde",
+	"eylülden",
+	"eylülle",
+	"eylüller",
+	"eğitim",
+	"eğitimde",
+	"eğitimle",
+	"eşekle",
+	"eşekler",
+	"eşekte",
+	"eşekten",
+	"fakat",
+	"farede",
+	"fareden",
+	"fareler",
+	"fareyle",
+	"faydada",
+	"faydadan",
+	"faydalar",
+	"faydayla",
+	"fazla",
+	"fenerde",
+	"fenerden",
+	"fenerle",
+	"fenerler",
+	"fidanda",
+	"fidandan",
+	"fidanla",
+	"fidanlar",
+	"fikirde",
+	"fikirden",
+	"fikirle",
+	"fikirler",
+	"filde",
+	"filden",
+	"fille",
+	"filler",
+	"fillerde",
+	"fincanda",
+	"fincanla",
+	"fiyatla",
+	"fiyatlar",
+	"fiyatta",
+	"fiyattan",
+	"fişek",
+	"fişekle",
+	"fişekler",
+	"fişekte",
+	"fişekten",
+	"fındıkla",
+	"fındıkta",
+	"fırsatla",
+	"fırsatta",
+	"fırçada",
+	"fırçadan",
+	"fırçalar",
+	"fırçayla",
+	"fırında",
+	"fırından",
+	"fırınla",
+	"fırınlar",
+	"fıstıkla",
+	"fıstıkta",
+	"gazetede",
+	"gecede",
+	"geceden",
+	"geceler",
+	"geceyle",
+	"geldi",
+	"geldik",
+	"geldiler",
+	"geldim",
+	"geldin",
+	"gelecek",
+	"gelen",
+	"gelinde",
+	"gelinden",
+	"gelinle",
+	"gelinler",
+	"gelirde",
+	"gelirden",
+	"gelirle",
+	"gelirler",
+	"geliyor",
+	"gelme",
+	"gelmedi",
+	"gelmedik",
+	"gelmedim",
+	"gelmiş",
+	"gemide",
+	"gemiden",
+	"gemiler",
+	"gemiyle",
+	"gene",
+	"gençle",
+	"gençler",
+	"gençte",
+	"gençten",
+	"geri",
+	"gerçekle",
+	"gerçekte",
+	"gezdi",
+	"gezdik",
+	"gezdiler",
+	"gezdim",
+	"gezdin",
+	"gezecek",
+	"gezen",
+	"geziyor",
+	"gezme",
+	"gezmedi",
+	"gezmedik",
+	"gezmedim",
+	"gezmiş",
+	"geçmiş",
+	"geçmişle",
+	"geçmişte",
+	"gibi",
+	"gidecek",
+	"gidelim",
+	"gider",
+	"giderde",
+	"giderden",
+	"giderle",
+	"giderler",
+	"gidiyor",
+	"girdi",
+	"girdik",
+	"girdiler",
+	"girdim",
+	"girdin",
+	"girecek",
+	"giren",
+	"giriyor",
+	"girme",
+	"girmedi",
+	"girmedik",
+	"girmedim",
+	"girmiş",
+	"giten",
+	"gitme",
+	"gitmedi",
+	"gitmedik",
+	"gitmedim",
+	"gitmiş",
+	"gitsin",
+	"gitti",
+	"gittik",
+	"gittiler",
+	"gittim",
+	"gittin",
+	"giysi",
+	"giyside",
+	"giysiden",
+	"giysiler",
+	"giysiyle",
+	"gururda",
+	"gururdan",
+	"gururla",
+	"gururlar",
+	"gökle",
+	"gökler",
+	"göklerde",
+	"gökte",
+	"gökten",
+	"gölde",
+	"gölden",
+	"gölgede",
+	"gölgeden",
+	"gölgeler",
+	"gölgeyle",
+	"gölle",
+	"göller",
+	"göllerde",
+	"gömlekle",
+	"gömlekte",
+	"gördü",
+	"gördük",
+	"gördüler",
+	"gördüm",
+	"gördün",
+	"göre",
+	"görecek",
+	"gören",
+	"görme",
+	"görmedi",
+	"görmedik",
+	"görmedim",
+	"görmüş",
+	"görüyor",
+	"gözcü",
+	"gözcüde",
+	"gözcüden",
+	"gözcüler",
+	"gözcüyle",
+	"gözde",
+	"gözden",
+	"gözle",
+	"gözler",
+	"gözlerde",
+	"gözlükle",
+	"gözlükte",
+	"güldü",
+	"güldük",
+	"güldüler",
+	"güldüm",
+	"güldün",
+	"gülecek",
+	"gülen",
+	"gülme",
+	"gülmedi",
+	"gülmedik",
+	"gülmedim",
+	"gülmüş",
+	"gülüyor",
+	"günde",
+	"günden",
+	"güneşle",
+	"güneşler",
+	"güneşte",
+	"güneşten",
+	"günle",
+	"günler",
+	"günlerde",
+	"güvende",
+	"güvenden",
+	"güvenle",
+	"güvenler",
+	"güzelde",
+	"güzelden",
+	"güzelle",
+	"güzeller",
+	"haberde",
+	"haberden",
+	"haberle",
+	"haberler",
+	"haftada",
+	"haftadan",
+	"haftalar",
+	"haftayla",
+	"hala",
+	"halkla",
+	"halklar",
+	"halkta",
+	"halktan",
+	"halıda",
+	"halıdan",
+	"halılar",
+	"halıyla",
+	"hamamda",
+	"hamamdan",
+	"hamamla",
+	"hamamlar",
+	"hamurda",
+	"hamurdan",
+	"hamurla",
+	"hamurlar",
+	"hangi",
+	"hançer",
+	"hançerde",
+	"hançerle",
+	"haritada",
+	"hasatla",
+	"hasatlar",
+	"hasatta",
+	"hasattan",
+	"hastada",
+	"hastadan",
+	"hastalar",
+	"hastane",
+	"hastayla",
+	"hatada",
+	"hatadan",
+	"hatalar",
+	"hatayla",
+	"havada",
+	"havadan",
+	"havalar",
+	"havayla",
+	"havuçla",
+	"havuçlar",
+	"havuçta",
+	"havuçtan",
+	"hayatla",
+	"hayatlar",
+	"hayatta",
+	"hayattan",
+	"hayvanda",
+	"hayvanla",
+	"hayır",
+	"haziran",
+	"hazırda",
+	"hazırdan",
+	"hazırla",
+	"hazırlar",
+	"hecede",
+	"heceden",
+	"heceler",
+	"heceyle",
+	"hedefle",
+	"hedefler",
+	"hedefte",
+	"hedeften",
+	"hediyede",
+	"hemen",
+	"henüz",
+	"hepsi",
+	"herkes",
+	"hikayede",
+	"hiçbir",
+	"hocada",
+	"hocadan",
+	"hocalar",
+	"hocayla",
+	"horozda",
+	"horozdan",
+	"horozla",
+	"horozlar",
+	"hurmada",
+	"hurmadan",
+	"hurmalar",
+	"hurmayla",
+	"hırsızda",
+	"hırsızla",
+	"hızlı",
+	"hızlıda",
+	"hızlıdan",
+	"hızlılar",
+	"hızlıyla",
+	"ibrik",
+	"ibrikle",
+	"ibrikler",
+	"ibrikte",
+	"ibrikten",
+	"ikinci",
+	"iklimde",
+	"iklimden",
+	"iklimle",
+	"iklimler",
+	"ilaçla",
+	"ilaçlar",
+	"ilaçta",
+	"ilaçtan",
+	"ileri",
+	"ilkbahar",
+	"ilke",
+	"ilkede",
+	"ilkeden",
+	"ilkeler",
+	"ilkeyle",
+	"ilçede",
+	"ilçeden",
+	"ilçeler",
+	"ilçeyle",
+	"inanç",
+	"inançla",
+	"inançlar",
+	"inançta",
+	"inançtan",
+	"incede",
+	"inceden",
+	"inceler",
+	"inceyle",
+	"incide",
+	"inciden",
+	"inciler",
+	"inciyle",
+	"inekle",
+	"inekler",
+	"inekte",
+	"inekten",
+	"insanda",
+	"insandan",
+	"insanla",
+	"insanlar",
+	"ipekle",
+	"ipekler",
+	"ipekte",
+	"ipekten",
+	"irmikle",
+	"irmikler",
+	"irmikte",
+	"irmikten",
+	"isimde",
+	"isimden",
+	"isimle",
+	"isimler",
+	"istasyon",
+	"iste",
+	"istedi",
+	"istedik",
+	"istedim",
+	"istedin",
+	"isteme",
+	"istemedi",
+	"istemiş",
+	"isteyen",
+	"istiyor",
+	"izinde",
+	"izinden",
+	"izinle",
+	"izinler",
+	"içecek",
+	"içen",
+	"içeri",
+	"için",
+	"içiyor",
+	"içiyorum",
+	"içiyoruz",
+	"içme",
+	"içmedi",
+	"içmedik",
+	"içmedim",
+	"içmiş",
+	"içmişler",
+	"içti",
+	"içtik",
+	"içtiler",
+	"içtim",
+	"içtin",
+	"iğde",
+	"iğdede",
+	"iğdeden",
+	"iğdeler",
+	"iğdeyle",
+	"iğnede",
+	"iğneden",
+	"iğneler",
+	"iğneyle",
+	"işaretle",
+	"işarette",
+	"işle",
+	"işler",
+	"işlerde",
+	"işlerden",
+	"işte",
+	"işten",
+	"işçide",
+	"işçiden",
+	"işçiler",
+	"işçiyle",
+	"kabakla",
+	"kabaklar",
+	"kabakta",
+	"kabaktan",
+	"kabukla",
+	"kabuklar",
+	"kabukta",
+	"kabuktan",
+	"kadar",
+	"kadeh",
+	"kadehle",
+	"kadehler",
+	"kadehte",
+	"kadehten",
+	"kadında",
+	"kadından",
+	"kadınla",
+	"kadınlar",
+	"kafada",
+	"kafadan",
+	"kafalar",
+	"kafayla",
+	"kafesle",
+	"kafesler",
+	"kafeste",
+	"kafesten",
+	"kahvede",
+	"kahveden",
+	"kahveler",
+	"kahveyle",
+	"kalacak",
+	"kalan",
+	"kaldı",
+	"kaldık",
+	"kaldılar",
+	"kaldım",
+	"kaldın",
+	"kalede",
+	"kaleden",
+	"kaleler",
+	"kalemde",
+	"kalemden",
+	"kalemle",
+	"kalemler",
+	"kaleyle",
+	"kalma",
+	"kalmadı",
+	"kalmadık",
+	"kalmadım",
+	"kalmış",
+	"kalp",
+	"kalında",
+	"kalından",
+	"kalınla",
+	"kalınlar",
+	"kalıpla",
+	"kalıplar",
+	"kalıpta",
+	"kalıptan",
+	"kalıyor",
+	"kamyonda",
+	"kamyonla",
+	"kamış",
+	"kamışla",
+	"kamışlar",
+	"kamışta",
+	"kamıştan",
+	"kanalda",
+	"kanaldan",
+	"kanalla",
+	"kanallar",
+	"kanatla",
+	"kanatlar",
+	"kanatta",
+	"kanattan",
+	"kanda",
+	"kandan",
+	"kanla",
+	"kanlar",
+	"kanlarda",
+	"kapa",
+	"kapadı",
+	"kapadık",
+	"kapadım",
+	"kapadın",
+	"kapakla",
+	"kapaklar",
+	"kapakta",
+	"kapaktan",
+	"kapama",
+	"kapamadı",
+	"kapamış",
+	"kapan",
+	"kapanda",
+	"kapandan",
+	"kapanla",
+	"kapanlar",
+	"kapayan",
+	"kaplanda",
+	"kaplanla",
+	"kapıda",
+	"kapıdan",
+	"kapılar",
+	"kapıyla",
+	"kapıyor",
+	"kararda",
+	"karardan",
+	"kararla",
+	"kararlar",
+	"karda",
+	"kardan",
+	"kardeşle",
+	"kardeşte",
+	"karede",
+	"kareden",
+	"kareler",
+	"kareyle",
+	"kargada",
+	"kargadan",
+	"kargalar",
+	"kargayla",
+	"karla",
+	"karlar",
+	"karlarda",
+	"karpuzda",
+	"karpuzla",
+	"kartalda",
+	"kartalla",
+	"kasada",
+	"kasadan",
+	"kasalar",
+	"kasapla",
+	"kasaplar",
+	"kasapta",
+	"kasaptan",
+	"kasayla",
+	"kasımda",
+	"kasımdan",
+	"kasımla",
+	"kasımlar",
+	"katil",
+	"katilde",
+	"katilden",
+	"katille",
+	"katiller",
+	"katırda",
+	"katırdan",
+	"katırla",
+	"katırlar",
+	"kavak",
+	"kavakla",
+	"kavaklar",
+	"kavakta",
+	"kavaktan",
+	"kavgada",
+	"kavgadan",
+	"kavgalar",
+	"kavgayla",
+	"kavunda",
+	"kavundan",
+	"kavunla",
+	"kavunlar",
+	"kayada",
+	"kayadan",
+	"kayalar",
+	"kayayla",
+	"kayıkla",
+	"kayıklar",
+	"kayıkta",
+	"kayıktan",
+	"kazacak",
+	"kazakla",
+	"kazaklar",
+	"kazakta",
+	"kazaktan",
+	"kazanda",
+	"kazandan",
+	"kazanla",
+	"kazanlar",
+	"kazdı",
+	"kazdık",
+	"kazdılar",
+	"kazdım",
+	"kazdın",
+	"kazma",
+	"kazmadı",
+	"kazmadık",
+	"kazmadım",
+	"kazmış",
+	"kazıyor",
+	"kağıtla",
+	"kağıtlar",
+	"kağıtta",
+	"kağıttan",
+	"kaşarda",
+	"kaşardan",
+	"kaşarla",
+	"kaşarlar",
+	"kaşıkla",
+	"kaşıklar",
+	"kaşıkta",
+	"kaşıktan",
+	"kedide",
+	"kediden",
+	"kediler",
+	"kediyle",
+	"kekle",
+	"kekler",
+	"keklerde",
+	"kekte",
+	"kekten",
+	"kelimede",
+	"kemerde",
+	"kemerden",
+	"kemerle",
+	"kemerler",
+	"kemikle",
+	"kemikler",
+	"kemikte",
+	"kemikten",
+	"kentle",
+	"kentler",
+	"kentte",
+	"kentten",
+	"kepek",
+	"kepekle",
+	"kepekler",
+	"kepekte",
+	"kepekten",
+	"kerpiç",
+	"kerpiçle",
+	"kerpiçte",
+	"kese",
+	"kesede",
+	"keseden",
+	"keseler",
+	"keseyle",
+	"keçide",
+	"keçiden",
+	"keçiler",
+	"keçiyle",
+	"kibritle",
+	"kibritte",
+	"kilde",
+	"kilden",
+	"kille",
+	"killer",
+	"killerde",
+	"kiloda",
+	"kilodan",
+	"kilolar",
+	"kiloyla",
+	"kimse",
+	"kirada",
+	"kiradan",
+	"kiralar",
+	"kirayla",
+	"kirazda",
+	"kirazdan",
+	"kirazla",
+	"kirazlar",
+	"kirlide",
+	"kirliden",
+	"kirliler",
+	"kirliyle",
+	"kitapla",
+	"kitaplar",
+	"kitaplık",
+	"kitapta",
+	"kitaptan",
+	"kitle",
+	"kitlede",
+	"kitleden",
+	"kitleler",
+	"kitleyle",
+	"kolayda",
+	"kolaydan",
+	"kolayla",
+	"kolaylar",
+	"kolda",
+	"koldan",
+	"kolla",
+	"kollar",
+	"kollarda",
+	"koltukla",
+	"koltukta",
+	"komşuda",
+	"komşudan",
+	"komşular",
+	"komşuyla",
+	"konakla",
+	"konaklar",
+	"konakta",
+	"konaktan",
+	"konuda",
+	"konudan",
+	"konukla",
+	"konuklar",
+	"konukta",
+	"konuktan",
+	"konular",
+	"konuyla",
+	"kordon",
+	"kordonda",
+	"kordonla",
+	"korkuda",
+	"korkudan",
+	"korkular",
+	"korkuyla",
+	"kovada",
+	"kovadan",
+	"kovalar",
+	"kovan",
+	"kovanda",
+	"kovandan",
+	"kovanla",
+	"kovanlar",
+	"kovayla",
+	"koyu",
+	"koyuda",
+	"koyudan",
+	"koyular",
+	"koyunda",
+	"koyundan",
+	"koyunla",
+	"koyunlar",
+	"koyuyla",
+	"koçan",
+	"koçanda",
+	"koçandan",
+	"koçanla",
+	"koçanlar",
+	"koçla",
+	"koçlar",
+	"koçlarda",
+	"koçta",
+	"koçtan",
+	"koşacak",
+	"koşan",
+	"koşma",
+	"koşmadı",
+	"koşmadık",
+	"koşmadım",
+	"koşmuş",
+	"koştu",
+	"koştuk",
+	"koştular",
+	"koştum",
+	"koştun",
+	"koşuyor",
+	"kuklada",
+	"kukladan",
+	"kuklalar",
+	"kuklayla",
+	"kulakla",
+	"kulaklar",
+	"kulakta",
+	"kulaktan",
+	"kumaşla",
+	"kumaşlar",
+	"kumaşta",
+	"kumaştan",
+	"kumda",
+	"kumdan",
+	"kumla",
+	"kumlar",
+	"kumlarda",
+	"kumru",
+	"kumruda",
+	"kumrudan",
+	"kumrular",
+	"kumruyla",
+	"kuralda",
+	"kuraldan",
+	"kuralla",
+	"kurallar",
+	"kurt",
+	"kurtla",
+	"kurtlar",
+	"kurtta",
+	"kurttan",
+	"kurum",
+	"kurumda",
+	"kurumdan",
+	"kurumla",
+	"kurumlar",
+	"kutuda",
+	"kutudan",
+	"kutular",
+	"kutuyla",
+	"kuyrukla",
+	"kuyrukta",
+	"kuyuda",
+	"kuyudan",
+	"kuyular",
+	"kuyuyla",
+	"kuzende",
+	"kuzenden",
+	"kuzenle",
+	"kuzenler",
+	"kuzeyde",
+	"kuzeyden",
+	"kuzeyle",
+	"kuzeyler",
+	"kuzuda",
+	"kuzudan",
+	"kuzular",
+	"kuzuyla",
+	"kuşla",
+	"kuşlar",
+	"kuşlarda",
+	"kuşta",
+	"kuştan",
+	"köken",
+	"kökende",
+	"kökenden",
+	"kökenle",
+	"kökenler",
+	"köpekle",
+	"köpekler",
+	"köpekte",
+	"köpekten",
+	"köprüde",
+	"köprüden",
+	"köprüler",
+	"köprüyle",
+	"köpükle",
+	"köpükler",
+	"köpükte",
+	"köpükten",
+	"köyde",
+	"köyden",
+	"köyle",
+	"köyler",
+	"köylerde",
+	"küçükle",
+	"küçükler",
+	"küçükte",
+	"küçükten",
+	"kılıçla",
+	"kılıçlar",
+	"kılıçta",
+	"kılıçtan",
+	"kımız",
+	"kımızda",
+	"kımızdan",
+	"kımızla",
+	"kımızlar",
+	"kırat",
+	"kıratla",
+	"kıratlar",
+	"kıratta",
+	"kırattan",
+	"kırk",
+	"kızda",
+	"kızdan",
+	"kızla",
+	"kızlar",
+	"kızlarda",
+	"kışla",
+	"kışlar",
+	"kışlarda",
+	"kışta",
+	"kıştan",
+	"lalede",
+	"laleden",
+	"laleler",
+	"laleyle",
+	"lambada",
+	"lambadan",
+	"lambalar",
+	"lambayla",
+	"lastikle",
+	"lastikte",
+	"lezzet",
+	"lezzetle",
+	"lezzette",
+	"liderde",
+	"liderden",
+	"liderle",
+	"liderler",
+	"limanda",
+	"limandan",
+	"limanla",
+	"limanlar",
+	"limonda",
+	"limondan",
+	"limonla",
+	"limonlar",
+	"lisede",
+	"liseden",
+	"liseler",
+	"liseyle",
+	"lütfen",
+	"madende",
+	"madenden",
+	"madenle",
+	"madenler",
+	"makam",
+	"makamda",
+	"makamdan",
+	"makamla",
+	"makamlar",
+	"makasla",
+	"makaslar",
+	"makasta",
+	"makastan",
+	"mantı",
+	"mantıda",
+	"mantıdan",
+	"mantılar",
+	"mantıyla",
+	"mart",
+	"marul",
+	"marulda",
+	"maruldan",
+	"marulla",
+	"marullar",
+	"masada",
+	"masadan",
+	"masalar",
+	"masalda",
+	"masaldan",
+	"masalla",
+	"masallar",
+	"masayla",
+	"maskede",
+	"maskeden",
+	"maskeler",
+	"maskeyle",
+	"mavide",
+	"maviden",
+	"maviler",
+	"maviyle",
+	"maydanoz",
+	"maymunda",
+	"maymunla",
+	"mayıs",
+	"mağarada",
+	"mektupla",
+	"mektupta",
+	"melekle",
+	"melekler",
+	"melekte",
+	"melekten",
+	"mendilde",
+	"mendille",
+	"mera",
+	"merada",
+	"meradan",
+	"meralar",
+	"merayla",
+	"merhaba",
+	"merkezde",
+	"merkezle",
+	"mesajda",
+	"mesajdan",
+	"mesajla",
+	"mesajlar",
+	"meslekle",
+	"meslekte",
+	"mevsimde",
+	"mevsimle",
+	"meydanda",
+	"meydanla",
+	"meyvede",
+	"meyveden",
+	"meyveler",
+	"meyveyle",
+	"mezarda",
+	"mezardan",
+	"mezarla",
+	"mezarlar",
+	"meşede",
+	"meşeden",
+	"meşeler",
+	"meşeyle",
+	"midede",
+	"mideden",
+	"mideler",
+	"mideyle",
+	"milli",
+	"millide",
+	"milliden",
+	"milliler",
+	"milliyle",
+	"milyon",
+	"mimarda",
+	"mimardan",
+	"mimarla",
+	"mimarlar",
+	"minderde",
+	"minderle",
+	"mutfakla",
+	"mutfakta",
+	"mutluda",
+	"mutludan",
+	"mutlular",
+	"mutluyla",
+	"müzikle",
+	"müzikler",
+	"müzikte",
+	"müzikten",
+	"mıknatıs",
+	"mısırda",
+	"mısırdan",
+	"mısırla",
+	"mısırlar",
+	"nakış",
+	"nakışla",
+	"nakışlar",
+	"nakışta",
+	"nakıştan",
+	"nanede",
+	"naneden",
+	"naneler",
+	"naneyle",
+	"nasıl",
+	"neden",
+	"nefesle",
+	"nefesler",
+	"nefeste",
+	"nefesten",
+	"nehirde",
+	"nehirden",
+	"nehirle",
+	"nehirler",
+	"nerede",
+	"nereden",
+	"nereye",
+	"nezle",
+	"nezlede",
+	"nezleden",
+	"nezleler",
+	"nezleyle",
+	"ninede",
+	"nineden",
+	"nineler",
+	"nineyle",
+	"nisanda",
+	"nisandan",
+	"nisanla",
+	"nisanlar",
+	"niçin",
+	"noktada",
+	"noktadan",
+	"noktalar",
+	"noktayla",
+	"nöbetle",
+	"nöbetler",
+	"nöbette",
+	"nöbetten",
+	"nüfusla",
+	"nüfuslar",
+	"nüfusta",
+	"nüfustan",
+	"ocak",
+	"ocakla",
+	"ocaklar",
+	"ocakta",
+	"ocaktan",
+	"okudu",
+	"okuduk",
+	"okudular",
+	"okudum",
+	"okudun",
+	"okulda",
+	"okuldan",
+	"okulla",
+	"okullar",
+	"okuma",
+	"okumadı",
+	"okumadık",
+	"okumadım",
+	"okumuş",
+	"okuyacak",
+	"okuyan",
+	"okuyor",
+	"okuyorum",
+	"okuyoruz",
+	"olacak",
+	"olan",
+	"oldu",
+	"olduk",
+	"oldular",
+	"oldum",
+	"oldun",
+	"olma",
+	"olmadı",
+	"olmadık",
+	"olmadım",
+	"olmuş",
+	"olmuşlar",
+	"oluyor",
+	"oluyorum",
+	"oluyoruz",
+	"onlar",
+	"onları",
+	"onun",
+	"orada",
+	"orak",
+	"orakla",
+	"oraklar",
+	"orakta",
+	"oraktan",
+	"oraya",
+	"orduda",
+	"ordudan",
+	"ordular",
+	"orduyla",
+	"orkide",
+	"orkidede",
+	"ormanda",
+	"ormandan",
+	"ormanla",
+	"ormanlar",
+	"ortakla",
+	"ortaklar",
+	"ortakta",
+	"ortaktan",
+	"otelde",
+	"otelden",
+	"otelle",
+	"oteller",
+	"otla",
+	"otlak",
+	"otlakla",
+	"otlaklar",
+	"otlakta",
+	"otlaktan",
+	"otlar",
+	"otlarda",
+	"otlardan",
+	"otobüsle",
+	"otobüste",
+	"otta",
+	"ottan",
+	"otuz",
+	"oyunda",
+	"oyundan",
+	"oyunla",
+	"oyunlar",
+	"pabuç",
+	"pabuçla",
+	"pabuçlar",
+	"pabuçta",
+	"pabuçtan",
+	"paltoda",
+	"paltodan",
+	"paltolar",
+	"paltoyla",
+	"pamukla",
+	"pamuklar",
+	"pamukta",
+	"pamuktan",
+	"pancar",
+	"pancarda",
+	"pancarla",
+	"pantolon",
+	"parada",
+	"paradan",
+	"paralar",
+	"parayla",
+	"parmakla",
+	"parmakta",
+	"pastada",
+	"pastadan",
+	"pastalar",
+	"pastayla",
+	"pazarda",
+	"pazardan",
+	"pazarla",
+	"pazarlar",
+	"pazı",
+	"pazıda",
+	"pazıdan",
+	"pazılar",
+	"pazıyla",
+	"pekmezde",
+	"pekmezle",
+	"pelin",
+	"pelinde",
+	"pelinden",
+	"pelinle",
+	"pelinler",
+	"pembede",
+	"pembeden",
+	"pembeler",
+	"pembeyle",
+	"perdede",
+	"perdeden",
+	"perdeler",
+	"perdeyle",
+	"perşembe",
+	"petek",
+	"petekle",
+	"petekler",
+	"petekte",
+	"petekten",
+	"peynirde",
+	"peynirle",
+	"peçete",
+	"peçetede",
+	"pidede",
+	"pideden",
+	"pideler",
+	"pideyle",
+	"pilavda",
+	"pilavdan",
+	"pilavla",
+	"pilavlar",
+	"pipo",
+	"pipoda",
+	"pipodan",
+	"pipolar",
+	"pipoyla",
+	"pirinçle",
+	"pirinçte",
+	"poşet",
+	"poşetle",
+	"poşetler",
+	"poşette",
+	"poşetten",
+	"pulda",
+	"puldan",
+	"pulla",
+	"pullar",
+	"pullarda",
+	"pusulada",
+	"pınarda",
+	"pınardan",
+	"pınarla",
+	"pınarlar",
+	"radyoda",
+	"radyodan",
+	"radyolar",
+	"radyoyla",
+	"rakamda",
+	"rakamdan",
+	"rakamla",
+	"rakamlar",
+	"rehberde",
+	"rehberle",
+	"renkle",
+	"renkler",
+	"renkte",
+	"renkten",
+	"resimde",
+	"resimden",
+	"resimle",
+	"resimler",
+	"resmi",
+	"resmide",
+	"resmiden",
+	"resmiler",
+	"resmiyle",
+	"rüyada",
+	"rüyadan",
+	"rüyalar",
+	"rüyayla",
+	"rüzgarda",
+	"rüzgarla",
+	"rıhtım",
+	"rıhtımda",
+	"rıhtımla",
+	"sabahla",
+	"sabahlar",
+	"sabahta",
+	"sabahtan",
+	"sabunda",
+	"sabundan",
+	"sabunla",
+	"sabunlar",
+	"sadece",
+	"sahilde",
+	"sahilden",
+	"sahille",
+	"sahiller",
+	"sahne",
+	"sahnede",
+	"sahneden",
+	"sahneler",
+	"sahneyle",
+	"salatada",
+	"salonda",
+	"salondan",
+	"salonla",
+	"salonlar",
+	"salı",
+	"sana",
+	"sanatla",
+	"sanatlar",
+	"sanatta",
+	"sanattan",
+	"sandıkla",
+	"sandıkta",
+	"sarayda",
+	"saraydan",
+	"sarayla",
+	"saraylar",
+	"sargı",
+	"sargıda",
+	"sargıdan",
+	"sargılar",
+	"sargıyla",
+	"sarıda",
+	"sarıdan",
+	"sarık",
+	"sarıkla",
+	"sarıklar",
+	"sarıkta",
+	"sarıktan",
+	"sarılar",
+	"sarımsak",
+	"sarıyla",
+	"satacak",
+	"satan",
+	"satma",
+	"satmadı",
+	"satmadık",
+	"satmadım",
+	"satmış",
+	"sattı",
+	"sattık",
+	"sattılar",
+	"sattım",
+	"sattın",
+	"satır",
+	"satırda",
+	"satırdan",
+	"satırla",
+	"satırlar",
+	"satıyor",
+	"savaşla",
+	"savaşlar",
+	"savaşta",
+	"savaştan",
+	"sayacak",
+	"sayan",
+	"saydı",
+	"saydık",
+	"saydılar",
+	"saydım",
+	"saydın",
+	"sayma",
+	"saymadı",
+	"saymadık",
+	"saymadım",
+	"saymış",
+	"sayıda",
+	"sayıdan",
+	"sayılar",
+	"sayıyla",
+	"sayıyor",
+	"sazan",
+	"sazanda",
+	"sazandan",
+	"sazanla",
+	"sazanlar",
+	"saçla",
+	"saçlar",
+	"saçlarda",
+	"saçta",
+	"saçtan",
+	"sağlık",
+	"sağlıkla",
+	"sağlıkta",
+	"sebeple",
+	"sebepler",
+	"sebepte",
+	"sebepten",
+	"sebzede",
+	"sebzeden",
+	"sebzeler",
+	"sebzeyle",
+	"sedir",
+	"sedirde",
+	"sedirden",
+	"sedirle",
+	"sedirler",
+	"sefer",
+	"seferde",
+	"seferden",
+	"seferle",
+	"seferler",
+	"sehpada",
+	"sehpadan",
+	"sehpalar",
+	"sehpayla",
+	"sekizde",
+	"sekizden",
+	"sekizle",
+	"sekizler",
+	"seksen",
+	"selamda",
+	"selamdan",
+	"selamla",
+	"selamlar",
+	"sema",
+	"semada",
+	"semadan",
+	"semalar",
+	"semayla",
+	"semtle",
+	"semtler",
+	"semtte",
+	"semtten",
+	"senin",
+	"sepetle",
+	"sepetler",
+	"sepette",
+	"sepetten",
+	"sergi",
+	"sergide",
+	"sergiden",
+	"sergiler",
+	"sergiyle",
+	"serçede",
+	"serçeden",
+	"serçeler",
+	"serçeyle",
+	"sesle",
+	"sesler",
+	"seslerde",
+	"seslide",
+	"sesliden",
+	"sesliler",
+	"sesliyle",
+	"seste",
+	"sesten",
+	"sevdi",
+	"sevdik",
+	"sevdiler",
+	"sevdim",
+	"sevdin",
+	"sevecek",
+	"seven",
+	"sevgide",
+	"sevgiden",
+	"sevgiler",
+	"sevgiyle",
+	"seviyor",
+	"sevme",
+	"sevmedi",
+	"sevmedik",
+	"sevmedim",
+	"sevmiş",
+	"seçim",
+	"seçimde",
+	"seçimden",
+	"seçimle",
+	"seçimler",
+	"sicim",
+	"sicimde",
+	"sicimden",
+	"sicimle",
+	"sicimler",
+	"sigara",
+	"sigarada",
+	"sildi",
+	"sildik",
+	"sildiler",
+	"sildim",
+	"sildin",
+	"silecek",
+	"silen",
+	"silgide",
+	"silgiden",
+	"silgiler",
+	"silgiyle",
+	"siliyor",
+	"silme",
+	"silmedi",
+	"silmedik",
+	"silmedim",
+	"silmiş",
+	"simitle",
+	"simitler",
+	"simitte",
+	"simitten",
+	"sincapla",
+	"sincapta",
+	"sinemada",
+	"sirkede",
+	"sirkeden",
+	"sirkeler",
+	"sirkeyle",
+	"sisle",
+	"sisler",
+	"sislerde",
+	"siste",
+	"sisten",
+	"siyahla",
+	"siyahlar",
+	"siyahta",
+	"siyahtan",
+	"size",
+	"sizin",
+	"sobada",
+	"sobadan",
+	"sobalar",
+	"sobayla",
+	"sofrada",
+	"sofradan",
+	"sofralar",
+	"sofrayla",
+	"sohbet",
+	"sohbetle",
+	"sohbette",
+	"sokakla",
+	"sokaklar",
+	"sokakta",
+	"sokaktan",
+	"solda",
+	"soldada",
+	"soldadan",
+	"soldalar",
+	"soldayla",
+	"somunda",
+	"somundan",
+	"somunla",
+	"somunlar",
+	"sonbahar",
+	"sonuçla",
+	"sonuçlar",
+	"sonuçta",
+	"sonuçtan",
+	"sopada",
+	"sopadan",
+	"sopalar",
+	"sopayla",
+	"soracak",
+	"soran",
+	"sordu",
+	"sorduk",
+	"sordular",
+	"sordum",
+	"sordun",
+	"sorma",
+	"sormadı",
+	"sormadık",
+	"sormadım",
+	"sormuş",
+	"soruda",
+	"sorudan",
+	"sorular",
+	"sorunda",
+	"sorundan",
+	"sorunla",
+	"sorunlar",
+	"soruyla",
+	"soruyor",
+	"sosis",
+	"sosisle",
+	"sosisler",
+	"sosiste",
+	"sosisten",
+	"soğanda",
+	"soğandan",
+	"soğanla",
+	"soğanlar",
+	"soğukla",
+	"soğuklar",
+	"soğukta",
+	"soğuktan",
+	"sporda",
+	"spordan",
+	"sporla",
+	"sporlar",
+	"sucukla",
+	"sucuklar",
+	"sucukta",
+	"sucuktan",
+	"sulak",
+	"sulakla",
+	"sulaklar",
+	"sulakta",
+	"sulaktan",
+	"suluda",
+	"suludan",
+	"sulular",
+	"suluyla",
+	"susacak",
+	"susan",
+	"susma",
+	"susmadı",
+	"susmadık",
+	"susmadım",
+	"susmuş",
+	"sustu",
+	"sustuk",
+	"sustular",
+	"sustum",
+	"sustun",
+	"susuyor",
+	"sözlükle",
+	"sözlükte",
+	"süngerde",
+	"süngerle",
+	"süre",
+	"sürede",
+	"süreden",
+	"süreler",
+	"süreyle",
+	"sürüde",
+	"sürüden",
+	"sürüler",
+	"sürüyle",
+	"sütle",
+	"sütler",
+	"sütlerde",
+	"sütte",
+	"sütten",
+	"sıcakla",
+	"sıcaklar",
+	"sıcakta",
+	"sıcaktan",
+	"sıfır",
+	"sıfırda",
+	"sıfırdan",
+	"sıfırla",
+	"sıfırlar",
+	"sınavda",
+	"sınavdan",
+	"sınavla",
+	"sınavlar",
+	"sınırda",
+	"sınırdan",
+	"sınırla",
+	"sınırlar",
+	"sırada",
+	"sıradan",
+	"sıralar",
+	"sırayla",
+	"sırık",
+	"sırıkla",
+	"sırıklar",
+	"sırıkta",
+	"sırıktan",
+	"sıçanda",
+	"sıçandan",
+	"sıçanla",
+	"sıçanlar",
+	"tabakla",
+	"tabaklar",
+	"tabakta",
+	"tabaktan",
+	"tabela",
+	"tabelada",
+	"tabutla",
+	"tabutlar",
+	"tabutta",
+	"tabuttan",
+	"tahtada",
+	"tahtadan",
+	"tahtalar",
+	"tahtayla",
+	"tahılda",
+	"tahıldan",
+	"tahılla",
+	"tahıllar",
+	"takvimde",
+	"takvimle",
+	"takımda",
+	"takımdan",
+	"takımla",
+	"takımlar",
+	"talep",
+	"taleple",
+	"talepler",
+	"talepte",
+	"talepten",
+	"tamam",
+	"tarakla",
+	"taraklar",
+	"tarakta",
+	"taraktan",
+	"tarihle",
+	"tarihler",
+	"tarihte",
+	"tarihten",
+	"tarlada",
+	"tarladan",
+	"tarlalar",
+	"tarlayla",
+	"taslak",
+	"taslakla",
+	"taslakta",
+	"tatar",
+	"tatarda",
+	"tatardan",
+	"tatarla",
+	"tatarlar",
+	"tatilde",
+	"tatilden",
+	"tatille",
+	"tatiller",
+	"tatlıda",
+	"tatlıdan",
+	"tatlılar",
+	"tatlıyla",
+	"tavanda",
+	"tavandan",
+	"tavanla",
+	"tavanlar",
+	"tavlada",
+	"tavladan",
+	"tavlalar",
+	"tavlayla",
+	"tavukla",
+	"tavuklar",
+	"tavukta",
+	"tavuktan",
+	"tavşanda",
+	"tavşanla",
+	"tazede",
+	"tazeden",
+	"tazeler",
+	"tazeyle",
+	"taşla",
+	"taşlar",
+	"taşlarda",
+	"taşta",
+	"taştan",
+	"tekke",
+	"tekkede",
+	"tekkeden",
+	"tekkeler",
+	"tekkeyle",
+	"teknede",
+	"tekneden",
+	"tekneler",
+	"tekneyle",
+	"telde",
+	"telden",
+	"telle",
+	"teller",
+	"tellerde",
+	"tembelde",
+	"tembelle",
+	"temizde",
+	"temizden",
+	"temizle",
+	"temizler",
+	"temmuz",
+	"tepede",
+	"tepeden",
+	"tepeler",
+	"tepeyle",
+	"tepside",
+	"tepsiden",
+	"tepsiler",
+	"tepsiyle",
+	"terde",
+	"terden",
+	"terle",
+	"terler",
+	"terlerde",
+	"terlikle",
+	"terlikte",
+	"terzide",
+	"terziden",
+	"terziler",
+	"terziyle",
+	"tezgah",
+	"tezgahla",
+	"tezgahta",
+	"teşekkür",
+	"ticaret",
+	"tilkide",
+	"tilkiden",
+	"tilkiler",
+	"tilkiyle",
+	"tohumda",
+	"tohumdan",
+	"tohumla",
+	"tohumlar",
+	"tomruk",
+	"tomrukla",
+	"tomrukta",
+	"topla",
+	"toplar",
+	"toplarda",
+	"toprakla",
+	"toprakta",
+	"topta",
+	"toptan",
+	"topukla",
+	"topuklar",
+	"topukta",
+	"topuktan",
+	"torbada",
+	"torbadan",
+	"torbalar",
+	"torbayla",
+	"tufan",
+	"tufanda",
+	"tufandan",
+	"tufanla",
+	"tufanlar",
+	"turna",
+	"turnada",
+	"turnadan",
+	"turnalar",
+	"turnayla",
+	"turşuda",
+	"turşudan",
+	"turşular",
+	"turşuyla",
+	"tutacak",
+	"tutan",
+	"tutma",
+	"tutmadı",
+	"tutmadık",
+	"tutmadım",
+	"tutmuş",
+	"tuttu",
+	"tuttuk",
+	"tuttular",
+	"tuttum",
+	"tuttun",
+	"tutuyor",
+	"tuzda",
+	"tuzdan",
+	"tuzla",
+	"tuzlar",
+	"tuzlarda",
+	"tuzluda",
+	"tuzludan",
+	"tuzlular",
+	"tuzluyla",
+	"tuğlada",
+	"tuğladan",
+	"tuğlalar",
+	"tuğlayla",
+	"törende",
+	"törenden",
+	"törenle",
+	"törenler",
+	"tümen",
+	"tümende",
+	"tümenden",
+	"tümenle",
+	"tümenler",
+	"tünelde",
+	"tünelden",
+	"tünelle",
+	"tüneller",
+	"türküde",
+	"türküden",
+	"türküler",
+	"türküyle",
+	"tütün",
+	"tütünde",
+	"tütünden",
+	"tütünle",
+	"tütünler",
+	"tıraş",
+	"tıraşla",
+	"tıraşlar",
+	"tıraşta",
+	"tıraştan",
+	"tırnakla",
+	"tırnakta",
+	"ucuzda",
+	"ucuzdan",
+	"ucuzla",
+	"ucuzlar",
+	"ufukla",
+	"ufuklar",
+	"ufukta",
+	"ufuktan",
+	"ulak",
+	"ulakla",
+	"ulaklar",
+	"ulakta",
+	"ulaktan",
+	"ulusla",
+	"uluslar",
+	"ulusta",
+	"ulustan",
+	"umman",
+	"ummanda",
+	"ummandan",
+	"ummanla",
+	"ummanlar",
+	"umutla",
+	"umutlar",
+	"umutta",
+	"umuttan",
+	"ustada",
+	"ustadan",
+	"ustalar",
+	"ustayla",
+	"usul",
+	"uygun",
+	"uygunda",
+	"uygundan",
+	"uygunla",
+	"uygunlar",
+	"uykuda",
+	"uykudan",
+	"uykular",
+	"uykuyla",
+	"uyudu",
+	"uyuduk",
+	"uyudular",
+	"uyudum",
+	"uyudun",
+	"uyum",
+	"uyuma",
+	"uyumadı",
+	"uyumadık",
+	"uyumadım",
+	"uyumda",
+	"uyumdan",
+	"uyumla",
+	"uyumlar",
+	"uyumuş",
+	"uyuyacak",
+	"uyuyan",
+	"uyuyor",
+	"uyuyorum",
+	"uyuyoruz",
+	"uzakla",
+	"uzaklar",
+	"uzakta",
+	"uzaktan",
+	"uzunda",
+	"uzundan",
+	"uzunla",
+	"uzunlar",
+	"uçacak",
+	"uçakla",
+	"uçaklar",
+	"uçakta",
+	"uçaktan",
+	"uçan",
+	"uçma",
+	"uçmadı",
+	"uçmadık",
+	"uçmadım",
+	"uçmuş",
+	"uçmuşlar",
+	"uçtu",
+	"uçtuk",
+	"uçtular",
+	"uçtum",
+	"uçtun",
+	"uçurtma",
+	"uçuyor",
+	"uçuyorum",
+	"uçuyoruz",
+	"uğurda",
+	"uğurdan",
+	"uğurla",
+	"uğurlar",
+	"vaaz",
+	"vaazda",
+	"vaazdan",
+	"vaazla",
+	"vaazlar",
+	"vadide",
+	"vadiden",
+	"vadiler",
+	"vadiyle",
+	"vagon",
+	"vagonda",
+	"vagondan",
+	"vagonla",
+	"vagonlar",
+	"vakitle",
+	"vakitler",
+	"vakitte",
+	"vakitten",
+	"vapurda",
+	"vapurdan",
+	"vapurla",
+	"vapurlar",
+	"varlık",
+	"varlıkla",
+	"varlıkta",
+	"vatanda",
+	"vatandan",
+	"vatanla",
+	"vatanlar",
+	"vazoda",
+	"vazodan",
+	"vazolar",
+	"vazoyla",
+	"verdi",
+	"verdik",
+	"verdiler",
+	"verdim",
+	"verdin",
+	"verecek",
+	"veren",
+	"vergide",
+	"vergiden",
+	"vergiler",
+	"vergiyle",
+	"veriyor",
+	"verme",
+	"vermedi",
+	"vermedik",
+	"vermedim",
+	"vermiş",
+	"veya",
+	"vitrin",
+	"vitrinde",
+	"vitrinle",
+	"yabani",
+	"yabanide",
+	"yakut",
+	"yakutla",
+	"yakutlar",
+	"yakutta",
+	"yakuttan",
+	"yakında",
+	"yakından",
+	"yakınla",
+	"yakınlar",
+	"yalanda",
+	"yalandan",
+	"yalanla",
+	"yalanlar",
+	"yalnız",
+	"yamaç",
+	"yamaçla",
+	"yamaçlar",
+	"yamaçta",
+	"yamaçtan",
+	"yanakla",
+	"yanaklar",
+	"yanakta",
+	"yanaktan",
+	"yani",
+	"yanlışla",
+	"yanlışta",
+	"yapacak",
+	"yapan",
+	"yapma",
+	"yapmadı",
+	"yapmadık",
+	"yapmadım",
+	"yapmış",
+	"yaprakla",
+	"yaprakta",
+	"yaptı",
+	"yaptık",
+	"yaptılar",
+	"yaptım",
+	"yaptın",
+	"yapıyor",
+	"yarışla",
+	"yarışlar",
+	"yarışta",
+	"yarıştan",
+	"yastıkla",
+	"yastıkta",
+	"yatakla",
+	"yataklar",
+	"yatakta",
+	"yataktan",
+	"yatırım",
+	"yaylada",
+	"yayladan",
+	"yaylalar",
+	"yaylayla",
+	"yazacak",
+	"yazan",
+	"yazarda",
+	"yazardan",
+	"yazarla",
+	"yazarlar",
+	"yazdı",
+	"yazdık",
+	"yazdılar",
+	"yazdım",
+	"yazdın",
+	"yazma",
+	"yazmadı",
+	"yazmadık",
+	"yazmadım",
+	"yazmış",
+	"yazıda",
+	"yazıdan",
+	"yazılar",
+	"yazıyla",
+	"yazıyor",
+	"yağda",
+	"yağdan",
+	"yağla",
+	"yağlar",
+	"yağlarda",
+	"yağmurda",
+	"yağmurla",
+	"yağış",
+	"yağışla",
+	"yağışlar",
+	"yağışta",
+	"yağıştan",
+	"yaşlıda",
+	"yaşlıdan",
+	"yaşlılar",
+	"yaşlıyla",
+	"yedi",
+	"yedik",
+	"yedim",
+	"yelekle",
+	"yelekler",
+	"yelekte",
+	"yelekten",
+	"yemekle",
+	"yemekler",
+	"yemekte",
+	"yemekten",
+	"yemiş",
+	"yemişle",
+	"yemişler",
+	"yemişte",
+	"yemişten",
+	"yengeçle",
+	"yengeçte",
+	"yenide",
+	"yeniden",
+	"yeniler",
+	"yeniyle",
+	"yerde",
+	"yerden",
+	"yerle",
+	"yerler",
+	"yerlerde",
+	"yetmiş",
+	"yeşilde",
+	"yeşilden",
+	"yeşille",
+	"yeşiller",
+	"yine",
+	"yirmi",
+	"yiyecek",
+	"yiyor",
+	"yokuşla",
+	"yokuşlar",
+	"yokuşta",
+	"yokuştan",
+	"yolda",
+	"yoldan",
+	"yolla",
+	"yollar",
+	"yollarda",
+	"yorganda",
+	"yorganla",
+	"yorgunda",
+	"yorgunla",
+	"yosunda",
+	"yosundan",
+	"yosunla",
+	"yosunlar",
+	"yudumda",
+	"yudumdan",
+	"yudumla",
+	"yudumlar",
+	"yukarı",
+	"yular",
+	"yularda",
+	"yulardan",
+	"yularla",
+	"yularlar",
+	"yumrukla",
+	"yumrukta",
+	"yurtla",
+	"yurtlar",
+	"yurtta",
+	"yurttan",
+	"yönetim",
+	"yüklük",
+	"yüklükle",
+	"yüklükte",
+	"yüzde",
+	"yüzden",
+	"yüzdü",
+	"yüzdük",
+	"yüzdüler",
+	"yüzdüm",
+	"yüzdün",
+	"yüzecek",
+	"yüzen",
+	"yüzle",
+	"yüzler",
+	"yüzlerde",
+	"yüzme",
+	"yüzmede",
+	"yüzmeden",
+	"yüzmedi",
+	"yüzmedik",
+	"yüzmedim",
+	"yüzmeler",
+	"yüzmeyle",
+	"yüzmüş",
+	"yüzükle",
+	"yüzükler",
+	"yüzükte",
+	"yüzükten",
+	"yüzüyor",
+	"yılda",
+	"yıldan",
+	"yıldızda",
+	"yıldızla",
+	"yılla",
+	"yıllar",
+	"yıllarda",
+	"yığın",
+	"yığında",
+	"yığından",
+	"yığınla",
+	"yığınlar",
+	"zaferde",
+	"zaferden",
+	"zaferle",
+	"zaferler",
+	"zamanda",
+	"zamandan",
+	"zamanla",
+	"zamanlar",
+	"zambakla",
+	"zambakta",
+	"zamir",
+	"zamirde",
+	"zamirden",
+	"zamirle",
+	"zamirler",
+	"zarfla",
+	"zarflar",
+	"zarfta",
+	"zarftan",
+	"zayıfla",
+	"zayıflar",
+	"zayıfta",
+	"zayıftan",
+	"zeki",
+	"zekide",
+	"zekiden",
+	"zekiler",
+	"zekiyle",
+	"zeminde",
+	"zeminden",
+	"zeminle",
+	"zeminler",
+	"zenginde",
+	"zenginle",
+	"zerre",
+	"zerrede",
+	"zerreden",
+	"zerreler",
+	"zerreyle",
+	"zeytinde",
+	"zeytinle",
+	"zincirde",
+	"zincirle",
+	"zırh",
+	"zırhla",
+	"zırhlar",
+	"zırhta",
+	"zırhtan",
+	"çadırda",
+	"çadırdan",
+	"çadırla",
+	"çadırlar",
+	"çakalda",
+	"çakaldan",
+	"çakalla",
+	"çakallar",
+	"çakıl",
+	"çakılda",
+	"çakıldan",
+	"çakılla",
+	"çakıllar",
+	"çalacak",
+	"çalan",
+	"çaldı",
+	"çaldık",
+	"çaldılar",
+	"çaldım",
+	"çaldın",
+	"çalma",
+	"çalmadı",
+	"çalmadık",
+	"çalmadım",
+	"çalmış",
+	"çalıyor",
+	"çamurda",
+	"çamurdan",
+	"çamurla",
+	"çamurlar",
+	"çantada",
+	"çantadan",
+	"çantalar",
+	"çantayla",
+	"çarşaf",
+	"çarşafla",
+	"çarşafta",
+	"çarşamba",
+	"çarşıda",
+	"çarşıdan",
+	"çarşılar",
+	"çarşıyla",
+	"çatalda",
+	"çataldan",
+	"çatalla",
+	"çatallar",
+	"çatıda",
+	"çatıdan",
+	"çatılar",
+	"çatıyla",
+	"çayda",
+	"çaydan",
+	"çayla",
+	"çaylar",
+	"çaylarda",
+	"çekecek",
+	"çeken",
+	"çekiyor",
+	"çekiçle",
+	"çekiçler",
+	"çekiçte",
+	"çekiçten",
+	"çekme",
+	"çekmece",
+	"çekmedi",
+	"çekmedik",
+	"çekmedim",
+	"çekmiş",
+	"çekti",
+	"çektik",
+	"çektiler",
+	"çektim",
+	"çektin",
+	"çelikle",
+	"çelikler",
+	"çelikte",
+	"çelikten",
+	"çenede",
+	"çeneden",
+	"çeneler",
+	"çeneyle",
+	"çiftle",
+	"çiftler",
+	"çiftte",
+	"çiftten",
+	"çimende",
+	"çimenden",
+	"çimenle",
+	"çimenler",
+	"çizgide",
+	"çizgiden",
+	"çizgiler",
+	"çizgiyle",
+	"çizmede",
+	"çizmeden",
+	"çizmeler",
+	"çizmeyle",
+	"çiçekle",
+	"çiçekler",
+	"çiçekte",
+	"çiçekten",
+	"çocukla",
+	"çocuklar",
+	"çocukta",
+	"çocuktan",
+	"çorapla",
+	"çoraplar",
+	"çorapta",
+	"çoraptan",
+	"çorbada",
+	"çorbadan",
+	"çorbalar",
+	"çorbayla",
+	"çıkacak",
+	"çıkan",
+	"çıkma",
+	"çıkmadı",
+	"çıkmadık",
+	"çıkmadım",
+	"çıkmış",
+	"çıktı",
+	"çıktık",
+	"çıktılar",
+	"çıktım",
+	"çıktın",
+	"çıkıyor",
+	"çınarda",
+	"çınardan",
+	"çınarla",
+	"çınarlar",
+	"öbek",
+	"öbekle",
+	"öbekler",
+	"öbekte",
+	"öbekten",
+	"ödevde",
+	"ödevden",
+	"ödevle",
+	"ödevler",
+	"ödülde",
+	"ödülden",
+	"ödülle",
+	"ödüller",
+	"öfkede",
+	"öfkeden",
+	"öfkeler",
+	"öfkeyle",
+	"öldü",
+	"öldük",
+	"öldüler",
+	"öldüm",
+	"öldün",
+	"ölecek",
+	"ölen",
+	"ölme",
+	"ölmedi",
+	"ölmedik",
+	"ölmedim",
+	"ölmüş",
+	"ölmüşler",
+	"ölçek",
+	"ölçekle",
+	"ölçekler",
+	"ölçekte",
+	"ölçekten",
+	"ölçü",
+	"ölçüde",
+	"ölçüden",
+	"ölçüler",
+	"ölçüyle",
+	"ölümde",
+	"ölümden",
+	"ölümle",
+	"ölümler",
+	"ölüyor",
+	"ölüyorum",
+	"ölüyoruz",
+	"ördekle",
+	"ördekler",
+	"ördekte",
+	"ördekten",
+	"örgüde",
+	"örgüden",
+	"örgüler",
+	"örgüyle",
+	"örnekle",
+	"örnekler",
+	"örnekte",
+	"örnekten",
+	"örtü",
+	"örtüde",
+	"örtüden",
+	"örtüler",
+	"örtüyle",
+	"övgü",
+	"övgüde",
+	"övgüden",
+	"övgüler",
+	"övgüyle",
+	"öyküde",
+	"öyküden",
+	"öyküler",
+	"öyküyle",
+	"öyle",
+	"özelde",
+	"özelden",
+	"özelle",
+	"özeller",
+	"öğlede",
+	"öğleden",
+	"öğleler",
+	"öğleyle",
+	"öğretmen",
+	"ücretle",
+	"ücretler",
+	"ücrette",
+	"ücretten",
+	"ülkede",
+	"ülkeden",
+	"ülkeler",
+	"ülkeyle",
+	"üzümde",
+	"üzümden",
+	"üzümle",
+	"üzümler",
+	"üçüncü",
+	"ılıkla",
+	"ılıklar",
+	"ılıkta",
+	"ılıktan",
+	"ırmakla",
+	"ırmaklar",
+	"ırmakta",
+	"ırmaktan",
+	"ısırgan",
+	"ışıkla",
+	"ışıklar",
+	"ışıkta",
+	"ışıktan",
+	"şahinde",
+	"şahinden",
+	"şahinle",
+	"şahinler",
+	"şalgam",
+	"şalgamda",
+	"şalgamla",
+	"şansla",
+	"şanslar",
+	"şansta",
+	"şanstan",
+	"şapkada",
+	"şapkadan",
+	"şapkalar",
+	"şapkayla",
+	"şarkıda",
+	"şarkıdan",
+	"şarkılar",
+	"şarkıyla",
+	"şeftali",
+	"şehirde",
+	"şehirden",
+	"şehirle",
+	"şehirler",
+	"şekerde",
+	"şekerden",
+	"şekerle",
+	"şekerler",
+	"şelalede",
+	"şerit",
+	"şeritle",
+	"şeritler",
+	"şeritte",
+	"şeritten",
+	"şiirde",
+	"şiirden",
+	"şiirle",
+	"şiirler",
+	"şimşekle",
+	"şimşekte",
+	"şirketle",
+	"şirkette",
+	"şişede",
+	"şişeden",
+	"şişeler",
+	"şişeyle",
+	"şişman",
+	"şişmanda",
+	"şişmanla",
+	"şoförde",
+	"şoförden",
+	"şoförle",
+	"şoförler",
+	"şubat",
+	"şunlar",
+	"şunu",
+	"şurada",
+	"şöyle",
+}
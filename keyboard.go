@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/layout"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// Layout the keyboard is drawn in.
+var currentLayout = layout.QWERTY
+
+// Use the keyboard layout picked on the command line, or in $WORDLE_LAYOUT,
+// or else the one usual for the locale.
+func useLayout() error {
+	name := *layoutName
+	if name == "" {
+		name = os.Getenv("WORDLE_LAYOUT")
+	}
+	if name != "" {
+		l, err := layout.Find(name)
+		if err != nil {
+			return err
+		}
+		currentLayout = l
+		return nil
+	}
+
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := os.Getenv(key); locale != "" {
+			currentLayout = layout.ForLocale(locale)
+			break
+		}
+	}
+	return nil
+}
+
+type letterMap map[rune]guess
+
+// Build the map of guessed letters and their state for one board. Keys from
+// the map are unsorted, so we just use the alphabet of the language for
+// display.
+func newLetterMap(g *game.Game) letterMap {
+	l := letterMap{}
+	for _, key := range words.Language().Alphabet {
+		l[key] = guess{Letter: key, State: g.Letter(key)}
+	}
+	return l
+}
+
+// Print the map of guessed letters and their state.
+func (l letterMap) render(w io.Writer) {
+	renderKeyboards(w, []letterMap{l})
+}
+
+// Print the keyboard of several boards at once. Each key is split into the
+// same quadrants as the boards on screen, one copy of the letter per board.
+func renderKeyboards(w io.Writer, maps []letterMap) {
+	columns := boardColumns(len(maps))
+
+	// Width of a key, with the gap after it, so that each row can be moved
+	// along by half a key or so, like the rows of a real keyboard.
+	width := columns + 1
+	if len(maps) == 1 {
+		width = 1
+		if currentTiles != textTiles {
+			width = 4
+		}
+	}
+	stagger := max(1, width/2)
+
+	// Print the rows of the layout, with the letters of the language.
+	for k, keys := range currentLayout.Keys(words.Language().Alphabet) {
+		if k > 0 && len(maps) > 1 {
+			fmt.Fprintln(w)
+		}
+
+		for first := 0; first < len(maps); first += columns {
+			fmt.Fprint(w, "  "+strings.Repeat(" ", k*stagger))
+			for i, v := range keys {
+				if i > 0 && (len(maps) > 1 || currentTiles != textTiles) {
+					fmt.Fprint(w, " ")
+				}
+				for _, l := range maps[first:min(first+columns, len(maps))] {
+					fmt.Fprint(w, l[v].key(len(maps) == 1))
+				}
+			}
+			fmt.Fprintln(w)
+		}
+	}
+}
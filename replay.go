@@ -0,0 +1,182 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/history"
+	"github.com/bitmap/wordle/internal/lang"
+	"github.com/bitmap/wordle/internal/prompt"
+)
+
+// Play back a finished game guess by guess, the last one unless an ID is
+// given. Each guess is revealed one tile at a time.
+func runReplay(args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	delay := flags.Duration("delay", time.Second, "time between guesses")
+	step := flags.Bool("step", false, "wait for Enter before each guess")
+	args = parseArgs(flags, args)
+
+	if err := setup(); err != nil {
+		return err
+	}
+
+	e, err := findReplay(firstArg(args))
+	if err != nil {
+		return err
+	}
+	useLanguage(e.Language)
+
+	// Leave the screen as it was if the replay is stopped halfway.
+	defer display.Close()
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+	go func() {
+		<-interrupt
+		display.Close()
+		os.Exit(130)
+	}()
+
+	for played, forward := 0, false; ; {
+		if err := revealReplay(e, played, forward, *delay); err != nil {
+			return err
+		}
+
+		forward = true
+		if *step {
+			switch prompt.Step() {
+			case "q":
+				return nil
+			case "b":
+				played, forward = max(0, played-1), false
+				continue
+			}
+		} else if played < len(e.Guesses) {
+			time.Sleep(*delay)
+		}
+
+		if played == len(e.Guesses) {
+			break
+		}
+		played++
+	}
+
+	// The last frame is kept in view once the replay is over.
+	display.Close()
+	frame, err := replayFrame(e, len(e.Guesses), utf8.RuneCountInString(e.Boards[0].Answer))
+	if err != nil {
+		return err
+	}
+	fmt.Print(frame)
+
+	if e.Won {
+		fmt.Println("Won, " + e.Score() + ".")
+	} else {
+		fmt.Println("Lost. The answer was " + currentTheme.Correct.Escape() + lang.Get(e.Language).Upper(strings.Join(e.Answers(), ", ")) + color.Reset + ".")
+	}
+	return nil
+}
+
+// Returns the game with the given ID, or the last game played.
+func findReplay(arg string) (history.Entry, error) {
+	if arg == "" {
+		entries, err := history.Load()
+		if err != nil {
+			return history.Entry{}, err
+		}
+		if len(entries) == 0 {
+			return history.Entry{}, errors.New("no games played yet")
+		}
+		return entries[len(entries)-1], nil
+	}
+
+	id, err := strconv.Atoi(arg)
+	if err != nil {
+		return history.Entry{}, errors.New(arg + " is not a game ID")
+	}
+
+	e, ok, err := history.Find(id)
+	if err == nil && !ok {
+		err = fmt.Errorf("there is no game %d", id)
+	}
+	return e, err
+}
+
+// Draw a logged game as it stood after a number of guesses. Moving forward,
+// the last guess is revealed one tile at a time first.
+func revealReplay(e history.Entry, played int, forward bool, delay time.Duration) error {
+	if forward && played > 0 {
+		length := utf8.RuneCountInString(e.Boards[0].Answer)
+		for revealed := range length {
+			if err := drawReplay(e, played, revealed); err != nil {
+				return err
+			}
+			time.Sleep(delay / time.Duration(2*length))
+		}
+	}
+
+	return drawReplay(e, played, utf8.RuneCountInString(e.Boards[0].Answer))
+}
+
+// Draw a logged game as it stood after a number of guesses, with only the
+// first tiles of the last guess revealed.
+func drawReplay(e history.Entry, played, revealed int) error {
+	frame, err := replayFrame(e, played, revealed)
+	if err != nil {
+		return err
+	}
+	display.Draw(frame)
+	return nil
+}
+
+// Returns the frame of a logged game as it stood after a number of guesses.
+func replayFrame(e history.Entry, played, revealed int) (string, error) {
+	m, err := replayStep(e, played, revealed)
+	if err != nil {
+		return "", err
+	}
+
+	var frame strings.Builder
+	fmt.Fprintf(&frame, "\nReplay of game %d (%s), guess %d of %d\n", e.ID, e.Mode, played, len(e.Guesses))
+	render(&frame, m)
+	renderKeyboard(&frame, m)
+	return frame.String(), nil
+}
+
+// Rebuild a logged game as it stood after a number of guesses. Tiles of the
+// last guess past the revealed ones are left without a color.
+func replayStep(e history.Entry, played, revealed int) (*game.Multi, error) {
+	opts := game.Options{MaxGuesses: e.MaxGuesses}
+
+	boards := make([]*game.Game, len(e.Boards))
+	for i, b := range e.Boards {
+		feedback, err := e.Feedback(i)
+		if err != nil {
+			return nil, err
+		}
+
+		feedback = feedback[:min(played, len(feedback))]
+		if n := len(feedback); n == played && n > 0 {
+			last := append(game.Feedback(nil), feedback[n-1]...)
+			for j := revealed; j < len(last); j++ {
+				last[j].State = game.Unknown
+			}
+			feedback[n-1] = last
+		}
+
+		boards[i] = game.Restore(b.Answer, feedback, opts)
+	}
+
+	return game.NewMulti(boards...), nil
+}
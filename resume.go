@@ -0,0 +1,104 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bitmap/wordle/internal/code"
+	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/daily"
+	"github.com/bitmap/wordle/internal/prompt"
+	"github.com/bitmap/wordle/internal/saved"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// Offer to pick up the game that was saved last, when wordle is run without
+// any flags. Returns true if it was played.
+func offerResume() (bool, error) {
+	if flag.NFlag() > 0 {
+		return false, nil
+	}
+
+	games, err := saved.Load()
+	if err != nil || len(games) == 0 {
+		return false, err
+	}
+
+	s := games[len(games)-1]
+	if !prompt.Resume(savedTitle(s)) {
+		// Daily puzzles can't be started over, so they are kept.
+		if s.Kind == "daily" {
+			fmt.Println("Pick it up later with: wordle daily " + strings.TrimPrefix(s.Puzzle, "#"))
+			return false, nil
+		}
+		return false, saved.Remove(s.Kind, s.Puzzle)
+	}
+
+	r, restore, err := resumeRound(s)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, color.Red+"could not pick up the saved game: "+err.Error()+color.Reset)
+		return false, saved.Remove(s.Kind, s.Puzzle)
+	}
+
+	play(r)
+	finished := r.finish()
+
+	// Games played after this one use the words that were asked for.
+	if err := restore(); err != nil {
+		return true, err
+	}
+	return true, finished
+}
+
+// Returns the header of a saved game, without rebuilding it.
+func savedTitle(s saved.Game) string {
+	return title(s.Puzzle, modeName(s.Kind, rules{
+		length:   s.Length,
+		boards:   len(s.Answers),
+		absurdle: s.Absurdle,
+		options:  s.Options,
+		words:    s.Words,
+	}))
+}
+
+// Rebuild a saved game with the words it was played with, and return a
+// function that goes back to the word lists in use before. If the game can't
+// be rebuilt, the word lists in use are kept.
+func resumeRound(s saved.Game) (round, func() error, error) {
+	before := words.Current()
+	if err := words.Use(s.Words); err != nil {
+		return round{}, nil, err
+	}
+	restore := func() error { return words.Use(before) }
+
+	m, err := s.Multi()
+	if err != nil {
+		restore()
+		return round{}, nil, err
+	}
+	return round{Multi: m, kind: s.Kind, puzzle: s.Puzzle, started: s.Started}, restore, nil
+}
+
+// Do what the kind of game needs once it is over: daily puzzles are marked
+// as played, and challenges give a result to send back.
+func (r round) finish() error {
+	switch r.kind {
+	case "daily":
+		number, err := daily.Parse(r.puzzle)
+		if err != nil {
+			return err
+		}
+		return daily.Record(number, r.Boards()[0].Result())
+	case "challenge":
+		g := r.Boards()[0]
+		result := code.ChallengeResult{Word: g.Answer(), Language: words.Current().Language, Guesses: g.Result().Guesses}
+		fmt.Println("\nSend this back to the challenger:\n\n  wordle challenge result " + result.String())
+	default:
+		if r.puzzle != "" {
+			fmt.Println("\nPlay this game again with: wordle --code " + r.puzzle)
+		}
+	}
+	return nil
+}
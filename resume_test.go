@@ -0,0 +1,56 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/saved"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+func TestResumeRound(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "six.txt")
+	if err := os.WriteFile(file, []byte("planet\nstream\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	six := words.Source{Name: "six", Answers: file}
+	if err := words.Use(six); err != nil {
+		t.Fatal(err)
+	}
+	g := game.New("planet", game.Options{MaxGuesses: game.DefaultMaxGuesses, Words: words.Dict(), Fold: words.Language()})
+	if _, err := g.Submit("stream"); err != nil {
+		t.Fatal(err)
+	}
+	s := saved.New(game.NewMulti(g), "", "", time.Now())
+
+	if err := words.Use(words.Source{}); err != nil {
+		t.Fatal(err)
+	}
+	r, restore, err := resumeRound(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if words.Current() != six || r.Played() != 1 {
+		t.Errorf("resumed with %+v after %d guesses", words.Current(), r.Played())
+	}
+
+	if err := restore(); err != nil {
+		t.Fatal(err)
+	}
+	if words.Current() != (words.Source{}) || len(words.Answers(words.DefaultLength)) == 0 {
+		t.Errorf("still playing with %+v after the resumed game", words.Current())
+	}
+
+	// A game that can't be rebuilt keeps the words in use.
+	s.Guesses = []string{"zzzzzz"}
+	if _, _, err := resumeRound(s); err == nil {
+		t.Error("rebuilt a game with a guess that is not a word")
+	}
+	if words.Current() != (words.Source{}) {
+		t.Errorf("left playing with %+v", words.Current())
+	}
+}
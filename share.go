@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bitmap/wordle/internal/history"
+	"github.com/bitmap/wordle/internal/share"
+	"github.com/bitmap/wordle/internal/theme"
+)
+
+// Print the share block of a finished game, the last one unless an ID is
+// given.
+func runShare(args []string) error {
+	flags := flag.NewFlagSet("share", flag.ExitOnError)
+	format := flags.String("format", "text", "where the result is pasted: "+strings.Join(formatNames(), ", "))
+	contrast := flags.Bool("contrast", false, "orange and blue squares instead of green and yellow, like -theme contrast")
+	copy := flags.Bool("copy", false, "also copy the result to the terminal clipboard")
+	imageFile := flags.String("image", "", "draw the result to a .png or .svg file instead")
+	letters := flags.Bool("letters", false, "draw the guesses on the image tiles")
+	flags.StringVar(themeName, "theme", *themeName, "draw the result with this theme")
+	args = parseArgs(flags, args)
+
+	if err := setup(); err != nil {
+		return err
+	}
+
+	f, ok := share.Formats[*format]
+	if !ok {
+		return fmt.Errorf("unknown format %s, use one of %s", *format, strings.Join(formatNames(), ", "))
+	}
+
+	e, err := findReplay(firstArg(args))
+	if err != nil {
+		return err
+	}
+
+	opts := share.Options{Format: f, Theme: currentTheme, Letters: *letters}
+	if *contrast {
+		if opts.Theme, err = theme.Load("contrast"); err != nil {
+			return err
+		}
+	}
+	if *imageFile != "" {
+		return writeImage(*imageFile, e, opts)
+	}
+	return printShare(e, opts, *copy)
+}
+
+// Draw a game to an image file, as PNG or SVG by the file extension.
+func writeImage(name string, e history.Entry, opts share.Options) error {
+	var draw func(io.Writer, history.Entry, share.Options) error
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".png":
+		draw = share.PNG
+	case ".svg":
+		draw = share.SVG
+	default:
+		return errors.New("the image must be a .png or .svg file")
+	}
+
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if err := draw(f, e, opts); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Println("Saved " + name)
+	return nil
+}
+
+// Print the share block of a game and copy it if asked to.
+func printShare(e history.Entry, opts share.Options, copy bool) error {
+	text, err := share.Summary(e, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(text)
+	if copy {
+		return share.Copy(text)
+	}
+	return nil
+}
+
+// Returns the names of the share formats, sorted.
+func formatNames() []string {
+	var names []string
+	for name := range share.Formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/stats"
+)
+
+// Widest bar of the guess distribution.
+const maxBarWidth = 30
+
+// Print the stats of every mode played, or of the modes given.
+func showStats(args []string) error {
+	if err := setup(); err != nil {
+		return err
+	}
+
+	all, err := stats.Load()
+	if err != nil {
+		return err
+	}
+
+	var modes []string
+	for mode := range all {
+		if len(args) == 0 || slices.Contains(args, mode) {
+			modes = append(modes, mode)
+		}
+	}
+
+	if len(modes) == 0 {
+		if len(args) > 0 {
+			return errors.New("no games played in " + strings.Join(args, " or "))
+		}
+		fmt.Println("No games played yet.")
+		return nil
+	}
+
+	// Classic games first, then the rest by name.
+	slices.SortFunc(modes, func(a, b string) int {
+		switch {
+		case a == b:
+			return 0
+		case a == "classic":
+			return -1
+		case b == "classic":
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+
+	for _, mode := range modes {
+		renderStats(mode, all[mode])
+	}
+	return nil
+}
+
+// Print the stats of one mode with a histogram of the guesses it took to win.
+func renderStats(mode string, s *stats.Stats) {
+	fmt.Println("\n  " + color.BrightWhite + strings.ToUpper(mode) + color.Reset + "\n")
+
+	fmt.Printf("  %6s %6s %8s %8s\n", "Played", "Win %", "Current", "Max")
+	fmt.Printf("  %6s %6s %8s %8s\n", "", "", "Streak", "Streak")
+	fmt.Printf("  %6d %6d %8d %8d\n\n", s.Played, s.WinPercent(), s.CurrentStreak, s.MaxStreak)
+
+	fmt.Println("  Guess Distribution")
+
+	rows := max(len(s.Distribution), game.DefaultMaxGuesses)
+	most := slices.Max(append([]int{1}, s.Distribution...))
+
+	for i := range rows {
+		count := 0
+		if i < len(s.Distribution) {
+			count = s.Distribution[i]
+		}
+
+		bar := strings.Repeat("█", count*maxBarWidth/most)
+		if count > 0 && bar == "" {
+			bar = "▏"
+		}
+
+		fmt.Printf("  %2d %s %d\n", i+1, color.Gray+bar+color.Reset, count)
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"os"
+
+	"github.com/bitmap/wordle/internal/theme"
+)
+
+// Theme the game is drawn with.
+var currentTheme = theme.Classic
+
+// Use the theme picked on the command line, or in $WORDLE_THEME.
+func useTheme() error {
+	name := *themeName
+	if name == "" {
+		name = os.Getenv("WORDLE_THEME")
+	}
+	if name == "" {
+		return nil
+	}
+
+	t, err := theme.Load(name)
+	if err != nil {
+		return err
+	}
+	currentTheme = t
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// tileStyle is how the tiles of the board and the keys of the keyboard are
+// drawn.
+type tileStyle int
+
+const (
+	// Colored letters, between the marks of the theme.
+	textTiles tileStyle = iota
+	// Letters on cells filled with the color of their state, like the web
+	// game.
+	paddedTiles
+	// Letters in box-drawn cells, three lines high.
+	boxedTiles
+)
+
+// Tile styles by name, as given on the command line.
+var tileStyles = map[string]tileStyle{
+	"text":   textTiles,
+	"padded": paddedTiles,
+	"boxed":  boxedTiles,
+}
+
+// Style the tiles are drawn in.
+var currentTiles = textTiles
+
+// Use the tile style picked on the command line.
+func useTiles() error {
+	t, ok := tileStyles[strings.ToLower(*tilesName)]
+	if !ok {
+		return fmt.Errorf("unknown tile style %s, use text, padded or boxed", *tilesName)
+	}
+	currentTiles = t
+	return nil
+}
+
+// Returns the number of lines a row of the board takes.
+func tileHeight() int {
+	if currentTiles == boxedTiles {
+		return 3
+	}
+	return 1
+}
+
+// Returns the lines guess is drawn with as a tile of the board, from top to
+// bottom.
+func (g guess) lines() []string {
+	switch currentTiles {
+	case paddedTiles:
+		return []string{g.filled() + " "}
+	case boxedTiles:
+		border := currentTheme.Style(g.State).Border()
+		return []string{
+			border + "┌───┐" + color.Reset,
+			border + "│" + color.Reset + g.filled() + border + "│" + color.Reset,
+			border + "└───┘" + color.Reset,
+		}
+	}
+	return []string{g.Tile()}
+}
+
+// Returns guess rune between the marks of its state, on a cell filled with
+// the color of the state. Letters that are not scored yet are left unfilled,
+// like the empty tiles of the web game.
+func (g guess) filled() string {
+	if g.State == game.Unknown {
+		return g.Tile()
+	}
+	s := currentTheme.Style(g.State)
+	return s.Filled() + s.Mark(words.Language().Upper(string(g.Letter))) + color.Reset
+}
+
+// Returns guess rune as a key of the keyboard. Keys are filled with the color
+// of their state unless tiles are drawn as text, and padded when there is
+// room for it.
+func (g guess) key(padded bool) string {
+	if currentTiles == textTiles {
+		return g.String()
+	}
+
+	letter := words.Language().Upper(string(g.Letter))
+	if padded {
+		letter = " " + letter + " "
+	}
+	return currentTheme.Style(g.State).Filled() + letter + color.Reset
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/prompt"
+	"github.com/bitmap/wordle/internal/term"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// Keyboard of the terminal while guesses are typed into the board, as it was
+// before. Nil while guesses are typed at a prompt.
+var (
+	rawKeys   *term.State
+	rawKeysMu sync.Mutex
+)
+
+// Start typing guesses straight into the board, if the terminal allows it.
+// Otherwise guesses are typed at a prompt below the board.
+func startTyping() {
+	if *linePrompt || !display.Full() {
+		return
+	}
+
+	state, err := term.Raw(os.Stdin)
+	if err != nil {
+		return
+	}
+
+	rawKeysMu.Lock()
+	rawKeys = state
+	rawKeysMu.Unlock()
+	display.HideCursor()
+}
+
+// Stop typing into the board, leaving the keyboard of the terminal as it was.
+// It is safe to call more than once, and from another goroutine.
+func stopTyping() {
+	rawKeysMu.Lock()
+	defer rawKeysMu.Unlock()
+
+	if rawKeys != nil {
+		rawKeys.Restore()
+		rawKeys = nil
+	}
+}
+
+// Returns true if guesses are typed into the board.
+func typing() bool {
+	rawKeysMu.Lock()
+	defer rawKeysMu.Unlock()
+	return rawKeys != nil
+}
+
+// Draw the game and read the next guess. A message, like why the last guess
+// was not taken, is shown above the board. When typing into the board, the
+// letters of that guess are still there to be fixed.
+func readGuess(r round, message, typed string) (string, error) {
+	if !typing() {
+		drawGame(r, message, "")
+		return prompt.Guess()
+	}
+
+	length := r.Boards()[0].WordLength()
+	for {
+		drawGame(r, message, words.Language().Fold(typed))
+
+		key, err := prompt.ReadKey()
+		if err != nil {
+			return "", err
+		}
+
+		switch key.Kind {
+		case prompt.Letter:
+			if next := typed + string(key.Rune); utf8.RuneCountInString(words.Language().Fold(next)) <= length {
+				typed = next
+			}
+			message = ""
+		case prompt.Backspace:
+			if _, size := utf8.DecodeLastRuneInString(typed); size > 0 {
+				typed = typed[:len(typed)-size]
+			}
+			message = ""
+		case prompt.Enter:
+			if typed == "" {
+				message = color.Red + "type a word first" + color.Reset
+				continue
+			}
+			return typed, nil
+		case prompt.Interrupt:
+			suspend(r.Multi, 130)
+		}
+	}
+}
+
+// Draw the boards and keyboard of a game, with the letters typed so far.
+func drawGame(r round, message, typed string) {
+	var frame strings.Builder
+	frame.WriteString(message + "\n" + r.title() + "\n")
+	renderTyped(&frame, r.Multi, typed)
+	renderKeyboard(&frame, r.Multi)
+	if typing() {
+		frame.WriteString("\n  Type a word and press Enter.\n")
+	}
+	display.Draw(frame.String())
+}
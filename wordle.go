@@ -2,218 +2,565 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
+	"math/rand/v2"
 	"os"
-	"os/exec"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
+	"github.com/bitmap/wordle/game"
+	"github.com/bitmap/wordle/internal/code"
 	"github.com/bitmap/wordle/internal/color"
+	"github.com/bitmap/wordle/internal/history"
+	"github.com/bitmap/wordle/internal/lang"
+	"github.com/bitmap/wordle/internal/layout"
 	"github.com/bitmap/wordle/internal/prompt"
+	"github.com/bitmap/wordle/internal/saved"
+	"github.com/bitmap/wordle/internal/screen"
+	"github.com/bitmap/wordle/internal/share"
+	"github.com/bitmap/wordle/internal/stats"
 	"github.com/bitmap/wordle/internal/words"
 )
 
-const wordLength = 5
-const totalGuesses = 6
-const emptySpaceRune = '•'
-const aplhabet = "abcdefghijklmnopqrstuvwxyz"
-
-type letterState int
-
-const (
-	_ letterState = iota
-	isGuessed
-	isInWord
-	isCorrect
+var (
+	wordLength = flag.Int("length", words.DefaultLength, fmt.Sprintf("number of letters in the word (%d to %d)", words.MinLength, words.MaxLength))
+	maxGuesses = flag.Int("guesses", game.DefaultMaxGuesses, "number of guesses allowed")
+	zen        = flag.Bool("zen", false, "unlimited guesses")
+	hard       = flag.Bool("hard", false, "revealed hints must be used in later guesses")
+	ultraHard  = flag.Bool("ultra", false, "like -hard, and eliminated letters and positions may not be reused")
+	absurdle   = flag.Bool("absurdle", false, "the answer dodges your guesses for as long as it can")
+	boardCount = flag.Int("boards", 1, "play 2 (dordle), 4 (quordle) or 8 (octordle) boards at once")
+	seed       = flag.Uint64("seed", 0, "pick the answer with this seed instead of at random")
+	gameCode   = flag.String("code", "", "play the game with this code")
+	language   = flag.String("lang", "", "play in another language: "+strings.Join(lang.Codes(), ", "))
+	answers    = flag.String("answers", "", "pick answers from this file, one word per line")
+	allowed    = flag.String("allowed", "", "allow guesses from this file, one word per line")
+	pack       = flag.String("pack", "", "play with a word pack from the config directory")
+	extend     = flag.Bool("extend", false, "add the words of -answers, -allowed or -pack to the built-in ones")
+	shareGame  = flag.Bool("share", false, "print a spoiler-free result to paste into chat when the game is over")
+	colorMode  = flag.String("color", "auto", "when to use colors: auto, always or never")
+	tilesName  = flag.String("tiles", "text", "draw tiles as colored text, padded cells or boxed cells: text, padded or boxed")
+	linePrompt = flag.Bool("prompt", false, "type guesses at a prompt below the board instead of into it")
+	layoutName = flag.String("layout", "", "keyboard layout: "+strings.Join(layout.Names(), ", ")+" (by default the one of your locale)")
+	themeName  = flag.String("theme", "", "draw the game with this theme: classic, contrast, mono or one of your own")
 )
 
-type guess struct {
-	value rune
-	state letterState
+// Screen games are drawn on.
+var display = screen.New(os.Stdout)
+
+// Names of the multi-board modes, by number of boards.
+var boardNames = map[int]string{
+	2: "dordle",
+	4: "quordle",
+	8: "octordle",
 }
 
-// Prints guess rune in color.
-func (g guess) Render() {
-	var keyColor string
+// rules are what a game is played by, as far as its mode goes.
+type rules struct {
+	length   int
+	boards   int
+	absurdle bool
+	options  game.Options
+	words    words.Source
+}
 
-	switch g.state {
-	case isCorrect:
-		keyColor = color.Green
-	case isInWord:
-		keyColor = color.Yellow
-	case isGuessed:
-		keyColor = color.Gray
-	default:
-		keyColor = color.White
+// Returns the rules of a game played with the word lists in use.
+func rulesOf(m *game.Multi) rules {
+	g := m.Boards()[0]
+	return rules{
+		length:   g.WordLength(),
+		boards:   len(m.Boards()),
+		absurdle: g.Absurdle(),
+		options:  g.Options(),
+		words:    words.Current(),
 	}
-
-	print(keyColor + strings.ToUpper(string(g.value)) + color.Reset)
 }
 
-type gameGrid [totalGuesses][wordLength]guess
+// List the rules that differ from a standard game.
+func modes(r rules) []string {
+	var modes []string
+
+	if r.length != words.DefaultLength {
+		modes = append(modes, fmt.Sprint(r.length)+" letters")
+	}
+	if name, ok := boardNames[r.boards]; ok {
+		modes = append(modes, name)
+	}
+	if r.absurdle {
+		modes = append(modes, "absurdle")
+	}
 
-// Game is a x * y grid.
-var game gameGrid
+	switch n := r.options.MaxGuesses; {
+	case n == game.Unlimited:
+		modes = append(modes, "zen")
+	case n == 1:
+		modes = append(modes, "1 guess")
+	case n != game.MultiGuesses(r.boards):
+		modes = append(modes, fmt.Sprint(n)+" guesses")
+	}
 
-// Print the current state of the game.
-func (g gameGrid) render() {
-	for i := range g {
-		fmt.Print(" ")
-		for j := range g[i] {
-			currentChar := g[i][j]
-			fmt.Print(" ")
-			currentChar.Render()
-			fmt.Print(" ")
-		}
-		fmt.Println()
+	if d := r.options.Difficulty; d != game.Normal {
+		modes = append(modes, d.String()+" mode")
 	}
-	fmt.Println()
-}
 
-// Initialize the game grid.
-func (g gameGrid) init() {
-	for i := range game {
-		for j := range game[i] {
-			game[i][j] = guess{
-				value: emptySpaceRune,
-				state: 0,
-			}
-		}
+	if l := lang.Get(r.words.Language); l != lang.English {
+		modes = append(modes, l.Name)
 	}
+	if r.words.Name != "" {
+		modes = append(modes, r.words.Name+" words")
+	}
+
+	return modes
 }
 
-type letterMap map[rune]guess
+// round is a game being played, with what it is called.
+type round struct {
+	*game.Multi
+	kind    string    // daily, challenge, or empty for a random game
+	puzzle  string    // puzzle number or game code, if there is one
+	started time.Time // when a saved game was first started
+}
 
-// Map of all guessed letters.
-var guessedLetters = letterMap{}
+// Returns the mode the game is kept under in stats and history: the kind of
+// game, then any rules that differ from a standard game.
+func (r round) mode() string {
+	return modeName(r.kind, rulesOf(r.Multi))
+}
 
-// Print the map of guessed letters and their state.
-func (l letterMap) render() {
-	fmt.Print("  ")
+// Returns the header shown above the board.
+func (r round) title() string {
+	return title(r.puzzle, r.mode())
+}
 
-	// Print used keys a-m.
-	for _, v := range aplhabet[0:13] {
-		l[v].Render()
+// Returns the mode of a kind of game played by the given rules.
+func modeName(kind string, r rules) string {
+	modes := modes(r)
+	if kind != "" {
+		modes = append([]string{kind}, modes...)
 	}
+	if len(modes) == 0 {
+		return "classic"
+	}
+	return strings.Join(modes, ", ")
+}
 
-	fmt.Println()
-	fmt.Print("  ")
-
-	// Print used keys n-z.
-	for _, v := range aplhabet[13:] {
-		l[v].Render()
+// Returns the header of a game with the given puzzle and mode.
+func title(puzzle, mode string) string {
+	title := "Welcome to Wordle"
+	if puzzle != "" {
+		title += " " + puzzle
+	}
+	if mode != "classic" {
+		title += " (" + mode + ")"
 	}
+	return title
+}
 
-	fmt.Println()
+// Returns the rules picked on the command line.
+func options() game.Options {
+	opts := game.Options{MaxGuesses: *maxGuesses, Words: words.Dict(), Fold: words.Language()}
+	switch {
+	case *ultraHard:
+		opts.Difficulty = game.UltraHard
+	case *hard:
+		opts.Difficulty = game.Hard
+	}
+	if *zen {
+		opts.MaxGuesses = game.Unlimited
+	}
+	return opts
 }
 
-// Initialize the letters map. Keys from the guessedLetters are unsorted,
-// so we just use the slice for display
-func (g letterMap) init() {
-	for _, key := range aplhabet {
-		guessedLetters[key] = guess{
-			value: key,
-			state: 0,
+// Start a new game with the rules picked on the command line. The seed picks
+// the answers.
+func newGame(seed uint64) *game.Multi {
+	opts := options()
+	if *absurdle {
+		return game.NewMulti(game.NewAbsurdle(words.Answers(*wordLength), opts))
+	}
+
+	picker := words.NewPicker(seed)
+	boards := make([]*game.Game, *boardCount)
+	answers := map[string]bool{}
+	for i := range boards {
+		// Every board gets a different word.
+		answer := picker.Answer(*wordLength)
+		for answers[answer] {
+			answer = picker.Answer(*wordLength)
 		}
+		answers[answer] = true
+		boards[i] = game.New(answer, opts)
 	}
+
+	return game.NewMulti(boards...)
 }
 
-func clearScreen() {
-	cmd := exec.Command("clear")
-	cmd.Stdout = os.Stdout
-	err := cmd.Run()
-	if err != nil {
-		panic(err)
+// Print every board of the game.
+func render(w io.Writer, m *game.Multi) {
+	renderTyped(w, m, "")
+}
+
+// Print every board of the game, with the letters typed so far in the next
+// row.
+func renderTyped(w io.Writer, m *game.Multi, typed string) {
+	grids := make([]gameGrid, len(m.Boards()))
+	for i := range grids {
+		grids[i] = newGameGrid(m, i, typed)
 	}
+	renderGrids(w, grids)
 }
 
-func main() {
-	var (
-		answer     = words.RandomAnswer()
-		winFlag    = false
-		guessCount = 0
-	)
+// Print the keyboard of every board.
+func renderKeyboard(w io.Writer, m *game.Multi) {
+	maps := make([]letterMap, len(m.Boards()))
+	for i, b := range m.Boards() {
+		maps[i] = newLetterMap(b)
+	}
+	renderKeyboards(w, maps)
+}
 
-	game.init()
-	guessedLetters.init()
-	clearScreen()
+// Play a single game in the terminal, then keep score and log it.
+func play(r round) {
+	m := r.Multi
+	started := r.started
+	if started.IsZero() {
+		started = time.Now()
+	}
 
-	// Loop until we're out of guesses.
-	for guessCount < totalGuesses {
-		fmt.Println("\nWelcome to Wordle")
+	// The game is saved after every guess, so an interrupt only has to say
+	// how to get back to it.
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-interrupt:
+			suspend(m, 130)
+		case <-done:
+		}
+	}()
 
-		// Print state of the game
-		game.render()
-		guessedLetters.render()
+	defer display.Close()
 
-		// Get user input
-		currentGuess, err := prompt.Guess()
+	startTyping()
+	defer stopTyping()
 
+	// Loop until the game is won or we're out of guesses.
+	var message, typed string
+	for m.Status() == game.Playing {
+		// Print state of the game and get user input
+		currentGuess, err := readGuess(r, message, typed)
 		if err != nil {
-			clearScreen()
-			fmt.Print(color.Red + err.Error() + color.Reset)
-			continue
-		}
-
-		for i := range game[guessCount] {
-			charValue := rune(currentGuess[i])
-			var charState letterState
-
-			switch {
-			// Check if it's the same character at that index...
-			case rune(answer[i]) == charValue:
-				charState = isCorrect
-			// ...or string contains the character elsewhere
-			case strings.ContainsRune(answer, charValue):
-				charState = isInWord
-			default:
-				charState = isGuessed
+			suspend(m, 0)
+		}
+
+		message, typed = "", ""
+		_, err = m.Submit(words.Language().Fold(currentGuess))
+		if err != nil {
+			message = color.Red + err.Error() + color.Reset
+			typed = currentGuess
+		} else if m.Status() == game.Playing {
+			if err := saved.Save(saved.New(m, r.kind, r.puzzle, started)); err != nil {
+				message = color.Red + "could not save game: " + err.Error() + color.Reset
 			}
+		}
+	}
+	stopTyping()
+	display.Close()
 
-			// Update the values
-			game[guessCount][i].value = charValue
-			game[guessCount][i].state = charState
+	if err := saved.Remove(r.kind, r.puzzle); err != nil {
+		fmt.Fprintln(os.Stderr, color.Red+"could not remove saved game: "+err.Error()+color.Reset)
+	}
 
-			// For letters map, check previous state if placement differs
-			currentCharState := guessedLetters[charValue].state
-			if charState > currentCharState {
-				currentCharState = charState
-			}
+	// Print final game state
+	fmt.Println("\n    Game Over")
+	gameOver(m)
 
-			// Update the character in the letters map
-			guessedLetters[charValue] = guess{
-				value: charValue,
-				state: currentCharState,
-			}
-		}
+	if err := stats.Record(r.mode(), m.Status() == game.Won, m.Played()); err != nil {
+		fmt.Fprintln(os.Stderr, color.Red+"could not save stats: "+err.Error()+color.Reset)
+	}
 
-		// Increment the guess counter
-		guessCount++
+	entry := history.NewEntry(m, r.mode(), r.puzzle, started, time.Now())
+	if _, err := history.Add(entry); err != nil {
+		fmt.Fprintln(os.Stderr, color.Red+"could not save game: "+err.Error()+color.Reset)
+	}
 
-		// Stop looping if we found the answer
-		if currentGuess == answer {
-			winFlag = true
-			break
+	if *shareGame {
+		fmt.Println()
+		if err := printShare(entry, share.Options{Theme: currentTheme}, false); err != nil {
+			fmt.Fprintln(os.Stderr, color.Red+err.Error()+color.Reset)
 		}
-		clearScreen()
 	}
+}
 
-	// Print final game state
-	clearScreen()
-	fmt.Println("\n    Game Over")
-	game.render()
+// Leave a game that was saved to be picked up later. Games without a guess
+// have nothing to save.
+func suspend(m *game.Multi, code int) {
+	stopTyping()
+	display.Close()
+	if m.Played() > 0 {
+		fmt.Fprintln(os.Stderr, "\n\nYour game is saved. Run wordle to pick it up again.")
+	}
+	os.Exit(code)
+}
 
-	if winFlag {
+// Print the final state of a game and how it went.
+func gameOver(m *game.Multi) {
+	render(os.Stdout, m)
+
+	guessCount := m.Played()
+
+	if m.Status() == game.Won {
 		if guessCount == 1 {
 			fmt.Println("🫨 Woah! You got it right on the first try! Nice!")
 		} else {
 			fmt.Println("🎉 Correct! You won in " + fmt.Sprint(guessCount) + " guesses.")
 		}
+		return
+	}
+
+	var missed []string
+	for _, b := range m.Boards() {
+		if b.Status() != game.Won {
+			missed = append(missed, currentTheme.Correct.Escape()+b.Answer()+color.Reset)
+		}
+	}
+
+	if len(missed) == 1 {
+		fmt.Println("😓 Sorry, the answer was " + missed[0] + ".")
 	} else {
-		fmt.Println("😓 Sorry, the answer was " + color.Green + answer + color.Reset + ".")
+		fmt.Println("😓 Sorry, the answers were " + strings.Join(missed, ", ") + ".")
+	}
+}
+
+// Play a game picked by a seed, showing its code so that it can be shared.
+func playSeeded(seed uint64) {
+	// Absurdle games don't have an answer to share, and games with other
+	// words can't be played again from a code.
+	if *absurdle || customWords() {
+		play(round{Multi: newGame(seed)})
+		return
 	}
 
-	// Ask user to play again
-	if prompt.Retry() {
-		main()
+	c := code.Game{
+		Seed:     seed,
+		Length:   *wordLength,
+		Boards:   *boardCount,
+		Language: words.Current().Language,
+	}
+	r := round{Multi: newGame(seed), puzzle: c.String()}
+	play(r)
+	r.finish()
+}
+
+// Returns true if the flag was given on the command line.
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// Parse flags that may come before or after the arguments of a command, as in
+// "wordle replay 12 -step", and return the arguments.
+func parseArgs(flags *flag.FlagSet, args []string) []string {
+	var rest []string
+	for {
+		flags.Parse(args)
+		args = flags.Args()
+		if len(args) == 0 {
+			return rest
+		}
+		rest, args = append(rest, args[0]), args[1:]
+	}
+}
+
+// Returns the first argument, or "" if there is none.
+func firstArg(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+// Exit with an error message.
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+// Set up how the game is drawn, from the flags and the terminal. Commands
+// with flags of their own call it once those are parsed.
+func setup() error {
+	for _, use := range []func() error{useColor, useTheme, useTiles, useLayout} {
+		if err := use(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Turn colors on or off as picked with --color, or by the terminal.
+func useColor() error {
+	mode, err := color.ParseMode(*colorMode)
+	if err != nil {
+		return err
+	}
+	color.Use(color.Detect(mode))
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	command := flag.Arg(0)
+	var args []string
+	if command != "" {
+		args = flag.Args()[1:]
+	}
+
+	// Commands that don't play a game take flags of their own.
+	switch command {
+	case "stats":
+		if err := showStats(args); err != nil {
+			fail(err)
+		}
+		return
+	case "history":
+		if err := showHistory(args); err != nil {
+			fail(err)
+		}
+		return
+	case "replay":
+		if err := runReplay(args); err != nil {
+			fail(err)
+		}
+		return
+	case "share":
+		if err := runShare(args); err != nil {
+			fail(err)
+		}
+		return
+	}
+
+	// Flags for the game may also follow the command.
+	flag.CommandLine.Parse(args)
+	args = flag.Args()
+
+	// A game code brings its own word length and number of boards.
+	if *gameCode != "" {
+		c, err := code.Parse(*gameCode)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		*seed, *wordLength, *boardCount, *language = c.Seed, c.Length, c.Boards, c.Language
+	}
+
+	if err := (code.Game{Seed: *seed}).Check(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if err := setup(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if err := useWordLists(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	// Codes only pick the same answers from the same words.
+	if (*gameCode != "" || isFlagSet("seed")) && customWords() {
+		fmt.Fprintln(os.Stderr, "game codes and seeds are played with the built-in words")
+		os.Exit(2)
+	}
+
+	if err := words.Check(*wordLength); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if *maxGuesses < 1 {
+		fmt.Fprintln(os.Stderr, "you need at least one guess")
+		os.Exit(2)
+	}
+
+	if _, ok := boardNames[*boardCount]; !ok && *boardCount != 1 {
+		fmt.Fprintln(os.Stderr, "the number of boards must be 1, 2, 4 or 8")
+		os.Exit(2)
+	}
+
+	// Every board gets a different answer.
+	if n := len(words.Answers(*wordLength)); n < *boardCount {
+		fmt.Fprintf(os.Stderr, "there are only %d answers for %d boards\n", n, *boardCount)
+		os.Exit(2)
+	}
+
+	// Every adversary would dodge the same way, so the boards would all be
+	// the same.
+	if *absurdle && *boardCount > 1 {
+		fmt.Fprintln(os.Stderr, "absurdle is played on one board")
+		os.Exit(2)
+	}
+
+	// Multi-board games get a few more guesses.
+	if !isFlagSet("guesses") {
+		*maxGuesses = game.MultiGuesses(*boardCount)
+	}
+
+	// Absurdle takes more than six guesses, so it is unlimited unless the
+	// number of guesses is given.
+	if *absurdle && !isFlagSet("guesses") {
+		*zen = true
+	}
+
+	switch command {
+	case "":
+		resumed, err := offerResume()
+		if err != nil {
+			fail(err)
+		}
+
+		if !resumed {
+			if *gameCode != "" || isFlagSet("seed") {
+				playSeeded(*seed)
+			} else {
+				playSeeded(rand.Uint64N(code.RandomSeeds))
+			}
+		}
+
+		// Ask user to play again
+		for prompt.Retry() {
+			playSeeded(rand.Uint64N(code.RandomSeeds))
+		}
+	case "daily":
+		if words.Current() != (words.Source{}) {
+			fmt.Fprintln(os.Stderr, "daily puzzles are always played with the built-in English words")
+			os.Exit(2)
+		}
+		if err := playDaily(args); err != nil {
+			fail(err)
+		}
+	case "challenge":
+		if err := runChallenge(args); err != nil {
+			fail(err)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, "unknown command "+command)
+		flag.Usage()
+		os.Exit(2)
 	}
 }
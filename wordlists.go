@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bitmap/wordle/internal/lang"
+	"github.com/bitmap/wordle/internal/store"
+	"github.com/bitmap/wordle/internal/words"
+)
+
+// Play with the language and word lists picked on the command line, if any.
+func useWordLists() error {
+	l, err := lang.Find(*language)
+	if err != nil {
+		return err
+	}
+
+	s := words.Source{Answers: *answers, Allowed: *allowed, Extend: *extend}
+	if l != lang.English {
+		s.Language = l.Code
+	}
+
+	switch {
+	case *pack != "":
+		if s.Answers != "" || s.Allowed != "" {
+			return errors.New("use either -pack or -answers and -allowed")
+		}
+
+		p, err := findPack(*pack)
+		if err != nil {
+			return err
+		}
+		s.Name, s.Answers, s.Allowed = p.Name, p.Answers, p.Allowed
+	case s.Answers != "" || s.Allowed != "":
+		s.Name = "custom"
+	}
+
+	if s == (words.Source{}) {
+		return nil
+	}
+	return words.Use(s)
+}
+
+// Returns true if the game is played with word lists of the player's own,
+// rather than the built-in ones.
+func customWords() bool {
+	s := words.Current()
+	return s.Answers != "" || s.Allowed != ""
+}
+
+// Returns the word lists of a pack: a directory in the config directory
+// holding answers.txt, allowed.txt or both.
+func findPack(name string) (words.Source, error) {
+	config, err := store.ConfigDir()
+	if err != nil {
+		return words.Source{}, err
+	}
+
+	dir := filepath.Join(config, "packs", name)
+	s := words.Source{Name: name}
+	if file := filepath.Join(dir, "answers.txt"); exists(file) {
+		s.Answers = file
+	}
+	if file := filepath.Join(dir, "allowed.txt"); exists(file) {
+		s.Allowed = file
+	}
+
+	if s.Answers == "" && s.Allowed == "" {
+		return s, fmt.Errorf("there is no word pack %s: %s has no answers.txt or allowed.txt", name, dir)
+	}
+	return s, nil
+}
+
+// Returns true if the file exists.
+func exists(name string) bool {
+	_, err := os.Stat(name)
+	return err == nil
+}